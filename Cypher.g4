@@ -51,6 +51,7 @@ singleQuery : singlePartQuery
 
 singlePartQuery : ( ( readingClause SP? )* returnClause )
                    | ( ( readingClause SP? )* updatingClause ( SP? updatingClause )* ( SP? returnClause )? )
+                   | ( ( readingClause SP? )* subqueryClause )
                    ;
 
 multiPartQuery : multiPartQueryPartial+ singlePartQuery ;
@@ -67,6 +68,7 @@ updatingClause : createClause
 readingClause : matchClause
                  | unwindClause
                  | inQueryCall
+                 | subqueryClause
                  ;
 
 matchClause : ( OPTIONAL SP )? MATCH SP? pattern ( SP? whereClause )? ;
@@ -125,6 +127,26 @@ CALL : ( 'C' | 'c' ) ( 'A' | 'a' ) ( 'L' | 'l' ) ( 'L' | 'l' )  ;
 
 YIELD : ( 'Y' | 'y' ) ( 'I' | 'i' ) ( 'E' | 'e' ) ( 'L' | 'l' ) ( 'D' | 'd' )  ;
 
+subqueryClause : CALL SP? '{' SP? regularQuery SP? '}' ( SP? inTransactions )? ;
+
+inTransactions : IN SP ( expr SP CONCURRENT SP )? TRANSACTIONS ( SP OF SP expr SP ( ROW | ROWS ) )? ( SP ON SP ERROR SP ( CONTINUE | BREAK | FAIL ) )? ;
+
+CONCURRENT : ( 'C' | 'c' ) ( 'O' | 'o' ) ( 'N' | 'n' ) ( 'C' | 'c' ) ( 'U' | 'u' ) ( 'R' | 'r' ) ( 'R' | 'r' ) ( 'E' | 'e' ) ( 'N' | 'n' ) ( 'T' | 't' )  ;
+
+TRANSACTIONS : ( 'T' | 't' ) ( 'R' | 'r' ) ( 'A' | 'a' ) ( 'N' | 'n' ) ( 'S' | 's' ) ( 'A' | 'a' ) ( 'C' | 'c' ) ( 'T' | 't' ) ( 'I' | 'i' ) ( 'O' | 'o' ) ( 'N' | 'n' ) ( 'S' | 's' )  ;
+
+ROW : ( 'R' | 'r' ) ( 'O' | 'o' ) ( 'W' | 'w' )  ;
+
+ROWS : ( 'R' | 'r' ) ( 'O' | 'o' ) ( 'W' | 'w' ) ( 'S' | 's' )  ;
+
+ERROR : ( 'E' | 'e' ) ( 'R' | 'r' ) ( 'R' | 'r' ) ( 'O' | 'o' ) ( 'R' | 'r' )  ;
+
+CONTINUE : ( 'C' | 'c' ) ( 'O' | 'o' ) ( 'N' | 'n' ) ( 'T' | 't' ) ( 'I' | 'i' ) ( 'N' | 'n' ) ( 'U' | 'u' ) ( 'E' | 'e' )  ;
+
+BREAK : ( 'B' | 'b' ) ( 'R' | 'r' ) ( 'E' | 'e' ) ( 'A' | 'a' ) ( 'K' | 'k' )  ;
+
+FAIL : ( 'F' | 'f' ) ( 'A' | 'a' ) ( 'I' | 'i' ) ( 'L' | 'l' )  ;
+
 standaloneCall : CALL SP ( explicitProcedureInvocation | implicitProcedureInvocation ) ( SP YIELD SP yieldItems )? ;
 
 yieldItems : ( '*' | ( yieldItem ( SP? ',' SP? yieldItem )* ) ) ( SP? whereClause )? ;
@@ -282,6 +304,8 @@ atom : literal
         | parameter
         | caseExpr
         | ( COUNT SP? '(' SP? '*' SP? ')' )
+        | existsSubquery
+        | countSubquery
         | listComprehension
         | patternComprehension
         | ( ALL SP? '(' SP? filterExpr SP? ')' )
@@ -302,6 +326,15 @@ NONE : ( 'N' | 'n' ) ( 'O' | 'o' ) ( 'N' | 'n' ) ( 'E' | 'e' )  ;
 
 SINGLE : ( 'S' | 's' ) ( 'I' | 'i' ) ( 'N' | 'n' ) ( 'G' | 'g' ) ( 'L' | 'l' ) ( 'E' | 'e' )  ;
 
+existsSubquery : EXISTS SP? '{' SP? subqueryBody SP? '}' ;
+
+countSubquery : COUNT SP? '{' SP? subqueryBody SP? '}' ;
+
+subqueryBody : regularQuery
+             | ( readingClause ( SP? readingClause )* )
+             | ( pattern ( SP? whereClause )? )
+             ;
+
 literal : numberLiteral
            | StringLiteral
            | booleanLiteral
@@ -539,6 +572,14 @@ symbolicName : UnescapedSymbolicName
                 | ANY
                 | NONE
                 | SINGLE
+                | CONCURRENT
+                | TRANSACTIONS
+                | ROW
+                | ROWS
+                | ERROR
+                | CONTINUE
+                | BREAK
+                | FAIL
                 ;
 
 FILTER : ( 'F' | 'f' ) ( 'I' | 'i' ) ( 'L' | 'l' ) ( 'T' | 't' ) ( 'E' | 'e' ) ( 'R' | 'r' )  ;
@@ -600,11 +641,11 @@ WHITESPACE : SPACE
               | ' '
               | ' '
               | ' '
-              | Comment
               ;
 
-Comment : ( '/*' ( Comment_1 | ( '*' Comment_2 ) )* '*/' )
-           | ( '//' ( Comment_3 )* CR? ( LF | EOF ) )
+Comment : ( ( '/*' ( Comment_1 | ( '*' Comment_2 ) )* '*/' )
+             | ( '//' ( Comment_3 )* CR? ( LF | EOF ) )
+             ) -> channel(HIDDEN)
            ;
 
 leftArrowHead : '<'
@@ -0,0 +1,203 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ApplyFunc is called by Apply for each node.
+type ApplyFunc func(c *Cursor) bool
+
+// Apply traverses the tree of root, calling pre before visiting the children of a node
+// and post after that, either of them can be nil.
+//
+// If pre returns false, the children and post of the node are skipped. If post returns
+// false, the traversal is stopped. Children are visited in the order of fields of
+// the node, nil children are skipped.
+//
+// The current node can be changed through the Cursor, a replacement isn't walked.
+// Apply returns root, or its replacement.
+func Apply(root Node, pre, post ApplyFunc) (result Node) {
+	defer func() {
+		if r := recover(); r != nil && r != abort {
+			panic(r)
+		}
+		result = root
+	}()
+	a := &application{pre: pre, post: post}
+	a.apply(nil, "", nil, reflect.Value{}, reflect.ValueOf(&root).Elem())
+	return
+}
+
+var abort = new(int)
+
+// Cursor describes the node being visited by Apply.
+type Cursor struct {
+	parent Node
+	name   string
+	iter   *iterator
+	// list is the slice field containing the node, if iter isn't nil.
+	list  reflect.Value
+	field reflect.Value
+}
+
+// Node returns the current node.
+func (c *Cursor) Node() Node {
+	if c.field.IsNil() {
+		return nil
+	}
+	return c.field.Interface().(Node)
+}
+
+// Parent returns the parent of the current node, it's nil for the root.
+func (c *Cursor) Parent() Node {
+	return c.parent
+}
+
+// Name returns the name of the field of Parent containing the current node,
+// e.g. "Clauses" for a clause in QueryStmt.
+func (c *Cursor) Name() string {
+	return c.name
+}
+
+// Index returns the index of the current node in the slice field, or -1 if the field
+// isn't a slice.
+func (c *Cursor) Index() int {
+	if c.iter == nil {
+		return -1
+	}
+	return c.iter.index
+}
+
+// Replace replaces the current node with n, the field is cleared if n is nil.
+func (c *Cursor) Replace(n Node) {
+	if n == nil {
+		if c.iter != nil {
+			panic("ast: Replace with nil node in slice, use Delete instead")
+		}
+		c.field.Set(reflect.Zero(c.field.Type()))
+		return
+	}
+	c.field.Set(c.value(n))
+	n.SetParent(c.parent)
+}
+
+// Delete deletes the current node from the slice field.
+func (c *Cursor) Delete() {
+	if c.iter == nil {
+		panic("ast: Delete node not contained in slice")
+	}
+	i := c.iter.index
+	l := c.list.Len()
+	reflect.Copy(c.list.Slice(i, l), c.list.Slice(i+1, l))
+	c.list.Index(l - 1).Set(reflect.Zero(c.list.Type().Elem()))
+	c.list.Set(c.list.Slice(0, l-1))
+	c.iter.step--
+}
+
+// InsertAfter inserts n after the current node in the slice field, n isn't walked.
+func (c *Cursor) InsertAfter(n Node) {
+	if c.iter == nil {
+		panic("ast: InsertAfter node not contained in slice")
+	}
+	c.insert(c.iter.index+1, n)
+	c.iter.step++
+	c.field = c.list.Index(c.iter.index)
+}
+
+// InsertBefore inserts n before the current node in the slice field, n isn't walked.
+func (c *Cursor) InsertBefore(n Node) {
+	if c.iter == nil {
+		panic("ast: InsertBefore node not contained in slice")
+	}
+	c.insert(c.iter.index, n)
+	c.iter.index++
+	c.field = c.list.Index(c.iter.index)
+}
+
+func (c *Cursor) insert(i int, n Node) {
+	v := c.value(n)
+	c.list.Set(reflect.Append(c.list, reflect.Zero(v.Type())))
+	reflect.Copy(c.list.Slice(i+1, c.list.Len()), c.list.Slice(i, c.list.Len()-1))
+	c.list.Index(i).Set(v)
+	n.SetParent(c.parent)
+}
+
+// value returns n as the type of the current field.
+func (c *Cursor) value(n Node) reflect.Value {
+	v := reflect.ValueOf(n)
+	if !v.Type().AssignableTo(c.field.Type()) {
+		panic(fmt.Sprintf("ast: %s.%s can't be %T", typeName(c.parent), c.name, n))
+	}
+	return v
+}
+
+func typeName(n Node) string {
+	if n == nil {
+		return "root"
+	}
+	return reflect.TypeOf(n).Elem().Name()
+}
+
+type iterator struct {
+	index, step int
+}
+
+type application struct {
+	pre, post ApplyFunc
+	cursor    Cursor
+}
+
+func (a *application) apply(parent Node, name string, iter *iterator, list, field reflect.Value) {
+	if field.IsNil() {
+		return
+	}
+	saved := a.cursor
+	a.cursor = Cursor{parent: parent, name: name, iter: iter, list: list, field: field}
+	n := a.cursor.Node()
+
+	if a.pre != nil && !a.pre(&a.cursor) {
+		a.cursor = saved
+		return
+	}
+
+	v := reflect.ValueOf(n).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.Anonymous {
+			continue
+		}
+		switch v.Field(i).Kind() {
+		case reflect.Ptr, reflect.Interface:
+			a.apply(n, f.Name, nil, reflect.Value{}, v.Field(i))
+		case reflect.Slice:
+			a.applyList(n, f.Name, v.Field(i))
+		}
+	}
+
+	if a.post != nil && !a.post(&a.cursor) {
+		panic(abort)
+	}
+	a.cursor = saved
+}
+
+func (a *application) applyList(parent Node, name string, list reflect.Value) {
+	iter := &iterator{}
+	for iter.step = 1; iter.index < list.Len(); iter.index += iter.step {
+		iter.step = 1
+		a.apply(parent, name, iter, list, list.Index(iter.index))
+	}
+}
@@ -13,11 +13,32 @@
 
 package ast
 
+// Pos represents a position in cypher source.
+type Pos struct {
+	// Offset is the byte offset, starting from 0.
+	Offset int
+	// Line starts from 1, it is 0 if the position is unknown.
+	Line int
+	// Column is the character offset in line, starting from 0.
+	Column int
+}
+
 // Node is basic type of ast.
 type Node interface {
 	Accept(visitor Visitor) (node Node, ok bool)
 	Text() string
 	SetText(text string)
+	// Start returns position of the first character of the node.
+	Start() Pos
+	// End returns position immediately after the node.
+	End() Pos
+	SetPos(start, end Pos)
+	// Comments returns the comments attached to the node, it's never nil.
+	Comments() *Comments
+	// Parent returns the node containing the node, it's nil for the root.
+	// Parents are set by the parser, call SetParents after rewriting with Accept.
+	Parent() Node
+	SetParent(parent Node)
 	Restore(ctx *RestoreContext)
 }
 
@@ -33,13 +54,20 @@ type Expr interface {
 	exprNode()
 }
 
+//go:generate go run gen_typed_visitor.go
+
 // Visitor can visit ast.
+//
+// Accept calls Enter, then Accept of each non-nil child once, in the order they're
+// written in cypher, and stores the returned node back into the field of the child.
+// If Accept of a child returns false, the traversal is stopped and Accept returns false.
 type Visitor interface {
 	// Enter would be called at the begin of Accept.
 	// The visited node will be replaced by returned Node.
 	// If skipChildren is true, the child nodes' Accept wouldn't be called.
 	Enter(n Node) (node Node, skipChildren bool)
 
-	// Accept will directly return Leave.
+	// Accept will directly return Leave, the visited node will be replaced by returned Node.
+	// If ok is false, the traversal is stopped.
 	Leave(n Node) (node Node, ok bool)
 }
@@ -0,0 +1,8290 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The messages mirror the node types in package ast, a field of a node is the
+// field with the same name in snake case. An enum mirrors the enum type with the
+// same name. Fields of ast.Expr and ast.Stmt are wrapped by Expr and Stmt.
+//
+// New node types and fields must be appended, never renumber them. The numbers of
+// enum values are the values of the Go constants, which are pinned by TestEnumNumbers,
+// so new constants must be appended too.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        v5.29.3
+// source: ast.proto
+
+package astpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CypherStmtType int32
+
+const (
+	CypherStmtType_CYPHER_STMT_QUERY           CypherStmtType = 0
+	CypherStmtType_CYPHER_STMT_STANDALONE_CALL CypherStmtType = 1
+)
+
+// Enum value maps for CypherStmtType.
+var (
+	CypherStmtType_name = map[int32]string{
+		0: "CYPHER_STMT_QUERY",
+		1: "CYPHER_STMT_STANDALONE_CALL",
+	}
+	CypherStmtType_value = map[string]int32{
+		"CYPHER_STMT_QUERY":           0,
+		"CYPHER_STMT_STANDALONE_CALL": 1,
+	}
+)
+
+func (x CypherStmtType) Enum() *CypherStmtType {
+	p := new(CypherStmtType)
+	*p = x
+	return p
+}
+
+func (x CypherStmtType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CypherStmtType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[0].Descriptor()
+}
+
+func (CypherStmtType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[0]
+}
+
+func (x CypherStmtType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CypherStmtType.Descriptor instead.
+func (CypherStmtType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{0}
+}
+
+type FilterType int32
+
+const (
+	FilterType_FILTER_LIST_COMPREHENSION FilterType = 0
+	FilterType_FILTER_ALL                FilterType = 1
+	FilterType_FILTER_ANY                FilterType = 2
+	FilterType_FILTER_SINGLE             FilterType = 3
+	FilterType_FILTER_NONE               FilterType = 4
+)
+
+// Enum value maps for FilterType.
+var (
+	FilterType_name = map[int32]string{
+		0: "FILTER_LIST_COMPREHENSION",
+		1: "FILTER_ALL",
+		2: "FILTER_ANY",
+		3: "FILTER_SINGLE",
+		4: "FILTER_NONE",
+	}
+	FilterType_value = map[string]int32{
+		"FILTER_LIST_COMPREHENSION": 0,
+		"FILTER_ALL":                1,
+		"FILTER_ANY":                2,
+		"FILTER_SINGLE":             3,
+		"FILTER_NONE":               4,
+	}
+)
+
+func (x FilterType) Enum() *FilterType {
+	p := new(FilterType)
+	*p = x
+	return p
+}
+
+func (x FilterType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[1].Descriptor()
+}
+
+func (FilterType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[1]
+}
+
+func (x FilterType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterType.Descriptor instead.
+func (FilterType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{1}
+}
+
+type LiteralType int32
+
+const (
+	LiteralType_LITERAL_NUMBER  LiteralType = 0
+	LiteralType_LITERAL_STRING  LiteralType = 1
+	LiteralType_LITERAL_BOOLEAN LiteralType = 2
+	LiteralType_LITERAL_NULL    LiteralType = 3
+	LiteralType_LITERAL_MAP     LiteralType = 4
+	LiteralType_LITERAL_LIST    LiteralType = 5
+)
+
+// Enum value maps for LiteralType.
+var (
+	LiteralType_name = map[int32]string{
+		0: "LITERAL_NUMBER",
+		1: "LITERAL_STRING",
+		2: "LITERAL_BOOLEAN",
+		3: "LITERAL_NULL",
+		4: "LITERAL_MAP",
+		5: "LITERAL_LIST",
+	}
+	LiteralType_value = map[string]int32{
+		"LITERAL_NUMBER":  0,
+		"LITERAL_STRING":  1,
+		"LITERAL_BOOLEAN": 2,
+		"LITERAL_NULL":    3,
+		"LITERAL_MAP":     4,
+		"LITERAL_LIST":    5,
+	}
+)
+
+func (x LiteralType) Enum() *LiteralType {
+	p := new(LiteralType)
+	*p = x
+	return p
+}
+
+func (x LiteralType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LiteralType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[2].Descriptor()
+}
+
+func (LiteralType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[2]
+}
+
+func (x LiteralType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LiteralType.Descriptor instead.
+func (LiteralType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{2}
+}
+
+type MergeActionType int32
+
+const (
+	MergeActionType_MERGE_ACTION_CREATE MergeActionType = 0
+	MergeActionType_MERGE_ACTION_MATCH  MergeActionType = 1
+)
+
+// Enum value maps for MergeActionType.
+var (
+	MergeActionType_name = map[int32]string{
+		0: "MERGE_ACTION_CREATE",
+		1: "MERGE_ACTION_MATCH",
+	}
+	MergeActionType_value = map[string]int32{
+		"MERGE_ACTION_CREATE": 0,
+		"MERGE_ACTION_MATCH":  1,
+	}
+)
+
+func (x MergeActionType) Enum() *MergeActionType {
+	p := new(MergeActionType)
+	*p = x
+	return p
+}
+
+func (x MergeActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[3].Descriptor()
+}
+
+func (MergeActionType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[3]
+}
+
+func (x MergeActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeActionType.Descriptor instead.
+func (MergeActionType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{3}
+}
+
+type NumberLiteralType int32
+
+const (
+	NumberLiteralType_NUMBER_LITERAL_INTEGER NumberLiteralType = 0
+	NumberLiteralType_NUMBER_LITERAL_DOUBLE  NumberLiteralType = 1
+)
+
+// Enum value maps for NumberLiteralType.
+var (
+	NumberLiteralType_name = map[int32]string{
+		0: "NUMBER_LITERAL_INTEGER",
+		1: "NUMBER_LITERAL_DOUBLE",
+	}
+	NumberLiteralType_value = map[string]int32{
+		"NUMBER_LITERAL_INTEGER": 0,
+		"NUMBER_LITERAL_DOUBLE":  1,
+	}
+)
+
+func (x NumberLiteralType) Enum() *NumberLiteralType {
+	p := new(NumberLiteralType)
+	*p = x
+	return p
+}
+
+func (x NumberLiteralType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NumberLiteralType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[4].Descriptor()
+}
+
+func (NumberLiteralType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[4]
+}
+
+func (x NumberLiteralType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NumberLiteralType.Descriptor instead.
+func (NumberLiteralType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{4}
+}
+
+type OnErrorType int32
+
+const (
+	OnErrorType_ON_ERROR_NONE     OnErrorType = 0
+	OnErrorType_ON_ERROR_CONTINUE OnErrorType = 1
+	OnErrorType_ON_ERROR_BREAK    OnErrorType = 2
+	OnErrorType_ON_ERROR_FAIL     OnErrorType = 3
+)
+
+// Enum value maps for OnErrorType.
+var (
+	OnErrorType_name = map[int32]string{
+		0: "ON_ERROR_NONE",
+		1: "ON_ERROR_CONTINUE",
+		2: "ON_ERROR_BREAK",
+		3: "ON_ERROR_FAIL",
+	}
+	OnErrorType_value = map[string]int32{
+		"ON_ERROR_NONE":     0,
+		"ON_ERROR_CONTINUE": 1,
+		"ON_ERROR_BREAK":    2,
+		"ON_ERROR_FAIL":     3,
+	}
+)
+
+func (x OnErrorType) Enum() *OnErrorType {
+	p := new(OnErrorType)
+	*p = x
+	return p
+}
+
+func (x OnErrorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OnErrorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[5].Descriptor()
+}
+
+func (OnErrorType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[5]
+}
+
+func (x OnErrorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OnErrorType.Descriptor instead.
+func (OnErrorType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{5}
+}
+
+type OpType int32
+
+const (
+	OpType_OP_ADD   OpType = 0
+	OpType_OP_SUB   OpType = 1
+	OpType_OP_MUL   OpType = 2
+	OpType_OP_DIV   OpType = 3
+	OpType_OP_MOD   OpType = 4
+	OpType_OP_POW   OpType = 5
+	OpType_OP_PLUS  OpType = 6
+	OpType_OP_MINUS OpType = 7
+	OpType_OP_EQ    OpType = 8
+	OpType_OP_NE    OpType = 9
+	OpType_OP_LT    OpType = 10
+	OpType_OP_GT    OpType = 11
+	OpType_OP_LTE   OpType = 12
+	OpType_OP_GTE   OpType = 13
+	OpType_OP_OR    OpType = 14
+	OpType_OP_AND   OpType = 15
+	OpType_OP_XOR   OpType = 16
+	OpType_OP_NOT   OpType = 17
+)
+
+// Enum value maps for OpType.
+var (
+	OpType_name = map[int32]string{
+		0:  "OP_ADD",
+		1:  "OP_SUB",
+		2:  "OP_MUL",
+		3:  "OP_DIV",
+		4:  "OP_MOD",
+		5:  "OP_POW",
+		6:  "OP_PLUS",
+		7:  "OP_MINUS",
+		8:  "OP_EQ",
+		9:  "OP_NE",
+		10: "OP_LT",
+		11: "OP_GT",
+		12: "OP_LTE",
+		13: "OP_GTE",
+		14: "OP_OR",
+		15: "OP_AND",
+		16: "OP_XOR",
+		17: "OP_NOT",
+	}
+	OpType_value = map[string]int32{
+		"OP_ADD":   0,
+		"OP_SUB":   1,
+		"OP_MUL":   2,
+		"OP_DIV":   3,
+		"OP_MOD":   4,
+		"OP_POW":   5,
+		"OP_PLUS":  6,
+		"OP_MINUS": 7,
+		"OP_EQ":    8,
+		"OP_NE":    9,
+		"OP_LT":    10,
+		"OP_GT":    11,
+		"OP_LTE":   12,
+		"OP_GTE":   13,
+		"OP_OR":    14,
+		"OP_AND":   15,
+		"OP_XOR":   16,
+		"OP_NOT":   17,
+	}
+)
+
+func (x OpType) Enum() *OpType {
+	p := new(OpType)
+	*p = x
+	return p
+}
+
+func (x OpType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OpType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[6].Descriptor()
+}
+
+func (OpType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[6]
+}
+
+func (x OpType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OpType.Descriptor instead.
+func (OpType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{6}
+}
+
+type ParameterType int32
+
+const (
+	ParameterType_PARAMETER_SYMBOLIC_NAME   ParameterType = 0
+	ParameterType_PARAMETER_DECIMAL_INTEGER ParameterType = 1
+)
+
+// Enum value maps for ParameterType.
+var (
+	ParameterType_name = map[int32]string{
+		0: "PARAMETER_SYMBOLIC_NAME",
+		1: "PARAMETER_DECIMAL_INTEGER",
+	}
+	ParameterType_value = map[string]int32{
+		"PARAMETER_SYMBOLIC_NAME":   0,
+		"PARAMETER_DECIMAL_INTEGER": 1,
+	}
+)
+
+func (x ParameterType) Enum() *ParameterType {
+	p := new(ParameterType)
+	*p = x
+	return p
+}
+
+func (x ParameterType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParameterType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[7].Descriptor()
+}
+
+func (ParameterType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[7]
+}
+
+func (x ParameterType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParameterType.Descriptor instead.
+func (ParameterType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{7}
+}
+
+type PredicationType int32
+
+const (
+	PredicationType_PREDICATION_STRING_OP PredicationType = 0
+	PredicationType_PREDICATION_LIST_OP   PredicationType = 1
+	PredicationType_PREDICATION_NULL_OP   PredicationType = 2
+)
+
+// Enum value maps for PredicationType.
+var (
+	PredicationType_name = map[int32]string{
+		0: "PREDICATION_STRING_OP",
+		1: "PREDICATION_LIST_OP",
+		2: "PREDICATION_NULL_OP",
+	}
+	PredicationType_value = map[string]int32{
+		"PREDICATION_STRING_OP": 0,
+		"PREDICATION_LIST_OP":   1,
+		"PREDICATION_NULL_OP":   2,
+	}
+)
+
+func (x PredicationType) Enum() *PredicationType {
+	p := new(PredicationType)
+	*p = x
+	return p
+}
+
+func (x PredicationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PredicationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[8].Descriptor()
+}
+
+func (PredicationType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[8]
+}
+
+func (x PredicationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PredicationType.Descriptor instead.
+func (PredicationType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{8}
+}
+
+type PropertiesType int32
+
+const (
+	PropertiesType_PROPERTIES_MAP_LITERAL PropertiesType = 0
+	PropertiesType_PROPERTIES_PARAMETER   PropertiesType = 1
+)
+
+// Enum value maps for PropertiesType.
+var (
+	PropertiesType_name = map[int32]string{
+		0: "PROPERTIES_MAP_LITERAL",
+		1: "PROPERTIES_PARAMETER",
+	}
+	PropertiesType_value = map[string]int32{
+		"PROPERTIES_MAP_LITERAL": 0,
+		"PROPERTIES_PARAMETER":   1,
+	}
+)
+
+func (x PropertiesType) Enum() *PropertiesType {
+	p := new(PropertiesType)
+	*p = x
+	return p
+}
+
+func (x PropertiesType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PropertiesType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[9].Descriptor()
+}
+
+func (PropertiesType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[9]
+}
+
+func (x PropertiesType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PropertiesType.Descriptor instead.
+func (PropertiesType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{9}
+}
+
+type ReadingClauseType int32
+
+const (
+	ReadingClauseType_READING_CLAUSE_MATCH         ReadingClauseType = 0
+	ReadingClauseType_READING_CLAUSE_UNWIND        ReadingClauseType = 1
+	ReadingClauseType_READING_CLAUSE_IN_QUERY_CALL ReadingClauseType = 2
+)
+
+// Enum value maps for ReadingClauseType.
+var (
+	ReadingClauseType_name = map[int32]string{
+		0: "READING_CLAUSE_MATCH",
+		1: "READING_CLAUSE_UNWIND",
+		2: "READING_CLAUSE_IN_QUERY_CALL",
+	}
+	ReadingClauseType_value = map[string]int32{
+		"READING_CLAUSE_MATCH":         0,
+		"READING_CLAUSE_UNWIND":        1,
+		"READING_CLAUSE_IN_QUERY_CALL": 2,
+	}
+)
+
+func (x ReadingClauseType) Enum() *ReadingClauseType {
+	p := new(ReadingClauseType)
+	*p = x
+	return p
+}
+
+func (x ReadingClauseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReadingClauseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[10].Descriptor()
+}
+
+func (ReadingClauseType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[10]
+}
+
+func (x ReadingClauseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReadingClauseType.Descriptor instead.
+func (ReadingClauseType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{10}
+}
+
+type RelationshipType int32
+
+const (
+	RelationshipType_RELATIONSHIP_IN   RelationshipType = 0
+	RelationshipType_RELATIONSHIP_OUT  RelationshipType = 1
+	RelationshipType_RELATIONSHIP_BOTH RelationshipType = 2
+	RelationshipType_RELATIONSHIP_ALL  RelationshipType = 3
+)
+
+// Enum value maps for RelationshipType.
+var (
+	RelationshipType_name = map[int32]string{
+		0: "RELATIONSHIP_IN",
+		1: "RELATIONSHIP_OUT",
+		2: "RELATIONSHIP_BOTH",
+		3: "RELATIONSHIP_ALL",
+	}
+	RelationshipType_value = map[string]int32{
+		"RELATIONSHIP_IN":   0,
+		"RELATIONSHIP_OUT":  1,
+		"RELATIONSHIP_BOTH": 2,
+		"RELATIONSHIP_ALL":  3,
+	}
+)
+
+func (x RelationshipType) Enum() *RelationshipType {
+	p := new(RelationshipType)
+	*p = x
+	return p
+}
+
+func (x RelationshipType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationshipType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[11].Descriptor()
+}
+
+func (RelationshipType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[11]
+}
+
+func (x RelationshipType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationshipType.Descriptor instead.
+func (RelationshipType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{11}
+}
+
+type RemoveItemType int32
+
+const (
+	RemoveItemType_REMOVE_ITEM_VARIABLE RemoveItemType = 0
+	RemoveItemType_REMOVE_ITEM_PROPERTY RemoveItemType = 1
+)
+
+// Enum value maps for RemoveItemType.
+var (
+	RemoveItemType_name = map[int32]string{
+		0: "REMOVE_ITEM_VARIABLE",
+		1: "REMOVE_ITEM_PROPERTY",
+	}
+	RemoveItemType_value = map[string]int32{
+		"REMOVE_ITEM_VARIABLE": 0,
+		"REMOVE_ITEM_PROPERTY": 1,
+	}
+)
+
+func (x RemoveItemType) Enum() *RemoveItemType {
+	p := new(RemoveItemType)
+	*p = x
+	return p
+}
+
+func (x RemoveItemType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RemoveItemType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[12].Descriptor()
+}
+
+func (RemoveItemType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[12]
+}
+
+func (x RemoveItemType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RemoveItemType.Descriptor instead.
+func (RemoveItemType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{12}
+}
+
+type SchemaNameType int32
+
+const (
+	SchemaNameType_SCHEMA_NAME_SYMBOLIC_NAME SchemaNameType = 0
+	SchemaNameType_SCHEMA_NAME_RESERVED_WORD SchemaNameType = 1
+)
+
+// Enum value maps for SchemaNameType.
+var (
+	SchemaNameType_name = map[int32]string{
+		0: "SCHEMA_NAME_SYMBOLIC_NAME",
+		1: "SCHEMA_NAME_RESERVED_WORD",
+	}
+	SchemaNameType_value = map[string]int32{
+		"SCHEMA_NAME_SYMBOLIC_NAME": 0,
+		"SCHEMA_NAME_RESERVED_WORD": 1,
+	}
+)
+
+func (x SchemaNameType) Enum() *SchemaNameType {
+	p := new(SchemaNameType)
+	*p = x
+	return p
+}
+
+func (x SchemaNameType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaNameType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[13].Descriptor()
+}
+
+func (SchemaNameType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[13]
+}
+
+func (x SchemaNameType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaNameType.Descriptor instead.
+func (SchemaNameType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{13}
+}
+
+type SetItemType int32
+
+const (
+	SetItemType_SET_ITEM_PROPERTY            SetItemType = 0
+	SetItemType_SET_ITEM_VARIABLE_ASSIGNMENT SetItemType = 1
+	SetItemType_SET_ITEM_VARIABLE_INCREMENT  SetItemType = 2
+	SetItemType_SET_ITEM_VARIABLE_LABEL      SetItemType = 3
+)
+
+// Enum value maps for SetItemType.
+var (
+	SetItemType_name = map[int32]string{
+		0: "SET_ITEM_PROPERTY",
+		1: "SET_ITEM_VARIABLE_ASSIGNMENT",
+		2: "SET_ITEM_VARIABLE_INCREMENT",
+		3: "SET_ITEM_VARIABLE_LABEL",
+	}
+	SetItemType_value = map[string]int32{
+		"SET_ITEM_PROPERTY":            0,
+		"SET_ITEM_VARIABLE_ASSIGNMENT": 1,
+		"SET_ITEM_VARIABLE_INCREMENT":  2,
+		"SET_ITEM_VARIABLE_LABEL":      3,
+	}
+)
+
+func (x SetItemType) Enum() *SetItemType {
+	p := new(SetItemType)
+	*p = x
+	return p
+}
+
+func (x SetItemType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SetItemType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[14].Descriptor()
+}
+
+func (SetItemType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[14]
+}
+
+func (x SetItemType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SetItemType.Descriptor instead.
+func (SetItemType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{14}
+}
+
+type SortType int32
+
+const (
+	SortType_SORT_ASCENDING  SortType = 0
+	SortType_SORT_DESCENDING SortType = 1
+)
+
+// Enum value maps for SortType.
+var (
+	SortType_name = map[int32]string{
+		0: "SORT_ASCENDING",
+		1: "SORT_DESCENDING",
+	}
+	SortType_value = map[string]int32{
+		"SORT_ASCENDING":  0,
+		"SORT_DESCENDING": 1,
+	}
+)
+
+func (x SortType) Enum() *SortType {
+	p := new(SortType)
+	*p = x
+	return p
+}
+
+func (x SortType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[15].Descriptor()
+}
+
+func (SortType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[15]
+}
+
+func (x SortType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortType.Descriptor instead.
+func (SortType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{15}
+}
+
+type StringOperationType int32
+
+const (
+	StringOperationType_STRING_OPERATION_STARTS_WITH StringOperationType = 0
+	StringOperationType_STRING_OPERATION_ENDS_WITH   StringOperationType = 1
+	StringOperationType_STRING_OPERATION_CONTAINS    StringOperationType = 2
+)
+
+// Enum value maps for StringOperationType.
+var (
+	StringOperationType_name = map[int32]string{
+		0: "STRING_OPERATION_STARTS_WITH",
+		1: "STRING_OPERATION_ENDS_WITH",
+		2: "STRING_OPERATION_CONTAINS",
+	}
+	StringOperationType_value = map[string]int32{
+		"STRING_OPERATION_STARTS_WITH": 0,
+		"STRING_OPERATION_ENDS_WITH":   1,
+		"STRING_OPERATION_CONTAINS":    2,
+	}
+)
+
+func (x StringOperationType) Enum() *StringOperationType {
+	p := new(StringOperationType)
+	*p = x
+	return p
+}
+
+func (x StringOperationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StringOperationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[16].Descriptor()
+}
+
+func (StringOperationType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[16]
+}
+
+func (x StringOperationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StringOperationType.Descriptor instead.
+func (StringOperationType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{16}
+}
+
+type SymbolicNameType int32
+
+const (
+	SymbolicNameType_SYMBOLIC_NAME_UNESCAPED  SymbolicNameType = 0
+	SymbolicNameType_SYMBOLIC_NAME_ESCAPED    SymbolicNameType = 1
+	SymbolicNameType_SYMBOLIC_NAME_HEX_LETTER SymbolicNameType = 2
+	SymbolicNameType_SYMBOLIC_NAME_COUNT      SymbolicNameType = 3
+	SymbolicNameType_SYMBOLIC_NAME_FILTER     SymbolicNameType = 4
+	SymbolicNameType_SYMBOLIC_NAME_EXTRACT    SymbolicNameType = 5
+	SymbolicNameType_SYMBOLIC_NAME_ANY        SymbolicNameType = 6
+	SymbolicNameType_SYMBOLIC_NAME_NONE       SymbolicNameType = 7
+	SymbolicNameType_SYMBOLIC_NAME_SINGLE     SymbolicNameType = 8
+	SymbolicNameType_SYMBOLIC_NAME_EXISTS     SymbolicNameType = 9
+)
+
+// Enum value maps for SymbolicNameType.
+var (
+	SymbolicNameType_name = map[int32]string{
+		0: "SYMBOLIC_NAME_UNESCAPED",
+		1: "SYMBOLIC_NAME_ESCAPED",
+		2: "SYMBOLIC_NAME_HEX_LETTER",
+		3: "SYMBOLIC_NAME_COUNT",
+		4: "SYMBOLIC_NAME_FILTER",
+		5: "SYMBOLIC_NAME_EXTRACT",
+		6: "SYMBOLIC_NAME_ANY",
+		7: "SYMBOLIC_NAME_NONE",
+		8: "SYMBOLIC_NAME_SINGLE",
+		9: "SYMBOLIC_NAME_EXISTS",
+	}
+	SymbolicNameType_value = map[string]int32{
+		"SYMBOLIC_NAME_UNESCAPED":  0,
+		"SYMBOLIC_NAME_ESCAPED":    1,
+		"SYMBOLIC_NAME_HEX_LETTER": 2,
+		"SYMBOLIC_NAME_COUNT":      3,
+		"SYMBOLIC_NAME_FILTER":     4,
+		"SYMBOLIC_NAME_EXTRACT":    5,
+		"SYMBOLIC_NAME_ANY":        6,
+		"SYMBOLIC_NAME_NONE":       7,
+		"SYMBOLIC_NAME_SINGLE":     8,
+		"SYMBOLIC_NAME_EXISTS":     9,
+	}
+)
+
+func (x SymbolicNameType) Enum() *SymbolicNameType {
+	p := new(SymbolicNameType)
+	*p = x
+	return p
+}
+
+func (x SymbolicNameType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SymbolicNameType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ast_proto_enumTypes[17].Descriptor()
+}
+
+func (SymbolicNameType) Type() protoreflect.EnumType {
+	return &file_ast_proto_enumTypes[17]
+}
+
+func (x SymbolicNameType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SymbolicNameType.Descriptor instead.
+func (SymbolicNameType) EnumDescriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{17}
+}
+
+// Position mirrors ast.Pos.
+type Position struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int64                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Line          int64                  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Column        int64                  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_ast_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Position) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Position) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *Position) GetColumn() int64 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Start         *Position              `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *Position              `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	OwnLine       bool                   `protobuf:"varint,4,opt,name=own_line,json=ownLine,proto3" json:"own_line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_ast_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetStart() *Position {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Comment) GetEnd() *Position {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Comment) GetOwnLine() bool {
+	if x != nil {
+		return x.OwnLine
+	}
+	return false
+}
+
+// Base holds the fields shared by all nodes.
+type Base struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Start            *Position              `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End              *Position              `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Text             string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	LeadingComments  []*Comment             `protobuf:"bytes,4,rep,name=leading_comments,json=leadingComments,proto3" json:"leading_comments,omitempty"`
+	TrailingComments []*Comment             `protobuf:"bytes,5,rep,name=trailing_comments,json=trailingComments,proto3" json:"trailing_comments,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Base) Reset() {
+	*x = Base{}
+	mi := &file_ast_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Base) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Base) ProtoMessage() {}
+
+func (x *Base) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Base.ProtoReflect.Descriptor instead.
+func (*Base) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Base) GetStart() *Position {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Base) GetEnd() *Position {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Base) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Base) GetLeadingComments() []*Comment {
+	if x != nil {
+		return x.LeadingComments
+	}
+	return nil
+}
+
+func (x *Base) GetTrailingComments() []*Comment {
+	if x != nil {
+		return x.TrailingComments
+	}
+	return nil
+}
+
+// Node is any node.
+type Node struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Node:
+	//
+	//	*Node_PropertyExpr
+	//	*Node_BinaryExpr
+	//	*Node_UnaryExpr
+	//	*Node_PredicationExpr
+	//	*Node_StringOperationExpr
+	//	*Node_ListOperationExpr
+	//	*Node_NullOperationExpr
+	//	*Node_PropertyOrLabelsExpr
+	//	*Node_PropertyLookup
+	//	*Node_CaseExpr
+	//	*Node_CaseAlt
+	//	*Node_FilterExpr
+	//	*Node_ListComprehension
+	//	*Node_FunctionInvocation
+	//	*Node_ParenExpr
+	//	*Node_CountAllExpr
+	//	*Node_SchemaNameNode
+	//	*Node_SymbolicNameNode
+	//	*Node_ReservedWordNode
+	//	*Node_VariableNode
+	//	*Node_NodeLabelNode
+	//	*Node_ParameterNode
+	//	*Node_LiteralExpr
+	//	*Node_NumberLiteral
+	//	*Node_MapLiteral
+	//	*Node_ListLiteral
+	//	*Node_Pattern
+	//	*Node_PatternPart
+	//	*Node_PatternElement
+	//	*Node_NodePattern
+	//	*Node_RelationshipPattern
+	//	*Node_RelationshipDetail
+	//	*Node_Properties
+	//	*Node_PatternComprehension
+	//	*Node_ProcedureInvocation
+	//	*Node_YieldItems
+	//	*Node_YieldItem
+	//	*Node_CypherStmt
+	//	*Node_QueryStmt
+	//	*Node_UnionClause
+	//	*Node_StandaloneCall
+	//	*Node_ReadingClause
+	//	*Node_MatchClause
+	//	*Node_UnwindClause
+	//	*Node_InQueryCallClause
+	//	*Node_WithClause
+	//	*Node_ReturnClause
+	//	*Node_ReturnBody
+	//	*Node_ReturnItem
+	//	*Node_OrderClause
+	//	*Node_SortItem
+	//	*Node_CreateClause
+	//	*Node_MergeClause
+	//	*Node_MergeAction
+	//	*Node_SetClause
+	//	*Node_SetItem
+	//	*Node_DeleteClause
+	//	*Node_RemoveClause
+	//	*Node_RemoveItem
+	//	*Node_ExistsSubqueryExpr
+	//	*Node_CountSubqueryExpr
+	//	*Node_SubqueryClause
+	Node          isNode_Node `protobuf_oneof:"node"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_ast_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Node) GetNode() isNode_Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *Node) GetPropertyExpr() *PropertyExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_PropertyExpr); ok {
+			return x.PropertyExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetBinaryExpr() *BinaryExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_BinaryExpr); ok {
+			return x.BinaryExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetUnaryExpr() *UnaryExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_UnaryExpr); ok {
+			return x.UnaryExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetPredicationExpr() *PredicationExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_PredicationExpr); ok {
+			return x.PredicationExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetStringOperationExpr() *StringOperationExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_StringOperationExpr); ok {
+			return x.StringOperationExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetListOperationExpr() *ListOperationExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ListOperationExpr); ok {
+			return x.ListOperationExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetNullOperationExpr() *NullOperationExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_NullOperationExpr); ok {
+			return x.NullOperationExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetPropertyOrLabelsExpr() *PropertyOrLabelsExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_PropertyOrLabelsExpr); ok {
+			return x.PropertyOrLabelsExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetPropertyLookup() *PropertyLookup {
+	if x != nil {
+		if x, ok := x.Node.(*Node_PropertyLookup); ok {
+			return x.PropertyLookup
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetCaseExpr() *CaseExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_CaseExpr); ok {
+			return x.CaseExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetCaseAlt() *CaseAlt {
+	if x != nil {
+		if x, ok := x.Node.(*Node_CaseAlt); ok {
+			return x.CaseAlt
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetFilterExpr() *FilterExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_FilterExpr); ok {
+			return x.FilterExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetListComprehension() *ListComprehension {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ListComprehension); ok {
+			return x.ListComprehension
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetFunctionInvocation() *FunctionInvocation {
+	if x != nil {
+		if x, ok := x.Node.(*Node_FunctionInvocation); ok {
+			return x.FunctionInvocation
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetParenExpr() *ParenExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ParenExpr); ok {
+			return x.ParenExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetCountAllExpr() *CountAllExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_CountAllExpr); ok {
+			return x.CountAllExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetSchemaNameNode() *SchemaNameNode {
+	if x != nil {
+		if x, ok := x.Node.(*Node_SchemaNameNode); ok {
+			return x.SchemaNameNode
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetSymbolicNameNode() *SymbolicNameNode {
+	if x != nil {
+		if x, ok := x.Node.(*Node_SymbolicNameNode); ok {
+			return x.SymbolicNameNode
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetReservedWordNode() *ReservedWordNode {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ReservedWordNode); ok {
+			return x.ReservedWordNode
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetVariableNode() *VariableNode {
+	if x != nil {
+		if x, ok := x.Node.(*Node_VariableNode); ok {
+			return x.VariableNode
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetNodeLabelNode() *NodeLabelNode {
+	if x != nil {
+		if x, ok := x.Node.(*Node_NodeLabelNode); ok {
+			return x.NodeLabelNode
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetParameterNode() *ParameterNode {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ParameterNode); ok {
+			return x.ParameterNode
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetLiteralExpr() *LiteralExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_LiteralExpr); ok {
+			return x.LiteralExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetNumberLiteral() *NumberLiteral {
+	if x != nil {
+		if x, ok := x.Node.(*Node_NumberLiteral); ok {
+			return x.NumberLiteral
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetMapLiteral() *MapLiteral {
+	if x != nil {
+		if x, ok := x.Node.(*Node_MapLiteral); ok {
+			return x.MapLiteral
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetListLiteral() *ListLiteral {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ListLiteral); ok {
+			return x.ListLiteral
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetPattern() *Pattern {
+	if x != nil {
+		if x, ok := x.Node.(*Node_Pattern); ok {
+			return x.Pattern
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetPatternPart() *PatternPart {
+	if x != nil {
+		if x, ok := x.Node.(*Node_PatternPart); ok {
+			return x.PatternPart
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetPatternElement() *PatternElement {
+	if x != nil {
+		if x, ok := x.Node.(*Node_PatternElement); ok {
+			return x.PatternElement
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetNodePattern() *NodePattern {
+	if x != nil {
+		if x, ok := x.Node.(*Node_NodePattern); ok {
+			return x.NodePattern
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetRelationshipPattern() *RelationshipPattern {
+	if x != nil {
+		if x, ok := x.Node.(*Node_RelationshipPattern); ok {
+			return x.RelationshipPattern
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetRelationshipDetail() *RelationshipDetail {
+	if x != nil {
+		if x, ok := x.Node.(*Node_RelationshipDetail); ok {
+			return x.RelationshipDetail
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetProperties() *Properties {
+	if x != nil {
+		if x, ok := x.Node.(*Node_Properties); ok {
+			return x.Properties
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetPatternComprehension() *PatternComprehension {
+	if x != nil {
+		if x, ok := x.Node.(*Node_PatternComprehension); ok {
+			return x.PatternComprehension
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetProcedureInvocation() *ProcedureInvocation {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ProcedureInvocation); ok {
+			return x.ProcedureInvocation
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetYieldItems() *YieldItems {
+	if x != nil {
+		if x, ok := x.Node.(*Node_YieldItems); ok {
+			return x.YieldItems
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetYieldItem() *YieldItem {
+	if x != nil {
+		if x, ok := x.Node.(*Node_YieldItem); ok {
+			return x.YieldItem
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetCypherStmt() *CypherStmt {
+	if x != nil {
+		if x, ok := x.Node.(*Node_CypherStmt); ok {
+			return x.CypherStmt
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetQueryStmt() *QueryStmt {
+	if x != nil {
+		if x, ok := x.Node.(*Node_QueryStmt); ok {
+			return x.QueryStmt
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetUnionClause() *UnionClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_UnionClause); ok {
+			return x.UnionClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetStandaloneCall() *StandaloneCall {
+	if x != nil {
+		if x, ok := x.Node.(*Node_StandaloneCall); ok {
+			return x.StandaloneCall
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetReadingClause() *ReadingClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ReadingClause); ok {
+			return x.ReadingClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetMatchClause() *MatchClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_MatchClause); ok {
+			return x.MatchClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetUnwindClause() *UnwindClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_UnwindClause); ok {
+			return x.UnwindClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetInQueryCallClause() *InQueryCallClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_InQueryCallClause); ok {
+			return x.InQueryCallClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetWithClause() *WithClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_WithClause); ok {
+			return x.WithClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetReturnClause() *ReturnClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ReturnClause); ok {
+			return x.ReturnClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetReturnBody() *ReturnBody {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ReturnBody); ok {
+			return x.ReturnBody
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetReturnItem() *ReturnItem {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ReturnItem); ok {
+			return x.ReturnItem
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetOrderClause() *OrderClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_OrderClause); ok {
+			return x.OrderClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetSortItem() *SortItem {
+	if x != nil {
+		if x, ok := x.Node.(*Node_SortItem); ok {
+			return x.SortItem
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetCreateClause() *CreateClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_CreateClause); ok {
+			return x.CreateClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetMergeClause() *MergeClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_MergeClause); ok {
+			return x.MergeClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetMergeAction() *MergeAction {
+	if x != nil {
+		if x, ok := x.Node.(*Node_MergeAction); ok {
+			return x.MergeAction
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetSetClause() *SetClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_SetClause); ok {
+			return x.SetClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetSetItem() *SetItem {
+	if x != nil {
+		if x, ok := x.Node.(*Node_SetItem); ok {
+			return x.SetItem
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetDeleteClause() *DeleteClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_DeleteClause); ok {
+			return x.DeleteClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetRemoveClause() *RemoveClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_RemoveClause); ok {
+			return x.RemoveClause
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetRemoveItem() *RemoveItem {
+	if x != nil {
+		if x, ok := x.Node.(*Node_RemoveItem); ok {
+			return x.RemoveItem
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetExistsSubqueryExpr() *ExistsSubqueryExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_ExistsSubqueryExpr); ok {
+			return x.ExistsSubqueryExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetCountSubqueryExpr() *CountSubqueryExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Node_CountSubqueryExpr); ok {
+			return x.CountSubqueryExpr
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetSubqueryClause() *SubqueryClause {
+	if x != nil {
+		if x, ok := x.Node.(*Node_SubqueryClause); ok {
+			return x.SubqueryClause
+		}
+	}
+	return nil
+}
+
+type isNode_Node interface {
+	isNode_Node()
+}
+
+type Node_PropertyExpr struct {
+	PropertyExpr *PropertyExpr `protobuf:"bytes,1,opt,name=property_expr,json=propertyExpr,proto3,oneof"`
+}
+
+type Node_BinaryExpr struct {
+	BinaryExpr *BinaryExpr `protobuf:"bytes,2,opt,name=binary_expr,json=binaryExpr,proto3,oneof"`
+}
+
+type Node_UnaryExpr struct {
+	UnaryExpr *UnaryExpr `protobuf:"bytes,3,opt,name=unary_expr,json=unaryExpr,proto3,oneof"`
+}
+
+type Node_PredicationExpr struct {
+	PredicationExpr *PredicationExpr `protobuf:"bytes,4,opt,name=predication_expr,json=predicationExpr,proto3,oneof"`
+}
+
+type Node_StringOperationExpr struct {
+	StringOperationExpr *StringOperationExpr `protobuf:"bytes,5,opt,name=string_operation_expr,json=stringOperationExpr,proto3,oneof"`
+}
+
+type Node_ListOperationExpr struct {
+	ListOperationExpr *ListOperationExpr `protobuf:"bytes,6,opt,name=list_operation_expr,json=listOperationExpr,proto3,oneof"`
+}
+
+type Node_NullOperationExpr struct {
+	NullOperationExpr *NullOperationExpr `protobuf:"bytes,7,opt,name=null_operation_expr,json=nullOperationExpr,proto3,oneof"`
+}
+
+type Node_PropertyOrLabelsExpr struct {
+	PropertyOrLabelsExpr *PropertyOrLabelsExpr `protobuf:"bytes,8,opt,name=property_or_labels_expr,json=propertyOrLabelsExpr,proto3,oneof"`
+}
+
+type Node_PropertyLookup struct {
+	PropertyLookup *PropertyLookup `protobuf:"bytes,9,opt,name=property_lookup,json=propertyLookup,proto3,oneof"`
+}
+
+type Node_CaseExpr struct {
+	CaseExpr *CaseExpr `protobuf:"bytes,10,opt,name=case_expr,json=caseExpr,proto3,oneof"`
+}
+
+type Node_CaseAlt struct {
+	CaseAlt *CaseAlt `protobuf:"bytes,11,opt,name=case_alt,json=caseAlt,proto3,oneof"`
+}
+
+type Node_FilterExpr struct {
+	FilterExpr *FilterExpr `protobuf:"bytes,12,opt,name=filter_expr,json=filterExpr,proto3,oneof"`
+}
+
+type Node_ListComprehension struct {
+	ListComprehension *ListComprehension `protobuf:"bytes,13,opt,name=list_comprehension,json=listComprehension,proto3,oneof"`
+}
+
+type Node_FunctionInvocation struct {
+	FunctionInvocation *FunctionInvocation `protobuf:"bytes,14,opt,name=function_invocation,json=functionInvocation,proto3,oneof"`
+}
+
+type Node_ParenExpr struct {
+	ParenExpr *ParenExpr `protobuf:"bytes,15,opt,name=paren_expr,json=parenExpr,proto3,oneof"`
+}
+
+type Node_CountAllExpr struct {
+	CountAllExpr *CountAllExpr `protobuf:"bytes,16,opt,name=count_all_expr,json=countAllExpr,proto3,oneof"`
+}
+
+type Node_SchemaNameNode struct {
+	SchemaNameNode *SchemaNameNode `protobuf:"bytes,17,opt,name=schema_name_node,json=schemaNameNode,proto3,oneof"`
+}
+
+type Node_SymbolicNameNode struct {
+	SymbolicNameNode *SymbolicNameNode `protobuf:"bytes,18,opt,name=symbolic_name_node,json=symbolicNameNode,proto3,oneof"`
+}
+
+type Node_ReservedWordNode struct {
+	ReservedWordNode *ReservedWordNode `protobuf:"bytes,19,opt,name=reserved_word_node,json=reservedWordNode,proto3,oneof"`
+}
+
+type Node_VariableNode struct {
+	VariableNode *VariableNode `protobuf:"bytes,20,opt,name=variable_node,json=variableNode,proto3,oneof"`
+}
+
+type Node_NodeLabelNode struct {
+	NodeLabelNode *NodeLabelNode `protobuf:"bytes,21,opt,name=node_label_node,json=nodeLabelNode,proto3,oneof"`
+}
+
+type Node_ParameterNode struct {
+	ParameterNode *ParameterNode `protobuf:"bytes,22,opt,name=parameter_node,json=parameterNode,proto3,oneof"`
+}
+
+type Node_LiteralExpr struct {
+	LiteralExpr *LiteralExpr `protobuf:"bytes,23,opt,name=literal_expr,json=literalExpr,proto3,oneof"`
+}
+
+type Node_NumberLiteral struct {
+	NumberLiteral *NumberLiteral `protobuf:"bytes,24,opt,name=number_literal,json=numberLiteral,proto3,oneof"`
+}
+
+type Node_MapLiteral struct {
+	MapLiteral *MapLiteral `protobuf:"bytes,25,opt,name=map_literal,json=mapLiteral,proto3,oneof"`
+}
+
+type Node_ListLiteral struct {
+	ListLiteral *ListLiteral `protobuf:"bytes,26,opt,name=list_literal,json=listLiteral,proto3,oneof"`
+}
+
+type Node_Pattern struct {
+	Pattern *Pattern `protobuf:"bytes,27,opt,name=pattern,proto3,oneof"`
+}
+
+type Node_PatternPart struct {
+	PatternPart *PatternPart `protobuf:"bytes,28,opt,name=pattern_part,json=patternPart,proto3,oneof"`
+}
+
+type Node_PatternElement struct {
+	PatternElement *PatternElement `protobuf:"bytes,29,opt,name=pattern_element,json=patternElement,proto3,oneof"`
+}
+
+type Node_NodePattern struct {
+	NodePattern *NodePattern `protobuf:"bytes,30,opt,name=node_pattern,json=nodePattern,proto3,oneof"`
+}
+
+type Node_RelationshipPattern struct {
+	RelationshipPattern *RelationshipPattern `protobuf:"bytes,31,opt,name=relationship_pattern,json=relationshipPattern,proto3,oneof"`
+}
+
+type Node_RelationshipDetail struct {
+	RelationshipDetail *RelationshipDetail `protobuf:"bytes,32,opt,name=relationship_detail,json=relationshipDetail,proto3,oneof"`
+}
+
+type Node_Properties struct {
+	Properties *Properties `protobuf:"bytes,33,opt,name=properties,proto3,oneof"`
+}
+
+type Node_PatternComprehension struct {
+	PatternComprehension *PatternComprehension `protobuf:"bytes,34,opt,name=pattern_comprehension,json=patternComprehension,proto3,oneof"`
+}
+
+type Node_ProcedureInvocation struct {
+	ProcedureInvocation *ProcedureInvocation `protobuf:"bytes,35,opt,name=procedure_invocation,json=procedureInvocation,proto3,oneof"`
+}
+
+type Node_YieldItems struct {
+	YieldItems *YieldItems `protobuf:"bytes,36,opt,name=yield_items,json=yieldItems,proto3,oneof"`
+}
+
+type Node_YieldItem struct {
+	YieldItem *YieldItem `protobuf:"bytes,37,opt,name=yield_item,json=yieldItem,proto3,oneof"`
+}
+
+type Node_CypherStmt struct {
+	CypherStmt *CypherStmt `protobuf:"bytes,38,opt,name=cypher_stmt,json=cypherStmt,proto3,oneof"`
+}
+
+type Node_QueryStmt struct {
+	QueryStmt *QueryStmt `protobuf:"bytes,39,opt,name=query_stmt,json=queryStmt,proto3,oneof"`
+}
+
+type Node_UnionClause struct {
+	UnionClause *UnionClause `protobuf:"bytes,40,opt,name=union_clause,json=unionClause,proto3,oneof"`
+}
+
+type Node_StandaloneCall struct {
+	StandaloneCall *StandaloneCall `protobuf:"bytes,41,opt,name=standalone_call,json=standaloneCall,proto3,oneof"`
+}
+
+type Node_ReadingClause struct {
+	ReadingClause *ReadingClause `protobuf:"bytes,42,opt,name=reading_clause,json=readingClause,proto3,oneof"`
+}
+
+type Node_MatchClause struct {
+	MatchClause *MatchClause `protobuf:"bytes,43,opt,name=match_clause,json=matchClause,proto3,oneof"`
+}
+
+type Node_UnwindClause struct {
+	UnwindClause *UnwindClause `protobuf:"bytes,44,opt,name=unwind_clause,json=unwindClause,proto3,oneof"`
+}
+
+type Node_InQueryCallClause struct {
+	InQueryCallClause *InQueryCallClause `protobuf:"bytes,45,opt,name=in_query_call_clause,json=inQueryCallClause,proto3,oneof"`
+}
+
+type Node_WithClause struct {
+	WithClause *WithClause `protobuf:"bytes,46,opt,name=with_clause,json=withClause,proto3,oneof"`
+}
+
+type Node_ReturnClause struct {
+	ReturnClause *ReturnClause `protobuf:"bytes,47,opt,name=return_clause,json=returnClause,proto3,oneof"`
+}
+
+type Node_ReturnBody struct {
+	ReturnBody *ReturnBody `protobuf:"bytes,48,opt,name=return_body,json=returnBody,proto3,oneof"`
+}
+
+type Node_ReturnItem struct {
+	ReturnItem *ReturnItem `protobuf:"bytes,49,opt,name=return_item,json=returnItem,proto3,oneof"`
+}
+
+type Node_OrderClause struct {
+	OrderClause *OrderClause `protobuf:"bytes,50,opt,name=order_clause,json=orderClause,proto3,oneof"`
+}
+
+type Node_SortItem struct {
+	SortItem *SortItem `protobuf:"bytes,51,opt,name=sort_item,json=sortItem,proto3,oneof"`
+}
+
+type Node_CreateClause struct {
+	CreateClause *CreateClause `protobuf:"bytes,52,opt,name=create_clause,json=createClause,proto3,oneof"`
+}
+
+type Node_MergeClause struct {
+	MergeClause *MergeClause `protobuf:"bytes,53,opt,name=merge_clause,json=mergeClause,proto3,oneof"`
+}
+
+type Node_MergeAction struct {
+	MergeAction *MergeAction `protobuf:"bytes,54,opt,name=merge_action,json=mergeAction,proto3,oneof"`
+}
+
+type Node_SetClause struct {
+	SetClause *SetClause `protobuf:"bytes,55,opt,name=set_clause,json=setClause,proto3,oneof"`
+}
+
+type Node_SetItem struct {
+	SetItem *SetItem `protobuf:"bytes,56,opt,name=set_item,json=setItem,proto3,oneof"`
+}
+
+type Node_DeleteClause struct {
+	DeleteClause *DeleteClause `protobuf:"bytes,57,opt,name=delete_clause,json=deleteClause,proto3,oneof"`
+}
+
+type Node_RemoveClause struct {
+	RemoveClause *RemoveClause `protobuf:"bytes,58,opt,name=remove_clause,json=removeClause,proto3,oneof"`
+}
+
+type Node_RemoveItem struct {
+	RemoveItem *RemoveItem `protobuf:"bytes,59,opt,name=remove_item,json=removeItem,proto3,oneof"`
+}
+
+type Node_ExistsSubqueryExpr struct {
+	ExistsSubqueryExpr *ExistsSubqueryExpr `protobuf:"bytes,60,opt,name=exists_subquery_expr,json=existsSubqueryExpr,proto3,oneof"`
+}
+
+type Node_CountSubqueryExpr struct {
+	CountSubqueryExpr *CountSubqueryExpr `protobuf:"bytes,61,opt,name=count_subquery_expr,json=countSubqueryExpr,proto3,oneof"`
+}
+
+type Node_SubqueryClause struct {
+	SubqueryClause *SubqueryClause `protobuf:"bytes,62,opt,name=subquery_clause,json=subqueryClause,proto3,oneof"`
+}
+
+func (*Node_PropertyExpr) isNode_Node() {}
+
+func (*Node_BinaryExpr) isNode_Node() {}
+
+func (*Node_UnaryExpr) isNode_Node() {}
+
+func (*Node_PredicationExpr) isNode_Node() {}
+
+func (*Node_StringOperationExpr) isNode_Node() {}
+
+func (*Node_ListOperationExpr) isNode_Node() {}
+
+func (*Node_NullOperationExpr) isNode_Node() {}
+
+func (*Node_PropertyOrLabelsExpr) isNode_Node() {}
+
+func (*Node_PropertyLookup) isNode_Node() {}
+
+func (*Node_CaseExpr) isNode_Node() {}
+
+func (*Node_CaseAlt) isNode_Node() {}
+
+func (*Node_FilterExpr) isNode_Node() {}
+
+func (*Node_ListComprehension) isNode_Node() {}
+
+func (*Node_FunctionInvocation) isNode_Node() {}
+
+func (*Node_ParenExpr) isNode_Node() {}
+
+func (*Node_CountAllExpr) isNode_Node() {}
+
+func (*Node_SchemaNameNode) isNode_Node() {}
+
+func (*Node_SymbolicNameNode) isNode_Node() {}
+
+func (*Node_ReservedWordNode) isNode_Node() {}
+
+func (*Node_VariableNode) isNode_Node() {}
+
+func (*Node_NodeLabelNode) isNode_Node() {}
+
+func (*Node_ParameterNode) isNode_Node() {}
+
+func (*Node_LiteralExpr) isNode_Node() {}
+
+func (*Node_NumberLiteral) isNode_Node() {}
+
+func (*Node_MapLiteral) isNode_Node() {}
+
+func (*Node_ListLiteral) isNode_Node() {}
+
+func (*Node_Pattern) isNode_Node() {}
+
+func (*Node_PatternPart) isNode_Node() {}
+
+func (*Node_PatternElement) isNode_Node() {}
+
+func (*Node_NodePattern) isNode_Node() {}
+
+func (*Node_RelationshipPattern) isNode_Node() {}
+
+func (*Node_RelationshipDetail) isNode_Node() {}
+
+func (*Node_Properties) isNode_Node() {}
+
+func (*Node_PatternComprehension) isNode_Node() {}
+
+func (*Node_ProcedureInvocation) isNode_Node() {}
+
+func (*Node_YieldItems) isNode_Node() {}
+
+func (*Node_YieldItem) isNode_Node() {}
+
+func (*Node_CypherStmt) isNode_Node() {}
+
+func (*Node_QueryStmt) isNode_Node() {}
+
+func (*Node_UnionClause) isNode_Node() {}
+
+func (*Node_StandaloneCall) isNode_Node() {}
+
+func (*Node_ReadingClause) isNode_Node() {}
+
+func (*Node_MatchClause) isNode_Node() {}
+
+func (*Node_UnwindClause) isNode_Node() {}
+
+func (*Node_InQueryCallClause) isNode_Node() {}
+
+func (*Node_WithClause) isNode_Node() {}
+
+func (*Node_ReturnClause) isNode_Node() {}
+
+func (*Node_ReturnBody) isNode_Node() {}
+
+func (*Node_ReturnItem) isNode_Node() {}
+
+func (*Node_OrderClause) isNode_Node() {}
+
+func (*Node_SortItem) isNode_Node() {}
+
+func (*Node_CreateClause) isNode_Node() {}
+
+func (*Node_MergeClause) isNode_Node() {}
+
+func (*Node_MergeAction) isNode_Node() {}
+
+func (*Node_SetClause) isNode_Node() {}
+
+func (*Node_SetItem) isNode_Node() {}
+
+func (*Node_DeleteClause) isNode_Node() {}
+
+func (*Node_RemoveClause) isNode_Node() {}
+
+func (*Node_RemoveItem) isNode_Node() {}
+
+func (*Node_ExistsSubqueryExpr) isNode_Node() {}
+
+func (*Node_CountSubqueryExpr) isNode_Node() {}
+
+func (*Node_SubqueryClause) isNode_Node() {}
+
+// Expr is a node of ast.Expr.
+type Expr struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Node:
+	//
+	//	*Expr_PropertyExpr
+	//	*Expr_BinaryExpr
+	//	*Expr_UnaryExpr
+	//	*Expr_PredicationExpr
+	//	*Expr_StringOperationExpr
+	//	*Expr_ListOperationExpr
+	//	*Expr_NullOperationExpr
+	//	*Expr_PropertyOrLabelsExpr
+	//	*Expr_CaseExpr
+	//	*Expr_CaseAlt
+	//	*Expr_FilterExpr
+	//	*Expr_ListComprehension
+	//	*Expr_FunctionInvocation
+	//	*Expr_ParenExpr
+	//	*Expr_CountAllExpr
+	//	*Expr_VariableNode
+	//	*Expr_ParameterNode
+	//	*Expr_LiteralExpr
+	//	*Expr_Pattern
+	//	*Expr_PatternPart
+	//	*Expr_PatternElement
+	//	*Expr_NodePattern
+	//	*Expr_RelationshipPattern
+	//	*Expr_RelationshipDetail
+	//	*Expr_Properties
+	//	*Expr_PatternComprehension
+	//	*Expr_ExistsSubqueryExpr
+	//	*Expr_CountSubqueryExpr
+	Node          isExpr_Node `protobuf_oneof:"node"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Expr) Reset() {
+	*x = Expr{}
+	mi := &file_ast_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expr) ProtoMessage() {}
+
+func (x *Expr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expr.ProtoReflect.Descriptor instead.
+func (*Expr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Expr) GetNode() isExpr_Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *Expr) GetPropertyExpr() *PropertyExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_PropertyExpr); ok {
+			return x.PropertyExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetBinaryExpr() *BinaryExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_BinaryExpr); ok {
+			return x.BinaryExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetUnaryExpr() *UnaryExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_UnaryExpr); ok {
+			return x.UnaryExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetPredicationExpr() *PredicationExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_PredicationExpr); ok {
+			return x.PredicationExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetStringOperationExpr() *StringOperationExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_StringOperationExpr); ok {
+			return x.StringOperationExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetListOperationExpr() *ListOperationExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_ListOperationExpr); ok {
+			return x.ListOperationExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetNullOperationExpr() *NullOperationExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_NullOperationExpr); ok {
+			return x.NullOperationExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetPropertyOrLabelsExpr() *PropertyOrLabelsExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_PropertyOrLabelsExpr); ok {
+			return x.PropertyOrLabelsExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetCaseExpr() *CaseExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_CaseExpr); ok {
+			return x.CaseExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetCaseAlt() *CaseAlt {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_CaseAlt); ok {
+			return x.CaseAlt
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetFilterExpr() *FilterExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_FilterExpr); ok {
+			return x.FilterExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetListComprehension() *ListComprehension {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_ListComprehension); ok {
+			return x.ListComprehension
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetFunctionInvocation() *FunctionInvocation {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_FunctionInvocation); ok {
+			return x.FunctionInvocation
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetParenExpr() *ParenExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_ParenExpr); ok {
+			return x.ParenExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetCountAllExpr() *CountAllExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_CountAllExpr); ok {
+			return x.CountAllExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetVariableNode() *VariableNode {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_VariableNode); ok {
+			return x.VariableNode
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetParameterNode() *ParameterNode {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_ParameterNode); ok {
+			return x.ParameterNode
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetLiteralExpr() *LiteralExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_LiteralExpr); ok {
+			return x.LiteralExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetPattern() *Pattern {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_Pattern); ok {
+			return x.Pattern
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetPatternPart() *PatternPart {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_PatternPart); ok {
+			return x.PatternPart
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetPatternElement() *PatternElement {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_PatternElement); ok {
+			return x.PatternElement
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetNodePattern() *NodePattern {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_NodePattern); ok {
+			return x.NodePattern
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetRelationshipPattern() *RelationshipPattern {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_RelationshipPattern); ok {
+			return x.RelationshipPattern
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetRelationshipDetail() *RelationshipDetail {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_RelationshipDetail); ok {
+			return x.RelationshipDetail
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetProperties() *Properties {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_Properties); ok {
+			return x.Properties
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetPatternComprehension() *PatternComprehension {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_PatternComprehension); ok {
+			return x.PatternComprehension
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetExistsSubqueryExpr() *ExistsSubqueryExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_ExistsSubqueryExpr); ok {
+			return x.ExistsSubqueryExpr
+		}
+	}
+	return nil
+}
+
+func (x *Expr) GetCountSubqueryExpr() *CountSubqueryExpr {
+	if x != nil {
+		if x, ok := x.Node.(*Expr_CountSubqueryExpr); ok {
+			return x.CountSubqueryExpr
+		}
+	}
+	return nil
+}
+
+type isExpr_Node interface {
+	isExpr_Node()
+}
+
+type Expr_PropertyExpr struct {
+	PropertyExpr *PropertyExpr `protobuf:"bytes,1,opt,name=property_expr,json=propertyExpr,proto3,oneof"`
+}
+
+type Expr_BinaryExpr struct {
+	BinaryExpr *BinaryExpr `protobuf:"bytes,2,opt,name=binary_expr,json=binaryExpr,proto3,oneof"`
+}
+
+type Expr_UnaryExpr struct {
+	UnaryExpr *UnaryExpr `protobuf:"bytes,3,opt,name=unary_expr,json=unaryExpr,proto3,oneof"`
+}
+
+type Expr_PredicationExpr struct {
+	PredicationExpr *PredicationExpr `protobuf:"bytes,4,opt,name=predication_expr,json=predicationExpr,proto3,oneof"`
+}
+
+type Expr_StringOperationExpr struct {
+	StringOperationExpr *StringOperationExpr `protobuf:"bytes,5,opt,name=string_operation_expr,json=stringOperationExpr,proto3,oneof"`
+}
+
+type Expr_ListOperationExpr struct {
+	ListOperationExpr *ListOperationExpr `protobuf:"bytes,6,opt,name=list_operation_expr,json=listOperationExpr,proto3,oneof"`
+}
+
+type Expr_NullOperationExpr struct {
+	NullOperationExpr *NullOperationExpr `protobuf:"bytes,7,opt,name=null_operation_expr,json=nullOperationExpr,proto3,oneof"`
+}
+
+type Expr_PropertyOrLabelsExpr struct {
+	PropertyOrLabelsExpr *PropertyOrLabelsExpr `protobuf:"bytes,8,opt,name=property_or_labels_expr,json=propertyOrLabelsExpr,proto3,oneof"`
+}
+
+type Expr_CaseExpr struct {
+	CaseExpr *CaseExpr `protobuf:"bytes,10,opt,name=case_expr,json=caseExpr,proto3,oneof"`
+}
+
+type Expr_CaseAlt struct {
+	CaseAlt *CaseAlt `protobuf:"bytes,11,opt,name=case_alt,json=caseAlt,proto3,oneof"`
+}
+
+type Expr_FilterExpr struct {
+	FilterExpr *FilterExpr `protobuf:"bytes,12,opt,name=filter_expr,json=filterExpr,proto3,oneof"`
+}
+
+type Expr_ListComprehension struct {
+	ListComprehension *ListComprehension `protobuf:"bytes,13,opt,name=list_comprehension,json=listComprehension,proto3,oneof"`
+}
+
+type Expr_FunctionInvocation struct {
+	FunctionInvocation *FunctionInvocation `protobuf:"bytes,14,opt,name=function_invocation,json=functionInvocation,proto3,oneof"`
+}
+
+type Expr_ParenExpr struct {
+	ParenExpr *ParenExpr `protobuf:"bytes,15,opt,name=paren_expr,json=parenExpr,proto3,oneof"`
+}
+
+type Expr_CountAllExpr struct {
+	CountAllExpr *CountAllExpr `protobuf:"bytes,16,opt,name=count_all_expr,json=countAllExpr,proto3,oneof"`
+}
+
+type Expr_VariableNode struct {
+	VariableNode *VariableNode `protobuf:"bytes,20,opt,name=variable_node,json=variableNode,proto3,oneof"`
+}
+
+type Expr_ParameterNode struct {
+	ParameterNode *ParameterNode `protobuf:"bytes,22,opt,name=parameter_node,json=parameterNode,proto3,oneof"`
+}
+
+type Expr_LiteralExpr struct {
+	LiteralExpr *LiteralExpr `protobuf:"bytes,23,opt,name=literal_expr,json=literalExpr,proto3,oneof"`
+}
+
+type Expr_Pattern struct {
+	Pattern *Pattern `protobuf:"bytes,27,opt,name=pattern,proto3,oneof"`
+}
+
+type Expr_PatternPart struct {
+	PatternPart *PatternPart `protobuf:"bytes,28,opt,name=pattern_part,json=patternPart,proto3,oneof"`
+}
+
+type Expr_PatternElement struct {
+	PatternElement *PatternElement `protobuf:"bytes,29,opt,name=pattern_element,json=patternElement,proto3,oneof"`
+}
+
+type Expr_NodePattern struct {
+	NodePattern *NodePattern `protobuf:"bytes,30,opt,name=node_pattern,json=nodePattern,proto3,oneof"`
+}
+
+type Expr_RelationshipPattern struct {
+	RelationshipPattern *RelationshipPattern `protobuf:"bytes,31,opt,name=relationship_pattern,json=relationshipPattern,proto3,oneof"`
+}
+
+type Expr_RelationshipDetail struct {
+	RelationshipDetail *RelationshipDetail `protobuf:"bytes,32,opt,name=relationship_detail,json=relationshipDetail,proto3,oneof"`
+}
+
+type Expr_Properties struct {
+	Properties *Properties `protobuf:"bytes,33,opt,name=properties,proto3,oneof"`
+}
+
+type Expr_PatternComprehension struct {
+	PatternComprehension *PatternComprehension `protobuf:"bytes,34,opt,name=pattern_comprehension,json=patternComprehension,proto3,oneof"`
+}
+
+type Expr_ExistsSubqueryExpr struct {
+	ExistsSubqueryExpr *ExistsSubqueryExpr `protobuf:"bytes,60,opt,name=exists_subquery_expr,json=existsSubqueryExpr,proto3,oneof"`
+}
+
+type Expr_CountSubqueryExpr struct {
+	CountSubqueryExpr *CountSubqueryExpr `protobuf:"bytes,61,opt,name=count_subquery_expr,json=countSubqueryExpr,proto3,oneof"`
+}
+
+func (*Expr_PropertyExpr) isExpr_Node() {}
+
+func (*Expr_BinaryExpr) isExpr_Node() {}
+
+func (*Expr_UnaryExpr) isExpr_Node() {}
+
+func (*Expr_PredicationExpr) isExpr_Node() {}
+
+func (*Expr_StringOperationExpr) isExpr_Node() {}
+
+func (*Expr_ListOperationExpr) isExpr_Node() {}
+
+func (*Expr_NullOperationExpr) isExpr_Node() {}
+
+func (*Expr_PropertyOrLabelsExpr) isExpr_Node() {}
+
+func (*Expr_CaseExpr) isExpr_Node() {}
+
+func (*Expr_CaseAlt) isExpr_Node() {}
+
+func (*Expr_FilterExpr) isExpr_Node() {}
+
+func (*Expr_ListComprehension) isExpr_Node() {}
+
+func (*Expr_FunctionInvocation) isExpr_Node() {}
+
+func (*Expr_ParenExpr) isExpr_Node() {}
+
+func (*Expr_CountAllExpr) isExpr_Node() {}
+
+func (*Expr_VariableNode) isExpr_Node() {}
+
+func (*Expr_ParameterNode) isExpr_Node() {}
+
+func (*Expr_LiteralExpr) isExpr_Node() {}
+
+func (*Expr_Pattern) isExpr_Node() {}
+
+func (*Expr_PatternPart) isExpr_Node() {}
+
+func (*Expr_PatternElement) isExpr_Node() {}
+
+func (*Expr_NodePattern) isExpr_Node() {}
+
+func (*Expr_RelationshipPattern) isExpr_Node() {}
+
+func (*Expr_RelationshipDetail) isExpr_Node() {}
+
+func (*Expr_Properties) isExpr_Node() {}
+
+func (*Expr_PatternComprehension) isExpr_Node() {}
+
+func (*Expr_ExistsSubqueryExpr) isExpr_Node() {}
+
+func (*Expr_CountSubqueryExpr) isExpr_Node() {}
+
+// Stmt is a node of ast.Stmt.
+type Stmt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Node:
+	//
+	//	*Stmt_CypherStmt
+	//	*Stmt_QueryStmt
+	//	*Stmt_UnionClause
+	//	*Stmt_StandaloneCall
+	//	*Stmt_ReadingClause
+	//	*Stmt_MatchClause
+	//	*Stmt_UnwindClause
+	//	*Stmt_InQueryCallClause
+	//	*Stmt_WithClause
+	//	*Stmt_ReturnClause
+	//	*Stmt_ReturnBody
+	//	*Stmt_ReturnItem
+	//	*Stmt_OrderClause
+	//	*Stmt_SortItem
+	//	*Stmt_CreateClause
+	//	*Stmt_MergeClause
+	//	*Stmt_MergeAction
+	//	*Stmt_SetClause
+	//	*Stmt_SetItem
+	//	*Stmt_DeleteClause
+	//	*Stmt_RemoveClause
+	//	*Stmt_RemoveItem
+	//	*Stmt_SubqueryClause
+	Node          isStmt_Node `protobuf_oneof:"node"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stmt) Reset() {
+	*x = Stmt{}
+	mi := &file_ast_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stmt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stmt) ProtoMessage() {}
+
+func (x *Stmt) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stmt.ProtoReflect.Descriptor instead.
+func (*Stmt) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Stmt) GetNode() isStmt_Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *Stmt) GetCypherStmt() *CypherStmt {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_CypherStmt); ok {
+			return x.CypherStmt
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetQueryStmt() *QueryStmt {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_QueryStmt); ok {
+			return x.QueryStmt
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetUnionClause() *UnionClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_UnionClause); ok {
+			return x.UnionClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetStandaloneCall() *StandaloneCall {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_StandaloneCall); ok {
+			return x.StandaloneCall
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetReadingClause() *ReadingClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_ReadingClause); ok {
+			return x.ReadingClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetMatchClause() *MatchClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_MatchClause); ok {
+			return x.MatchClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetUnwindClause() *UnwindClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_UnwindClause); ok {
+			return x.UnwindClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetInQueryCallClause() *InQueryCallClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_InQueryCallClause); ok {
+			return x.InQueryCallClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetWithClause() *WithClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_WithClause); ok {
+			return x.WithClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetReturnClause() *ReturnClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_ReturnClause); ok {
+			return x.ReturnClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetReturnBody() *ReturnBody {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_ReturnBody); ok {
+			return x.ReturnBody
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetReturnItem() *ReturnItem {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_ReturnItem); ok {
+			return x.ReturnItem
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetOrderClause() *OrderClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_OrderClause); ok {
+			return x.OrderClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetSortItem() *SortItem {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_SortItem); ok {
+			return x.SortItem
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetCreateClause() *CreateClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_CreateClause); ok {
+			return x.CreateClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetMergeClause() *MergeClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_MergeClause); ok {
+			return x.MergeClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetMergeAction() *MergeAction {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_MergeAction); ok {
+			return x.MergeAction
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetSetClause() *SetClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_SetClause); ok {
+			return x.SetClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetSetItem() *SetItem {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_SetItem); ok {
+			return x.SetItem
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetDeleteClause() *DeleteClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_DeleteClause); ok {
+			return x.DeleteClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetRemoveClause() *RemoveClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_RemoveClause); ok {
+			return x.RemoveClause
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetRemoveItem() *RemoveItem {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_RemoveItem); ok {
+			return x.RemoveItem
+		}
+	}
+	return nil
+}
+
+func (x *Stmt) GetSubqueryClause() *SubqueryClause {
+	if x != nil {
+		if x, ok := x.Node.(*Stmt_SubqueryClause); ok {
+			return x.SubqueryClause
+		}
+	}
+	return nil
+}
+
+type isStmt_Node interface {
+	isStmt_Node()
+}
+
+type Stmt_CypherStmt struct {
+	CypherStmt *CypherStmt `protobuf:"bytes,38,opt,name=cypher_stmt,json=cypherStmt,proto3,oneof"`
+}
+
+type Stmt_QueryStmt struct {
+	QueryStmt *QueryStmt `protobuf:"bytes,39,opt,name=query_stmt,json=queryStmt,proto3,oneof"`
+}
+
+type Stmt_UnionClause struct {
+	UnionClause *UnionClause `protobuf:"bytes,40,opt,name=union_clause,json=unionClause,proto3,oneof"`
+}
+
+type Stmt_StandaloneCall struct {
+	StandaloneCall *StandaloneCall `protobuf:"bytes,41,opt,name=standalone_call,json=standaloneCall,proto3,oneof"`
+}
+
+type Stmt_ReadingClause struct {
+	ReadingClause *ReadingClause `protobuf:"bytes,42,opt,name=reading_clause,json=readingClause,proto3,oneof"`
+}
+
+type Stmt_MatchClause struct {
+	MatchClause *MatchClause `protobuf:"bytes,43,opt,name=match_clause,json=matchClause,proto3,oneof"`
+}
+
+type Stmt_UnwindClause struct {
+	UnwindClause *UnwindClause `protobuf:"bytes,44,opt,name=unwind_clause,json=unwindClause,proto3,oneof"`
+}
+
+type Stmt_InQueryCallClause struct {
+	InQueryCallClause *InQueryCallClause `protobuf:"bytes,45,opt,name=in_query_call_clause,json=inQueryCallClause,proto3,oneof"`
+}
+
+type Stmt_WithClause struct {
+	WithClause *WithClause `protobuf:"bytes,46,opt,name=with_clause,json=withClause,proto3,oneof"`
+}
+
+type Stmt_ReturnClause struct {
+	ReturnClause *ReturnClause `protobuf:"bytes,47,opt,name=return_clause,json=returnClause,proto3,oneof"`
+}
+
+type Stmt_ReturnBody struct {
+	ReturnBody *ReturnBody `protobuf:"bytes,48,opt,name=return_body,json=returnBody,proto3,oneof"`
+}
+
+type Stmt_ReturnItem struct {
+	ReturnItem *ReturnItem `protobuf:"bytes,49,opt,name=return_item,json=returnItem,proto3,oneof"`
+}
+
+type Stmt_OrderClause struct {
+	OrderClause *OrderClause `protobuf:"bytes,50,opt,name=order_clause,json=orderClause,proto3,oneof"`
+}
+
+type Stmt_SortItem struct {
+	SortItem *SortItem `protobuf:"bytes,51,opt,name=sort_item,json=sortItem,proto3,oneof"`
+}
+
+type Stmt_CreateClause struct {
+	CreateClause *CreateClause `protobuf:"bytes,52,opt,name=create_clause,json=createClause,proto3,oneof"`
+}
+
+type Stmt_MergeClause struct {
+	MergeClause *MergeClause `protobuf:"bytes,53,opt,name=merge_clause,json=mergeClause,proto3,oneof"`
+}
+
+type Stmt_MergeAction struct {
+	MergeAction *MergeAction `protobuf:"bytes,54,opt,name=merge_action,json=mergeAction,proto3,oneof"`
+}
+
+type Stmt_SetClause struct {
+	SetClause *SetClause `protobuf:"bytes,55,opt,name=set_clause,json=setClause,proto3,oneof"`
+}
+
+type Stmt_SetItem struct {
+	SetItem *SetItem `protobuf:"bytes,56,opt,name=set_item,json=setItem,proto3,oneof"`
+}
+
+type Stmt_DeleteClause struct {
+	DeleteClause *DeleteClause `protobuf:"bytes,57,opt,name=delete_clause,json=deleteClause,proto3,oneof"`
+}
+
+type Stmt_RemoveClause struct {
+	RemoveClause *RemoveClause `protobuf:"bytes,58,opt,name=remove_clause,json=removeClause,proto3,oneof"`
+}
+
+type Stmt_RemoveItem struct {
+	RemoveItem *RemoveItem `protobuf:"bytes,59,opt,name=remove_item,json=removeItem,proto3,oneof"`
+}
+
+type Stmt_SubqueryClause struct {
+	SubqueryClause *SubqueryClause `protobuf:"bytes,62,opt,name=subquery_clause,json=subqueryClause,proto3,oneof"`
+}
+
+func (*Stmt_CypherStmt) isStmt_Node() {}
+
+func (*Stmt_QueryStmt) isStmt_Node() {}
+
+func (*Stmt_UnionClause) isStmt_Node() {}
+
+func (*Stmt_StandaloneCall) isStmt_Node() {}
+
+func (*Stmt_ReadingClause) isStmt_Node() {}
+
+func (*Stmt_MatchClause) isStmt_Node() {}
+
+func (*Stmt_UnwindClause) isStmt_Node() {}
+
+func (*Stmt_InQueryCallClause) isStmt_Node() {}
+
+func (*Stmt_WithClause) isStmt_Node() {}
+
+func (*Stmt_ReturnClause) isStmt_Node() {}
+
+func (*Stmt_ReturnBody) isStmt_Node() {}
+
+func (*Stmt_ReturnItem) isStmt_Node() {}
+
+func (*Stmt_OrderClause) isStmt_Node() {}
+
+func (*Stmt_SortItem) isStmt_Node() {}
+
+func (*Stmt_CreateClause) isStmt_Node() {}
+
+func (*Stmt_MergeClause) isStmt_Node() {}
+
+func (*Stmt_MergeAction) isStmt_Node() {}
+
+func (*Stmt_SetClause) isStmt_Node() {}
+
+func (*Stmt_SetItem) isStmt_Node() {}
+
+func (*Stmt_DeleteClause) isStmt_Node() {}
+
+func (*Stmt_RemoveClause) isStmt_Node() {}
+
+func (*Stmt_RemoveItem) isStmt_Node() {}
+
+func (*Stmt_SubqueryClause) isStmt_Node() {}
+
+type PropertyExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Expr          *Expr                  `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
+	Lookups       []*PropertyLookup      `protobuf:"bytes,3,rep,name=lookups,proto3" json:"lookups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyExpr) Reset() {
+	*x = PropertyExpr{}
+	mi := &file_ast_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyExpr) ProtoMessage() {}
+
+func (x *PropertyExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyExpr.ProtoReflect.Descriptor instead.
+func (*PropertyExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PropertyExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PropertyExpr) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+func (x *PropertyExpr) GetLookups() []*PropertyLookup {
+	if x != nil {
+		return x.Lookups
+	}
+	return nil
+}
+
+type BinaryExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Op            OpType                 `protobuf:"varint,2,opt,name=op,proto3,enum=leiysky.parser.ast.OpType" json:"op,omitempty"`
+	L             *Expr                  `protobuf:"bytes,3,opt,name=l,proto3" json:"l,omitempty"`
+	R             *Expr                  `protobuf:"bytes,4,opt,name=r,proto3" json:"r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BinaryExpr) Reset() {
+	*x = BinaryExpr{}
+	mi := &file_ast_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinaryExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryExpr) ProtoMessage() {}
+
+func (x *BinaryExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryExpr.ProtoReflect.Descriptor instead.
+func (*BinaryExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BinaryExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *BinaryExpr) GetOp() OpType {
+	if x != nil {
+		return x.Op
+	}
+	return OpType_OP_ADD
+}
+
+func (x *BinaryExpr) GetL() *Expr {
+	if x != nil {
+		return x.L
+	}
+	return nil
+}
+
+func (x *BinaryExpr) GetR() *Expr {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+type UnaryExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Op            OpType                 `protobuf:"varint,2,opt,name=op,proto3,enum=leiysky.parser.ast.OpType" json:"op,omitempty"`
+	V             *Expr                  `protobuf:"bytes,3,opt,name=v,proto3" json:"v,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnaryExpr) Reset() {
+	*x = UnaryExpr{}
+	mi := &file_ast_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnaryExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnaryExpr) ProtoMessage() {}
+
+func (x *UnaryExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnaryExpr.ProtoReflect.Descriptor instead.
+func (*UnaryExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnaryExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UnaryExpr) GetOp() OpType {
+	if x != nil {
+		return x.Op
+	}
+	return OpType_OP_ADD
+}
+
+func (x *UnaryExpr) GetV() *Expr {
+	if x != nil {
+		return x.V
+	}
+	return nil
+}
+
+type PredicationExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          PredicationType        `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.PredicationType" json:"type,omitempty"`
+	Expr          *Expr                  `protobuf:"bytes,3,opt,name=expr,proto3" json:"expr,omitempty"`
+	Op            *Expr                  `protobuf:"bytes,4,opt,name=op,proto3" json:"op,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PredicationExpr) Reset() {
+	*x = PredicationExpr{}
+	mi := &file_ast_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PredicationExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredicationExpr) ProtoMessage() {}
+
+func (x *PredicationExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredicationExpr.ProtoReflect.Descriptor instead.
+func (*PredicationExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PredicationExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PredicationExpr) GetType() PredicationType {
+	if x != nil {
+		return x.Type
+	}
+	return PredicationType_PREDICATION_STRING_OP
+}
+
+func (x *PredicationExpr) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+func (x *PredicationExpr) GetOp() *Expr {
+	if x != nil {
+		return x.Op
+	}
+	return nil
+}
+
+type StringOperationExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          StringOperationType    `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.StringOperationType" json:"type,omitempty"`
+	Expr          *Expr                  `protobuf:"bytes,3,opt,name=expr,proto3" json:"expr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringOperationExpr) Reset() {
+	*x = StringOperationExpr{}
+	mi := &file_ast_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringOperationExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringOperationExpr) ProtoMessage() {}
+
+func (x *StringOperationExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringOperationExpr.ProtoReflect.Descriptor instead.
+func (*StringOperationExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StringOperationExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *StringOperationExpr) GetType() StringOperationType {
+	if x != nil {
+		return x.Type
+	}
+	return StringOperationType_STRING_OPERATION_STARTS_WITH
+}
+
+func (x *StringOperationExpr) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+type ListOperationExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	InExpr        *Expr                  `protobuf:"bytes,2,opt,name=in_expr,json=inExpr,proto3" json:"in_expr,omitempty"`
+	SingleExpr    *Expr                  `protobuf:"bytes,3,opt,name=single_expr,json=singleExpr,proto3" json:"single_expr,omitempty"`
+	LowerBound    *Expr                  `protobuf:"bytes,4,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound    *Expr                  `protobuf:"bytes,5,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationExpr) Reset() {
+	*x = ListOperationExpr{}
+	mi := &file_ast_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationExpr) ProtoMessage() {}
+
+func (x *ListOperationExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationExpr.ProtoReflect.Descriptor instead.
+func (*ListOperationExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListOperationExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListOperationExpr) GetInExpr() *Expr {
+	if x != nil {
+		return x.InExpr
+	}
+	return nil
+}
+
+func (x *ListOperationExpr) GetSingleExpr() *Expr {
+	if x != nil {
+		return x.SingleExpr
+	}
+	return nil
+}
+
+func (x *ListOperationExpr) GetLowerBound() *Expr {
+	if x != nil {
+		return x.LowerBound
+	}
+	return nil
+}
+
+func (x *ListOperationExpr) GetUpperBound() *Expr {
+	if x != nil {
+		return x.UpperBound
+	}
+	return nil
+}
+
+type NullOperationExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	IsIsNull      bool                   `protobuf:"varint,2,opt,name=is_is_null,json=isIsNull,proto3" json:"is_is_null,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NullOperationExpr) Reset() {
+	*x = NullOperationExpr{}
+	mi := &file_ast_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NullOperationExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NullOperationExpr) ProtoMessage() {}
+
+func (x *NullOperationExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NullOperationExpr.ProtoReflect.Descriptor instead.
+func (*NullOperationExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NullOperationExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *NullOperationExpr) GetIsIsNull() bool {
+	if x != nil {
+		return x.IsIsNull
+	}
+	return false
+}
+
+type PropertyOrLabelsExpr struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Expr            *Expr                  `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
+	PropertyLookups []*PropertyLookup      `protobuf:"bytes,3,rep,name=property_lookups,json=propertyLookups,proto3" json:"property_lookups,omitempty"`
+	NodeLabels      []*NodeLabelNode       `protobuf:"bytes,4,rep,name=node_labels,json=nodeLabels,proto3" json:"node_labels,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PropertyOrLabelsExpr) Reset() {
+	*x = PropertyOrLabelsExpr{}
+	mi := &file_ast_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyOrLabelsExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyOrLabelsExpr) ProtoMessage() {}
+
+func (x *PropertyOrLabelsExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyOrLabelsExpr.ProtoReflect.Descriptor instead.
+func (*PropertyOrLabelsExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PropertyOrLabelsExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PropertyOrLabelsExpr) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+func (x *PropertyOrLabelsExpr) GetPropertyLookups() []*PropertyLookup {
+	if x != nil {
+		return x.PropertyLookups
+	}
+	return nil
+}
+
+func (x *PropertyOrLabelsExpr) GetNodeLabels() []*NodeLabelNode {
+	if x != nil {
+		return x.NodeLabels
+	}
+	return nil
+}
+
+type PropertyLookup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PropertyKey   *SchemaNameNode        `protobuf:"bytes,2,opt,name=property_key,json=propertyKey,proto3" json:"property_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyLookup) Reset() {
+	*x = PropertyLookup{}
+	mi := &file_ast_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyLookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyLookup) ProtoMessage() {}
+
+func (x *PropertyLookup) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyLookup.ProtoReflect.Descriptor instead.
+func (*PropertyLookup) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PropertyLookup) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PropertyLookup) GetPropertyKey() *SchemaNameNode {
+	if x != nil {
+		return x.PropertyKey
+	}
+	return nil
+}
+
+type CaseExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Expr          *Expr                  `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
+	Alts          []*CaseAlt             `protobuf:"bytes,3,rep,name=alts,proto3" json:"alts,omitempty"`
+	Else          *Expr                  `protobuf:"bytes,4,opt,name=else,proto3" json:"else,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseExpr) Reset() {
+	*x = CaseExpr{}
+	mi := &file_ast_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseExpr) ProtoMessage() {}
+
+func (x *CaseExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseExpr.ProtoReflect.Descriptor instead.
+func (*CaseExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CaseExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CaseExpr) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+func (x *CaseExpr) GetAlts() []*CaseAlt {
+	if x != nil {
+		return x.Alts
+	}
+	return nil
+}
+
+func (x *CaseExpr) GetElse() *Expr {
+	if x != nil {
+		return x.Else
+	}
+	return nil
+}
+
+type CaseAlt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	When          *Expr                  `protobuf:"bytes,2,opt,name=when,proto3" json:"when,omitempty"`
+	Then          *Expr                  `protobuf:"bytes,3,opt,name=then,proto3" json:"then,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseAlt) Reset() {
+	*x = CaseAlt{}
+	mi := &file_ast_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseAlt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseAlt) ProtoMessage() {}
+
+func (x *CaseAlt) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseAlt.ProtoReflect.Descriptor instead.
+func (*CaseAlt) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CaseAlt) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CaseAlt) GetWhen() *Expr {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *CaseAlt) GetThen() *Expr {
+	if x != nil {
+		return x.Then
+	}
+	return nil
+}
+
+type FilterExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          FilterType             `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.FilterType" json:"type,omitempty"`
+	Variable      *VariableNode          `protobuf:"bytes,3,opt,name=variable,proto3" json:"variable,omitempty"`
+	In            *Expr                  `protobuf:"bytes,4,opt,name=in,proto3" json:"in,omitempty"`
+	Where         *Expr                  `protobuf:"bytes,5,opt,name=where,proto3" json:"where,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterExpr) Reset() {
+	*x = FilterExpr{}
+	mi := &file_ast_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterExpr) ProtoMessage() {}
+
+func (x *FilterExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterExpr.ProtoReflect.Descriptor instead.
+func (*FilterExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FilterExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *FilterExpr) GetType() FilterType {
+	if x != nil {
+		return x.Type
+	}
+	return FilterType_FILTER_LIST_COMPREHENSION
+}
+
+func (x *FilterExpr) GetVariable() *VariableNode {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+func (x *FilterExpr) GetIn() *Expr {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *FilterExpr) GetWhere() *Expr {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+type ListComprehension struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	FilterExpr    *FilterExpr            `protobuf:"bytes,2,opt,name=filter_expr,json=filterExpr,proto3" json:"filter_expr,omitempty"`
+	Expr          *Expr                  `protobuf:"bytes,3,opt,name=expr,proto3" json:"expr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComprehension) Reset() {
+	*x = ListComprehension{}
+	mi := &file_ast_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComprehension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComprehension) ProtoMessage() {}
+
+func (x *ListComprehension) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComprehension.ProtoReflect.Descriptor instead.
+func (*ListComprehension) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListComprehension) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListComprehension) GetFilterExpr() *FilterExpr {
+	if x != nil {
+		return x.FilterExpr
+	}
+	return nil
+}
+
+func (x *ListComprehension) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+type FunctionInvocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Namespace     []*SymbolicNameNode    `protobuf:"bytes,2,rep,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          *SymbolicNameNode      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Distinct      bool                   `protobuf:"varint,4,opt,name=distinct,proto3" json:"distinct,omitempty"`
+	Args          []*Expr                `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FunctionInvocation) Reset() {
+	*x = FunctionInvocation{}
+	mi := &file_ast_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FunctionInvocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionInvocation) ProtoMessage() {}
+
+func (x *FunctionInvocation) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionInvocation.ProtoReflect.Descriptor instead.
+func (*FunctionInvocation) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FunctionInvocation) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *FunctionInvocation) GetNamespace() []*SymbolicNameNode {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *FunctionInvocation) GetName() *SymbolicNameNode {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *FunctionInvocation) GetDistinct() bool {
+	if x != nil {
+		return x.Distinct
+	}
+	return false
+}
+
+func (x *FunctionInvocation) GetArgs() []*Expr {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type ParenExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Expr          *Expr                  `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParenExpr) Reset() {
+	*x = ParenExpr{}
+	mi := &file_ast_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParenExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParenExpr) ProtoMessage() {}
+
+func (x *ParenExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParenExpr.ProtoReflect.Descriptor instead.
+func (*ParenExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ParenExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ParenExpr) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+type CountAllExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountAllExpr) Reset() {
+	*x = CountAllExpr{}
+	mi := &file_ast_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountAllExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountAllExpr) ProtoMessage() {}
+
+func (x *CountAllExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountAllExpr.ProtoReflect.Descriptor instead.
+func (*CountAllExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CountAllExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ExistsSubqueryExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Query         *QueryStmt             `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Pattern       *Pattern               `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Where         *Expr                  `protobuf:"bytes,4,opt,name=where,proto3" json:"where,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExistsSubqueryExpr) Reset() {
+	*x = ExistsSubqueryExpr{}
+	mi := &file_ast_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExistsSubqueryExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsSubqueryExpr) ProtoMessage() {}
+
+func (x *ExistsSubqueryExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsSubqueryExpr.ProtoReflect.Descriptor instead.
+func (*ExistsSubqueryExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExistsSubqueryExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ExistsSubqueryExpr) GetQuery() *QueryStmt {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ExistsSubqueryExpr) GetPattern() *Pattern {
+	if x != nil {
+		return x.Pattern
+	}
+	return nil
+}
+
+func (x *ExistsSubqueryExpr) GetWhere() *Expr {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+type CountSubqueryExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Query         *QueryStmt             `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Pattern       *Pattern               `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Where         *Expr                  `protobuf:"bytes,4,opt,name=where,proto3" json:"where,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountSubqueryExpr) Reset() {
+	*x = CountSubqueryExpr{}
+	mi := &file_ast_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountSubqueryExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountSubqueryExpr) ProtoMessage() {}
+
+func (x *CountSubqueryExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountSubqueryExpr.ProtoReflect.Descriptor instead.
+func (*CountSubqueryExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CountSubqueryExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CountSubqueryExpr) GetQuery() *QueryStmt {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CountSubqueryExpr) GetPattern() *Pattern {
+	if x != nil {
+		return x.Pattern
+	}
+	return nil
+}
+
+func (x *CountSubqueryExpr) GetWhere() *Expr {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+type SchemaNameNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          SchemaNameType         `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.SchemaNameType" json:"type,omitempty"`
+	SymbolicName  *SymbolicNameNode      `protobuf:"bytes,3,opt,name=symbolic_name,json=symbolicName,proto3" json:"symbolic_name,omitempty"`
+	ReservedWord  *ReservedWordNode      `protobuf:"bytes,4,opt,name=reserved_word,json=reservedWord,proto3" json:"reserved_word,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaNameNode) Reset() {
+	*x = SchemaNameNode{}
+	mi := &file_ast_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaNameNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaNameNode) ProtoMessage() {}
+
+func (x *SchemaNameNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaNameNode.ProtoReflect.Descriptor instead.
+func (*SchemaNameNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SchemaNameNode) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SchemaNameNode) GetType() SchemaNameType {
+	if x != nil {
+		return x.Type
+	}
+	return SchemaNameType_SCHEMA_NAME_SYMBOLIC_NAME
+}
+
+func (x *SchemaNameNode) GetSymbolicName() *SymbolicNameNode {
+	if x != nil {
+		return x.SymbolicName
+	}
+	return nil
+}
+
+func (x *SchemaNameNode) GetReservedWord() *ReservedWordNode {
+	if x != nil {
+		return x.ReservedWord
+	}
+	return nil
+}
+
+type SymbolicNameNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          SymbolicNameType       `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.SymbolicNameType" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SymbolicNameNode) Reset() {
+	*x = SymbolicNameNode{}
+	mi := &file_ast_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolicNameNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolicNameNode) ProtoMessage() {}
+
+func (x *SymbolicNameNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolicNameNode.ProtoReflect.Descriptor instead.
+func (*SymbolicNameNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SymbolicNameNode) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SymbolicNameNode) GetType() SymbolicNameType {
+	if x != nil {
+		return x.Type
+	}
+	return SymbolicNameType_SYMBOLIC_NAME_UNESCAPED
+}
+
+func (x *SymbolicNameNode) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ReservedWordNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservedWordNode) Reset() {
+	*x = ReservedWordNode{}
+	mi := &file_ast_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservedWordNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservedWordNode) ProtoMessage() {}
+
+func (x *ReservedWordNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservedWordNode.ProtoReflect.Descriptor instead.
+func (*ReservedWordNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReservedWordNode) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ReservedWordNode) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type VariableNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SymbolicName  *SymbolicNameNode      `protobuf:"bytes,2,opt,name=symbolic_name,json=symbolicName,proto3" json:"symbolic_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableNode) Reset() {
+	*x = VariableNode{}
+	mi := &file_ast_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableNode) ProtoMessage() {}
+
+func (x *VariableNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableNode.ProtoReflect.Descriptor instead.
+func (*VariableNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VariableNode) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *VariableNode) GetSymbolicName() *SymbolicNameNode {
+	if x != nil {
+		return x.SymbolicName
+	}
+	return nil
+}
+
+type NodeLabelNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	LabelName     *SchemaNameNode        `protobuf:"bytes,2,opt,name=label_name,json=labelName,proto3" json:"label_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeLabelNode) Reset() {
+	*x = NodeLabelNode{}
+	mi := &file_ast_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeLabelNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeLabelNode) ProtoMessage() {}
+
+func (x *NodeLabelNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeLabelNode.ProtoReflect.Descriptor instead.
+func (*NodeLabelNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NodeLabelNode) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *NodeLabelNode) GetLabelName() *SchemaNameNode {
+	if x != nil {
+		return x.LabelName
+	}
+	return nil
+}
+
+type ParameterNode struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Base           *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type           ParameterType          `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.ParameterType" json:"type,omitempty"`
+	SymbolicName   *SymbolicNameNode      `protobuf:"bytes,3,opt,name=symbolic_name,json=symbolicName,proto3" json:"symbolic_name,omitempty"`
+	DecimalInteger int64                  `protobuf:"varint,4,opt,name=decimal_integer,json=decimalInteger,proto3" json:"decimal_integer,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ParameterNode) Reset() {
+	*x = ParameterNode{}
+	mi := &file_ast_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParameterNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterNode) ProtoMessage() {}
+
+func (x *ParameterNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterNode.ProtoReflect.Descriptor instead.
+func (*ParameterNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ParameterNode) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ParameterNode) GetType() ParameterType {
+	if x != nil {
+		return x.Type
+	}
+	return ParameterType_PARAMETER_SYMBOLIC_NAME
+}
+
+func (x *ParameterNode) GetSymbolicName() *SymbolicNameNode {
+	if x != nil {
+		return x.SymbolicName
+	}
+	return nil
+}
+
+func (x *ParameterNode) GetDecimalInteger() int64 {
+	if x != nil {
+		return x.DecimalInteger
+	}
+	return 0
+}
+
+type LiteralExpr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          LiteralType            `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.LiteralType" json:"type,omitempty"`
+	Number        *NumberLiteral         `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
+	String_       string                 `protobuf:"bytes,4,opt,name=string,proto3" json:"string,omitempty"`
+	Boolean       bool                   `protobuf:"varint,5,opt,name=boolean,proto3" json:"boolean,omitempty"`
+	Map           *MapLiteral            `protobuf:"bytes,6,opt,name=map,proto3" json:"map,omitempty"`
+	List          *ListLiteral           `protobuf:"bytes,7,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiteralExpr) Reset() {
+	*x = LiteralExpr{}
+	mi := &file_ast_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiteralExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiteralExpr) ProtoMessage() {}
+
+func (x *LiteralExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiteralExpr.ProtoReflect.Descriptor instead.
+func (*LiteralExpr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LiteralExpr) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *LiteralExpr) GetType() LiteralType {
+	if x != nil {
+		return x.Type
+	}
+	return LiteralType_LITERAL_NUMBER
+}
+
+func (x *LiteralExpr) GetNumber() *NumberLiteral {
+	if x != nil {
+		return x.Number
+	}
+	return nil
+}
+
+func (x *LiteralExpr) GetString_() string {
+	if x != nil {
+		return x.String_
+	}
+	return ""
+}
+
+func (x *LiteralExpr) GetBoolean() bool {
+	if x != nil {
+		return x.Boolean
+	}
+	return false
+}
+
+func (x *LiteralExpr) GetMap() *MapLiteral {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *LiteralExpr) GetList() *ListLiteral {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type NumberLiteral struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          NumberLiteralType      `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.NumberLiteralType" json:"type,omitempty"`
+	Integer       int64                  `protobuf:"varint,3,opt,name=integer,proto3" json:"integer,omitempty"`
+	Double        float64                `protobuf:"fixed64,4,opt,name=double,proto3" json:"double,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NumberLiteral) Reset() {
+	*x = NumberLiteral{}
+	mi := &file_ast_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NumberLiteral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberLiteral) ProtoMessage() {}
+
+func (x *NumberLiteral) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberLiteral.ProtoReflect.Descriptor instead.
+func (*NumberLiteral) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NumberLiteral) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *NumberLiteral) GetType() NumberLiteralType {
+	if x != nil {
+		return x.Type
+	}
+	return NumberLiteralType_NUMBER_LITERAL_INTEGER
+}
+
+func (x *NumberLiteral) GetInteger() int64 {
+	if x != nil {
+		return x.Integer
+	}
+	return 0
+}
+
+func (x *NumberLiteral) GetDouble() float64 {
+	if x != nil {
+		return x.Double
+	}
+	return 0
+}
+
+type MapLiteral struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PropertyKeys  []*SchemaNameNode      `protobuf:"bytes,2,rep,name=property_keys,json=propertyKeys,proto3" json:"property_keys,omitempty"`
+	Exprs         []*Expr                `protobuf:"bytes,3,rep,name=exprs,proto3" json:"exprs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapLiteral) Reset() {
+	*x = MapLiteral{}
+	mi := &file_ast_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapLiteral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapLiteral) ProtoMessage() {}
+
+func (x *MapLiteral) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapLiteral.ProtoReflect.Descriptor instead.
+func (*MapLiteral) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MapLiteral) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MapLiteral) GetPropertyKeys() []*SchemaNameNode {
+	if x != nil {
+		return x.PropertyKeys
+	}
+	return nil
+}
+
+func (x *MapLiteral) GetExprs() []*Expr {
+	if x != nil {
+		return x.Exprs
+	}
+	return nil
+}
+
+type ListLiteral struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Exprs         []*Expr                `protobuf:"bytes,2,rep,name=exprs,proto3" json:"exprs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLiteral) Reset() {
+	*x = ListLiteral{}
+	mi := &file_ast_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLiteral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLiteral) ProtoMessage() {}
+
+func (x *ListLiteral) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLiteral.ProtoReflect.Descriptor instead.
+func (*ListLiteral) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListLiteral) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListLiteral) GetExprs() []*Expr {
+	if x != nil {
+		return x.Exprs
+	}
+	return nil
+}
+
+type Pattern struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Parts         []*PatternPart         `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pattern) Reset() {
+	*x = Pattern{}
+	mi := &file_ast_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pattern) ProtoMessage() {}
+
+func (x *Pattern) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pattern.ProtoReflect.Descriptor instead.
+func (*Pattern) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Pattern) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *Pattern) GetParts() []*PatternPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type PatternPart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Variable      *VariableNode          `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	Element       *PatternElement        `protobuf:"bytes,3,opt,name=element,proto3" json:"element,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatternPart) Reset() {
+	*x = PatternPart{}
+	mi := &file_ast_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatternPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatternPart) ProtoMessage() {}
+
+func (x *PatternPart) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatternPart.ProtoReflect.Descriptor instead.
+func (*PatternPart) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PatternPart) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PatternPart) GetVariable() *VariableNode {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+func (x *PatternPart) GetElement() *PatternElement {
+	if x != nil {
+		return x.Element
+	}
+	return nil
+}
+
+type PatternElement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Relationships []*RelationshipPattern `protobuf:"bytes,2,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	Nodes         []*NodePattern         `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatternElement) Reset() {
+	*x = PatternElement{}
+	mi := &file_ast_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatternElement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatternElement) ProtoMessage() {}
+
+func (x *PatternElement) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatternElement.ProtoReflect.Descriptor instead.
+func (*PatternElement) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PatternElement) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PatternElement) GetRelationships() []*RelationshipPattern {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+func (x *PatternElement) GetNodes() []*NodePattern {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type NodePattern struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Variable      *VariableNode          `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	Labels        []*NodeLabelNode       `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	Properties    *Properties            `protobuf:"bytes,4,opt,name=properties,proto3" json:"properties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodePattern) Reset() {
+	*x = NodePattern{}
+	mi := &file_ast_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodePattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePattern) ProtoMessage() {}
+
+func (x *NodePattern) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePattern.ProtoReflect.Descriptor instead.
+func (*NodePattern) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NodePattern) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *NodePattern) GetVariable() *VariableNode {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+func (x *NodePattern) GetLabels() []*NodeLabelNode {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NodePattern) GetProperties() *Properties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type RelationshipPattern struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          RelationshipType       `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.RelationshipType" json:"type,omitempty"`
+	Detail        *RelationshipDetail    `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipPattern) Reset() {
+	*x = RelationshipPattern{}
+	mi := &file_ast_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipPattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipPattern) ProtoMessage() {}
+
+func (x *RelationshipPattern) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipPattern.ProtoReflect.Descriptor instead.
+func (*RelationshipPattern) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RelationshipPattern) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RelationshipPattern) GetType() RelationshipType {
+	if x != nil {
+		return x.Type
+	}
+	return RelationshipType_RELATIONSHIP_IN
+}
+
+func (x *RelationshipPattern) GetDetail() *RelationshipDetail {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+type RelationshipDetail struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Base              *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Variable          *VariableNode          `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	RelationshipTypes []*SchemaNameNode      `protobuf:"bytes,3,rep,name=relationship_types,json=relationshipTypes,proto3" json:"relationship_types,omitempty"`
+	MinHops           int64                  `protobuf:"varint,4,opt,name=min_hops,json=minHops,proto3" json:"min_hops,omitempty"`
+	MaxHops           int64                  `protobuf:"varint,5,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	Properties        *Properties            `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RelationshipDetail) Reset() {
+	*x = RelationshipDetail{}
+	mi := &file_ast_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipDetail) ProtoMessage() {}
+
+func (x *RelationshipDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipDetail.ProtoReflect.Descriptor instead.
+func (*RelationshipDetail) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RelationshipDetail) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RelationshipDetail) GetVariable() *VariableNode {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+func (x *RelationshipDetail) GetRelationshipTypes() []*SchemaNameNode {
+	if x != nil {
+		return x.RelationshipTypes
+	}
+	return nil
+}
+
+func (x *RelationshipDetail) GetMinHops() int64 {
+	if x != nil {
+		return x.MinHops
+	}
+	return 0
+}
+
+func (x *RelationshipDetail) GetMaxHops() int64 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+func (x *RelationshipDetail) GetProperties() *Properties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type Properties struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          PropertiesType         `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.PropertiesType" json:"type,omitempty"`
+	MapLiteral    *MapLiteral            `protobuf:"bytes,3,opt,name=map_literal,json=mapLiteral,proto3" json:"map_literal,omitempty"`
+	Parameter     *ParameterNode         `protobuf:"bytes,4,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Properties) Reset() {
+	*x = Properties{}
+	mi := &file_ast_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Properties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Properties) ProtoMessage() {}
+
+func (x *Properties) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Properties.ProtoReflect.Descriptor instead.
+func (*Properties) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Properties) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *Properties) GetType() PropertiesType {
+	if x != nil {
+		return x.Type
+	}
+	return PropertiesType_PROPERTIES_MAP_LITERAL
+}
+
+func (x *Properties) GetMapLiteral() *MapLiteral {
+	if x != nil {
+		return x.MapLiteral
+	}
+	return nil
+}
+
+func (x *Properties) GetParameter() *ParameterNode {
+	if x != nil {
+		return x.Parameter
+	}
+	return nil
+}
+
+type PatternComprehension struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Base           *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Variable       *VariableNode          `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	PatternElement *PatternElement        `protobuf:"bytes,3,opt,name=pattern_element,json=patternElement,proto3" json:"pattern_element,omitempty"`
+	Where          *Expr                  `protobuf:"bytes,4,opt,name=where,proto3" json:"where,omitempty"`
+	Expr           *Expr                  `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PatternComprehension) Reset() {
+	*x = PatternComprehension{}
+	mi := &file_ast_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatternComprehension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatternComprehension) ProtoMessage() {}
+
+func (x *PatternComprehension) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatternComprehension.ProtoReflect.Descriptor instead.
+func (*PatternComprehension) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PatternComprehension) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PatternComprehension) GetVariable() *VariableNode {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+func (x *PatternComprehension) GetPatternElement() *PatternElement {
+	if x != nil {
+		return x.PatternElement
+	}
+	return nil
+}
+
+func (x *PatternComprehension) GetWhere() *Expr {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+func (x *PatternComprehension) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+type ProcedureInvocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Namespace     []*SymbolicNameNode    `protobuf:"bytes,2,rep,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          *SymbolicNameNode      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Implicit      bool                   `protobuf:"varint,4,opt,name=implicit,proto3" json:"implicit,omitempty"`
+	Args          []*Expr                `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcedureInvocation) Reset() {
+	*x = ProcedureInvocation{}
+	mi := &file_ast_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcedureInvocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcedureInvocation) ProtoMessage() {}
+
+func (x *ProcedureInvocation) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcedureInvocation.ProtoReflect.Descriptor instead.
+func (*ProcedureInvocation) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ProcedureInvocation) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ProcedureInvocation) GetNamespace() []*SymbolicNameNode {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *ProcedureInvocation) GetName() *SymbolicNameNode {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *ProcedureInvocation) GetImplicit() bool {
+	if x != nil {
+		return x.Implicit
+	}
+	return false
+}
+
+func (x *ProcedureInvocation) GetArgs() []*Expr {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type YieldItems struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Wildcard      bool                   `protobuf:"varint,2,opt,name=wildcard,proto3" json:"wildcard,omitempty"`
+	Items         []*YieldItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Where         *Expr                  `protobuf:"bytes,4,opt,name=where,proto3" json:"where,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *YieldItems) Reset() {
+	*x = YieldItems{}
+	mi := &file_ast_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *YieldItems) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YieldItems) ProtoMessage() {}
+
+func (x *YieldItems) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YieldItems.ProtoReflect.Descriptor instead.
+func (*YieldItems) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *YieldItems) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *YieldItems) GetWildcard() bool {
+	if x != nil {
+		return x.Wildcard
+	}
+	return false
+}
+
+func (x *YieldItems) GetItems() []*YieldItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *YieldItems) GetWhere() *Expr {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+type YieldItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Field         *SymbolicNameNode      `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Variable      *VariableNode          `protobuf:"bytes,3,opt,name=variable,proto3" json:"variable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *YieldItem) Reset() {
+	*x = YieldItem{}
+	mi := &file_ast_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *YieldItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YieldItem) ProtoMessage() {}
+
+func (x *YieldItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YieldItem.ProtoReflect.Descriptor instead.
+func (*YieldItem) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *YieldItem) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *YieldItem) GetField() *SymbolicNameNode {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+func (x *YieldItem) GetVariable() *VariableNode {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+type CypherStmt struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Base           *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type           CypherStmtType         `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.CypherStmtType" json:"type,omitempty"`
+	Query          *QueryStmt             `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	StandaloneCall *StandaloneCall        `protobuf:"bytes,4,opt,name=standalone_call,json=standaloneCall,proto3" json:"standalone_call,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CypherStmt) Reset() {
+	*x = CypherStmt{}
+	mi := &file_ast_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CypherStmt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CypherStmt) ProtoMessage() {}
+
+func (x *CypherStmt) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CypherStmt.ProtoReflect.Descriptor instead.
+func (*CypherStmt) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CypherStmt) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CypherStmt) GetType() CypherStmtType {
+	if x != nil {
+		return x.Type
+	}
+	return CypherStmtType_CYPHER_STMT_QUERY
+}
+
+func (x *CypherStmt) GetQuery() *QueryStmt {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CypherStmt) GetStandaloneCall() *StandaloneCall {
+	if x != nil {
+		return x.StandaloneCall
+	}
+	return nil
+}
+
+type QueryStmt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Clauses       []*Stmt                `protobuf:"bytes,2,rep,name=clauses,proto3" json:"clauses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryStmt) Reset() {
+	*x = QueryStmt{}
+	mi := &file_ast_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryStmt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStmt) ProtoMessage() {}
+
+func (x *QueryStmt) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryStmt.ProtoReflect.Descriptor instead.
+func (*QueryStmt) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *QueryStmt) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *QueryStmt) GetClauses() []*Stmt {
+	if x != nil {
+		return x.Clauses
+	}
+	return nil
+}
+
+type UnionClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	Clauses       []*Stmt                `protobuf:"bytes,3,rep,name=clauses,proto3" json:"clauses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnionClause) Reset() {
+	*x = UnionClause{}
+	mi := &file_ast_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnionClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnionClause) ProtoMessage() {}
+
+func (x *UnionClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnionClause.ProtoReflect.Descriptor instead.
+func (*UnionClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UnionClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UnionClause) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *UnionClause) GetClauses() []*Stmt {
+	if x != nil {
+		return x.Clauses
+	}
+	return nil
+}
+
+type StandaloneCall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Procedure     *ProcedureInvocation   `protobuf:"bytes,2,opt,name=procedure,proto3" json:"procedure,omitempty"`
+	Yield         *YieldItems            `protobuf:"bytes,3,opt,name=yield,proto3" json:"yield,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandaloneCall) Reset() {
+	*x = StandaloneCall{}
+	mi := &file_ast_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandaloneCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandaloneCall) ProtoMessage() {}
+
+func (x *StandaloneCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandaloneCall.ProtoReflect.Descriptor instead.
+func (*StandaloneCall) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StandaloneCall) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *StandaloneCall) GetProcedure() *ProcedureInvocation {
+	if x != nil {
+		return x.Procedure
+	}
+	return nil
+}
+
+func (x *StandaloneCall) GetYield() *YieldItems {
+	if x != nil {
+		return x.Yield
+	}
+	return nil
+}
+
+type ReadingClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          ReadingClauseType      `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.ReadingClauseType" json:"type,omitempty"`
+	Match         *MatchClause           `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	Unwind        *UnwindClause          `protobuf:"bytes,4,opt,name=unwind,proto3" json:"unwind,omitempty"`
+	InQueryCall   *InQueryCallClause     `protobuf:"bytes,5,opt,name=in_query_call,json=inQueryCall,proto3" json:"in_query_call,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadingClause) Reset() {
+	*x = ReadingClause{}
+	mi := &file_ast_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingClause) ProtoMessage() {}
+
+func (x *ReadingClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingClause.ProtoReflect.Descriptor instead.
+func (*ReadingClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReadingClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ReadingClause) GetType() ReadingClauseType {
+	if x != nil {
+		return x.Type
+	}
+	return ReadingClauseType_READING_CLAUSE_MATCH
+}
+
+func (x *ReadingClause) GetMatch() *MatchClause {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *ReadingClause) GetUnwind() *UnwindClause {
+	if x != nil {
+		return x.Unwind
+	}
+	return nil
+}
+
+func (x *ReadingClause) GetInQueryCall() *InQueryCallClause {
+	if x != nil {
+		return x.InQueryCall
+	}
+	return nil
+}
+
+type MatchClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pattern       *Pattern               `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Optional      bool                   `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
+	Where         *Expr                  `protobuf:"bytes,4,opt,name=where,proto3" json:"where,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchClause) Reset() {
+	*x = MatchClause{}
+	mi := &file_ast_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchClause) ProtoMessage() {}
+
+func (x *MatchClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchClause.ProtoReflect.Descriptor instead.
+func (*MatchClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MatchClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MatchClause) GetPattern() *Pattern {
+	if x != nil {
+		return x.Pattern
+	}
+	return nil
+}
+
+func (x *MatchClause) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *MatchClause) GetWhere() *Expr {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+type UnwindClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Expr          *Expr                  `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
+	Variable      *VariableNode          `protobuf:"bytes,3,opt,name=variable,proto3" json:"variable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwindClause) Reset() {
+	*x = UnwindClause{}
+	mi := &file_ast_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwindClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwindClause) ProtoMessage() {}
+
+func (x *UnwindClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwindClause.ProtoReflect.Descriptor instead.
+func (*UnwindClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UnwindClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UnwindClause) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+func (x *UnwindClause) GetVariable() *VariableNode {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+type InQueryCallClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Procedure     *ProcedureInvocation   `protobuf:"bytes,2,opt,name=procedure,proto3" json:"procedure,omitempty"`
+	Yield         *YieldItems            `protobuf:"bytes,3,opt,name=yield,proto3" json:"yield,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InQueryCallClause) Reset() {
+	*x = InQueryCallClause{}
+	mi := &file_ast_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InQueryCallClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InQueryCallClause) ProtoMessage() {}
+
+func (x *InQueryCallClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InQueryCallClause.ProtoReflect.Descriptor instead.
+func (*InQueryCallClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *InQueryCallClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *InQueryCallClause) GetProcedure() *ProcedureInvocation {
+	if x != nil {
+		return x.Procedure
+	}
+	return nil
+}
+
+func (x *InQueryCallClause) GetYield() *YieldItems {
+	if x != nil {
+		return x.Yield
+	}
+	return nil
+}
+
+type SubqueryClause struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Base           *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ImportingWith  *WithClause            `protobuf:"bytes,2,opt,name=importing_with,json=importingWith,proto3" json:"importing_with,omitempty"`
+	Query          *QueryStmt             `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	InTransactions bool                   `protobuf:"varint,4,opt,name=in_transactions,json=inTransactions,proto3" json:"in_transactions,omitempty"`
+	Concurrency    *Expr                  `protobuf:"bytes,5,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	BatchSize      *Expr                  `protobuf:"bytes,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	OnError        OnErrorType            `protobuf:"varint,7,opt,name=on_error,json=onError,proto3,enum=leiysky.parser.ast.OnErrorType" json:"on_error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubqueryClause) Reset() {
+	*x = SubqueryClause{}
+	mi := &file_ast_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubqueryClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubqueryClause) ProtoMessage() {}
+
+func (x *SubqueryClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubqueryClause.ProtoReflect.Descriptor instead.
+func (*SubqueryClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SubqueryClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SubqueryClause) GetImportingWith() *WithClause {
+	if x != nil {
+		return x.ImportingWith
+	}
+	return nil
+}
+
+func (x *SubqueryClause) GetQuery() *QueryStmt {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *SubqueryClause) GetInTransactions() bool {
+	if x != nil {
+		return x.InTransactions
+	}
+	return false
+}
+
+func (x *SubqueryClause) GetConcurrency() *Expr {
+	if x != nil {
+		return x.Concurrency
+	}
+	return nil
+}
+
+func (x *SubqueryClause) GetBatchSize() *Expr {
+	if x != nil {
+		return x.BatchSize
+	}
+	return nil
+}
+
+func (x *SubqueryClause) GetOnError() OnErrorType {
+	if x != nil {
+		return x.OnError
+	}
+	return OnErrorType_ON_ERROR_NONE
+}
+
+type WithClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Distinct      bool                   `protobuf:"varint,2,opt,name=distinct,proto3" json:"distinct,omitempty"`
+	ReturnBody    *ReturnBody            `protobuf:"bytes,3,opt,name=return_body,json=returnBody,proto3" json:"return_body,omitempty"`
+	Where         *Expr                  `protobuf:"bytes,4,opt,name=where,proto3" json:"where,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithClause) Reset() {
+	*x = WithClause{}
+	mi := &file_ast_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithClause) ProtoMessage() {}
+
+func (x *WithClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithClause.ProtoReflect.Descriptor instead.
+func (*WithClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *WithClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *WithClause) GetDistinct() bool {
+	if x != nil {
+		return x.Distinct
+	}
+	return false
+}
+
+func (x *WithClause) GetReturnBody() *ReturnBody {
+	if x != nil {
+		return x.ReturnBody
+	}
+	return nil
+}
+
+func (x *WithClause) GetWhere() *Expr {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+type ReturnClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Distinct      bool                   `protobuf:"varint,2,opt,name=distinct,proto3" json:"distinct,omitempty"`
+	ReturnBody    *ReturnBody            `protobuf:"bytes,3,opt,name=return_body,json=returnBody,proto3" json:"return_body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnClause) Reset() {
+	*x = ReturnClause{}
+	mi := &file_ast_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnClause) ProtoMessage() {}
+
+func (x *ReturnClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnClause.ProtoReflect.Descriptor instead.
+func (*ReturnClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReturnClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ReturnClause) GetDistinct() bool {
+	if x != nil {
+		return x.Distinct
+	}
+	return false
+}
+
+func (x *ReturnClause) GetReturnBody() *ReturnBody {
+	if x != nil {
+		return x.ReturnBody
+	}
+	return nil
+}
+
+type ReturnBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ReturnItems   []*ReturnItem          `protobuf:"bytes,2,rep,name=return_items,json=returnItems,proto3" json:"return_items,omitempty"`
+	OrderBy       *OrderClause           `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Skip          *Expr                  `protobuf:"bytes,4,opt,name=skip,proto3" json:"skip,omitempty"`
+	Limit         *Expr                  `protobuf:"bytes,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnBody) Reset() {
+	*x = ReturnBody{}
+	mi := &file_ast_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnBody) ProtoMessage() {}
+
+func (x *ReturnBody) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnBody.ProtoReflect.Descriptor instead.
+func (*ReturnBody) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReturnBody) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ReturnBody) GetReturnItems() []*ReturnItem {
+	if x != nil {
+		return x.ReturnItems
+	}
+	return nil
+}
+
+func (x *ReturnBody) GetOrderBy() *OrderClause {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+func (x *ReturnBody) GetSkip() *Expr {
+	if x != nil {
+		return x.Skip
+	}
+	return nil
+}
+
+func (x *ReturnBody) GetLimit() *Expr {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type ReturnItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Wildcard      bool                   `protobuf:"varint,2,opt,name=wildcard,proto3" json:"wildcard,omitempty"`
+	Expr          *Expr                  `protobuf:"bytes,3,opt,name=expr,proto3" json:"expr,omitempty"`
+	As            bool                   `protobuf:"varint,4,opt,name=as,proto3" json:"as,omitempty"`
+	Variable      *VariableNode          `protobuf:"bytes,5,opt,name=variable,proto3" json:"variable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnItem) Reset() {
+	*x = ReturnItem{}
+	mi := &file_ast_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnItem) ProtoMessage() {}
+
+func (x *ReturnItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnItem.ProtoReflect.Descriptor instead.
+func (*ReturnItem) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReturnItem) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ReturnItem) GetWildcard() bool {
+	if x != nil {
+		return x.Wildcard
+	}
+	return false
+}
+
+func (x *ReturnItem) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+func (x *ReturnItem) GetAs() bool {
+	if x != nil {
+		return x.As
+	}
+	return false
+}
+
+func (x *ReturnItem) GetVariable() *VariableNode {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+type OrderClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SortItems     []*SortItem            `protobuf:"bytes,2,rep,name=sort_items,json=sortItems,proto3" json:"sort_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderClause) Reset() {
+	*x = OrderClause{}
+	mi := &file_ast_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderClause) ProtoMessage() {}
+
+func (x *OrderClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderClause.ProtoReflect.Descriptor instead.
+func (*OrderClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *OrderClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *OrderClause) GetSortItems() []*SortItem {
+	if x != nil {
+		return x.SortItems
+	}
+	return nil
+}
+
+type SortItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          SortType               `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.SortType" json:"type,omitempty"`
+	Expr          *Expr                  `protobuf:"bytes,3,opt,name=expr,proto3" json:"expr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortItem) Reset() {
+	*x = SortItem{}
+	mi := &file_ast_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortItem) ProtoMessage() {}
+
+func (x *SortItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortItem.ProtoReflect.Descriptor instead.
+func (*SortItem) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SortItem) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SortItem) GetType() SortType {
+	if x != nil {
+		return x.Type
+	}
+	return SortType_SORT_ASCENDING
+}
+
+func (x *SortItem) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+type CreateClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pattern       *Pattern               `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClause) Reset() {
+	*x = CreateClause{}
+	mi := &file_ast_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClause) ProtoMessage() {}
+
+func (x *CreateClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClause.ProtoReflect.Descriptor instead.
+func (*CreateClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateClause) GetPattern() *Pattern {
+	if x != nil {
+		return x.Pattern
+	}
+	return nil
+}
+
+type MergeClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PatternPart   *PatternPart           `protobuf:"bytes,2,opt,name=pattern_part,json=patternPart,proto3" json:"pattern_part,omitempty"`
+	MergeActions  []*MergeAction         `protobuf:"bytes,3,rep,name=merge_actions,json=mergeActions,proto3" json:"merge_actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeClause) Reset() {
+	*x = MergeClause{}
+	mi := &file_ast_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeClause) ProtoMessage() {}
+
+func (x *MergeClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeClause.ProtoReflect.Descriptor instead.
+func (*MergeClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MergeClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MergeClause) GetPatternPart() *PatternPart {
+	if x != nil {
+		return x.PatternPart
+	}
+	return nil
+}
+
+func (x *MergeClause) GetMergeActions() []*MergeAction {
+	if x != nil {
+		return x.MergeActions
+	}
+	return nil
+}
+
+type MergeAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          MergeActionType        `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.MergeActionType" json:"type,omitempty"`
+	Set           *SetClause             `protobuf:"bytes,3,opt,name=set,proto3" json:"set,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeAction) Reset() {
+	*x = MergeAction{}
+	mi := &file_ast_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAction) ProtoMessage() {}
+
+func (x *MergeAction) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAction.ProtoReflect.Descriptor instead.
+func (*MergeAction) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MergeAction) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MergeAction) GetType() MergeActionType {
+	if x != nil {
+		return x.Type
+	}
+	return MergeActionType_MERGE_ACTION_CREATE
+}
+
+func (x *MergeAction) GetSet() *SetClause {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+type SetClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SetItems      []*SetItem             `protobuf:"bytes,2,rep,name=set_items,json=setItems,proto3" json:"set_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClause) Reset() {
+	*x = SetClause{}
+	mi := &file_ast_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClause) ProtoMessage() {}
+
+func (x *SetClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClause.ProtoReflect.Descriptor instead.
+func (*SetClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SetClause) GetSetItems() []*SetItem {
+	if x != nil {
+		return x.SetItems
+	}
+	return nil
+}
+
+type SetItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          SetItemType            `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.SetItemType" json:"type,omitempty"`
+	Property      *PropertyExpr          `protobuf:"bytes,3,opt,name=property,proto3" json:"property,omitempty"`
+	Variable      *VariableNode          `protobuf:"bytes,4,opt,name=variable,proto3" json:"variable,omitempty"`
+	Expr          *Expr                  `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	Labels        []*NodeLabelNode       `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetItem) Reset() {
+	*x = SetItem{}
+	mi := &file_ast_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetItem) ProtoMessage() {}
+
+func (x *SetItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetItem.ProtoReflect.Descriptor instead.
+func (*SetItem) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetItem) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SetItem) GetType() SetItemType {
+	if x != nil {
+		return x.Type
+	}
+	return SetItemType_SET_ITEM_PROPERTY
+}
+
+func (x *SetItem) GetProperty() *PropertyExpr {
+	if x != nil {
+		return x.Property
+	}
+	return nil
+}
+
+func (x *SetItem) GetVariable() *VariableNode {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+func (x *SetItem) GetExpr() *Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+func (x *SetItem) GetLabels() []*NodeLabelNode {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type DeleteClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Detach        bool                   `protobuf:"varint,2,opt,name=detach,proto3" json:"detach,omitempty"`
+	Exprs         []*Expr                `protobuf:"bytes,3,rep,name=exprs,proto3" json:"exprs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClause) Reset() {
+	*x = DeleteClause{}
+	mi := &file_ast_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClause) ProtoMessage() {}
+
+func (x *DeleteClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClause.ProtoReflect.Descriptor instead.
+func (*DeleteClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DeleteClause) GetDetach() bool {
+	if x != nil {
+		return x.Detach
+	}
+	return false
+}
+
+func (x *DeleteClause) GetExprs() []*Expr {
+	if x != nil {
+		return x.Exprs
+	}
+	return nil
+}
+
+type RemoveClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RemoveItems   []*RemoveItem          `protobuf:"bytes,2,rep,name=remove_items,json=removeItems,proto3" json:"remove_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveClause) Reset() {
+	*x = RemoveClause{}
+	mi := &file_ast_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveClause) ProtoMessage() {}
+
+func (x *RemoveClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveClause.ProtoReflect.Descriptor instead.
+func (*RemoveClause) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RemoveClause) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RemoveClause) GetRemoveItems() []*RemoveItem {
+	if x != nil {
+		return x.RemoveItems
+	}
+	return nil
+}
+
+type RemoveItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *Base                  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Type          RemoveItemType         `protobuf:"varint,2,opt,name=type,proto3,enum=leiysky.parser.ast.RemoveItemType" json:"type,omitempty"`
+	Variable      *VariableNode          `protobuf:"bytes,3,opt,name=variable,proto3" json:"variable,omitempty"`
+	Labels        []*NodeLabelNode       `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
+	Property      *PropertyExpr          `protobuf:"bytes,5,opt,name=property,proto3" json:"property,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveItem) Reset() {
+	*x = RemoveItem{}
+	mi := &file_ast_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveItem) ProtoMessage() {}
+
+func (x *RemoveItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveItem.ProtoReflect.Descriptor instead.
+func (*RemoveItem) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RemoveItem) GetBase() *Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RemoveItem) GetType() RemoveItemType {
+	if x != nil {
+		return x.Type
+	}
+	return RemoveItemType_REMOVE_ITEM_VARIABLE
+}
+
+func (x *RemoveItem) GetVariable() *VariableNode {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+func (x *RemoveItem) GetLabels() []*NodeLabelNode {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RemoveItem) GetProperty() *PropertyExpr {
+	if x != nil {
+		return x.Property
+	}
+	return nil
+}
+
+var File_ast_proto protoreflect.FileDescriptor
+
+const file_ast_proto_rawDesc = "" +
+	"\n" +
+	"\tast.proto\x12\x12leiysky.parser.ast\"N\n" +
+	"\bPosition\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x03R\x04line\x12\x16\n" +
+	"\x06column\x18\x03 \x01(\x03R\x06column\"\x9c\x01\n" +
+	"\aComment\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x122\n" +
+	"\x05start\x18\x02 \x01(\v2\x1c.leiysky.parser.ast.PositionR\x05start\x12.\n" +
+	"\x03end\x18\x03 \x01(\v2\x1c.leiysky.parser.ast.PositionR\x03end\x12\x19\n" +
+	"\bown_line\x18\x04 \x01(\bR\aownLine\"\x90\x02\n" +
+	"\x04Base\x122\n" +
+	"\x05start\x18\x01 \x01(\v2\x1c.leiysky.parser.ast.PositionR\x05start\x12.\n" +
+	"\x03end\x18\x02 \x01(\v2\x1c.leiysky.parser.ast.PositionR\x03end\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12F\n" +
+	"\x10leading_comments\x18\x04 \x03(\v2\x1b.leiysky.parser.ast.CommentR\x0fleadingComments\x12H\n" +
+	"\x11trailing_comments\x18\x05 \x03(\v2\x1b.leiysky.parser.ast.CommentR\x10trailingComments\"\xba$\n" +
+	"\x04Node\x12G\n" +
+	"\rproperty_expr\x18\x01 \x01(\v2 .leiysky.parser.ast.PropertyExprH\x00R\fpropertyExpr\x12A\n" +
+	"\vbinary_expr\x18\x02 \x01(\v2\x1e.leiysky.parser.ast.BinaryExprH\x00R\n" +
+	"binaryExpr\x12>\n" +
+	"\n" +
+	"unary_expr\x18\x03 \x01(\v2\x1d.leiysky.parser.ast.UnaryExprH\x00R\tunaryExpr\x12P\n" +
+	"\x10predication_expr\x18\x04 \x01(\v2#.leiysky.parser.ast.PredicationExprH\x00R\x0fpredicationExpr\x12]\n" +
+	"\x15string_operation_expr\x18\x05 \x01(\v2'.leiysky.parser.ast.StringOperationExprH\x00R\x13stringOperationExpr\x12W\n" +
+	"\x13list_operation_expr\x18\x06 \x01(\v2%.leiysky.parser.ast.ListOperationExprH\x00R\x11listOperationExpr\x12W\n" +
+	"\x13null_operation_expr\x18\a \x01(\v2%.leiysky.parser.ast.NullOperationExprH\x00R\x11nullOperationExpr\x12a\n" +
+	"\x17property_or_labels_expr\x18\b \x01(\v2(.leiysky.parser.ast.PropertyOrLabelsExprH\x00R\x14propertyOrLabelsExpr\x12M\n" +
+	"\x0fproperty_lookup\x18\t \x01(\v2\".leiysky.parser.ast.PropertyLookupH\x00R\x0epropertyLookup\x12;\n" +
+	"\tcase_expr\x18\n" +
+	" \x01(\v2\x1c.leiysky.parser.ast.CaseExprH\x00R\bcaseExpr\x128\n" +
+	"\bcase_alt\x18\v \x01(\v2\x1b.leiysky.parser.ast.CaseAltH\x00R\acaseAlt\x12A\n" +
+	"\vfilter_expr\x18\f \x01(\v2\x1e.leiysky.parser.ast.FilterExprH\x00R\n" +
+	"filterExpr\x12V\n" +
+	"\x12list_comprehension\x18\r \x01(\v2%.leiysky.parser.ast.ListComprehensionH\x00R\x11listComprehension\x12Y\n" +
+	"\x13function_invocation\x18\x0e \x01(\v2&.leiysky.parser.ast.FunctionInvocationH\x00R\x12functionInvocation\x12>\n" +
+	"\n" +
+	"paren_expr\x18\x0f \x01(\v2\x1d.leiysky.parser.ast.ParenExprH\x00R\tparenExpr\x12H\n" +
+	"\x0ecount_all_expr\x18\x10 \x01(\v2 .leiysky.parser.ast.CountAllExprH\x00R\fcountAllExpr\x12N\n" +
+	"\x10schema_name_node\x18\x11 \x01(\v2\".leiysky.parser.ast.SchemaNameNodeH\x00R\x0eschemaNameNode\x12T\n" +
+	"\x12symbolic_name_node\x18\x12 \x01(\v2$.leiysky.parser.ast.SymbolicNameNodeH\x00R\x10symbolicNameNode\x12T\n" +
+	"\x12reserved_word_node\x18\x13 \x01(\v2$.leiysky.parser.ast.ReservedWordNodeH\x00R\x10reservedWordNode\x12G\n" +
+	"\rvariable_node\x18\x14 \x01(\v2 .leiysky.parser.ast.VariableNodeH\x00R\fvariableNode\x12K\n" +
+	"\x0fnode_label_node\x18\x15 \x01(\v2!.leiysky.parser.ast.NodeLabelNodeH\x00R\rnodeLabelNode\x12J\n" +
+	"\x0eparameter_node\x18\x16 \x01(\v2!.leiysky.parser.ast.ParameterNodeH\x00R\rparameterNode\x12D\n" +
+	"\fliteral_expr\x18\x17 \x01(\v2\x1f.leiysky.parser.ast.LiteralExprH\x00R\vliteralExpr\x12J\n" +
+	"\x0enumber_literal\x18\x18 \x01(\v2!.leiysky.parser.ast.NumberLiteralH\x00R\rnumberLiteral\x12A\n" +
+	"\vmap_literal\x18\x19 \x01(\v2\x1e.leiysky.parser.ast.MapLiteralH\x00R\n" +
+	"mapLiteral\x12D\n" +
+	"\flist_literal\x18\x1a \x01(\v2\x1f.leiysky.parser.ast.ListLiteralH\x00R\vlistLiteral\x127\n" +
+	"\apattern\x18\x1b \x01(\v2\x1b.leiysky.parser.ast.PatternH\x00R\apattern\x12D\n" +
+	"\fpattern_part\x18\x1c \x01(\v2\x1f.leiysky.parser.ast.PatternPartH\x00R\vpatternPart\x12M\n" +
+	"\x0fpattern_element\x18\x1d \x01(\v2\".leiysky.parser.ast.PatternElementH\x00R\x0epatternElement\x12D\n" +
+	"\fnode_pattern\x18\x1e \x01(\v2\x1f.leiysky.parser.ast.NodePatternH\x00R\vnodePattern\x12\\\n" +
+	"\x14relationship_pattern\x18\x1f \x01(\v2'.leiysky.parser.ast.RelationshipPatternH\x00R\x13relationshipPattern\x12Y\n" +
+	"\x13relationship_detail\x18  \x01(\v2&.leiysky.parser.ast.RelationshipDetailH\x00R\x12relationshipDetail\x12@\n" +
+	"\n" +
+	"properties\x18! \x01(\v2\x1e.leiysky.parser.ast.PropertiesH\x00R\n" +
+	"properties\x12_\n" +
+	"\x15pattern_comprehension\x18\" \x01(\v2(.leiysky.parser.ast.PatternComprehensionH\x00R\x14patternComprehension\x12\\\n" +
+	"\x14procedure_invocation\x18# \x01(\v2'.leiysky.parser.ast.ProcedureInvocationH\x00R\x13procedureInvocation\x12A\n" +
+	"\vyield_items\x18$ \x01(\v2\x1e.leiysky.parser.ast.YieldItemsH\x00R\n" +
+	"yieldItems\x12>\n" +
+	"\n" +
+	"yield_item\x18% \x01(\v2\x1d.leiysky.parser.ast.YieldItemH\x00R\tyieldItem\x12A\n" +
+	"\vcypher_stmt\x18& \x01(\v2\x1e.leiysky.parser.ast.CypherStmtH\x00R\n" +
+	"cypherStmt\x12>\n" +
+	"\n" +
+	"query_stmt\x18' \x01(\v2\x1d.leiysky.parser.ast.QueryStmtH\x00R\tqueryStmt\x12D\n" +
+	"\funion_clause\x18( \x01(\v2\x1f.leiysky.parser.ast.UnionClauseH\x00R\vunionClause\x12M\n" +
+	"\x0fstandalone_call\x18) \x01(\v2\".leiysky.parser.ast.StandaloneCallH\x00R\x0estandaloneCall\x12J\n" +
+	"\x0ereading_clause\x18* \x01(\v2!.leiysky.parser.ast.ReadingClauseH\x00R\rreadingClause\x12D\n" +
+	"\fmatch_clause\x18+ \x01(\v2\x1f.leiysky.parser.ast.MatchClauseH\x00R\vmatchClause\x12G\n" +
+	"\runwind_clause\x18, \x01(\v2 .leiysky.parser.ast.UnwindClauseH\x00R\funwindClause\x12X\n" +
+	"\x14in_query_call_clause\x18- \x01(\v2%.leiysky.parser.ast.InQueryCallClauseH\x00R\x11inQueryCallClause\x12A\n" +
+	"\vwith_clause\x18. \x01(\v2\x1e.leiysky.parser.ast.WithClauseH\x00R\n" +
+	"withClause\x12G\n" +
+	"\rreturn_clause\x18/ \x01(\v2 .leiysky.parser.ast.ReturnClauseH\x00R\freturnClause\x12A\n" +
+	"\vreturn_body\x180 \x01(\v2\x1e.leiysky.parser.ast.ReturnBodyH\x00R\n" +
+	"returnBody\x12A\n" +
+	"\vreturn_item\x181 \x01(\v2\x1e.leiysky.parser.ast.ReturnItemH\x00R\n" +
+	"returnItem\x12D\n" +
+	"\forder_clause\x182 \x01(\v2\x1f.leiysky.parser.ast.OrderClauseH\x00R\vorderClause\x12;\n" +
+	"\tsort_item\x183 \x01(\v2\x1c.leiysky.parser.ast.SortItemH\x00R\bsortItem\x12G\n" +
+	"\rcreate_clause\x184 \x01(\v2 .leiysky.parser.ast.CreateClauseH\x00R\fcreateClause\x12D\n" +
+	"\fmerge_clause\x185 \x01(\v2\x1f.leiysky.parser.ast.MergeClauseH\x00R\vmergeClause\x12D\n" +
+	"\fmerge_action\x186 \x01(\v2\x1f.leiysky.parser.ast.MergeActionH\x00R\vmergeAction\x12>\n" +
+	"\n" +
+	"set_clause\x187 \x01(\v2\x1d.leiysky.parser.ast.SetClauseH\x00R\tsetClause\x128\n" +
+	"\bset_item\x188 \x01(\v2\x1b.leiysky.parser.ast.SetItemH\x00R\asetItem\x12G\n" +
+	"\rdelete_clause\x189 \x01(\v2 .leiysky.parser.ast.DeleteClauseH\x00R\fdeleteClause\x12G\n" +
+	"\rremove_clause\x18: \x01(\v2 .leiysky.parser.ast.RemoveClauseH\x00R\fremoveClause\x12A\n" +
+	"\vremove_item\x18; \x01(\v2\x1e.leiysky.parser.ast.RemoveItemH\x00R\n" +
+	"removeItem\x12Z\n" +
+	"\x14exists_subquery_expr\x18< \x01(\v2&.leiysky.parser.ast.ExistsSubqueryExprH\x00R\x12existsSubqueryExpr\x12W\n" +
+	"\x13count_subquery_expr\x18= \x01(\v2%.leiysky.parser.ast.CountSubqueryExprH\x00R\x11countSubqueryExpr\x12M\n" +
+	"\x0fsubquery_clause\x18> \x01(\v2\".leiysky.parser.ast.SubqueryClauseH\x00R\x0esubqueryClauseB\x06\n" +
+	"\x04node\"\x97\x11\n" +
+	"\x04Expr\x12G\n" +
+	"\rproperty_expr\x18\x01 \x01(\v2 .leiysky.parser.ast.PropertyExprH\x00R\fpropertyExpr\x12A\n" +
+	"\vbinary_expr\x18\x02 \x01(\v2\x1e.leiysky.parser.ast.BinaryExprH\x00R\n" +
+	"binaryExpr\x12>\n" +
+	"\n" +
+	"unary_expr\x18\x03 \x01(\v2\x1d.leiysky.parser.ast.UnaryExprH\x00R\tunaryExpr\x12P\n" +
+	"\x10predication_expr\x18\x04 \x01(\v2#.leiysky.parser.ast.PredicationExprH\x00R\x0fpredicationExpr\x12]\n" +
+	"\x15string_operation_expr\x18\x05 \x01(\v2'.leiysky.parser.ast.StringOperationExprH\x00R\x13stringOperationExpr\x12W\n" +
+	"\x13list_operation_expr\x18\x06 \x01(\v2%.leiysky.parser.ast.ListOperationExprH\x00R\x11listOperationExpr\x12W\n" +
+	"\x13null_operation_expr\x18\a \x01(\v2%.leiysky.parser.ast.NullOperationExprH\x00R\x11nullOperationExpr\x12a\n" +
+	"\x17property_or_labels_expr\x18\b \x01(\v2(.leiysky.parser.ast.PropertyOrLabelsExprH\x00R\x14propertyOrLabelsExpr\x12;\n" +
+	"\tcase_expr\x18\n" +
+	" \x01(\v2\x1c.leiysky.parser.ast.CaseExprH\x00R\bcaseExpr\x128\n" +
+	"\bcase_alt\x18\v \x01(\v2\x1b.leiysky.parser.ast.CaseAltH\x00R\acaseAlt\x12A\n" +
+	"\vfilter_expr\x18\f \x01(\v2\x1e.leiysky.parser.ast.FilterExprH\x00R\n" +
+	"filterExpr\x12V\n" +
+	"\x12list_comprehension\x18\r \x01(\v2%.leiysky.parser.ast.ListComprehensionH\x00R\x11listComprehension\x12Y\n" +
+	"\x13function_invocation\x18\x0e \x01(\v2&.leiysky.parser.ast.FunctionInvocationH\x00R\x12functionInvocation\x12>\n" +
+	"\n" +
+	"paren_expr\x18\x0f \x01(\v2\x1d.leiysky.parser.ast.ParenExprH\x00R\tparenExpr\x12H\n" +
+	"\x0ecount_all_expr\x18\x10 \x01(\v2 .leiysky.parser.ast.CountAllExprH\x00R\fcountAllExpr\x12G\n" +
+	"\rvariable_node\x18\x14 \x01(\v2 .leiysky.parser.ast.VariableNodeH\x00R\fvariableNode\x12J\n" +
+	"\x0eparameter_node\x18\x16 \x01(\v2!.leiysky.parser.ast.ParameterNodeH\x00R\rparameterNode\x12D\n" +
+	"\fliteral_expr\x18\x17 \x01(\v2\x1f.leiysky.parser.ast.LiteralExprH\x00R\vliteralExpr\x127\n" +
+	"\apattern\x18\x1b \x01(\v2\x1b.leiysky.parser.ast.PatternH\x00R\apattern\x12D\n" +
+	"\fpattern_part\x18\x1c \x01(\v2\x1f.leiysky.parser.ast.PatternPartH\x00R\vpatternPart\x12M\n" +
+	"\x0fpattern_element\x18\x1d \x01(\v2\".leiysky.parser.ast.PatternElementH\x00R\x0epatternElement\x12D\n" +
+	"\fnode_pattern\x18\x1e \x01(\v2\x1f.leiysky.parser.ast.NodePatternH\x00R\vnodePattern\x12\\\n" +
+	"\x14relationship_pattern\x18\x1f \x01(\v2'.leiysky.parser.ast.RelationshipPatternH\x00R\x13relationshipPattern\x12Y\n" +
+	"\x13relationship_detail\x18  \x01(\v2&.leiysky.parser.ast.RelationshipDetailH\x00R\x12relationshipDetail\x12@\n" +
+	"\n" +
+	"properties\x18! \x01(\v2\x1e.leiysky.parser.ast.PropertiesH\x00R\n" +
+	"properties\x12_\n" +
+	"\x15pattern_comprehension\x18\" \x01(\v2(.leiysky.parser.ast.PatternComprehensionH\x00R\x14patternComprehension\x12Z\n" +
+	"\x14exists_subquery_expr\x18< \x01(\v2&.leiysky.parser.ast.ExistsSubqueryExprH\x00R\x12existsSubqueryExpr\x12W\n" +
+	"\x13count_subquery_expr\x18= \x01(\v2%.leiysky.parser.ast.CountSubqueryExprH\x00R\x11countSubqueryExprB\x06\n" +
+	"\x04node\"\xe3\f\n" +
+	"\x04Stmt\x12A\n" +
+	"\vcypher_stmt\x18& \x01(\v2\x1e.leiysky.parser.ast.CypherStmtH\x00R\n" +
+	"cypherStmt\x12>\n" +
+	"\n" +
+	"query_stmt\x18' \x01(\v2\x1d.leiysky.parser.ast.QueryStmtH\x00R\tqueryStmt\x12D\n" +
+	"\funion_clause\x18( \x01(\v2\x1f.leiysky.parser.ast.UnionClauseH\x00R\vunionClause\x12M\n" +
+	"\x0fstandalone_call\x18) \x01(\v2\".leiysky.parser.ast.StandaloneCallH\x00R\x0estandaloneCall\x12J\n" +
+	"\x0ereading_clause\x18* \x01(\v2!.leiysky.parser.ast.ReadingClauseH\x00R\rreadingClause\x12D\n" +
+	"\fmatch_clause\x18+ \x01(\v2\x1f.leiysky.parser.ast.MatchClauseH\x00R\vmatchClause\x12G\n" +
+	"\runwind_clause\x18, \x01(\v2 .leiysky.parser.ast.UnwindClauseH\x00R\funwindClause\x12X\n" +
+	"\x14in_query_call_clause\x18- \x01(\v2%.leiysky.parser.ast.InQueryCallClauseH\x00R\x11inQueryCallClause\x12A\n" +
+	"\vwith_clause\x18. \x01(\v2\x1e.leiysky.parser.ast.WithClauseH\x00R\n" +
+	"withClause\x12G\n" +
+	"\rreturn_clause\x18/ \x01(\v2 .leiysky.parser.ast.ReturnClauseH\x00R\freturnClause\x12A\n" +
+	"\vreturn_body\x180 \x01(\v2\x1e.leiysky.parser.ast.ReturnBodyH\x00R\n" +
+	"returnBody\x12A\n" +
+	"\vreturn_item\x181 \x01(\v2\x1e.leiysky.parser.ast.ReturnItemH\x00R\n" +
+	"returnItem\x12D\n" +
+	"\forder_clause\x182 \x01(\v2\x1f.leiysky.parser.ast.OrderClauseH\x00R\vorderClause\x12;\n" +
+	"\tsort_item\x183 \x01(\v2\x1c.leiysky.parser.ast.SortItemH\x00R\bsortItem\x12G\n" +
+	"\rcreate_clause\x184 \x01(\v2 .leiysky.parser.ast.CreateClauseH\x00R\fcreateClause\x12D\n" +
+	"\fmerge_clause\x185 \x01(\v2\x1f.leiysky.parser.ast.MergeClauseH\x00R\vmergeClause\x12D\n" +
+	"\fmerge_action\x186 \x01(\v2\x1f.leiysky.parser.ast.MergeActionH\x00R\vmergeAction\x12>\n" +
+	"\n" +
+	"set_clause\x187 \x01(\v2\x1d.leiysky.parser.ast.SetClauseH\x00R\tsetClause\x128\n" +
+	"\bset_item\x188 \x01(\v2\x1b.leiysky.parser.ast.SetItemH\x00R\asetItem\x12G\n" +
+	"\rdelete_clause\x189 \x01(\v2 .leiysky.parser.ast.DeleteClauseH\x00R\fdeleteClause\x12G\n" +
+	"\rremove_clause\x18: \x01(\v2 .leiysky.parser.ast.RemoveClauseH\x00R\fremoveClause\x12A\n" +
+	"\vremove_item\x18; \x01(\v2\x1e.leiysky.parser.ast.RemoveItemH\x00R\n" +
+	"removeItem\x12M\n" +
+	"\x0fsubquery_clause\x18> \x01(\v2\".leiysky.parser.ast.SubqueryClauseH\x00R\x0esubqueryClauseB\x06\n" +
+	"\x04node\"\xa8\x01\n" +
+	"\fPropertyExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12,\n" +
+	"\x04expr\x18\x02 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\x12<\n" +
+	"\alookups\x18\x03 \x03(\v2\".leiysky.parser.ast.PropertyLookupR\alookups\"\xb6\x01\n" +
+	"\n" +
+	"BinaryExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12*\n" +
+	"\x02op\x18\x02 \x01(\x0e2\x1a.leiysky.parser.ast.OpTypeR\x02op\x12&\n" +
+	"\x01l\x18\x03 \x01(\v2\x18.leiysky.parser.ast.ExprR\x01l\x12&\n" +
+	"\x01r\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x01r\"\x8d\x01\n" +
+	"\tUnaryExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12*\n" +
+	"\x02op\x18\x02 \x01(\x0e2\x1a.leiysky.parser.ast.OpTypeR\x02op\x12&\n" +
+	"\x01v\x18\x03 \x01(\v2\x18.leiysky.parser.ast.ExprR\x01v\"\xd0\x01\n" +
+	"\x0fPredicationExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x127\n" +
+	"\x04type\x18\x02 \x01(\x0e2#.leiysky.parser.ast.PredicationTypeR\x04type\x12,\n" +
+	"\x04expr\x18\x03 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\x12(\n" +
+	"\x02op\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x02op\"\xae\x01\n" +
+	"\x13StringOperationExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12;\n" +
+	"\x04type\x18\x02 \x01(\x0e2'.leiysky.parser.ast.StringOperationTypeR\x04type\x12,\n" +
+	"\x04expr\x18\x03 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\"\xa5\x02\n" +
+	"\x11ListOperationExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x121\n" +
+	"\ain_expr\x18\x02 \x01(\v2\x18.leiysky.parser.ast.ExprR\x06inExpr\x129\n" +
+	"\vsingle_expr\x18\x03 \x01(\v2\x18.leiysky.parser.ast.ExprR\n" +
+	"singleExpr\x129\n" +
+	"\vlower_bound\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\n" +
+	"lowerBound\x129\n" +
+	"\vupper_bound\x18\x05 \x01(\v2\x18.leiysky.parser.ast.ExprR\n" +
+	"upperBound\"_\n" +
+	"\x11NullOperationExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12\x1c\n" +
+	"\n" +
+	"is_is_null\x18\x02 \x01(\bR\bisIsNull\"\x85\x02\n" +
+	"\x14PropertyOrLabelsExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12,\n" +
+	"\x04expr\x18\x02 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\x12M\n" +
+	"\x10property_lookups\x18\x03 \x03(\v2\".leiysky.parser.ast.PropertyLookupR\x0fpropertyLookups\x12B\n" +
+	"\vnode_labels\x18\x04 \x03(\v2!.leiysky.parser.ast.NodeLabelNodeR\n" +
+	"nodeLabels\"\x85\x01\n" +
+	"\x0ePropertyLookup\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12E\n" +
+	"\fproperty_key\x18\x02 \x01(\v2\".leiysky.parser.ast.SchemaNameNodeR\vpropertyKey\"\xc5\x01\n" +
+	"\bCaseExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12,\n" +
+	"\x04expr\x18\x02 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\x12/\n" +
+	"\x04alts\x18\x03 \x03(\v2\x1b.leiysky.parser.ast.CaseAltR\x04alts\x12,\n" +
+	"\x04else\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04else\"\x93\x01\n" +
+	"\aCaseAlt\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12,\n" +
+	"\x04when\x18\x02 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04when\x12,\n" +
+	"\x04then\x18\x03 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04then\"\x86\x02\n" +
+	"\n" +
+	"FilterExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x122\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.leiysky.parser.ast.FilterTypeR\x04type\x12<\n" +
+	"\bvariable\x18\x03 \x01(\v2 .leiysky.parser.ast.VariableNodeR\bvariable\x12(\n" +
+	"\x02in\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x02in\x12.\n" +
+	"\x05where\x18\x05 \x01(\v2\x18.leiysky.parser.ast.ExprR\x05where\"\xb0\x01\n" +
+	"\x11ListComprehension\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12?\n" +
+	"\vfilter_expr\x18\x02 \x01(\v2\x1e.leiysky.parser.ast.FilterExprR\n" +
+	"filterExpr\x12,\n" +
+	"\x04expr\x18\x03 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\"\x8a\x02\n" +
+	"\x12FunctionInvocation\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12B\n" +
+	"\tnamespace\x18\x02 \x03(\v2$.leiysky.parser.ast.SymbolicNameNodeR\tnamespace\x128\n" +
+	"\x04name\x18\x03 \x01(\v2$.leiysky.parser.ast.SymbolicNameNodeR\x04name\x12\x1a\n" +
+	"\bdistinct\x18\x04 \x01(\bR\bdistinct\x12,\n" +
+	"\x04args\x18\x05 \x03(\v2\x18.leiysky.parser.ast.ExprR\x04args\"g\n" +
+	"\tParenExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12,\n" +
+	"\x04expr\x18\x02 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\"<\n" +
+	"\fCountAllExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\"\xde\x01\n" +
+	"\x12ExistsSubqueryExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x123\n" +
+	"\x05query\x18\x02 \x01(\v2\x1d.leiysky.parser.ast.QueryStmtR\x05query\x125\n" +
+	"\apattern\x18\x03 \x01(\v2\x1b.leiysky.parser.ast.PatternR\apattern\x12.\n" +
+	"\x05where\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x05where\"\xdd\x01\n" +
+	"\x11CountSubqueryExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x123\n" +
+	"\x05query\x18\x02 \x01(\v2\x1d.leiysky.parser.ast.QueryStmtR\x05query\x125\n" +
+	"\apattern\x18\x03 \x01(\v2\x1b.leiysky.parser.ast.PatternR\apattern\x12.\n" +
+	"\x05where\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x05where\"\x8c\x02\n" +
+	"\x0eSchemaNameNode\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x126\n" +
+	"\x04type\x18\x02 \x01(\x0e2\".leiysky.parser.ast.SchemaNameTypeR\x04type\x12I\n" +
+	"\rsymbolic_name\x18\x03 \x01(\v2$.leiysky.parser.ast.SymbolicNameNodeR\fsymbolicName\x12I\n" +
+	"\rreserved_word\x18\x04 \x01(\v2$.leiysky.parser.ast.ReservedWordNodeR\freservedWord\"\x90\x01\n" +
+	"\x10SymbolicNameNode\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x128\n" +
+	"\x04type\x18\x02 \x01(\x0e2$.leiysky.parser.ast.SymbolicNameTypeR\x04type\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"Z\n" +
+	"\x10ReservedWordNode\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\x87\x01\n" +
+	"\fVariableNode\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12I\n" +
+	"\rsymbolic_name\x18\x02 \x01(\v2$.leiysky.parser.ast.SymbolicNameNodeR\fsymbolicName\"\x80\x01\n" +
+	"\rNodeLabelNode\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12A\n" +
+	"\n" +
+	"label_name\x18\x02 \x01(\v2\".leiysky.parser.ast.SchemaNameNodeR\tlabelName\"\xe8\x01\n" +
+	"\rParameterNode\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x125\n" +
+	"\x04type\x18\x02 \x01(\x0e2!.leiysky.parser.ast.ParameterTypeR\x04type\x12I\n" +
+	"\rsymbolic_name\x18\x03 \x01(\v2$.leiysky.parser.ast.SymbolicNameNodeR\fsymbolicName\x12'\n" +
+	"\x0fdecimal_integer\x18\x04 \x01(\x03R\x0edecimalInteger\"\xc4\x02\n" +
+	"\vLiteralExpr\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x123\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1f.leiysky.parser.ast.LiteralTypeR\x04type\x129\n" +
+	"\x06number\x18\x03 \x01(\v2!.leiysky.parser.ast.NumberLiteralR\x06number\x12\x16\n" +
+	"\x06string\x18\x04 \x01(\tR\x06string\x12\x18\n" +
+	"\aboolean\x18\x05 \x01(\bR\aboolean\x120\n" +
+	"\x03map\x18\x06 \x01(\v2\x1e.leiysky.parser.ast.MapLiteralR\x03map\x123\n" +
+	"\x04list\x18\a \x01(\v2\x1f.leiysky.parser.ast.ListLiteralR\x04list\"\xaa\x01\n" +
+	"\rNumberLiteral\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x129\n" +
+	"\x04type\x18\x02 \x01(\x0e2%.leiysky.parser.ast.NumberLiteralTypeR\x04type\x12\x18\n" +
+	"\ainteger\x18\x03 \x01(\x03R\ainteger\x12\x16\n" +
+	"\x06double\x18\x04 \x01(\x01R\x06double\"\xb3\x01\n" +
+	"\n" +
+	"MapLiteral\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12G\n" +
+	"\rproperty_keys\x18\x02 \x03(\v2\".leiysky.parser.ast.SchemaNameNodeR\fpropertyKeys\x12.\n" +
+	"\x05exprs\x18\x03 \x03(\v2\x18.leiysky.parser.ast.ExprR\x05exprs\"k\n" +
+	"\vListLiteral\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12.\n" +
+	"\x05exprs\x18\x02 \x03(\v2\x18.leiysky.parser.ast.ExprR\x05exprs\"n\n" +
+	"\aPattern\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x125\n" +
+	"\x05parts\x18\x02 \x03(\v2\x1f.leiysky.parser.ast.PatternPartR\x05parts\"\xb7\x01\n" +
+	"\vPatternPart\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12<\n" +
+	"\bvariable\x18\x02 \x01(\v2 .leiysky.parser.ast.VariableNodeR\bvariable\x12<\n" +
+	"\aelement\x18\x03 \x01(\v2\".leiysky.parser.ast.PatternElementR\aelement\"\xc4\x01\n" +
+	"\x0ePatternElement\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12M\n" +
+	"\rrelationships\x18\x02 \x03(\v2'.leiysky.parser.ast.RelationshipPatternR\rrelationships\x125\n" +
+	"\x05nodes\x18\x03 \x03(\v2\x1f.leiysky.parser.ast.NodePatternR\x05nodes\"\xf4\x01\n" +
+	"\vNodePattern\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12<\n" +
+	"\bvariable\x18\x02 \x01(\v2 .leiysky.parser.ast.VariableNodeR\bvariable\x129\n" +
+	"\x06labels\x18\x03 \x03(\v2!.leiysky.parser.ast.NodeLabelNodeR\x06labels\x12>\n" +
+	"\n" +
+	"properties\x18\x04 \x01(\v2\x1e.leiysky.parser.ast.PropertiesR\n" +
+	"properties\"\xbd\x01\n" +
+	"\x13RelationshipPattern\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x128\n" +
+	"\x04type\x18\x02 \x01(\x0e2$.leiysky.parser.ast.RelationshipTypeR\x04type\x12>\n" +
+	"\x06detail\x18\x03 \x01(\v2&.leiysky.parser.ast.RelationshipDetailR\x06detail\"\xc9\x02\n" +
+	"\x12RelationshipDetail\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12<\n" +
+	"\bvariable\x18\x02 \x01(\v2 .leiysky.parser.ast.VariableNodeR\bvariable\x12Q\n" +
+	"\x12relationship_types\x18\x03 \x03(\v2\".leiysky.parser.ast.SchemaNameNodeR\x11relationshipTypes\x12\x19\n" +
+	"\bmin_hops\x18\x04 \x01(\x03R\aminHops\x12\x19\n" +
+	"\bmax_hops\x18\x05 \x01(\x03R\amaxHops\x12>\n" +
+	"\n" +
+	"properties\x18\x06 \x01(\v2\x1e.leiysky.parser.ast.PropertiesR\n" +
+	"properties\"\xf4\x01\n" +
+	"\n" +
+	"Properties\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x126\n" +
+	"\x04type\x18\x02 \x01(\x0e2\".leiysky.parser.ast.PropertiesTypeR\x04type\x12?\n" +
+	"\vmap_literal\x18\x03 \x01(\v2\x1e.leiysky.parser.ast.MapLiteralR\n" +
+	"mapLiteral\x12?\n" +
+	"\tparameter\x18\x04 \x01(\v2!.leiysky.parser.ast.ParameterNodeR\tparameter\"\xad\x02\n" +
+	"\x14PatternComprehension\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12<\n" +
+	"\bvariable\x18\x02 \x01(\v2 .leiysky.parser.ast.VariableNodeR\bvariable\x12K\n" +
+	"\x0fpattern_element\x18\x03 \x01(\v2\".leiysky.parser.ast.PatternElementR\x0epatternElement\x12.\n" +
+	"\x05where\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x05where\x12,\n" +
+	"\x04expr\x18\x05 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\"\x8b\x02\n" +
+	"\x13ProcedureInvocation\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12B\n" +
+	"\tnamespace\x18\x02 \x03(\v2$.leiysky.parser.ast.SymbolicNameNodeR\tnamespace\x128\n" +
+	"\x04name\x18\x03 \x01(\v2$.leiysky.parser.ast.SymbolicNameNodeR\x04name\x12\x1a\n" +
+	"\bimplicit\x18\x04 \x01(\bR\bimplicit\x12,\n" +
+	"\x04args\x18\x05 \x03(\v2\x18.leiysky.parser.ast.ExprR\x04args\"\xbb\x01\n" +
+	"\n" +
+	"YieldItems\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12\x1a\n" +
+	"\bwildcard\x18\x02 \x01(\bR\bwildcard\x123\n" +
+	"\x05items\x18\x03 \x03(\v2\x1d.leiysky.parser.ast.YieldItemR\x05items\x12.\n" +
+	"\x05where\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x05where\"\xb3\x01\n" +
+	"\tYieldItem\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12:\n" +
+	"\x05field\x18\x02 \x01(\v2$.leiysky.parser.ast.SymbolicNameNodeR\x05field\x12<\n" +
+	"\bvariable\x18\x03 \x01(\v2 .leiysky.parser.ast.VariableNodeR\bvariable\"\xf4\x01\n" +
+	"\n" +
+	"CypherStmt\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x126\n" +
+	"\x04type\x18\x02 \x01(\x0e2\".leiysky.parser.ast.CypherStmtTypeR\x04type\x123\n" +
+	"\x05query\x18\x03 \x01(\v2\x1d.leiysky.parser.ast.QueryStmtR\x05query\x12K\n" +
+	"\x0fstandalone_call\x18\x04 \x01(\v2\".leiysky.parser.ast.StandaloneCallR\x0estandaloneCall\"m\n" +
+	"\tQueryStmt\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x122\n" +
+	"\aclauses\x18\x02 \x03(\v2\x18.leiysky.parser.ast.StmtR\aclauses\"\x81\x01\n" +
+	"\vUnionClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\x122\n" +
+	"\aclauses\x18\x03 \x03(\v2\x18.leiysky.parser.ast.StmtR\aclauses\"\xbb\x01\n" +
+	"\x0eStandaloneCall\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12E\n" +
+	"\tprocedure\x18\x02 \x01(\v2'.leiysky.parser.ast.ProcedureInvocationR\tprocedure\x124\n" +
+	"\x05yield\x18\x03 \x01(\v2\x1e.leiysky.parser.ast.YieldItemsR\x05yield\"\xb4\x02\n" +
+	"\rReadingClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x129\n" +
+	"\x04type\x18\x02 \x01(\x0e2%.leiysky.parser.ast.ReadingClauseTypeR\x04type\x125\n" +
+	"\x05match\x18\x03 \x01(\v2\x1f.leiysky.parser.ast.MatchClauseR\x05match\x128\n" +
+	"\x06unwind\x18\x04 \x01(\v2 .leiysky.parser.ast.UnwindClauseR\x06unwind\x12I\n" +
+	"\rin_query_call\x18\x05 \x01(\v2%.leiysky.parser.ast.InQueryCallClauseR\vinQueryCall\"\xbe\x01\n" +
+	"\vMatchClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x125\n" +
+	"\apattern\x18\x02 \x01(\v2\x1b.leiysky.parser.ast.PatternR\apattern\x12\x1a\n" +
+	"\boptional\x18\x03 \x01(\bR\boptional\x12.\n" +
+	"\x05where\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x05where\"\xa8\x01\n" +
+	"\fUnwindClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12,\n" +
+	"\x04expr\x18\x02 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\x12<\n" +
+	"\bvariable\x18\x03 \x01(\v2 .leiysky.parser.ast.VariableNodeR\bvariable\"\xbe\x01\n" +
+	"\x11InQueryCallClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12E\n" +
+	"\tprocedure\x18\x02 \x01(\v2'.leiysky.parser.ast.ProcedureInvocationR\tprocedure\x124\n" +
+	"\x05yield\x18\x03 \x01(\v2\x1e.leiysky.parser.ast.YieldItemsR\x05yield\"\x94\x03\n" +
+	"\x0eSubqueryClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12E\n" +
+	"\x0eimporting_with\x18\x02 \x01(\v2\x1e.leiysky.parser.ast.WithClauseR\rimportingWith\x123\n" +
+	"\x05query\x18\x03 \x01(\v2\x1d.leiysky.parser.ast.QueryStmtR\x05query\x12'\n" +
+	"\x0fin_transactions\x18\x04 \x01(\bR\x0einTransactions\x12:\n" +
+	"\vconcurrency\x18\x05 \x01(\v2\x18.leiysky.parser.ast.ExprR\vconcurrency\x127\n" +
+	"\n" +
+	"batch_size\x18\x06 \x01(\v2\x18.leiysky.parser.ast.ExprR\tbatchSize\x12:\n" +
+	"\bon_error\x18\a \x01(\x0e2\x1f.leiysky.parser.ast.OnErrorTypeR\aonError\"\xc7\x01\n" +
+	"\n" +
+	"WithClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12\x1a\n" +
+	"\bdistinct\x18\x02 \x01(\bR\bdistinct\x12?\n" +
+	"\vreturn_body\x18\x03 \x01(\v2\x1e.leiysky.parser.ast.ReturnBodyR\n" +
+	"returnBody\x12.\n" +
+	"\x05where\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x05where\"\x99\x01\n" +
+	"\fReturnClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12\x1a\n" +
+	"\bdistinct\x18\x02 \x01(\bR\bdistinct\x12?\n" +
+	"\vreturn_body\x18\x03 \x01(\v2\x1e.leiysky.parser.ast.ReturnBodyR\n" +
+	"returnBody\"\x97\x02\n" +
+	"\n" +
+	"ReturnBody\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12A\n" +
+	"\freturn_items\x18\x02 \x03(\v2\x1e.leiysky.parser.ast.ReturnItemR\vreturnItems\x12:\n" +
+	"\border_by\x18\x03 \x01(\v2\x1f.leiysky.parser.ast.OrderClauseR\aorderBy\x12,\n" +
+	"\x04skip\x18\x04 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04skip\x12.\n" +
+	"\x05limit\x18\x05 \x01(\v2\x18.leiysky.parser.ast.ExprR\x05limit\"\xd2\x01\n" +
+	"\n" +
+	"ReturnItem\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12\x1a\n" +
+	"\bwildcard\x18\x02 \x01(\bR\bwildcard\x12,\n" +
+	"\x04expr\x18\x03 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\x12\x0e\n" +
+	"\x02as\x18\x04 \x01(\bR\x02as\x12<\n" +
+	"\bvariable\x18\x05 \x01(\v2 .leiysky.parser.ast.VariableNodeR\bvariable\"x\n" +
+	"\vOrderClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12;\n" +
+	"\n" +
+	"sort_items\x18\x02 \x03(\v2\x1c.leiysky.parser.ast.SortItemR\tsortItems\"\x98\x01\n" +
+	"\bSortItem\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.leiysky.parser.ast.SortTypeR\x04type\x12,\n" +
+	"\x04expr\x18\x03 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\"s\n" +
+	"\fCreateClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x125\n" +
+	"\apattern\x18\x02 \x01(\v2\x1b.leiysky.parser.ast.PatternR\apattern\"\xc5\x01\n" +
+	"\vMergeClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12B\n" +
+	"\fpattern_part\x18\x02 \x01(\v2\x1f.leiysky.parser.ast.PatternPartR\vpatternPart\x12D\n" +
+	"\rmerge_actions\x18\x03 \x03(\v2\x1f.leiysky.parser.ast.MergeActionR\fmergeActions\"\xa5\x01\n" +
+	"\vMergeAction\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x127\n" +
+	"\x04type\x18\x02 \x01(\x0e2#.leiysky.parser.ast.MergeActionTypeR\x04type\x12/\n" +
+	"\x03set\x18\x03 \x01(\v2\x1d.leiysky.parser.ast.SetClauseR\x03set\"s\n" +
+	"\tSetClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x128\n" +
+	"\tset_items\x18\x02 \x03(\v2\x1b.leiysky.parser.ast.SetItemR\bsetItems\"\xd1\x02\n" +
+	"\aSetItem\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x123\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1f.leiysky.parser.ast.SetItemTypeR\x04type\x12<\n" +
+	"\bproperty\x18\x03 \x01(\v2 .leiysky.parser.ast.PropertyExprR\bproperty\x12<\n" +
+	"\bvariable\x18\x04 \x01(\v2 .leiysky.parser.ast.VariableNodeR\bvariable\x12,\n" +
+	"\x04expr\x18\x05 \x01(\v2\x18.leiysky.parser.ast.ExprR\x04expr\x129\n" +
+	"\x06labels\x18\x06 \x03(\v2!.leiysky.parser.ast.NodeLabelNodeR\x06labels\"\x84\x01\n" +
+	"\fDeleteClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12\x16\n" +
+	"\x06detach\x18\x02 \x01(\bR\x06detach\x12.\n" +
+	"\x05exprs\x18\x03 \x03(\v2\x18.leiysky.parser.ast.ExprR\x05exprs\"\x7f\n" +
+	"\fRemoveClause\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x12A\n" +
+	"\fremove_items\x18\x02 \x03(\v2\x1e.leiysky.parser.ast.RemoveItemR\vremoveItems\"\xa9\x02\n" +
+	"\n" +
+	"RemoveItem\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.leiysky.parser.ast.BaseR\x04base\x126\n" +
+	"\x04type\x18\x02 \x01(\x0e2\".leiysky.parser.ast.RemoveItemTypeR\x04type\x12<\n" +
+	"\bvariable\x18\x03 \x01(\v2 .leiysky.parser.ast.VariableNodeR\bvariable\x129\n" +
+	"\x06labels\x18\x04 \x03(\v2!.leiysky.parser.ast.NodeLabelNodeR\x06labels\x12<\n" +
+	"\bproperty\x18\x05 \x01(\v2 .leiysky.parser.ast.PropertyExprR\bproperty*H\n" +
+	"\x0eCypherStmtType\x12\x15\n" +
+	"\x11CYPHER_STMT_QUERY\x10\x00\x12\x1f\n" +
+	"\x1bCYPHER_STMT_STANDALONE_CALL\x10\x01*o\n" +
+	"\n" +
+	"FilterType\x12\x1d\n" +
+	"\x19FILTER_LIST_COMPREHENSION\x10\x00\x12\x0e\n" +
+	"\n" +
+	"FILTER_ALL\x10\x01\x12\x0e\n" +
+	"\n" +
+	"FILTER_ANY\x10\x02\x12\x11\n" +
+	"\rFILTER_SINGLE\x10\x03\x12\x0f\n" +
+	"\vFILTER_NONE\x10\x04*\x7f\n" +
+	"\vLiteralType\x12\x12\n" +
+	"\x0eLITERAL_NUMBER\x10\x00\x12\x12\n" +
+	"\x0eLITERAL_STRING\x10\x01\x12\x13\n" +
+	"\x0fLITERAL_BOOLEAN\x10\x02\x12\x10\n" +
+	"\fLITERAL_NULL\x10\x03\x12\x0f\n" +
+	"\vLITERAL_MAP\x10\x04\x12\x10\n" +
+	"\fLITERAL_LIST\x10\x05*B\n" +
+	"\x0fMergeActionType\x12\x17\n" +
+	"\x13MERGE_ACTION_CREATE\x10\x00\x12\x16\n" +
+	"\x12MERGE_ACTION_MATCH\x10\x01*J\n" +
+	"\x11NumberLiteralType\x12\x1a\n" +
+	"\x16NUMBER_LITERAL_INTEGER\x10\x00\x12\x19\n" +
+	"\x15NUMBER_LITERAL_DOUBLE\x10\x01*^\n" +
+	"\vOnErrorType\x12\x11\n" +
+	"\rON_ERROR_NONE\x10\x00\x12\x15\n" +
+	"\x11ON_ERROR_CONTINUE\x10\x01\x12\x12\n" +
+	"\x0eON_ERROR_BREAK\x10\x02\x12\x11\n" +
+	"\rON_ERROR_FAIL\x10\x03*\xde\x01\n" +
+	"\x06OpType\x12\n" +
+	"\n" +
+	"\x06OP_ADD\x10\x00\x12\n" +
+	"\n" +
+	"\x06OP_SUB\x10\x01\x12\n" +
+	"\n" +
+	"\x06OP_MUL\x10\x02\x12\n" +
+	"\n" +
+	"\x06OP_DIV\x10\x03\x12\n" +
+	"\n" +
+	"\x06OP_MOD\x10\x04\x12\n" +
+	"\n" +
+	"\x06OP_POW\x10\x05\x12\v\n" +
+	"\aOP_PLUS\x10\x06\x12\f\n" +
+	"\bOP_MINUS\x10\a\x12\t\n" +
+	"\x05OP_EQ\x10\b\x12\t\n" +
+	"\x05OP_NE\x10\t\x12\t\n" +
+	"\x05OP_LT\x10\n" +
+	"\x12\t\n" +
+	"\x05OP_GT\x10\v\x12\n" +
+	"\n" +
+	"\x06OP_LTE\x10\f\x12\n" +
+	"\n" +
+	"\x06OP_GTE\x10\r\x12\t\n" +
+	"\x05OP_OR\x10\x0e\x12\n" +
+	"\n" +
+	"\x06OP_AND\x10\x0f\x12\n" +
+	"\n" +
+	"\x06OP_XOR\x10\x10\x12\n" +
+	"\n" +
+	"\x06OP_NOT\x10\x11*K\n" +
+	"\rParameterType\x12\x1b\n" +
+	"\x17PARAMETER_SYMBOLIC_NAME\x10\x00\x12\x1d\n" +
+	"\x19PARAMETER_DECIMAL_INTEGER\x10\x01*^\n" +
+	"\x0fPredicationType\x12\x19\n" +
+	"\x15PREDICATION_STRING_OP\x10\x00\x12\x17\n" +
+	"\x13PREDICATION_LIST_OP\x10\x01\x12\x17\n" +
+	"\x13PREDICATION_NULL_OP\x10\x02*F\n" +
+	"\x0ePropertiesType\x12\x1a\n" +
+	"\x16PROPERTIES_MAP_LITERAL\x10\x00\x12\x18\n" +
+	"\x14PROPERTIES_PARAMETER\x10\x01*j\n" +
+	"\x11ReadingClauseType\x12\x18\n" +
+	"\x14READING_CLAUSE_MATCH\x10\x00\x12\x19\n" +
+	"\x15READING_CLAUSE_UNWIND\x10\x01\x12 \n" +
+	"\x1cREADING_CLAUSE_IN_QUERY_CALL\x10\x02*j\n" +
+	"\x10RelationshipType\x12\x13\n" +
+	"\x0fRELATIONSHIP_IN\x10\x00\x12\x14\n" +
+	"\x10RELATIONSHIP_OUT\x10\x01\x12\x15\n" +
+	"\x11RELATIONSHIP_BOTH\x10\x02\x12\x14\n" +
+	"\x10RELATIONSHIP_ALL\x10\x03*D\n" +
+	"\x0eRemoveItemType\x12\x18\n" +
+	"\x14REMOVE_ITEM_VARIABLE\x10\x00\x12\x18\n" +
+	"\x14REMOVE_ITEM_PROPERTY\x10\x01*N\n" +
+	"\x0eSchemaNameType\x12\x1d\n" +
+	"\x19SCHEMA_NAME_SYMBOLIC_NAME\x10\x00\x12\x1d\n" +
+	"\x19SCHEMA_NAME_RESERVED_WORD\x10\x01*\x84\x01\n" +
+	"\vSetItemType\x12\x15\n" +
+	"\x11SET_ITEM_PROPERTY\x10\x00\x12 \n" +
+	"\x1cSET_ITEM_VARIABLE_ASSIGNMENT\x10\x01\x12\x1f\n" +
+	"\x1bSET_ITEM_VARIABLE_INCREMENT\x10\x02\x12\x1b\n" +
+	"\x17SET_ITEM_VARIABLE_LABEL\x10\x03*3\n" +
+	"\bSortType\x12\x12\n" +
+	"\x0eSORT_ASCENDING\x10\x00\x12\x13\n" +
+	"\x0fSORT_DESCENDING\x10\x01*v\n" +
+	"\x13StringOperationType\x12 \n" +
+	"\x1cSTRING_OPERATION_STARTS_WITH\x10\x00\x12\x1e\n" +
+	"\x1aSTRING_OPERATION_ENDS_WITH\x10\x01\x12\x1d\n" +
+	"\x19STRING_OPERATION_CONTAINS\x10\x02*\x99\x02\n" +
+	"\x10SymbolicNameType\x12\x1b\n" +
+	"\x17SYMBOLIC_NAME_UNESCAPED\x10\x00\x12\x19\n" +
+	"\x15SYMBOLIC_NAME_ESCAPED\x10\x01\x12\x1c\n" +
+	"\x18SYMBOLIC_NAME_HEX_LETTER\x10\x02\x12\x17\n" +
+	"\x13SYMBOLIC_NAME_COUNT\x10\x03\x12\x18\n" +
+	"\x14SYMBOLIC_NAME_FILTER\x10\x04\x12\x19\n" +
+	"\x15SYMBOLIC_NAME_EXTRACT\x10\x05\x12\x15\n" +
+	"\x11SYMBOLIC_NAME_ANY\x10\x06\x12\x16\n" +
+	"\x12SYMBOLIC_NAME_NONE\x10\a\x12\x18\n" +
+	"\x14SYMBOLIC_NAME_SINGLE\x10\b\x12\x18\n" +
+	"\x14SYMBOLIC_NAME_EXISTS\x10\tB%Z#github.com/leiysky/parser/ast/astpbb\x06proto3"
+
+var (
+	file_ast_proto_rawDescOnce sync.Once
+	file_ast_proto_rawDescData []byte
+)
+
+func file_ast_proto_rawDescGZIP() []byte {
+	file_ast_proto_rawDescOnce.Do(func() {
+		file_ast_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ast_proto_rawDesc), len(file_ast_proto_rawDesc)))
+	})
+	return file_ast_proto_rawDescData
+}
+
+var file_ast_proto_enumTypes = make([]protoimpl.EnumInfo, 18)
+var file_ast_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_ast_proto_goTypes = []any{
+	(CypherStmtType)(0),          // 0: leiysky.parser.ast.CypherStmtType
+	(FilterType)(0),              // 1: leiysky.parser.ast.FilterType
+	(LiteralType)(0),             // 2: leiysky.parser.ast.LiteralType
+	(MergeActionType)(0),         // 3: leiysky.parser.ast.MergeActionType
+	(NumberLiteralType)(0),       // 4: leiysky.parser.ast.NumberLiteralType
+	(OnErrorType)(0),             // 5: leiysky.parser.ast.OnErrorType
+	(OpType)(0),                  // 6: leiysky.parser.ast.OpType
+	(ParameterType)(0),           // 7: leiysky.parser.ast.ParameterType
+	(PredicationType)(0),         // 8: leiysky.parser.ast.PredicationType
+	(PropertiesType)(0),          // 9: leiysky.parser.ast.PropertiesType
+	(ReadingClauseType)(0),       // 10: leiysky.parser.ast.ReadingClauseType
+	(RelationshipType)(0),        // 11: leiysky.parser.ast.RelationshipType
+	(RemoveItemType)(0),          // 12: leiysky.parser.ast.RemoveItemType
+	(SchemaNameType)(0),          // 13: leiysky.parser.ast.SchemaNameType
+	(SetItemType)(0),             // 14: leiysky.parser.ast.SetItemType
+	(SortType)(0),                // 15: leiysky.parser.ast.SortType
+	(StringOperationType)(0),     // 16: leiysky.parser.ast.StringOperationType
+	(SymbolicNameType)(0),        // 17: leiysky.parser.ast.SymbolicNameType
+	(*Position)(nil),             // 18: leiysky.parser.ast.Position
+	(*Comment)(nil),              // 19: leiysky.parser.ast.Comment
+	(*Base)(nil),                 // 20: leiysky.parser.ast.Base
+	(*Node)(nil),                 // 21: leiysky.parser.ast.Node
+	(*Expr)(nil),                 // 22: leiysky.parser.ast.Expr
+	(*Stmt)(nil),                 // 23: leiysky.parser.ast.Stmt
+	(*PropertyExpr)(nil),         // 24: leiysky.parser.ast.PropertyExpr
+	(*BinaryExpr)(nil),           // 25: leiysky.parser.ast.BinaryExpr
+	(*UnaryExpr)(nil),            // 26: leiysky.parser.ast.UnaryExpr
+	(*PredicationExpr)(nil),      // 27: leiysky.parser.ast.PredicationExpr
+	(*StringOperationExpr)(nil),  // 28: leiysky.parser.ast.StringOperationExpr
+	(*ListOperationExpr)(nil),    // 29: leiysky.parser.ast.ListOperationExpr
+	(*NullOperationExpr)(nil),    // 30: leiysky.parser.ast.NullOperationExpr
+	(*PropertyOrLabelsExpr)(nil), // 31: leiysky.parser.ast.PropertyOrLabelsExpr
+	(*PropertyLookup)(nil),       // 32: leiysky.parser.ast.PropertyLookup
+	(*CaseExpr)(nil),             // 33: leiysky.parser.ast.CaseExpr
+	(*CaseAlt)(nil),              // 34: leiysky.parser.ast.CaseAlt
+	(*FilterExpr)(nil),           // 35: leiysky.parser.ast.FilterExpr
+	(*ListComprehension)(nil),    // 36: leiysky.parser.ast.ListComprehension
+	(*FunctionInvocation)(nil),   // 37: leiysky.parser.ast.FunctionInvocation
+	(*ParenExpr)(nil),            // 38: leiysky.parser.ast.ParenExpr
+	(*CountAllExpr)(nil),         // 39: leiysky.parser.ast.CountAllExpr
+	(*ExistsSubqueryExpr)(nil),   // 40: leiysky.parser.ast.ExistsSubqueryExpr
+	(*CountSubqueryExpr)(nil),    // 41: leiysky.parser.ast.CountSubqueryExpr
+	(*SchemaNameNode)(nil),       // 42: leiysky.parser.ast.SchemaNameNode
+	(*SymbolicNameNode)(nil),     // 43: leiysky.parser.ast.SymbolicNameNode
+	(*ReservedWordNode)(nil),     // 44: leiysky.parser.ast.ReservedWordNode
+	(*VariableNode)(nil),         // 45: leiysky.parser.ast.VariableNode
+	(*NodeLabelNode)(nil),        // 46: leiysky.parser.ast.NodeLabelNode
+	(*ParameterNode)(nil),        // 47: leiysky.parser.ast.ParameterNode
+	(*LiteralExpr)(nil),          // 48: leiysky.parser.ast.LiteralExpr
+	(*NumberLiteral)(nil),        // 49: leiysky.parser.ast.NumberLiteral
+	(*MapLiteral)(nil),           // 50: leiysky.parser.ast.MapLiteral
+	(*ListLiteral)(nil),          // 51: leiysky.parser.ast.ListLiteral
+	(*Pattern)(nil),              // 52: leiysky.parser.ast.Pattern
+	(*PatternPart)(nil),          // 53: leiysky.parser.ast.PatternPart
+	(*PatternElement)(nil),       // 54: leiysky.parser.ast.PatternElement
+	(*NodePattern)(nil),          // 55: leiysky.parser.ast.NodePattern
+	(*RelationshipPattern)(nil),  // 56: leiysky.parser.ast.RelationshipPattern
+	(*RelationshipDetail)(nil),   // 57: leiysky.parser.ast.RelationshipDetail
+	(*Properties)(nil),           // 58: leiysky.parser.ast.Properties
+	(*PatternComprehension)(nil), // 59: leiysky.parser.ast.PatternComprehension
+	(*ProcedureInvocation)(nil),  // 60: leiysky.parser.ast.ProcedureInvocation
+	(*YieldItems)(nil),           // 61: leiysky.parser.ast.YieldItems
+	(*YieldItem)(nil),            // 62: leiysky.parser.ast.YieldItem
+	(*CypherStmt)(nil),           // 63: leiysky.parser.ast.CypherStmt
+	(*QueryStmt)(nil),            // 64: leiysky.parser.ast.QueryStmt
+	(*UnionClause)(nil),          // 65: leiysky.parser.ast.UnionClause
+	(*StandaloneCall)(nil),       // 66: leiysky.parser.ast.StandaloneCall
+	(*ReadingClause)(nil),        // 67: leiysky.parser.ast.ReadingClause
+	(*MatchClause)(nil),          // 68: leiysky.parser.ast.MatchClause
+	(*UnwindClause)(nil),         // 69: leiysky.parser.ast.UnwindClause
+	(*InQueryCallClause)(nil),    // 70: leiysky.parser.ast.InQueryCallClause
+	(*SubqueryClause)(nil),       // 71: leiysky.parser.ast.SubqueryClause
+	(*WithClause)(nil),           // 72: leiysky.parser.ast.WithClause
+	(*ReturnClause)(nil),         // 73: leiysky.parser.ast.ReturnClause
+	(*ReturnBody)(nil),           // 74: leiysky.parser.ast.ReturnBody
+	(*ReturnItem)(nil),           // 75: leiysky.parser.ast.ReturnItem
+	(*OrderClause)(nil),          // 76: leiysky.parser.ast.OrderClause
+	(*SortItem)(nil),             // 77: leiysky.parser.ast.SortItem
+	(*CreateClause)(nil),         // 78: leiysky.parser.ast.CreateClause
+	(*MergeClause)(nil),          // 79: leiysky.parser.ast.MergeClause
+	(*MergeAction)(nil),          // 80: leiysky.parser.ast.MergeAction
+	(*SetClause)(nil),            // 81: leiysky.parser.ast.SetClause
+	(*SetItem)(nil),              // 82: leiysky.parser.ast.SetItem
+	(*DeleteClause)(nil),         // 83: leiysky.parser.ast.DeleteClause
+	(*RemoveClause)(nil),         // 84: leiysky.parser.ast.RemoveClause
+	(*RemoveItem)(nil),           // 85: leiysky.parser.ast.RemoveItem
+}
+var file_ast_proto_depIdxs = []int32{
+	18,  // 0: leiysky.parser.ast.Comment.start:type_name -> leiysky.parser.ast.Position
+	18,  // 1: leiysky.parser.ast.Comment.end:type_name -> leiysky.parser.ast.Position
+	18,  // 2: leiysky.parser.ast.Base.start:type_name -> leiysky.parser.ast.Position
+	18,  // 3: leiysky.parser.ast.Base.end:type_name -> leiysky.parser.ast.Position
+	19,  // 4: leiysky.parser.ast.Base.leading_comments:type_name -> leiysky.parser.ast.Comment
+	19,  // 5: leiysky.parser.ast.Base.trailing_comments:type_name -> leiysky.parser.ast.Comment
+	24,  // 6: leiysky.parser.ast.Node.property_expr:type_name -> leiysky.parser.ast.PropertyExpr
+	25,  // 7: leiysky.parser.ast.Node.binary_expr:type_name -> leiysky.parser.ast.BinaryExpr
+	26,  // 8: leiysky.parser.ast.Node.unary_expr:type_name -> leiysky.parser.ast.UnaryExpr
+	27,  // 9: leiysky.parser.ast.Node.predication_expr:type_name -> leiysky.parser.ast.PredicationExpr
+	28,  // 10: leiysky.parser.ast.Node.string_operation_expr:type_name -> leiysky.parser.ast.StringOperationExpr
+	29,  // 11: leiysky.parser.ast.Node.list_operation_expr:type_name -> leiysky.parser.ast.ListOperationExpr
+	30,  // 12: leiysky.parser.ast.Node.null_operation_expr:type_name -> leiysky.parser.ast.NullOperationExpr
+	31,  // 13: leiysky.parser.ast.Node.property_or_labels_expr:type_name -> leiysky.parser.ast.PropertyOrLabelsExpr
+	32,  // 14: leiysky.parser.ast.Node.property_lookup:type_name -> leiysky.parser.ast.PropertyLookup
+	33,  // 15: leiysky.parser.ast.Node.case_expr:type_name -> leiysky.parser.ast.CaseExpr
+	34,  // 16: leiysky.parser.ast.Node.case_alt:type_name -> leiysky.parser.ast.CaseAlt
+	35,  // 17: leiysky.parser.ast.Node.filter_expr:type_name -> leiysky.parser.ast.FilterExpr
+	36,  // 18: leiysky.parser.ast.Node.list_comprehension:type_name -> leiysky.parser.ast.ListComprehension
+	37,  // 19: leiysky.parser.ast.Node.function_invocation:type_name -> leiysky.parser.ast.FunctionInvocation
+	38,  // 20: leiysky.parser.ast.Node.paren_expr:type_name -> leiysky.parser.ast.ParenExpr
+	39,  // 21: leiysky.parser.ast.Node.count_all_expr:type_name -> leiysky.parser.ast.CountAllExpr
+	42,  // 22: leiysky.parser.ast.Node.schema_name_node:type_name -> leiysky.parser.ast.SchemaNameNode
+	43,  // 23: leiysky.parser.ast.Node.symbolic_name_node:type_name -> leiysky.parser.ast.SymbolicNameNode
+	44,  // 24: leiysky.parser.ast.Node.reserved_word_node:type_name -> leiysky.parser.ast.ReservedWordNode
+	45,  // 25: leiysky.parser.ast.Node.variable_node:type_name -> leiysky.parser.ast.VariableNode
+	46,  // 26: leiysky.parser.ast.Node.node_label_node:type_name -> leiysky.parser.ast.NodeLabelNode
+	47,  // 27: leiysky.parser.ast.Node.parameter_node:type_name -> leiysky.parser.ast.ParameterNode
+	48,  // 28: leiysky.parser.ast.Node.literal_expr:type_name -> leiysky.parser.ast.LiteralExpr
+	49,  // 29: leiysky.parser.ast.Node.number_literal:type_name -> leiysky.parser.ast.NumberLiteral
+	50,  // 30: leiysky.parser.ast.Node.map_literal:type_name -> leiysky.parser.ast.MapLiteral
+	51,  // 31: leiysky.parser.ast.Node.list_literal:type_name -> leiysky.parser.ast.ListLiteral
+	52,  // 32: leiysky.parser.ast.Node.pattern:type_name -> leiysky.parser.ast.Pattern
+	53,  // 33: leiysky.parser.ast.Node.pattern_part:type_name -> leiysky.parser.ast.PatternPart
+	54,  // 34: leiysky.parser.ast.Node.pattern_element:type_name -> leiysky.parser.ast.PatternElement
+	55,  // 35: leiysky.parser.ast.Node.node_pattern:type_name -> leiysky.parser.ast.NodePattern
+	56,  // 36: leiysky.parser.ast.Node.relationship_pattern:type_name -> leiysky.parser.ast.RelationshipPattern
+	57,  // 37: leiysky.parser.ast.Node.relationship_detail:type_name -> leiysky.parser.ast.RelationshipDetail
+	58,  // 38: leiysky.parser.ast.Node.properties:type_name -> leiysky.parser.ast.Properties
+	59,  // 39: leiysky.parser.ast.Node.pattern_comprehension:type_name -> leiysky.parser.ast.PatternComprehension
+	60,  // 40: leiysky.parser.ast.Node.procedure_invocation:type_name -> leiysky.parser.ast.ProcedureInvocation
+	61,  // 41: leiysky.parser.ast.Node.yield_items:type_name -> leiysky.parser.ast.YieldItems
+	62,  // 42: leiysky.parser.ast.Node.yield_item:type_name -> leiysky.parser.ast.YieldItem
+	63,  // 43: leiysky.parser.ast.Node.cypher_stmt:type_name -> leiysky.parser.ast.CypherStmt
+	64,  // 44: leiysky.parser.ast.Node.query_stmt:type_name -> leiysky.parser.ast.QueryStmt
+	65,  // 45: leiysky.parser.ast.Node.union_clause:type_name -> leiysky.parser.ast.UnionClause
+	66,  // 46: leiysky.parser.ast.Node.standalone_call:type_name -> leiysky.parser.ast.StandaloneCall
+	67,  // 47: leiysky.parser.ast.Node.reading_clause:type_name -> leiysky.parser.ast.ReadingClause
+	68,  // 48: leiysky.parser.ast.Node.match_clause:type_name -> leiysky.parser.ast.MatchClause
+	69,  // 49: leiysky.parser.ast.Node.unwind_clause:type_name -> leiysky.parser.ast.UnwindClause
+	70,  // 50: leiysky.parser.ast.Node.in_query_call_clause:type_name -> leiysky.parser.ast.InQueryCallClause
+	72,  // 51: leiysky.parser.ast.Node.with_clause:type_name -> leiysky.parser.ast.WithClause
+	73,  // 52: leiysky.parser.ast.Node.return_clause:type_name -> leiysky.parser.ast.ReturnClause
+	74,  // 53: leiysky.parser.ast.Node.return_body:type_name -> leiysky.parser.ast.ReturnBody
+	75,  // 54: leiysky.parser.ast.Node.return_item:type_name -> leiysky.parser.ast.ReturnItem
+	76,  // 55: leiysky.parser.ast.Node.order_clause:type_name -> leiysky.parser.ast.OrderClause
+	77,  // 56: leiysky.parser.ast.Node.sort_item:type_name -> leiysky.parser.ast.SortItem
+	78,  // 57: leiysky.parser.ast.Node.create_clause:type_name -> leiysky.parser.ast.CreateClause
+	79,  // 58: leiysky.parser.ast.Node.merge_clause:type_name -> leiysky.parser.ast.MergeClause
+	80,  // 59: leiysky.parser.ast.Node.merge_action:type_name -> leiysky.parser.ast.MergeAction
+	81,  // 60: leiysky.parser.ast.Node.set_clause:type_name -> leiysky.parser.ast.SetClause
+	82,  // 61: leiysky.parser.ast.Node.set_item:type_name -> leiysky.parser.ast.SetItem
+	83,  // 62: leiysky.parser.ast.Node.delete_clause:type_name -> leiysky.parser.ast.DeleteClause
+	84,  // 63: leiysky.parser.ast.Node.remove_clause:type_name -> leiysky.parser.ast.RemoveClause
+	85,  // 64: leiysky.parser.ast.Node.remove_item:type_name -> leiysky.parser.ast.RemoveItem
+	40,  // 65: leiysky.parser.ast.Node.exists_subquery_expr:type_name -> leiysky.parser.ast.ExistsSubqueryExpr
+	41,  // 66: leiysky.parser.ast.Node.count_subquery_expr:type_name -> leiysky.parser.ast.CountSubqueryExpr
+	71,  // 67: leiysky.parser.ast.Node.subquery_clause:type_name -> leiysky.parser.ast.SubqueryClause
+	24,  // 68: leiysky.parser.ast.Expr.property_expr:type_name -> leiysky.parser.ast.PropertyExpr
+	25,  // 69: leiysky.parser.ast.Expr.binary_expr:type_name -> leiysky.parser.ast.BinaryExpr
+	26,  // 70: leiysky.parser.ast.Expr.unary_expr:type_name -> leiysky.parser.ast.UnaryExpr
+	27,  // 71: leiysky.parser.ast.Expr.predication_expr:type_name -> leiysky.parser.ast.PredicationExpr
+	28,  // 72: leiysky.parser.ast.Expr.string_operation_expr:type_name -> leiysky.parser.ast.StringOperationExpr
+	29,  // 73: leiysky.parser.ast.Expr.list_operation_expr:type_name -> leiysky.parser.ast.ListOperationExpr
+	30,  // 74: leiysky.parser.ast.Expr.null_operation_expr:type_name -> leiysky.parser.ast.NullOperationExpr
+	31,  // 75: leiysky.parser.ast.Expr.property_or_labels_expr:type_name -> leiysky.parser.ast.PropertyOrLabelsExpr
+	33,  // 76: leiysky.parser.ast.Expr.case_expr:type_name -> leiysky.parser.ast.CaseExpr
+	34,  // 77: leiysky.parser.ast.Expr.case_alt:type_name -> leiysky.parser.ast.CaseAlt
+	35,  // 78: leiysky.parser.ast.Expr.filter_expr:type_name -> leiysky.parser.ast.FilterExpr
+	36,  // 79: leiysky.parser.ast.Expr.list_comprehension:type_name -> leiysky.parser.ast.ListComprehension
+	37,  // 80: leiysky.parser.ast.Expr.function_invocation:type_name -> leiysky.parser.ast.FunctionInvocation
+	38,  // 81: leiysky.parser.ast.Expr.paren_expr:type_name -> leiysky.parser.ast.ParenExpr
+	39,  // 82: leiysky.parser.ast.Expr.count_all_expr:type_name -> leiysky.parser.ast.CountAllExpr
+	45,  // 83: leiysky.parser.ast.Expr.variable_node:type_name -> leiysky.parser.ast.VariableNode
+	47,  // 84: leiysky.parser.ast.Expr.parameter_node:type_name -> leiysky.parser.ast.ParameterNode
+	48,  // 85: leiysky.parser.ast.Expr.literal_expr:type_name -> leiysky.parser.ast.LiteralExpr
+	52,  // 86: leiysky.parser.ast.Expr.pattern:type_name -> leiysky.parser.ast.Pattern
+	53,  // 87: leiysky.parser.ast.Expr.pattern_part:type_name -> leiysky.parser.ast.PatternPart
+	54,  // 88: leiysky.parser.ast.Expr.pattern_element:type_name -> leiysky.parser.ast.PatternElement
+	55,  // 89: leiysky.parser.ast.Expr.node_pattern:type_name -> leiysky.parser.ast.NodePattern
+	56,  // 90: leiysky.parser.ast.Expr.relationship_pattern:type_name -> leiysky.parser.ast.RelationshipPattern
+	57,  // 91: leiysky.parser.ast.Expr.relationship_detail:type_name -> leiysky.parser.ast.RelationshipDetail
+	58,  // 92: leiysky.parser.ast.Expr.properties:type_name -> leiysky.parser.ast.Properties
+	59,  // 93: leiysky.parser.ast.Expr.pattern_comprehension:type_name -> leiysky.parser.ast.PatternComprehension
+	40,  // 94: leiysky.parser.ast.Expr.exists_subquery_expr:type_name -> leiysky.parser.ast.ExistsSubqueryExpr
+	41,  // 95: leiysky.parser.ast.Expr.count_subquery_expr:type_name -> leiysky.parser.ast.CountSubqueryExpr
+	63,  // 96: leiysky.parser.ast.Stmt.cypher_stmt:type_name -> leiysky.parser.ast.CypherStmt
+	64,  // 97: leiysky.parser.ast.Stmt.query_stmt:type_name -> leiysky.parser.ast.QueryStmt
+	65,  // 98: leiysky.parser.ast.Stmt.union_clause:type_name -> leiysky.parser.ast.UnionClause
+	66,  // 99: leiysky.parser.ast.Stmt.standalone_call:type_name -> leiysky.parser.ast.StandaloneCall
+	67,  // 100: leiysky.parser.ast.Stmt.reading_clause:type_name -> leiysky.parser.ast.ReadingClause
+	68,  // 101: leiysky.parser.ast.Stmt.match_clause:type_name -> leiysky.parser.ast.MatchClause
+	69,  // 102: leiysky.parser.ast.Stmt.unwind_clause:type_name -> leiysky.parser.ast.UnwindClause
+	70,  // 103: leiysky.parser.ast.Stmt.in_query_call_clause:type_name -> leiysky.parser.ast.InQueryCallClause
+	72,  // 104: leiysky.parser.ast.Stmt.with_clause:type_name -> leiysky.parser.ast.WithClause
+	73,  // 105: leiysky.parser.ast.Stmt.return_clause:type_name -> leiysky.parser.ast.ReturnClause
+	74,  // 106: leiysky.parser.ast.Stmt.return_body:type_name -> leiysky.parser.ast.ReturnBody
+	75,  // 107: leiysky.parser.ast.Stmt.return_item:type_name -> leiysky.parser.ast.ReturnItem
+	76,  // 108: leiysky.parser.ast.Stmt.order_clause:type_name -> leiysky.parser.ast.OrderClause
+	77,  // 109: leiysky.parser.ast.Stmt.sort_item:type_name -> leiysky.parser.ast.SortItem
+	78,  // 110: leiysky.parser.ast.Stmt.create_clause:type_name -> leiysky.parser.ast.CreateClause
+	79,  // 111: leiysky.parser.ast.Stmt.merge_clause:type_name -> leiysky.parser.ast.MergeClause
+	80,  // 112: leiysky.parser.ast.Stmt.merge_action:type_name -> leiysky.parser.ast.MergeAction
+	81,  // 113: leiysky.parser.ast.Stmt.set_clause:type_name -> leiysky.parser.ast.SetClause
+	82,  // 114: leiysky.parser.ast.Stmt.set_item:type_name -> leiysky.parser.ast.SetItem
+	83,  // 115: leiysky.parser.ast.Stmt.delete_clause:type_name -> leiysky.parser.ast.DeleteClause
+	84,  // 116: leiysky.parser.ast.Stmt.remove_clause:type_name -> leiysky.parser.ast.RemoveClause
+	85,  // 117: leiysky.parser.ast.Stmt.remove_item:type_name -> leiysky.parser.ast.RemoveItem
+	71,  // 118: leiysky.parser.ast.Stmt.subquery_clause:type_name -> leiysky.parser.ast.SubqueryClause
+	20,  // 119: leiysky.parser.ast.PropertyExpr.base:type_name -> leiysky.parser.ast.Base
+	22,  // 120: leiysky.parser.ast.PropertyExpr.expr:type_name -> leiysky.parser.ast.Expr
+	32,  // 121: leiysky.parser.ast.PropertyExpr.lookups:type_name -> leiysky.parser.ast.PropertyLookup
+	20,  // 122: leiysky.parser.ast.BinaryExpr.base:type_name -> leiysky.parser.ast.Base
+	6,   // 123: leiysky.parser.ast.BinaryExpr.op:type_name -> leiysky.parser.ast.OpType
+	22,  // 124: leiysky.parser.ast.BinaryExpr.l:type_name -> leiysky.parser.ast.Expr
+	22,  // 125: leiysky.parser.ast.BinaryExpr.r:type_name -> leiysky.parser.ast.Expr
+	20,  // 126: leiysky.parser.ast.UnaryExpr.base:type_name -> leiysky.parser.ast.Base
+	6,   // 127: leiysky.parser.ast.UnaryExpr.op:type_name -> leiysky.parser.ast.OpType
+	22,  // 128: leiysky.parser.ast.UnaryExpr.v:type_name -> leiysky.parser.ast.Expr
+	20,  // 129: leiysky.parser.ast.PredicationExpr.base:type_name -> leiysky.parser.ast.Base
+	8,   // 130: leiysky.parser.ast.PredicationExpr.type:type_name -> leiysky.parser.ast.PredicationType
+	22,  // 131: leiysky.parser.ast.PredicationExpr.expr:type_name -> leiysky.parser.ast.Expr
+	22,  // 132: leiysky.parser.ast.PredicationExpr.op:type_name -> leiysky.parser.ast.Expr
+	20,  // 133: leiysky.parser.ast.StringOperationExpr.base:type_name -> leiysky.parser.ast.Base
+	16,  // 134: leiysky.parser.ast.StringOperationExpr.type:type_name -> leiysky.parser.ast.StringOperationType
+	22,  // 135: leiysky.parser.ast.StringOperationExpr.expr:type_name -> leiysky.parser.ast.Expr
+	20,  // 136: leiysky.parser.ast.ListOperationExpr.base:type_name -> leiysky.parser.ast.Base
+	22,  // 137: leiysky.parser.ast.ListOperationExpr.in_expr:type_name -> leiysky.parser.ast.Expr
+	22,  // 138: leiysky.parser.ast.ListOperationExpr.single_expr:type_name -> leiysky.parser.ast.Expr
+	22,  // 139: leiysky.parser.ast.ListOperationExpr.lower_bound:type_name -> leiysky.parser.ast.Expr
+	22,  // 140: leiysky.parser.ast.ListOperationExpr.upper_bound:type_name -> leiysky.parser.ast.Expr
+	20,  // 141: leiysky.parser.ast.NullOperationExpr.base:type_name -> leiysky.parser.ast.Base
+	20,  // 142: leiysky.parser.ast.PropertyOrLabelsExpr.base:type_name -> leiysky.parser.ast.Base
+	22,  // 143: leiysky.parser.ast.PropertyOrLabelsExpr.expr:type_name -> leiysky.parser.ast.Expr
+	32,  // 144: leiysky.parser.ast.PropertyOrLabelsExpr.property_lookups:type_name -> leiysky.parser.ast.PropertyLookup
+	46,  // 145: leiysky.parser.ast.PropertyOrLabelsExpr.node_labels:type_name -> leiysky.parser.ast.NodeLabelNode
+	20,  // 146: leiysky.parser.ast.PropertyLookup.base:type_name -> leiysky.parser.ast.Base
+	42,  // 147: leiysky.parser.ast.PropertyLookup.property_key:type_name -> leiysky.parser.ast.SchemaNameNode
+	20,  // 148: leiysky.parser.ast.CaseExpr.base:type_name -> leiysky.parser.ast.Base
+	22,  // 149: leiysky.parser.ast.CaseExpr.expr:type_name -> leiysky.parser.ast.Expr
+	34,  // 150: leiysky.parser.ast.CaseExpr.alts:type_name -> leiysky.parser.ast.CaseAlt
+	22,  // 151: leiysky.parser.ast.CaseExpr.else:type_name -> leiysky.parser.ast.Expr
+	20,  // 152: leiysky.parser.ast.CaseAlt.base:type_name -> leiysky.parser.ast.Base
+	22,  // 153: leiysky.parser.ast.CaseAlt.when:type_name -> leiysky.parser.ast.Expr
+	22,  // 154: leiysky.parser.ast.CaseAlt.then:type_name -> leiysky.parser.ast.Expr
+	20,  // 155: leiysky.parser.ast.FilterExpr.base:type_name -> leiysky.parser.ast.Base
+	1,   // 156: leiysky.parser.ast.FilterExpr.type:type_name -> leiysky.parser.ast.FilterType
+	45,  // 157: leiysky.parser.ast.FilterExpr.variable:type_name -> leiysky.parser.ast.VariableNode
+	22,  // 158: leiysky.parser.ast.FilterExpr.in:type_name -> leiysky.parser.ast.Expr
+	22,  // 159: leiysky.parser.ast.FilterExpr.where:type_name -> leiysky.parser.ast.Expr
+	20,  // 160: leiysky.parser.ast.ListComprehension.base:type_name -> leiysky.parser.ast.Base
+	35,  // 161: leiysky.parser.ast.ListComprehension.filter_expr:type_name -> leiysky.parser.ast.FilterExpr
+	22,  // 162: leiysky.parser.ast.ListComprehension.expr:type_name -> leiysky.parser.ast.Expr
+	20,  // 163: leiysky.parser.ast.FunctionInvocation.base:type_name -> leiysky.parser.ast.Base
+	43,  // 164: leiysky.parser.ast.FunctionInvocation.namespace:type_name -> leiysky.parser.ast.SymbolicNameNode
+	43,  // 165: leiysky.parser.ast.FunctionInvocation.name:type_name -> leiysky.parser.ast.SymbolicNameNode
+	22,  // 166: leiysky.parser.ast.FunctionInvocation.args:type_name -> leiysky.parser.ast.Expr
+	20,  // 167: leiysky.parser.ast.ParenExpr.base:type_name -> leiysky.parser.ast.Base
+	22,  // 168: leiysky.parser.ast.ParenExpr.expr:type_name -> leiysky.parser.ast.Expr
+	20,  // 169: leiysky.parser.ast.CountAllExpr.base:type_name -> leiysky.parser.ast.Base
+	20,  // 170: leiysky.parser.ast.ExistsSubqueryExpr.base:type_name -> leiysky.parser.ast.Base
+	64,  // 171: leiysky.parser.ast.ExistsSubqueryExpr.query:type_name -> leiysky.parser.ast.QueryStmt
+	52,  // 172: leiysky.parser.ast.ExistsSubqueryExpr.pattern:type_name -> leiysky.parser.ast.Pattern
+	22,  // 173: leiysky.parser.ast.ExistsSubqueryExpr.where:type_name -> leiysky.parser.ast.Expr
+	20,  // 174: leiysky.parser.ast.CountSubqueryExpr.base:type_name -> leiysky.parser.ast.Base
+	64,  // 175: leiysky.parser.ast.CountSubqueryExpr.query:type_name -> leiysky.parser.ast.QueryStmt
+	52,  // 176: leiysky.parser.ast.CountSubqueryExpr.pattern:type_name -> leiysky.parser.ast.Pattern
+	22,  // 177: leiysky.parser.ast.CountSubqueryExpr.where:type_name -> leiysky.parser.ast.Expr
+	20,  // 178: leiysky.parser.ast.SchemaNameNode.base:type_name -> leiysky.parser.ast.Base
+	13,  // 179: leiysky.parser.ast.SchemaNameNode.type:type_name -> leiysky.parser.ast.SchemaNameType
+	43,  // 180: leiysky.parser.ast.SchemaNameNode.symbolic_name:type_name -> leiysky.parser.ast.SymbolicNameNode
+	44,  // 181: leiysky.parser.ast.SchemaNameNode.reserved_word:type_name -> leiysky.parser.ast.ReservedWordNode
+	20,  // 182: leiysky.parser.ast.SymbolicNameNode.base:type_name -> leiysky.parser.ast.Base
+	17,  // 183: leiysky.parser.ast.SymbolicNameNode.type:type_name -> leiysky.parser.ast.SymbolicNameType
+	20,  // 184: leiysky.parser.ast.ReservedWordNode.base:type_name -> leiysky.parser.ast.Base
+	20,  // 185: leiysky.parser.ast.VariableNode.base:type_name -> leiysky.parser.ast.Base
+	43,  // 186: leiysky.parser.ast.VariableNode.symbolic_name:type_name -> leiysky.parser.ast.SymbolicNameNode
+	20,  // 187: leiysky.parser.ast.NodeLabelNode.base:type_name -> leiysky.parser.ast.Base
+	42,  // 188: leiysky.parser.ast.NodeLabelNode.label_name:type_name -> leiysky.parser.ast.SchemaNameNode
+	20,  // 189: leiysky.parser.ast.ParameterNode.base:type_name -> leiysky.parser.ast.Base
+	7,   // 190: leiysky.parser.ast.ParameterNode.type:type_name -> leiysky.parser.ast.ParameterType
+	43,  // 191: leiysky.parser.ast.ParameterNode.symbolic_name:type_name -> leiysky.parser.ast.SymbolicNameNode
+	20,  // 192: leiysky.parser.ast.LiteralExpr.base:type_name -> leiysky.parser.ast.Base
+	2,   // 193: leiysky.parser.ast.LiteralExpr.type:type_name -> leiysky.parser.ast.LiteralType
+	49,  // 194: leiysky.parser.ast.LiteralExpr.number:type_name -> leiysky.parser.ast.NumberLiteral
+	50,  // 195: leiysky.parser.ast.LiteralExpr.map:type_name -> leiysky.parser.ast.MapLiteral
+	51,  // 196: leiysky.parser.ast.LiteralExpr.list:type_name -> leiysky.parser.ast.ListLiteral
+	20,  // 197: leiysky.parser.ast.NumberLiteral.base:type_name -> leiysky.parser.ast.Base
+	4,   // 198: leiysky.parser.ast.NumberLiteral.type:type_name -> leiysky.parser.ast.NumberLiteralType
+	20,  // 199: leiysky.parser.ast.MapLiteral.base:type_name -> leiysky.parser.ast.Base
+	42,  // 200: leiysky.parser.ast.MapLiteral.property_keys:type_name -> leiysky.parser.ast.SchemaNameNode
+	22,  // 201: leiysky.parser.ast.MapLiteral.exprs:type_name -> leiysky.parser.ast.Expr
+	20,  // 202: leiysky.parser.ast.ListLiteral.base:type_name -> leiysky.parser.ast.Base
+	22,  // 203: leiysky.parser.ast.ListLiteral.exprs:type_name -> leiysky.parser.ast.Expr
+	20,  // 204: leiysky.parser.ast.Pattern.base:type_name -> leiysky.parser.ast.Base
+	53,  // 205: leiysky.parser.ast.Pattern.parts:type_name -> leiysky.parser.ast.PatternPart
+	20,  // 206: leiysky.parser.ast.PatternPart.base:type_name -> leiysky.parser.ast.Base
+	45,  // 207: leiysky.parser.ast.PatternPart.variable:type_name -> leiysky.parser.ast.VariableNode
+	54,  // 208: leiysky.parser.ast.PatternPart.element:type_name -> leiysky.parser.ast.PatternElement
+	20,  // 209: leiysky.parser.ast.PatternElement.base:type_name -> leiysky.parser.ast.Base
+	56,  // 210: leiysky.parser.ast.PatternElement.relationships:type_name -> leiysky.parser.ast.RelationshipPattern
+	55,  // 211: leiysky.parser.ast.PatternElement.nodes:type_name -> leiysky.parser.ast.NodePattern
+	20,  // 212: leiysky.parser.ast.NodePattern.base:type_name -> leiysky.parser.ast.Base
+	45,  // 213: leiysky.parser.ast.NodePattern.variable:type_name -> leiysky.parser.ast.VariableNode
+	46,  // 214: leiysky.parser.ast.NodePattern.labels:type_name -> leiysky.parser.ast.NodeLabelNode
+	58,  // 215: leiysky.parser.ast.NodePattern.properties:type_name -> leiysky.parser.ast.Properties
+	20,  // 216: leiysky.parser.ast.RelationshipPattern.base:type_name -> leiysky.parser.ast.Base
+	11,  // 217: leiysky.parser.ast.RelationshipPattern.type:type_name -> leiysky.parser.ast.RelationshipType
+	57,  // 218: leiysky.parser.ast.RelationshipPattern.detail:type_name -> leiysky.parser.ast.RelationshipDetail
+	20,  // 219: leiysky.parser.ast.RelationshipDetail.base:type_name -> leiysky.parser.ast.Base
+	45,  // 220: leiysky.parser.ast.RelationshipDetail.variable:type_name -> leiysky.parser.ast.VariableNode
+	42,  // 221: leiysky.parser.ast.RelationshipDetail.relationship_types:type_name -> leiysky.parser.ast.SchemaNameNode
+	58,  // 222: leiysky.parser.ast.RelationshipDetail.properties:type_name -> leiysky.parser.ast.Properties
+	20,  // 223: leiysky.parser.ast.Properties.base:type_name -> leiysky.parser.ast.Base
+	9,   // 224: leiysky.parser.ast.Properties.type:type_name -> leiysky.parser.ast.PropertiesType
+	50,  // 225: leiysky.parser.ast.Properties.map_literal:type_name -> leiysky.parser.ast.MapLiteral
+	47,  // 226: leiysky.parser.ast.Properties.parameter:type_name -> leiysky.parser.ast.ParameterNode
+	20,  // 227: leiysky.parser.ast.PatternComprehension.base:type_name -> leiysky.parser.ast.Base
+	45,  // 228: leiysky.parser.ast.PatternComprehension.variable:type_name -> leiysky.parser.ast.VariableNode
+	54,  // 229: leiysky.parser.ast.PatternComprehension.pattern_element:type_name -> leiysky.parser.ast.PatternElement
+	22,  // 230: leiysky.parser.ast.PatternComprehension.where:type_name -> leiysky.parser.ast.Expr
+	22,  // 231: leiysky.parser.ast.PatternComprehension.expr:type_name -> leiysky.parser.ast.Expr
+	20,  // 232: leiysky.parser.ast.ProcedureInvocation.base:type_name -> leiysky.parser.ast.Base
+	43,  // 233: leiysky.parser.ast.ProcedureInvocation.namespace:type_name -> leiysky.parser.ast.SymbolicNameNode
+	43,  // 234: leiysky.parser.ast.ProcedureInvocation.name:type_name -> leiysky.parser.ast.SymbolicNameNode
+	22,  // 235: leiysky.parser.ast.ProcedureInvocation.args:type_name -> leiysky.parser.ast.Expr
+	20,  // 236: leiysky.parser.ast.YieldItems.base:type_name -> leiysky.parser.ast.Base
+	62,  // 237: leiysky.parser.ast.YieldItems.items:type_name -> leiysky.parser.ast.YieldItem
+	22,  // 238: leiysky.parser.ast.YieldItems.where:type_name -> leiysky.parser.ast.Expr
+	20,  // 239: leiysky.parser.ast.YieldItem.base:type_name -> leiysky.parser.ast.Base
+	43,  // 240: leiysky.parser.ast.YieldItem.field:type_name -> leiysky.parser.ast.SymbolicNameNode
+	45,  // 241: leiysky.parser.ast.YieldItem.variable:type_name -> leiysky.parser.ast.VariableNode
+	20,  // 242: leiysky.parser.ast.CypherStmt.base:type_name -> leiysky.parser.ast.Base
+	0,   // 243: leiysky.parser.ast.CypherStmt.type:type_name -> leiysky.parser.ast.CypherStmtType
+	64,  // 244: leiysky.parser.ast.CypherStmt.query:type_name -> leiysky.parser.ast.QueryStmt
+	66,  // 245: leiysky.parser.ast.CypherStmt.standalone_call:type_name -> leiysky.parser.ast.StandaloneCall
+	20,  // 246: leiysky.parser.ast.QueryStmt.base:type_name -> leiysky.parser.ast.Base
+	23,  // 247: leiysky.parser.ast.QueryStmt.clauses:type_name -> leiysky.parser.ast.Stmt
+	20,  // 248: leiysky.parser.ast.UnionClause.base:type_name -> leiysky.parser.ast.Base
+	23,  // 249: leiysky.parser.ast.UnionClause.clauses:type_name -> leiysky.parser.ast.Stmt
+	20,  // 250: leiysky.parser.ast.StandaloneCall.base:type_name -> leiysky.parser.ast.Base
+	60,  // 251: leiysky.parser.ast.StandaloneCall.procedure:type_name -> leiysky.parser.ast.ProcedureInvocation
+	61,  // 252: leiysky.parser.ast.StandaloneCall.yield:type_name -> leiysky.parser.ast.YieldItems
+	20,  // 253: leiysky.parser.ast.ReadingClause.base:type_name -> leiysky.parser.ast.Base
+	10,  // 254: leiysky.parser.ast.ReadingClause.type:type_name -> leiysky.parser.ast.ReadingClauseType
+	68,  // 255: leiysky.parser.ast.ReadingClause.match:type_name -> leiysky.parser.ast.MatchClause
+	69,  // 256: leiysky.parser.ast.ReadingClause.unwind:type_name -> leiysky.parser.ast.UnwindClause
+	70,  // 257: leiysky.parser.ast.ReadingClause.in_query_call:type_name -> leiysky.parser.ast.InQueryCallClause
+	20,  // 258: leiysky.parser.ast.MatchClause.base:type_name -> leiysky.parser.ast.Base
+	52,  // 259: leiysky.parser.ast.MatchClause.pattern:type_name -> leiysky.parser.ast.Pattern
+	22,  // 260: leiysky.parser.ast.MatchClause.where:type_name -> leiysky.parser.ast.Expr
+	20,  // 261: leiysky.parser.ast.UnwindClause.base:type_name -> leiysky.parser.ast.Base
+	22,  // 262: leiysky.parser.ast.UnwindClause.expr:type_name -> leiysky.parser.ast.Expr
+	45,  // 263: leiysky.parser.ast.UnwindClause.variable:type_name -> leiysky.parser.ast.VariableNode
+	20,  // 264: leiysky.parser.ast.InQueryCallClause.base:type_name -> leiysky.parser.ast.Base
+	60,  // 265: leiysky.parser.ast.InQueryCallClause.procedure:type_name -> leiysky.parser.ast.ProcedureInvocation
+	61,  // 266: leiysky.parser.ast.InQueryCallClause.yield:type_name -> leiysky.parser.ast.YieldItems
+	20,  // 267: leiysky.parser.ast.SubqueryClause.base:type_name -> leiysky.parser.ast.Base
+	72,  // 268: leiysky.parser.ast.SubqueryClause.importing_with:type_name -> leiysky.parser.ast.WithClause
+	64,  // 269: leiysky.parser.ast.SubqueryClause.query:type_name -> leiysky.parser.ast.QueryStmt
+	22,  // 270: leiysky.parser.ast.SubqueryClause.concurrency:type_name -> leiysky.parser.ast.Expr
+	22,  // 271: leiysky.parser.ast.SubqueryClause.batch_size:type_name -> leiysky.parser.ast.Expr
+	5,   // 272: leiysky.parser.ast.SubqueryClause.on_error:type_name -> leiysky.parser.ast.OnErrorType
+	20,  // 273: leiysky.parser.ast.WithClause.base:type_name -> leiysky.parser.ast.Base
+	74,  // 274: leiysky.parser.ast.WithClause.return_body:type_name -> leiysky.parser.ast.ReturnBody
+	22,  // 275: leiysky.parser.ast.WithClause.where:type_name -> leiysky.parser.ast.Expr
+	20,  // 276: leiysky.parser.ast.ReturnClause.base:type_name -> leiysky.parser.ast.Base
+	74,  // 277: leiysky.parser.ast.ReturnClause.return_body:type_name -> leiysky.parser.ast.ReturnBody
+	20,  // 278: leiysky.parser.ast.ReturnBody.base:type_name -> leiysky.parser.ast.Base
+	75,  // 279: leiysky.parser.ast.ReturnBody.return_items:type_name -> leiysky.parser.ast.ReturnItem
+	76,  // 280: leiysky.parser.ast.ReturnBody.order_by:type_name -> leiysky.parser.ast.OrderClause
+	22,  // 281: leiysky.parser.ast.ReturnBody.skip:type_name -> leiysky.parser.ast.Expr
+	22,  // 282: leiysky.parser.ast.ReturnBody.limit:type_name -> leiysky.parser.ast.Expr
+	20,  // 283: leiysky.parser.ast.ReturnItem.base:type_name -> leiysky.parser.ast.Base
+	22,  // 284: leiysky.parser.ast.ReturnItem.expr:type_name -> leiysky.parser.ast.Expr
+	45,  // 285: leiysky.parser.ast.ReturnItem.variable:type_name -> leiysky.parser.ast.VariableNode
+	20,  // 286: leiysky.parser.ast.OrderClause.base:type_name -> leiysky.parser.ast.Base
+	77,  // 287: leiysky.parser.ast.OrderClause.sort_items:type_name -> leiysky.parser.ast.SortItem
+	20,  // 288: leiysky.parser.ast.SortItem.base:type_name -> leiysky.parser.ast.Base
+	15,  // 289: leiysky.parser.ast.SortItem.type:type_name -> leiysky.parser.ast.SortType
+	22,  // 290: leiysky.parser.ast.SortItem.expr:type_name -> leiysky.parser.ast.Expr
+	20,  // 291: leiysky.parser.ast.CreateClause.base:type_name -> leiysky.parser.ast.Base
+	52,  // 292: leiysky.parser.ast.CreateClause.pattern:type_name -> leiysky.parser.ast.Pattern
+	20,  // 293: leiysky.parser.ast.MergeClause.base:type_name -> leiysky.parser.ast.Base
+	53,  // 294: leiysky.parser.ast.MergeClause.pattern_part:type_name -> leiysky.parser.ast.PatternPart
+	80,  // 295: leiysky.parser.ast.MergeClause.merge_actions:type_name -> leiysky.parser.ast.MergeAction
+	20,  // 296: leiysky.parser.ast.MergeAction.base:type_name -> leiysky.parser.ast.Base
+	3,   // 297: leiysky.parser.ast.MergeAction.type:type_name -> leiysky.parser.ast.MergeActionType
+	81,  // 298: leiysky.parser.ast.MergeAction.set:type_name -> leiysky.parser.ast.SetClause
+	20,  // 299: leiysky.parser.ast.SetClause.base:type_name -> leiysky.parser.ast.Base
+	82,  // 300: leiysky.parser.ast.SetClause.set_items:type_name -> leiysky.parser.ast.SetItem
+	20,  // 301: leiysky.parser.ast.SetItem.base:type_name -> leiysky.parser.ast.Base
+	14,  // 302: leiysky.parser.ast.SetItem.type:type_name -> leiysky.parser.ast.SetItemType
+	24,  // 303: leiysky.parser.ast.SetItem.property:type_name -> leiysky.parser.ast.PropertyExpr
+	45,  // 304: leiysky.parser.ast.SetItem.variable:type_name -> leiysky.parser.ast.VariableNode
+	22,  // 305: leiysky.parser.ast.SetItem.expr:type_name -> leiysky.parser.ast.Expr
+	46,  // 306: leiysky.parser.ast.SetItem.labels:type_name -> leiysky.parser.ast.NodeLabelNode
+	20,  // 307: leiysky.parser.ast.DeleteClause.base:type_name -> leiysky.parser.ast.Base
+	22,  // 308: leiysky.parser.ast.DeleteClause.exprs:type_name -> leiysky.parser.ast.Expr
+	20,  // 309: leiysky.parser.ast.RemoveClause.base:type_name -> leiysky.parser.ast.Base
+	85,  // 310: leiysky.parser.ast.RemoveClause.remove_items:type_name -> leiysky.parser.ast.RemoveItem
+	20,  // 311: leiysky.parser.ast.RemoveItem.base:type_name -> leiysky.parser.ast.Base
+	12,  // 312: leiysky.parser.ast.RemoveItem.type:type_name -> leiysky.parser.ast.RemoveItemType
+	45,  // 313: leiysky.parser.ast.RemoveItem.variable:type_name -> leiysky.parser.ast.VariableNode
+	46,  // 314: leiysky.parser.ast.RemoveItem.labels:type_name -> leiysky.parser.ast.NodeLabelNode
+	24,  // 315: leiysky.parser.ast.RemoveItem.property:type_name -> leiysky.parser.ast.PropertyExpr
+	316, // [316:316] is the sub-list for method output_type
+	316, // [316:316] is the sub-list for method input_type
+	316, // [316:316] is the sub-list for extension type_name
+	316, // [316:316] is the sub-list for extension extendee
+	0,   // [0:316] is the sub-list for field type_name
+}
+
+func init() { file_ast_proto_init() }
+func file_ast_proto_init() {
+	if File_ast_proto != nil {
+		return
+	}
+	file_ast_proto_msgTypes[3].OneofWrappers = []any{
+		(*Node_PropertyExpr)(nil),
+		(*Node_BinaryExpr)(nil),
+		(*Node_UnaryExpr)(nil),
+		(*Node_PredicationExpr)(nil),
+		(*Node_StringOperationExpr)(nil),
+		(*Node_ListOperationExpr)(nil),
+		(*Node_NullOperationExpr)(nil),
+		(*Node_PropertyOrLabelsExpr)(nil),
+		(*Node_PropertyLookup)(nil),
+		(*Node_CaseExpr)(nil),
+		(*Node_CaseAlt)(nil),
+		(*Node_FilterExpr)(nil),
+		(*Node_ListComprehension)(nil),
+		(*Node_FunctionInvocation)(nil),
+		(*Node_ParenExpr)(nil),
+		(*Node_CountAllExpr)(nil),
+		(*Node_SchemaNameNode)(nil),
+		(*Node_SymbolicNameNode)(nil),
+		(*Node_ReservedWordNode)(nil),
+		(*Node_VariableNode)(nil),
+		(*Node_NodeLabelNode)(nil),
+		(*Node_ParameterNode)(nil),
+		(*Node_LiteralExpr)(nil),
+		(*Node_NumberLiteral)(nil),
+		(*Node_MapLiteral)(nil),
+		(*Node_ListLiteral)(nil),
+		(*Node_Pattern)(nil),
+		(*Node_PatternPart)(nil),
+		(*Node_PatternElement)(nil),
+		(*Node_NodePattern)(nil),
+		(*Node_RelationshipPattern)(nil),
+		(*Node_RelationshipDetail)(nil),
+		(*Node_Properties)(nil),
+		(*Node_PatternComprehension)(nil),
+		(*Node_ProcedureInvocation)(nil),
+		(*Node_YieldItems)(nil),
+		(*Node_YieldItem)(nil),
+		(*Node_CypherStmt)(nil),
+		(*Node_QueryStmt)(nil),
+		(*Node_UnionClause)(nil),
+		(*Node_StandaloneCall)(nil),
+		(*Node_ReadingClause)(nil),
+		(*Node_MatchClause)(nil),
+		(*Node_UnwindClause)(nil),
+		(*Node_InQueryCallClause)(nil),
+		(*Node_WithClause)(nil),
+		(*Node_ReturnClause)(nil),
+		(*Node_ReturnBody)(nil),
+		(*Node_ReturnItem)(nil),
+		(*Node_OrderClause)(nil),
+		(*Node_SortItem)(nil),
+		(*Node_CreateClause)(nil),
+		(*Node_MergeClause)(nil),
+		(*Node_MergeAction)(nil),
+		(*Node_SetClause)(nil),
+		(*Node_SetItem)(nil),
+		(*Node_DeleteClause)(nil),
+		(*Node_RemoveClause)(nil),
+		(*Node_RemoveItem)(nil),
+		(*Node_ExistsSubqueryExpr)(nil),
+		(*Node_CountSubqueryExpr)(nil),
+		(*Node_SubqueryClause)(nil),
+	}
+	file_ast_proto_msgTypes[4].OneofWrappers = []any{
+		(*Expr_PropertyExpr)(nil),
+		(*Expr_BinaryExpr)(nil),
+		(*Expr_UnaryExpr)(nil),
+		(*Expr_PredicationExpr)(nil),
+		(*Expr_StringOperationExpr)(nil),
+		(*Expr_ListOperationExpr)(nil),
+		(*Expr_NullOperationExpr)(nil),
+		(*Expr_PropertyOrLabelsExpr)(nil),
+		(*Expr_CaseExpr)(nil),
+		(*Expr_CaseAlt)(nil),
+		(*Expr_FilterExpr)(nil),
+		(*Expr_ListComprehension)(nil),
+		(*Expr_FunctionInvocation)(nil),
+		(*Expr_ParenExpr)(nil),
+		(*Expr_CountAllExpr)(nil),
+		(*Expr_VariableNode)(nil),
+		(*Expr_ParameterNode)(nil),
+		(*Expr_LiteralExpr)(nil),
+		(*Expr_Pattern)(nil),
+		(*Expr_PatternPart)(nil),
+		(*Expr_PatternElement)(nil),
+		(*Expr_NodePattern)(nil),
+		(*Expr_RelationshipPattern)(nil),
+		(*Expr_RelationshipDetail)(nil),
+		(*Expr_Properties)(nil),
+		(*Expr_PatternComprehension)(nil),
+		(*Expr_ExistsSubqueryExpr)(nil),
+		(*Expr_CountSubqueryExpr)(nil),
+	}
+	file_ast_proto_msgTypes[5].OneofWrappers = []any{
+		(*Stmt_CypherStmt)(nil),
+		(*Stmt_QueryStmt)(nil),
+		(*Stmt_UnionClause)(nil),
+		(*Stmt_StandaloneCall)(nil),
+		(*Stmt_ReadingClause)(nil),
+		(*Stmt_MatchClause)(nil),
+		(*Stmt_UnwindClause)(nil),
+		(*Stmt_InQueryCallClause)(nil),
+		(*Stmt_WithClause)(nil),
+		(*Stmt_ReturnClause)(nil),
+		(*Stmt_ReturnBody)(nil),
+		(*Stmt_ReturnItem)(nil),
+		(*Stmt_OrderClause)(nil),
+		(*Stmt_SortItem)(nil),
+		(*Stmt_CreateClause)(nil),
+		(*Stmt_MergeClause)(nil),
+		(*Stmt_MergeAction)(nil),
+		(*Stmt_SetClause)(nil),
+		(*Stmt_SetItem)(nil),
+		(*Stmt_DeleteClause)(nil),
+		(*Stmt_RemoveClause)(nil),
+		(*Stmt_RemoveItem)(nil),
+		(*Stmt_SubqueryClause)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ast_proto_rawDesc), len(file_ast_proto_rawDesc)),
+			NumEnums:      18,
+			NumMessages:   68,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ast_proto_goTypes,
+		DependencyIndexes: file_ast_proto_depIdxs,
+		EnumInfos:         file_ast_proto_enumTypes,
+		MessageInfos:      file_ast_proto_msgTypes,
+	}.Build()
+	File_ast_proto = out.File
+	file_ast_proto_goTypes = nil
+	file_ast_proto_depIdxs = nil
+}
@@ -0,0 +1,356 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package astpb converts the nodes in package ast from and to protobuf messages.
+package astpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative ast.proto
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/leiysky/parser/ast"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// nodeTypes are all the node types, by type name, which is also the message name.
+var nodeTypes = map[protoreflect.Name]reflect.Type{}
+
+func init() {
+	for _, n := range []ast.Node{
+		&ast.CypherStmt{}, &ast.QueryStmt{}, &ast.UnionClause{}, &ast.StandaloneCall{},
+		&ast.ReadingClause{}, &ast.MatchClause{}, &ast.UnwindClause{}, &ast.InQueryCallClause{}, &ast.SubqueryClause{},
+		&ast.WithClause{}, &ast.ReturnClause{}, &ast.ReturnBody{}, &ast.ReturnItem{}, &ast.OrderClause{}, &ast.SortItem{},
+		&ast.CreateClause{}, &ast.MergeClause{}, &ast.MergeAction{}, &ast.SetClause{}, &ast.SetItem{},
+		&ast.DeleteClause{}, &ast.RemoveClause{}, &ast.RemoveItem{},
+		&ast.ProcedureInvocation{}, &ast.YieldItems{}, &ast.YieldItem{},
+		&ast.Pattern{}, &ast.PatternPart{}, &ast.PatternElement{}, &ast.NodePattern{},
+		&ast.RelationshipPattern{}, &ast.RelationshipDetail{}, &ast.Properties{}, &ast.PatternComprehension{},
+		&ast.PropertyExpr{}, &ast.BinaryExpr{}, &ast.UnaryExpr{}, &ast.PredicationExpr{},
+		&ast.StringOperationExpr{}, &ast.ListOperationExpr{}, &ast.NullOperationExpr{},
+		&ast.PropertyOrLabelsExpr{}, &ast.PropertyLookup{}, &ast.CaseExpr{}, &ast.CaseAlt{}, &ast.FilterExpr{},
+		&ast.ListComprehension{}, &ast.FunctionInvocation{}, &ast.ParenExpr{}, &ast.CountAllExpr{},
+		&ast.ExistsSubqueryExpr{}, &ast.CountSubqueryExpr{},
+		&ast.SchemaNameNode{}, &ast.SymbolicNameNode{}, &ast.ReservedWordNode{}, &ast.VariableNode{},
+		&ast.NodeLabelNode{}, &ast.ParameterNode{}, &ast.LiteralExpr{}, &ast.NumberLiteral{}, &ast.MapLiteral{}, &ast.ListLiteral{},
+	} {
+		t := reflect.TypeOf(n).Elem()
+		nodeTypes[protoreflect.Name(t.Name())] = t
+	}
+}
+
+// Marshal encodes n in protobuf wire format.
+func Marshal(n ast.Node) ([]byte, error) {
+	m, err := FromNode(n)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(m)
+}
+
+// Unmarshal decodes a node encoded by Marshal.
+func Unmarshal(data []byte) (ast.Node, error) {
+	m := &Node{}
+	if err := proto.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	return ToNode(m)
+}
+
+// FromNode converts n to a message.
+func FromNode(n ast.Node) (*Node, error) {
+	if n == nil {
+		return nil, fmt.Errorf("astpb: nil node")
+	}
+	m := &Node{}
+	if err := fromWrapped(m.ProtoReflect(), reflect.ValueOf(n)); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ToNode converts m back to a node.
+func ToNode(m *Node) (ast.Node, error) {
+	v, err := toWrapped(m.ProtoReflect())
+	if err != nil {
+		return nil, err
+	}
+	n := v.Interface().(ast.Node)
+	ast.SetParents(n)
+	return n, nil
+}
+
+// fromWrapped sets the field of wrapper for node v, wrapper is one of Node, Expr and Stmt.
+func fromWrapped(wrapper protoreflect.Message, v reflect.Value) error {
+	name := v.Elem().Type().Name()
+	fd := wrapper.Descriptor().Fields().ByName(protoreflect.Name(snakeCase(name)))
+	if fd == nil {
+		return fmt.Errorf("astpb: %s can't be %s", name, wrapper.Descriptor().Name())
+	}
+	m, err := fromNode(v)
+	if err != nil {
+		return err
+	}
+	wrapper.Set(fd, protoreflect.ValueOfMessage(m))
+	return nil
+}
+
+// fromNode converts the pointer to node v to the message with the same name.
+func fromNode(v reflect.Value) (protoreflect.Message, error) {
+	name := v.Elem().Type().Name()
+	if nodeTypes[protoreflect.Name(name)] != v.Elem().Type() {
+		return nil, fmt.Errorf("astpb: unknown node type %s", v.Type())
+	}
+	m, err := newMessage(protoreflect.Name(name))
+	if err != nil {
+		return nil, err
+	}
+	fromBase(m, v.Interface().(ast.Node))
+	for i := 0; i < v.Elem().NumField(); i++ {
+		field := v.Elem().Type().Field(i)
+		if field.Anonymous {
+			continue
+		}
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(snakeCase(field.Name)))
+		if fd == nil {
+			return nil, fmt.Errorf("astpb: no field for %s.%s", name, field.Name)
+		}
+		if err := fromField(m, fd, v.Elem().Field(i)); err != nil {
+			return nil, fmt.Errorf("astpb: %s.%s: %v", name, field.Name, err)
+		}
+	}
+	return m, nil
+}
+
+func fromField(m protoreflect.Message, fd protoreflect.FieldDescriptor, v reflect.Value) error {
+	if !fd.IsList() {
+		if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+			return nil
+		}
+		value, err := fromValue(fd, v)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, value)
+		return nil
+	}
+	list := m.Mutable(fd).List()
+	for i := 0; i < v.Len(); i++ {
+		if elem := v.Index(i); elem.IsNil() {
+			return fmt.Errorf("nil element %d", i)
+		}
+		value, err := fromValue(fd, v.Index(i))
+		if err != nil {
+			return err
+		}
+		list.Append(value)
+	}
+	return nil
+}
+
+func fromValue(fd protoreflect.FieldDescriptor, v reflect.Value) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		if v.Kind() == reflect.Interface {
+			wrapper, err := newMessage(fd.Message().Name())
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			if err := fromWrapped(wrapper, v.Elem()); err != nil {
+				return protoreflect.Value{}, err
+			}
+			return protoreflect.ValueOfMessage(wrapper), nil
+		}
+		m, err := fromNode(v)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfMessage(m), nil
+	case protoreflect.EnumKind:
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v.Uint())), nil
+	case protoreflect.Int64Kind:
+		return protoreflect.ValueOfInt64(v.Int()), nil
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(v.Float()), nil
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(v.String()), nil
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(v.Bool()), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("unexpected kind %s", fd.Kind())
+}
+
+func fromBase(m protoreflect.Message, n ast.Node) {
+	base := &Base{
+		Start:            fromPos(n.Start()),
+		End:              fromPos(n.End()),
+		Text:             n.Text(),
+		LeadingComments:  fromComments(n.Comments().Leading),
+		TrailingComments: fromComments(n.Comments().Trailing),
+	}
+	m.Set(m.Descriptor().Fields().ByName("base"), protoreflect.ValueOfMessage(base.ProtoReflect()))
+}
+
+func fromPos(pos ast.Pos) *Position {
+	return &Position{Offset: int64(pos.Offset), Line: int64(pos.Line), Column: int64(pos.Column)}
+}
+
+func fromComments(comments []*ast.Comment) []*Comment {
+	var ms []*Comment
+	for _, c := range comments {
+		ms = append(ms, &Comment{Text: c.Text, Start: fromPos(c.Start), End: fromPos(c.End), OwnLine: c.OwnLine})
+	}
+	return ms
+}
+
+// toWrapped converts the node set in wrapper, wrapper is one of Node, Expr and Stmt.
+func toWrapped(wrapper protoreflect.Message) (reflect.Value, error) {
+	fd := wrapper.WhichOneof(wrapper.Descriptor().Oneofs().ByName("node"))
+	if fd == nil {
+		return reflect.Value{}, fmt.Errorf("astpb: empty %s", wrapper.Descriptor().Name())
+	}
+	return toNode(wrapper.Get(fd).Message())
+}
+
+// toNode converts m to the pointer to node with the same name.
+func toNode(m protoreflect.Message) (reflect.Value, error) {
+	name := m.Descriptor().Name()
+	t, ok := nodeTypes[name]
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("astpb: unknown message %s", name)
+	}
+	v := reflect.New(t)
+	toBase(v.Interface().(ast.Node), m)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			continue
+		}
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(snakeCase(field.Name)))
+		if fd == nil {
+			return reflect.Value{}, fmt.Errorf("astpb: no field for %s.%s", name, field.Name)
+		}
+		if err := toField(v.Elem().Field(i), m, fd); err != nil {
+			return reflect.Value{}, fmt.Errorf("astpb: %s.%s: %v", name, field.Name, err)
+		}
+	}
+	return v, nil
+}
+
+func toField(v reflect.Value, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	if !fd.IsList() {
+		if !m.Has(fd) {
+			return nil
+		}
+		return toValue(v, fd, m.Get(fd))
+	}
+	list := m.Get(fd).List()
+	if list.Len() == 0 {
+		return nil
+	}
+	v.Set(reflect.MakeSlice(v.Type(), list.Len(), list.Len()))
+	for i := 0; i < list.Len(); i++ {
+		if err := toValue(v.Index(i), fd, list.Get(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toValue(v reflect.Value, fd protoreflect.FieldDescriptor, value protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		var node reflect.Value
+		var err error
+		if v.Kind() == reflect.Interface {
+			node, err = toWrapped(value.Message())
+		} else {
+			node, err = toNode(value.Message())
+		}
+		if err != nil {
+			return err
+		}
+		if !node.Type().AssignableTo(v.Type()) {
+			return fmt.Errorf("%s is not %s", node.Elem().Type().Name(), v.Type())
+		}
+		v.Set(node)
+	case protoreflect.EnumKind:
+		if fd.Enum().Values().ByNumber(value.Enum()) == nil {
+			return fmt.Errorf("unknown %s %d", fd.Enum().Name(), value.Enum())
+		}
+		v.SetUint(uint64(value.Enum()))
+	case protoreflect.Int64Kind:
+		v.SetInt(value.Int())
+	case protoreflect.DoubleKind:
+		v.SetFloat(value.Float())
+	case protoreflect.StringKind:
+		v.SetString(value.String())
+	case protoreflect.BoolKind:
+		v.SetBool(value.Bool())
+	default:
+		return fmt.Errorf("unexpected kind %s", fd.Kind())
+	}
+	return nil
+}
+
+func toBase(n ast.Node, m protoreflect.Message) {
+	base, ok := m.Get(m.Descriptor().Fields().ByName("base")).Message().Interface().(*Base)
+	if !ok {
+		return
+	}
+	n.SetPos(toPos(base.GetStart()), toPos(base.GetEnd()))
+	n.SetText(base.GetText())
+	n.Comments().Leading = toComments(base.GetLeadingComments())
+	n.Comments().Trailing = toComments(base.GetTrailingComments())
+}
+
+func toPos(pos *Position) ast.Pos {
+	return ast.Pos{Offset: int(pos.GetOffset()), Line: int(pos.GetLine()), Column: int(pos.GetColumn())}
+}
+
+func toComments(ms []*Comment) []*ast.Comment {
+	var comments []*ast.Comment
+	for _, m := range ms {
+		comments = append(comments, &ast.Comment{Text: m.GetText(), Start: toPos(m.GetStart()), End: toPos(m.GetEnd()), OwnLine: m.GetOwnLine()})
+	}
+	return comments
+}
+
+func newMessage(name protoreflect.Name) (protoreflect.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(File_ast_proto.Package().Append(name))
+	if err != nil {
+		return nil, err
+	}
+	return mt.New(), nil
+}
+
+// snakeCase returns the name of the message field for the Go field name.
+func snakeCase(name string) string {
+	var b strings.Builder
+	rs := []rune(name)
+	for i, r := range rs {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(rs[i-1]) || (i+1 < len(rs) && unicode.IsLower(rs[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
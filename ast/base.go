@@ -15,7 +15,11 @@ package ast
 
 type baseNode struct {
 	Node
-	text string
+	text     string
+	start    Pos
+	end      Pos
+	comments Comments
+	parent   Node
 }
 
 func (n *baseNode) Text() string {
@@ -26,6 +30,31 @@ func (n *baseNode) SetText(text string) {
 	n.text = text
 }
 
+func (n *baseNode) Start() Pos {
+	return n.start
+}
+
+func (n *baseNode) End() Pos {
+	return n.end
+}
+
+func (n *baseNode) SetPos(start, end Pos) {
+	n.start = start
+	n.end = end
+}
+
+func (n *baseNode) Comments() *Comments {
+	return &n.comments
+}
+
+func (n *baseNode) Parent() Node {
+	return n.parent
+}
+
+func (n *baseNode) SetParent(parent Node) {
+	n.parent = parent
+}
+
 type baseStmt struct {
 	baseNode
 }
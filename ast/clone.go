@@ -0,0 +1,90 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import "reflect"
+
+// Clone returns a deep copy of n, which shares nothing with n.
+// Positions, text and comments are copied as well, the copy of n has no parent.
+func Clone(n Node) Node {
+	if n == nil {
+		return nil
+	}
+	c := cloneValue(reflect.ValueOf(n)).Interface().(Node)
+	c.SetParent(nil)
+	SetParents(c)
+	return c
+}
+
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		// copies the unexported fields of the embedded base
+		c.Elem().Set(v.Elem())
+		if n, ok := c.Interface().(Node); ok {
+			comments := n.Comments()
+			comments.Leading = cloneComments(comments.Leading)
+			comments.Trailing = cloneComments(comments.Trailing)
+		}
+		cloneFields(c.Elem())
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// cloneFields replaces the exported fields of struct v with their copies.
+func cloneFields(v reflect.Value) {
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Anonymous {
+			continue
+		}
+		v.Field(i).Set(cloneValue(v.Field(i)))
+	}
+}
+
+func cloneComments(comments []*Comment) []*Comment {
+	if comments == nil {
+		return nil
+	}
+	c := make([]*Comment, len(comments))
+	for i, comment := range comments {
+		copied := *comment
+		c[i] = &copied
+	}
+	return c
+}
@@ -0,0 +1,40 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import "strings"
+
+// Comment represents a comment in cypher, either `// ...` or `/* ... */`.
+type Comment struct {
+	// Text is the original text of comment, without the newline ending a line comment.
+	Text  string
+	Start Pos
+	End   Pos
+	// OwnLine is set if nothing but spaces precedes the comment on its line,
+	// it's restored on its own line too.
+	OwnLine bool
+}
+
+// IsBlock returns true for `/* ... */` comment.
+func (c *Comment) IsBlock() bool {
+	return strings.HasPrefix(c.Text, "/*")
+}
+
+// Comments holds the comments attached to a node.
+type Comments struct {
+	// Leading are the comments before the node.
+	Leading []*Comment
+	// Trailing are the comments after the node, usually on the same line.
+	Trailing []*Comment
+}
@@ -0,0 +1,162 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"strings"
+)
+
+// EqualFlags controls what is compared by EqualWithFlags.
+type EqualFlags uint64
+
+const (
+	// EqualIgnorePos ignores positions of nodes and comments, and whether comments are on their own lines.
+	EqualIgnorePos EqualFlags = 1 << iota
+	// EqualIgnoreText ignores the source text of nodes and how names are written,
+	// e.g. n and `n` are the same name.
+	EqualIgnoreText
+)
+
+// Has returns whether flag is set.
+func (f EqualFlags) Has(flag EqualFlags) bool {
+	return f&flag != 0
+}
+
+// Equal returns whether a and b are the same tree, including positions, text and comments.
+func Equal(a, b Node) bool {
+	return EqualWithFlags(a, b, 0)
+}
+
+// EqualWithFlags returns whether a and b are the same tree, ignoring what's set in flags.
+func EqualWithFlags(a, b Node, flags EqualFlags) bool {
+	return equalValue(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem(), flags)
+}
+
+func equalValue(a, b reflect.Value, flags EqualFlags) bool {
+	switch a.Kind() {
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		if a.Elem().Type() != b.Elem().Type() {
+			return false
+		}
+		return equalValue(a.Elem(), b.Elem(), flags)
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		if a.Pointer() == b.Pointer() {
+			return true
+		}
+		if n, ok := a.Interface().(Node); ok && !equalNode(n, b.Interface().(Node), flags) {
+			return false
+		}
+		if flags.Has(EqualIgnoreText) {
+			switch n := a.Interface().(type) {
+			case *SymbolicNameNode:
+				return symbolicNameValue(n) == symbolicNameValue(b.Interface().(*SymbolicNameNode))
+			case *SchemaNameNode:
+				return equalSchemaName(n, b.Interface().(*SchemaNameNode), flags)
+			}
+		}
+		return equalValue(a.Elem(), b.Elem(), flags)
+	case reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !equalValue(a.Index(i), b.Index(i), flags) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			// the embedded base is compared by equalNode
+			if a.Type().Field(i).Anonymous {
+				continue
+			}
+			if !equalValue(a.Field(i), b.Field(i), flags) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a.Interface() == b.Interface()
+	}
+}
+
+// equalNode compares the fields of the embedded base.
+func equalNode(a, b Node, flags EqualFlags) bool {
+	if !flags.Has(EqualIgnoreText) && a.Text() != b.Text() {
+		return false
+	}
+	if !flags.Has(EqualIgnorePos) && (a.Start() != b.Start() || a.End() != b.End()) {
+		return false
+	}
+	return equalComments(a.Comments().Leading, b.Comments().Leading, flags) &&
+		equalComments(a.Comments().Trailing, b.Comments().Trailing, flags)
+}
+
+// symbolicNameValue returns the name regardless of how it's written,
+// names spelled as keywords without Value are written in upper case.
+func symbolicNameValue(n *SymbolicNameNode) string {
+	if n.Value != "" {
+		return n.Value
+	}
+	var b strings.Builder
+	n.Restore(NewRestoreContext(&b))
+	return b.String()
+}
+
+// equalSchemaName compares schema names by Name, a reserved word
+// such as match is the same name as `match`.
+func equalSchemaName(a, b *SchemaNameNode, flags EqualFlags) bool {
+	if a.Name() != b.Name() {
+		return false
+	}
+	x, y := schemaNameNode(a), schemaNameNode(b)
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalNode(x, y, flags)
+}
+
+// schemaNameNode returns the node holding the name, or nil if there's none.
+func schemaNameNode(n *SchemaNameNode) Node {
+	if n.Type == SchemaNameReservedWord && n.ReservedWord != nil {
+		return n.ReservedWord
+	}
+	if n.Type == SchemaNameSymbolicName && n.SymbolicName != nil {
+		return n.SymbolicName
+	}
+	return nil
+}
+
+func equalComments(a, b []*Comment, flags EqualFlags) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Text != b[i].Text {
+			return false
+		}
+		if !flags.Has(EqualIgnorePos) && (a[i].Start != b[i].Start || a[i].End != b[i].End || a[i].OwnLine != b[i].OwnLine) {
+			return false
+		}
+	}
+	return true
+}
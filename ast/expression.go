@@ -29,6 +29,7 @@ var (
 	_ Expr = &ListComprehension{}
 	_ Expr = &ParenExpr{}
 	_ Expr = &FilterExpr{}
+	_ Expr = &FunctionInvocation{}
 	_ Node = &PropertyLookup{}
 )
 
@@ -45,20 +46,33 @@ type PropertyExpr struct {
 func (n *PropertyExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*PropertyExpr)
-	n.Expr.Accept(v)
-	for _, lookup := range n.Lookups {
-		lookup.Accept(v)
+	if n.Expr != nil {
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
+	}
+	for i, child := range n.Lookups {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Lookups[i] = node.(*PropertyLookup)
 	}
 	return v.Leave(n)
 }
 
 func (n *PropertyExpr) Restore(ctx *RestoreContext) {
-	n.Expr.Restore(ctx)
+	ctx.Restore(n.Expr)
 	for _, l := range n.Lookups {
-		l.Restore(ctx)
+		ctx.Restore(l)
 	}
 }
 
@@ -75,18 +89,51 @@ type BinaryExpr struct {
 func (n *BinaryExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*BinaryExpr)
-	n.L.Accept(v)
-	n.R.Accept(v)
+	if n.L != nil {
+		node, ok := n.L.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.L = node.(Expr)
+	}
+	if n.R != nil {
+		node, ok := n.R.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.R = node.(Expr)
+	}
 	return v.Leave(n)
 }
 
 func (n *BinaryExpr) Restore(ctx *RestoreContext) {
-	n.L.Restore(ctx)
-	ctx.Writef(" %s ", n.Op)
-	n.R.Restore(ctx)
+	if n.Op != OpOr && n.Op != OpXor && n.Op != OpAnd {
+		ctx.Restore(n.L)
+		ctx.WriteKeyword(" " + n.Op.String() + " ")
+		ctx.Restore(n.R)
+		return
+	}
+	// a chain like `a AND b AND c` is one group, so each operator starts a line when it's broken
+	restore := func() {
+		if l, ok := n.L.(*BinaryExpr); ok && l.Op == n.Op {
+			ctx.chained = true
+		}
+		ctx.Restore(n.L)
+		ctx.withIndent(func() {
+			ctx.softBreak(" ")
+			ctx.WriteKeyword(n.Op.String() + " ")
+			ctx.Restore(n.R)
+		})
+	}
+	if ctx.chained {
+		ctx.chained = false
+		restore()
+		return
+	}
+	ctx.group(restore)
 }
 
 // UnaryExpr represents a unary expression with expression and an operator.
@@ -101,16 +148,25 @@ type UnaryExpr struct {
 func (n *UnaryExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*UnaryExpr)
-	n.V.Accept(v)
+	if n.V != nil {
+		node, ok := n.V.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.V = node.(Expr)
+	}
 	return v.Leave(n)
 }
 
 func (n *UnaryExpr) Restore(ctx *RestoreContext) {
-	ctx.Write(n.Op.String())
-	n.V.Restore(ctx)
+	ctx.WriteKeyword(n.Op.String())
+	if n.Op == OpNot {
+		ctx.Write(" ")
+	}
+	ctx.Restore(n.V)
 }
 
 // PredicationType represents types of PredicationExpr
@@ -123,27 +179,49 @@ const (
 	PredicationNullOp
 )
 
-// PredicationExpr represents a expression with boolean value but not logical operation.
+// PredicationExpr represents a expression with boolean value but not logical operation,
+// e.g. `a STARTS WITH 'b'`, `a IN list`, `a[0]` and `a IS NULL`.
 type PredicationExpr struct {
 	baseExpr
 
 	Type PredicationType
+	// Expr is the operand of Op.
 	Expr Expr
+	// Op is a *StringOperationExpr, *ListOperationExpr or *NullOperationExpr by Type.
+	Op Expr
 }
 
 // Accept implements Node interface
 func (n *PredicationExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*PredicationExpr)
-	n.Expr.Accept(v)
+	if n.Expr != nil {
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
+	}
+	if n.Op != nil {
+		node, ok := n.Op.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Op = node.(Expr)
+	}
 	return v.Leave(n)
 }
 
 func (n *PredicationExpr) Restore(ctx *RestoreContext) {
-	n.Expr.Restore(ctx)
+	ctx.Restore(n.Expr)
+	// subscripts and slices follow the operand directly
+	if op, ok := n.Op.(*ListOperationExpr); !ok || op.InExpr != nil {
+		ctx.Write(" ")
+	}
+	ctx.Restore(n.Op)
 }
 
 // OpType represents operator type of expression
@@ -223,16 +301,22 @@ type StringOperationExpr struct {
 	baseExpr
 
 	Type StringOperationType
-	Expr *PropertyOrLabelsExpr
+	Expr Expr
 }
 
 func (n *StringOperationExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*StringOperationExpr)
-	n.Expr.Accept(v)
+	if n.Expr != nil {
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
+	}
 	return v.Leave(n)
 }
 
@@ -240,13 +324,13 @@ func (n *StringOperationExpr) Restore(ctx *RestoreContext) {
 	switch n.Type {
 	case StringOperationStartsWith:
 		ctx.WriteKeyword("STARTS WITH ")
-		n.Expr.Restore(ctx)
+		ctx.Restore(n.Expr)
 	case StringOperationEndsWith:
 		ctx.WriteKeyword("ENDS WITH ")
-		n.Expr.Restore(ctx)
+		ctx.Restore(n.Expr)
 	case StringOperationContains:
 		ctx.WriteKeyword("CONTAINS ")
-		n.Expr.Restore(ctx)
+		ctx.Restore(n.Expr)
 	}
 }
 
@@ -258,10 +342,12 @@ const (
 	ListOperationRange
 )
 
+// ListOperationExpr represents `IN list`, subscript `[i]` or slice `[from..to]`.
+// It's a slice if both InExpr and SingleExpr are nil, in which the bounds are optional.
 type ListOperationExpr struct {
 	baseExpr
 
-	InExpr     *PropertyOrLabelsExpr
+	InExpr     Expr
 	SingleExpr Expr
 	LowerBound Expr
 	UpperBound Expr
@@ -270,27 +356,57 @@ type ListOperationExpr struct {
 func (n *ListOperationExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*ListOperationExpr)
-	n.InExpr.Accept(v)
-	n.SingleExpr.Accept(v)
+	if n.InExpr != nil {
+		node, ok := n.InExpr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.InExpr = node.(Expr)
+	}
+	if n.SingleExpr != nil {
+		node, ok := n.SingleExpr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.SingleExpr = node.(Expr)
+	}
+	if n.LowerBound != nil {
+		node, ok := n.LowerBound.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.LowerBound = node.(Expr)
+	}
+	if n.UpperBound != nil {
+		node, ok := n.UpperBound.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.UpperBound = node.(Expr)
+	}
 	return v.Leave(n)
 }
 
 func (n *ListOperationExpr) Restore(ctx *RestoreContext) {
 	if n.InExpr != nil {
 		ctx.WriteKeyword("IN ")
-		n.InExpr.Restore(ctx)
+		ctx.Restore(n.InExpr)
 	} else if n.SingleExpr != nil {
 		ctx.Write("[")
-		n.SingleExpr.Restore(ctx)
+		ctx.Restore(n.SingleExpr)
 		ctx.Write("]")
 	} else {
 		ctx.Write("[")
-		n.LowerBound.Restore(ctx)
+		if n.LowerBound != nil {
+			ctx.Restore(n.LowerBound)
+		}
 		ctx.Write("..")
-		n.UpperBound.Restore(ctx)
+		if n.UpperBound != nil {
+			ctx.Restore(n.UpperBound)
+		}
 		ctx.Write("]")
 	}
 }
@@ -306,7 +422,7 @@ type NullOperationExpr struct {
 func (n *NullOperationExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*NullOperationExpr)
 	return v.Leave(n)
@@ -331,26 +447,46 @@ type PropertyOrLabelsExpr struct {
 func (n *PropertyOrLabelsExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*PropertyOrLabelsExpr)
-	n.Expr.Accept(v)
-	for _, lookup := range n.PropertyLookups {
-		lookup.Accept(v)
+	if n.Expr != nil {
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
 	}
-	for _, label := range n.NodeLabels {
-		label.Accept(v)
+	for i, child := range n.PropertyLookups {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.PropertyLookups[i] = node.(*PropertyLookup)
+	}
+	for i, child := range n.NodeLabels {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.NodeLabels[i] = node.(*NodeLabelNode)
 	}
 	return v.Leave(n)
 }
 
 func (n *PropertyOrLabelsExpr) Restore(ctx *RestoreContext) {
-	n.Expr.Restore(ctx)
+	ctx.Restore(n.Expr)
 	for _, l := range n.PropertyLookups {
-		l.Restore(ctx)
+		ctx.Restore(l)
 	}
 	for _, label := range n.NodeLabels {
-		label.Restore(ctx)
+		ctx.Restore(label)
 	}
 }
 
@@ -454,7 +590,7 @@ func (n *PropertyOrLabelsExpr) Restore(ctx *RestoreContext) {
 // }
 
 type PropertyLookup struct {
-	Node
+	baseNode
 
 	PropertyKey *SchemaNameNode
 }
@@ -462,18 +598,22 @@ type PropertyLookup struct {
 func (n *PropertyLookup) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*PropertyLookup)
-	n.PropertyKey.Accept(v)
+	if n.PropertyKey != nil {
+		node, ok := n.PropertyKey.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.PropertyKey = node.(*SchemaNameNode)
+	}
 	return v.Leave(n)
 }
 
 func (n *PropertyLookup) Restore(ctx *RestoreContext) {
 	ctx.Write(".")
-	ctx.Write("`")
-	n.PropertyKey.Restore(ctx)
-	ctx.Write("`")
+	ctx.WriteIdent(n.PropertyKey.Name())
 }
 
 type CaseExpr struct {
@@ -487,32 +627,57 @@ type CaseExpr struct {
 func (n *CaseExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*CaseExpr)
-	n.Expr.Accept(v)
-	for _, alt := range n.Alts {
-		alt.Accept(v)
-	}
-	n.Else.Accept(v)
-	return v.Leave(n)
-}
-
-func (n *CaseExpr) Restore(ctx *RestoreContext) {
-	ctx.WriteKeyword("CASE")
 	if n.Expr != nil {
-		ctx.Write(" ")
-		n.Expr.Restore(ctx)
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
 	}
-	for _, alt := range n.Alts {
-		ctx.Write(" ")
-		alt.Restore(ctx)
+	for i, child := range n.Alts {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Alts[i] = node.(*CaseAlt)
 	}
 	if n.Else != nil {
-		ctx.WriteKeyword(" ELSE ")
-		n.Else.Restore(ctx)
+		node, ok := n.Else.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Else = node.(Expr)
 	}
-	ctx.WriteKeyword(" END")
+	return v.Leave(n)
+}
+
+func (n *CaseExpr) Restore(ctx *RestoreContext) {
+	ctx.group(func() {
+		ctx.WriteKeyword("CASE")
+		if n.Expr != nil {
+			ctx.Write(" ")
+			ctx.Restore(n.Expr)
+		}
+		ctx.withIndent(func() {
+			for _, alt := range n.Alts {
+				ctx.softBreak(" ")
+				ctx.Restore(alt)
+			}
+			if n.Else != nil {
+				ctx.softBreak(" ")
+				ctx.WriteKeyword("ELSE ")
+				ctx.Restore(n.Else)
+			}
+		})
+		ctx.softBreak(" ")
+		ctx.WriteKeyword("END")
+	})
 }
 
 type CaseAlt struct {
@@ -525,19 +690,31 @@ type CaseAlt struct {
 func (n *CaseAlt) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*CaseAlt)
-	n.When.Accept(v)
-	n.Then.Accept(v)
+	if n.When != nil {
+		node, ok := n.When.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.When = node.(Expr)
+	}
+	if n.Then != nil {
+		node, ok := n.Then.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Then = node.(Expr)
+	}
 	return v.Leave(n)
 }
 
 func (n *CaseAlt) Restore(ctx *RestoreContext) {
 	ctx.WriteKeyword("WHEN ")
-	n.When.Restore(ctx)
-	ctx.WriteKeyword("THEN ")
-	n.Then.Restore(ctx)
+	ctx.Restore(n.When)
+	ctx.WriteKeyword(" THEN ")
+	ctx.Restore(n.Then)
 }
 
 type FilterType byte
@@ -562,13 +739,29 @@ type FilterExpr struct {
 func (n *FilterExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*FilterExpr)
-	n.Variable.Accept(v)
-	n.In.Accept(v)
+	if n.Variable != nil {
+		node, ok := n.Variable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variable = node.(*VariableNode)
+	}
+	if n.In != nil {
+		node, ok := n.In.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.In = node.(Expr)
+	}
 	if n.Where != nil {
-		n.Where.Accept(v)
+		node, ok := n.Where.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Where = node.(Expr)
 	}
 	return v.Leave(n)
 }
@@ -588,13 +781,18 @@ func (n *FilterExpr) Restore(ctx *RestoreContext) {
 		ctx.WriteKeyword("SINGLE(")
 		defer ctx.Write(")")
 	}
-	n.Variable.Restore(ctx)
-	ctx.WriteKeyword(" IN ")
-	n.In.Restore(ctx)
-	if n.Where != nil {
-		ctx.WriteKeyword(" WHERE ")
-		n.Where.Restore(ctx)
-	}
+	ctx.group(func() {
+		ctx.Restore(n.Variable)
+		ctx.WriteKeyword(" IN ")
+		ctx.Restore(n.In)
+		if n.Where != nil {
+			ctx.withIndent(func() {
+				ctx.softBreak(" ")
+				ctx.WriteKeyword("WHERE ")
+				ctx.Restore(n.Where)
+			})
+		}
+	})
 }
 
 type ListComprehension struct {
@@ -607,29 +805,106 @@ type ListComprehension struct {
 func (n *ListComprehension) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*ListComprehension)
-	n.FilterExpr.Accept(v)
+	if n.FilterExpr != nil {
+		node, ok := n.FilterExpr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.FilterExpr = node.(*FilterExpr)
+	}
 	if n.Expr != nil {
-		n.Expr.Accept(v)
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
 	}
 	return v.Leave(n)
 }
 
 func (n *ListComprehension) Restore(ctx *RestoreContext) {
-	ctx.Write("[")
-	n.FilterExpr.Restore(ctx)
-	if n.Expr != nil {
-		ctx.Write(" | ")
-		n.Expr.Restore(ctx)
-	}
-	ctx.Write("]")
+	ctx.group(func() {
+		ctx.Write("[")
+		ctx.Restore(n.FilterExpr)
+		if n.Expr != nil {
+			ctx.withIndent(func() {
+				ctx.softBreak(" ")
+				ctx.Write("| ")
+				ctx.Restore(n.Expr)
+			})
+		}
+		ctx.Write("]")
+	})
 }
 
+// FunctionInvocation represents a function call like `count(DISTINCT n)` or `apoc.coll.sum(list)`.
 type FunctionInvocation struct {
-	// TODO
-	// don't support now
+	baseExpr
+
+	// Namespace is the prefix of function name, e.g. `apoc` and `coll` in `apoc.coll.sum`.
+	Namespace []*SymbolicNameNode
+	// Name is with type SymbolicNameExists for `exists` function.
+	Name     *SymbolicNameNode
+	Distinct bool
+	Args     []Expr
+}
+
+func (n *FunctionInvocation) Accept(v Visitor) (Node, bool) {
+	newNode, skip := v.Enter(n)
+	if skip {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*FunctionInvocation)
+	for i, child := range n.Namespace {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Namespace[i] = node.(*SymbolicNameNode)
+	}
+	if n.Name != nil {
+		node, ok := n.Name.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Name = node.(*SymbolicNameNode)
+	}
+	for i, child := range n.Args {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Args[i] = node.(Expr)
+	}
+	return v.Leave(n)
+}
+
+func (n *FunctionInvocation) Restore(ctx *RestoreContext) {
+	for _, ns := range n.Namespace {
+		ctx.Restore(ns)
+		ctx.Write(".")
+	}
+	ctx.Restore(n.Name)
+	ctx.Write("(")
+	if n.Distinct {
+		ctx.WriteKeyword("DISTINCT ")
+	}
+	for i, arg := range n.Args {
+		if i > 0 {
+			ctx.Write(", ")
+		}
+		ctx.Restore(arg)
+	}
+	ctx.Write(")")
 }
 
 type ParenExpr struct {
@@ -641,16 +916,22 @@ type ParenExpr struct {
 func (n *ParenExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*ParenExpr)
-	n.Expr.Accept(v)
+	if n.Expr != nil {
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
+	}
 	return v.Leave(n)
 }
 
 func (n *ParenExpr) Restore(ctx *RestoreContext) {
 	ctx.Write("(")
-	n.Expr.Restore(ctx)
+	ctx.Restore(n.Expr)
 	ctx.Write(")")
 }
 
@@ -661,7 +942,7 @@ type CountAllExpr struct {
 func (n *CountAllExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*CountAllExpr)
 	return v.Leave(n)
@@ -670,3 +951,120 @@ func (n *CountAllExpr) Accept(v Visitor) (Node, bool) {
 func (n *CountAllExpr) Restore(ctx *RestoreContext) {
 	ctx.WriteKeyword("COUNT(*)")
 }
+
+// ExistsSubqueryExpr is `EXISTS { ... }`, which has either a Query or a Pattern with an optional Where.
+type ExistsSubqueryExpr struct {
+	baseExpr
+
+	Query   *QueryStmt
+	Pattern *Pattern
+	Where   Expr
+}
+
+func (n *ExistsSubqueryExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skip := v.Enter(n)
+	if skip {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ExistsSubqueryExpr)
+	if n.Query != nil {
+		node, ok := n.Query.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Query = node.(*QueryStmt)
+	}
+	if n.Pattern != nil {
+		node, ok := n.Pattern.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Pattern = node.(*Pattern)
+	}
+	if n.Where != nil {
+		node, ok := n.Where.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Where = node.(Expr)
+	}
+	return v.Leave(n)
+}
+
+func (n *ExistsSubqueryExpr) Restore(ctx *RestoreContext) {
+	ctx.WriteKeyword("EXISTS")
+	restoreSubquery(ctx, n.Query, n.Pattern, n.Where)
+}
+
+// CountSubqueryExpr is `COUNT { ... }`, which has either a Query or a Pattern with an optional Where.
+type CountSubqueryExpr struct {
+	baseExpr
+
+	Query   *QueryStmt
+	Pattern *Pattern
+	Where   Expr
+}
+
+func (n *CountSubqueryExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skip := v.Enter(n)
+	if skip {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CountSubqueryExpr)
+	if n.Query != nil {
+		node, ok := n.Query.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Query = node.(*QueryStmt)
+	}
+	if n.Pattern != nil {
+		node, ok := n.Pattern.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Pattern = node.(*Pattern)
+	}
+	if n.Where != nil {
+		node, ok := n.Where.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Where = node.(Expr)
+	}
+	return v.Leave(n)
+}
+
+func (n *CountSubqueryExpr) Restore(ctx *RestoreContext) {
+	ctx.WriteKeyword("COUNT")
+	restoreSubquery(ctx, n.Query, n.Pattern, n.Where)
+}
+
+// restoreSubquery restores the braces of a subquery expression. A query is
+// always on its own lines when formatting, as its clauses are.
+func restoreSubquery(ctx *RestoreContext, query *QueryStmt, pattern *Pattern, where Expr) {
+	if query != nil {
+		ctx.Write(" {")
+		ctx.withIndent(func() {
+			ctx.breakLine(" ")
+			ctx.Restore(query)
+		})
+		ctx.breakLine(" ")
+		ctx.Write("}")
+		return
+	}
+	ctx.group(func() {
+		ctx.Write(" {")
+		ctx.withIndent(func() {
+			ctx.softBreak(" ")
+			ctx.Restore(pattern)
+			if where != nil {
+				ctx.softBreak(" ")
+				ctx.WriteKeyword("WHERE ")
+				ctx.Restore(where)
+			}
+		})
+		ctx.softBreak(" ")
+		ctx.Write("}")
+	})
+}
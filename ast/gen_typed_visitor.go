@@ -0,0 +1,138 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+
+// gen_typed_visitor generates typed_visitor.go from the nodes with Accept methods.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const header = `// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by gen_typed_visitor.go. DO NOT EDIT.
+
+`
+
+var tmpl = template.Must(template.New("").Parse(`package ast
+
+// TypedVisitor has a method for each node type, which is called when entering
+// the node, use AdaptTypedVisitor to drive it through Accept.
+// If skipChildren is true, the children of the node aren't visited.
+//
+// Embed BaseTypedVisitor to implement only the methods needed.
+type TypedVisitor interface {
+{{- range .}}
+	Visit{{.}}(n *{{.}}) (skipChildren bool)
+{{- end}}
+}
+
+// BaseTypedVisitor implements TypedVisitor, all its methods visit the children.
+type BaseTypedVisitor struct{}
+
+{{range .}}
+func (BaseTypedVisitor) Visit{{.}}(n *{{.}}) bool { return false }
+{{end}}
+
+// AdaptTypedVisitor returns a Visitor calling the method of v for each node.
+func AdaptTypedVisitor(v TypedVisitor) Visitor {
+	return typedVisitorAdapter{v}
+}
+
+type typedVisitorAdapter struct {
+	v TypedVisitor
+}
+
+func (a typedVisitorAdapter) Enter(n Node) (Node, bool) {
+	switch n := n.(type) {
+{{- range .}}
+	case *{{.}}:
+		return n, a.v.Visit{{.}}(n)
+{{- end}}
+	}
+	return n, false
+}
+
+func (a typedVisitorAdapter) Leave(n Node) (Node, bool) {
+	return n, true
+}
+`))
+
+func main() {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Strings(files)
+	fset := token.NewFileSet()
+	var nodes []string
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || file == "typed_visitor.go" || file == "gen_typed_visitor.go" {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Name.Name != "Accept" {
+				continue
+			}
+			star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			if ident, ok := star.X.(*ast.Ident); ok {
+				nodes = append(nodes, ident.Name)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	if err := tmpl.Execute(&buf, nodes); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(fmt.Errorf("%v\n%s", err, buf.Bytes()))
+	}
+	if err := os.WriteFile("typed_visitor.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
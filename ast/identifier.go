@@ -35,24 +35,43 @@ type SchemaNameNode struct {
 func (n *SchemaNameNode) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*SchemaNameNode)
+	if n.SymbolicName != nil {
+		node, ok := n.SymbolicName.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.SymbolicName = node.(*SymbolicNameNode)
+	}
+	if n.ReservedWord != nil {
+		node, ok := n.ReservedWord.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.ReservedWord = node.(*ReservedWordNode)
+	}
+	return v.Leave(n)
+}
+
+// Name returns the name of SchemaNameNode without backquotes.
+func (n *SchemaNameNode) Name() string {
 	switch n.Type {
 	case SchemaNameSymbolicName:
-		n.SymbolicName.Accept(v)
+		return n.SymbolicName.Value
 	case SchemaNameReservedWord:
-		n.ReservedWord.Accept(v)
+		return n.ReservedWord.Content
 	}
-	return v.Leave(n)
+	return ""
 }
 
 func (n *SchemaNameNode) Restore(ctx *RestoreContext) {
 	switch n.Type {
 	case SchemaNameSymbolicName:
-		n.SymbolicName.Restore(ctx)
+		ctx.Restore(n.SymbolicName)
 	case SchemaNameReservedWord:
-		n.ReservedWord.Restore(ctx)
+		ctx.Restore(n.ReservedWord)
 	}
 }
 
@@ -70,8 +89,11 @@ const (
 	SymbolicNameAny
 	SymbolicNameNone
 	SymbolicNameSingle
+	// SymbolicNameExists is only used as name of FunctionInvocation
+	SymbolicNameExists
 )
 
+// SymbolicNameNode is a name, Value is the name as written without backquotes.
 type SymbolicNameNode struct {
 	baseNode
 	Type  SymbolicNameType
@@ -81,16 +103,25 @@ type SymbolicNameNode struct {
 func (n *SymbolicNameNode) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*SymbolicNameNode)
 	return v.Leave(n)
 }
 
+// Restore implements Node interface.
+// Names spelled as keywords, such as `count`, are written as they are,
+// and written in upper case if Value is not set.
 func (n *SymbolicNameNode) Restore(ctx *RestoreContext) {
-	switch n.Type {
-	case SymbolicNameUnescaped, SymbolicNameEscaped, SymbolicNameHexLetter:
+	switch {
+	case n.Type == SymbolicNameUnescaped, n.Type == SymbolicNameEscaped, n.Type == SymbolicNameHexLetter:
+		ctx.WriteName(n.Value)
+		return
+	case n.Value != "":
 		ctx.Write(n.Value)
+		return
+	}
+	switch n.Type {
 	case SymbolicNameAny:
 		ctx.WriteKeyword("ANY")
 	case SymbolicNameCount:
@@ -103,6 +134,8 @@ func (n *SymbolicNameNode) Restore(ctx *RestoreContext) {
 		ctx.WriteKeyword("NONE")
 	case SymbolicNameSingle:
 		ctx.WriteKeyword("SINGLE")
+	case SymbolicNameExists:
+		ctx.WriteKeyword("EXISTS")
 	}
 }
 
@@ -115,14 +148,15 @@ type ReservedWordNode struct {
 func (n *ReservedWordNode) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*ReservedWordNode)
 	return v.Leave(n)
 }
 
+// Restore implements Node interface, ReservedWordNode is used as a name so it's written as it is.
 func (n *ReservedWordNode) Restore(ctx *RestoreContext) {
-	ctx.WriteKeyword(n.Content)
+	ctx.Write(n.Content)
 }
 
 type VariableNode struct {
@@ -134,17 +168,21 @@ type VariableNode struct {
 func (n *VariableNode) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*VariableNode)
-	n.SymbolicName.Accept(v)
+	if n.SymbolicName != nil {
+		node, ok := n.SymbolicName.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.SymbolicName = node.(*SymbolicNameNode)
+	}
 	return v.Leave(n)
 }
 
 func (n *VariableNode) Restore(ctx *RestoreContext) {
-	ctx.Write("`")
-	n.SymbolicName.Restore(ctx)
-	ctx.Write("`")
+	ctx.WriteIdent(n.Name())
 }
 
 func (n *VariableNode) Name() string {
@@ -161,23 +199,29 @@ type NodeLabelNode struct {
 func (n *NodeLabelNode) String() string {
 	var str strings.Builder
 	ctx := NewRestoreContext(&str)
-	n.LabelName.Restore(ctx)
+	ctx.Restore(n.LabelName)
 	return str.String()
 }
 
 func (n *NodeLabelNode) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*NodeLabelNode)
-	n.LabelName.Accept(v)
+	if n.LabelName != nil {
+		node, ok := n.LabelName.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.LabelName = node.(*SchemaNameNode)
+	}
 	return v.Leave(n)
 }
 
 func (n *NodeLabelNode) Restore(ctx *RestoreContext) {
 	ctx.Write(":")
-	n.LabelName.Restore(ctx)
+	ctx.Restore(n.LabelName)
 }
 
 type DecimalInteger = int
@@ -188,8 +232,9 @@ const (
 	ParameterDecimalInteger
 )
 
+// ParameterNode represents a parameter like `$name` or `$0`.
 type ParameterNode struct {
-	baseNode
+	baseExpr
 
 	Type           ParameterType
 	SymbolicName   *SymbolicNameNode
@@ -199,22 +244,24 @@ type ParameterNode struct {
 func (n *ParameterNode) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*ParameterNode)
-	switch n.Type {
-	case ParameterSymbolicName:
-		n.SymbolicName.Accept(v)
-	case ParameterDecimalInteger:
-		break
+	if n.SymbolicName != nil {
+		node, ok := n.SymbolicName.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.SymbolicName = node.(*SymbolicNameNode)
 	}
 	return v.Leave(n)
 }
 
 func (n *ParameterNode) Restore(ctx *RestoreContext) {
+	ctx.Write("$")
 	switch n.Type {
 	case ParameterSymbolicName:
-		n.SymbolicName.Restore(ctx)
+		ctx.Restore(n.SymbolicName)
 	case ParameterDecimalInteger:
 		ctx.Write(n.DecimalInteger)
 	}
@@ -245,16 +292,29 @@ type LiteralExpr struct {
 func (n *LiteralExpr) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*LiteralExpr)
-	switch n.Type {
-	case LiteralNumber:
-		n.Number.Accept(v)
-	case LiteralMap:
-		n.Map.Accept(v)
-	case LiteralList:
-		n.List.Accept(v)
+	if n.Number != nil {
+		node, ok := n.Number.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Number = node.(*NumberLiteral)
+	}
+	if n.Map != nil {
+		node, ok := n.Map.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Map = node.(*MapLiteral)
+	}
+	if n.List != nil {
+		node, ok := n.List.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.List = node.(*ListLiteral)
 	}
 	return v.Leave(n)
 }
@@ -262,7 +322,7 @@ func (n *LiteralExpr) Accept(v Visitor) (Node, bool) {
 func (n *LiteralExpr) Restore(ctx *RestoreContext) {
 	switch n.Type {
 	case LiteralNumber:
-		n.Number.Restore(ctx)
+		ctx.Restore(n.Number)
 	case LiteralString:
 		ctx.WriteString(n.String)
 	case LiteralBoolean:
@@ -271,10 +331,12 @@ func (n *LiteralExpr) Restore(ctx *RestoreContext) {
 		} else {
 			ctx.WriteKeyword("FALSE")
 		}
+	case LiteralNull:
+		ctx.WriteKeyword("NULL")
 	case LiteralList:
-		n.List.Restore(ctx)
+		ctx.Restore(n.List)
 	case LiteralMap:
-		n.Map.Restore(ctx)
+		ctx.Restore(n.Map)
 	}
 }
 
@@ -298,20 +360,20 @@ type NumberLiteral struct {
 func (n *NumberLiteral) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*NumberLiteral)
 	return v.Leave(n)
 }
 
 // Restore would restore NumberLiteral value.
-// It will use default format for int and float64
+// Integers are written in decimal, and doubles are written by RestoreContext.WriteFloat.
 func (n *NumberLiteral) Restore(ctx *RestoreContext) {
 	switch n.Type {
 	case NumberLiteralInteger:
 		ctx.Writef("%d", n.Integer)
 	case NumberLiteralDouble:
-		ctx.Writef("%f", n.Double)
+		ctx.WriteFloat(n.Double)
 	}
 }
 
@@ -337,29 +399,35 @@ type MapLiteral struct {
 func (n *MapLiteral) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*MapLiteral)
-	for _, key := range n.PropertyKeys {
-		key.Accept(v)
-	}
-	for _, expr := range n.Exprs {
-		expr.Accept(v)
+	// each key is visited before its value
+	for i := 0; i < len(n.PropertyKeys) || i < len(n.Exprs); i++ {
+		if i < len(n.PropertyKeys) && n.PropertyKeys[i] != nil {
+			node, ok := n.PropertyKeys[i].Accept(v)
+			if !ok {
+				return n, false
+			}
+			n.PropertyKeys[i] = node.(*SchemaNameNode)
+		}
+		if i < len(n.Exprs) && n.Exprs[i] != nil {
+			node, ok := n.Exprs[i].Accept(v)
+			if !ok {
+				return n, false
+			}
+			n.Exprs[i] = node.(Expr)
+		}
 	}
 	return v.Leave(n)
 }
 
 func (n *MapLiteral) Restore(ctx *RestoreContext) {
-	ctx.Write("{")
-	for i := range n.PropertyKeys {
-		if i > 0 {
-			ctx.Write(", ")
-		}
-		n.PropertyKeys[i].Restore(ctx)
+	ctx.restoreList("{", "}", len(n.PropertyKeys), func(i int) {
+		ctx.Restore(n.PropertyKeys[i])
 		ctx.Write(": ")
-		n.Exprs[i].Restore(ctx)
-	}
-	ctx.Write("}")
+		ctx.Restore(n.Exprs[i])
+	})
 }
 
 type ListLiteral struct {
@@ -371,21 +439,24 @@ type ListLiteral struct {
 func (n *ListLiteral) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*ListLiteral)
-	for _, expr := range n.Exprs {
-		expr.Accept(v)
+	for i, child := range n.Exprs {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Exprs[i] = node.(Expr)
 	}
 	return v.Leave(n)
 }
 
 func (n *ListLiteral) Restore(ctx *RestoreContext) {
-	ctx.Write("[")
-	for i, expr := range n.Exprs {
-		if i > 0 {
-			ctx.Write(", ")
-		}
-		expr.Restore(ctx)
-	}
+	ctx.restoreList("[", "]", len(n.Exprs), func(i int) {
+		ctx.Restore(n.Exprs[i])
+	})
 }
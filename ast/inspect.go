@@ -0,0 +1,85 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+// Inspect traverses the tree of n in source order like go/ast.Inspect, it calls f(n)
+// first, if f returns true, Inspect is called for each non-nil child of n, followed
+// by f(nil).
+func Inspect(n Node, f func(Node) bool) {
+	if n == nil {
+		return
+	}
+	n.Accept(&inspector{f: f})
+}
+
+type inspector struct {
+	f func(Node) bool
+	// entered records whether f returned true for each node being visited.
+	entered []bool
+}
+
+func (v *inspector) Enter(n Node) (Node, bool) {
+	ok := v.f(n)
+	v.entered = append(v.entered, ok)
+	return n, !ok
+}
+
+func (v *inspector) Leave(n Node) (Node, bool) {
+	if v.entered[len(v.entered)-1] {
+		v.f(nil)
+	}
+	v.entered = v.entered[:len(v.entered)-1]
+	return n, true
+}
+
+// FindAll returns all the nodes of type T under root, including root, in source order.
+// e.g. FindAll[*VariableNode](stmt) returns all the variables in stmt.
+func FindAll[T Node](root Node) []T {
+	var nodes []T
+	Inspect(root, func(n Node) bool {
+		if t, ok := n.(T); ok {
+			nodes = append(nodes, t)
+		}
+		return true
+	})
+	return nodes
+}
+
+// Find returns the first node of type T under root, including root, in source order.
+// ok is false if there is none.
+func Find[T Node](root Node) (node T, ok bool) {
+	if root == nil {
+		return node, false
+	}
+	v := &finder[T]{}
+	root.Accept(v)
+	return v.node, v.found
+}
+
+type finder[T Node] struct {
+	node  T
+	found bool
+}
+
+func (v *finder[T]) Enter(n Node) (Node, bool) {
+	if t, ok := n.(T); ok {
+		v.node, v.found = t, true
+	}
+	return n, v.found
+}
+
+// Leave stops the traversal once the node is found.
+func (v *finder[T]) Leave(n Node) (Node, bool) {
+	return n, !v.found
+}
@@ -0,0 +1,241 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// JSONVersion is the version of the JSON encoding, it's bumped on incompatible changes.
+const JSONVersion = 1
+
+// nodeTypes are all the node types, by type name.
+var nodeTypes = map[string]reflect.Type{}
+
+func init() {
+	for _, n := range []Node{
+		&CypherStmt{}, &QueryStmt{}, &UnionClause{}, &StandaloneCall{},
+		&ReadingClause{}, &MatchClause{}, &UnwindClause{}, &InQueryCallClause{}, &SubqueryClause{},
+		&WithClause{}, &ReturnClause{}, &ReturnBody{}, &ReturnItem{}, &OrderClause{}, &SortItem{},
+		&CreateClause{}, &MergeClause{}, &MergeAction{}, &SetClause{}, &SetItem{},
+		&DeleteClause{}, &RemoveClause{}, &RemoveItem{},
+		&ProcedureInvocation{}, &YieldItems{}, &YieldItem{},
+		&Pattern{}, &PatternPart{}, &PatternElement{}, &NodePattern{},
+		&RelationshipPattern{}, &RelationshipDetail{}, &Properties{}, &PatternComprehension{},
+		&PropertyExpr{}, &BinaryExpr{}, &UnaryExpr{}, &PredicationExpr{},
+		&StringOperationExpr{}, &ListOperationExpr{}, &NullOperationExpr{},
+		&PropertyOrLabelsExpr{}, &PropertyLookup{}, &CaseExpr{}, &CaseAlt{}, &FilterExpr{},
+		&ListComprehension{}, &FunctionInvocation{}, &ParenExpr{}, &CountAllExpr{},
+		&ExistsSubqueryExpr{}, &CountSubqueryExpr{},
+		&SchemaNameNode{}, &SymbolicNameNode{}, &ReservedWordNode{}, &VariableNode{},
+		&NodeLabelNode{}, &ParameterNode{}, &LiteralExpr{}, &NumberLiteral{}, &MapLiteral{}, &ListLiteral{},
+	} {
+		t := reflect.TypeOf(n).Elem()
+		nodeTypes[t.Name()] = t
+	}
+}
+
+// MarshalJSON encodes n as JSON.
+//
+// The result is an object with "version" and "node". A node is an object with
+// its type name in "type", "start", "end", "text", "comments" if there is any,
+// and its fields by their names in Go. Enums are encoded as numbers,
+// which are the same as the numbers of the enums in ast/astpb/ast.proto.
+func MarshalJSON(n Node) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `{"version":%d,"node":`, JSONVersion)
+	if err := encodeJSON(&buf, reflect.ValueOf(&n).Elem()); err != nil {
+		return nil, err
+	}
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
+func encodeJSON(buf *bytes.Buffer, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		if v.Kind() == reflect.Interface {
+			return encodeJSON(buf, v.Elem())
+		}
+		n, ok := v.Interface().(Node)
+		if !ok {
+			return fmt.Errorf("ast: unexpected type %s", v.Type())
+		}
+		return encodeNode(buf, n, v.Elem())
+	case reflect.Slice:
+		buf.WriteString("[")
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			if err := encodeJSON(buf, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("]")
+		return nil
+	default:
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		return nil
+	}
+}
+
+func encodeNode(buf *bytes.Buffer, n Node, v reflect.Value) error {
+	if nodeTypes[v.Type().Name()] != v.Type() {
+		return fmt.Errorf("ast: unknown node type %s", v.Type())
+	}
+	fmt.Fprintf(buf, `{"type":%q,"start":`, v.Type().Name())
+	writeJSON(buf, n.Start())
+	buf.WriteString(`,"end":`)
+	writeJSON(buf, n.End())
+	buf.WriteString(`,"text":`)
+	writeJSON(buf, n.Text())
+	if comments := n.Comments(); len(comments.Leading) > 0 || len(comments.Trailing) > 0 {
+		buf.WriteString(`,"comments":`)
+		writeJSON(buf, comments)
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Anonymous {
+			continue
+		}
+		fmt.Fprintf(buf, ",%q:", field.Name)
+		if err := encodeJSON(buf, v.Field(i)); err != nil {
+			return err
+		}
+	}
+	buf.WriteString("}")
+	return nil
+}
+
+// writeJSON writes values which can always be marshaled.
+func writeJSON(buf *bytes.Buffer, v interface{}) {
+	data, _ := json.Marshal(v)
+	buf.Write(data)
+}
+
+// UnmarshalJSON decodes a node encoded by MarshalJSON.
+func UnmarshalJSON(data []byte) (Node, error) {
+	var doc struct {
+		Version int
+		Node    json.RawMessage
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Version != JSONVersion {
+		return nil, fmt.Errorf("ast: unsupported JSON version %d", doc.Version)
+	}
+	var n Node
+	if err := decodeJSON(doc.Node, reflect.ValueOf(&n).Elem()); err != nil {
+		return nil, err
+	}
+	if n != nil {
+		SetParents(n)
+	}
+	return n, nil
+}
+
+func decodeJSON(data json.RawMessage, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if string(data) == "null" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		n, err := decodeNode(data)
+		if err != nil {
+			return err
+		}
+		node := reflect.ValueOf(n)
+		if !node.Type().AssignableTo(v.Type()) {
+			return fmt.Errorf("ast: %s is not %s", node.Elem().Type().Name(), v.Type())
+		}
+		v.Set(node)
+		return nil
+	case reflect.Slice:
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		v.Set(reflect.MakeSlice(v.Type(), len(items), len(items)))
+		for i, item := range items {
+			if err := decodeJSON(item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return json.Unmarshal(data, v.Addr().Interface())
+	}
+}
+
+func decodeNode(data json.RawMessage) (Node, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var name string
+	if err := json.Unmarshal(fields["type"], &name); err != nil {
+		return nil, fmt.Errorf("ast: invalid node type: %s", fields["type"])
+	}
+	t, ok := nodeTypes[name]
+	if !ok {
+		return nil, fmt.Errorf("ast: unknown node type %q", name)
+	}
+	v := reflect.New(t)
+	n := v.Interface().(Node)
+
+	var start, end Pos
+	var text string
+	for key, dst := range map[string]interface{}{"start": &start, "end": &end, "text": &text, "comments": n.Comments()} {
+		if data, ok := fields[key]; ok {
+			if err := json.Unmarshal(data, dst); err != nil {
+				return nil, fmt.Errorf("ast: invalid %s of %s: %v", key, name, err)
+			}
+		}
+		delete(fields, key)
+	}
+	delete(fields, "type")
+	n.SetPos(start, end)
+	n.SetText(text)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			continue
+		}
+		if data, ok := fields[field.Name]; ok {
+			if err := decodeJSON(data, v.Elem().Field(i)); err != nil {
+				return nil, fmt.Errorf("ast: invalid %s.%s: %v", name, field.Name, err)
+			}
+		}
+		delete(fields, field.Name)
+	}
+	for key := range fields {
+		return nil, fmt.Errorf("ast: unknown field %s.%s", name, key)
+	}
+	return n, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+// SetParents sets the parents of all nodes under root, the parent of root is unchanged.
+func SetParents(root Node) {
+	root.Accept(&parentVisitor{})
+}
+
+type parentVisitor struct {
+	stack []Node
+}
+
+func (v *parentVisitor) Enter(n Node) (Node, bool) {
+	if len(v.stack) > 0 {
+		n.SetParent(v.stack[len(v.stack)-1])
+	}
+	v.stack = append(v.stack, n)
+	return n, false
+}
+
+func (v *parentVisitor) Leave(n Node) (Node, bool) {
+	v.stack = v.stack[:len(v.stack)-1]
+	return n, true
+}
+
+// Ancestors returns the parent of n, the parent of the parent, and so on up to the root.
+func Ancestors(n Node) []Node {
+	var ancestors []Node
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		ancestors = append(ancestors, p)
+	}
+	return ancestors
+}
+
+// EnclosingClause returns the clause containing n, i.e. the ancestor in Clauses of
+// QueryStmt or UnionClause, or the ImportingWith of a SubqueryClause, it's nil if there is none.
+// e.g. it's the MergeClause for a SetItem in `MERGE (n) ON CREATE SET n.a = 1`.
+func EnclosingClause(n Node) Stmt {
+	ancestors := Ancestors(n)
+	for i := 0; i+1 < len(ancestors); i++ {
+		switch ancestors[i+1].(type) {
+		case *QueryStmt, *UnionClause:
+			return ancestors[i].(Stmt)
+		case *SubqueryClause:
+			if with, ok := ancestors[i].(*WithClause); ok {
+				return with
+			}
+		}
+	}
+	return nil
+}
@@ -22,11 +22,18 @@ type Pattern struct {
 func (n *Pattern) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*Pattern)
-	for _, part := range n.Parts {
-		part.Accept(v)
+	for i, child := range n.Parts {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Parts[i] = node.(*PatternPart)
 	}
 	return v.Leave(n)
 }
@@ -36,7 +43,7 @@ func (n *Pattern) Restore(ctx *RestoreContext) {
 		if i > 0 {
 			ctx.Write(", ")
 		}
-		part.Restore(ctx)
+		ctx.Restore(part)
 	}
 }
 
@@ -50,22 +57,32 @@ type PatternPart struct {
 func (n *PatternPart) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*PatternPart)
 	if n.Variable != nil {
-		n.Variable.Accept(v)
+		node, ok := n.Variable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variable = node.(*VariableNode)
+	}
+	if n.Element != nil {
+		node, ok := n.Element.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Element = node.(*PatternElement)
 	}
-	n.Element.Accept(v)
 	return v.Leave(n)
 }
 
 func (n *PatternPart) Restore(ctx *RestoreContext) {
 	if n.Variable != nil {
-		n.Variable.Restore(ctx)
+		ctx.Restore(n.Variable)
 		ctx.Write(" = ")
 	}
-	n.Element.Restore(ctx)
+	ctx.Restore(n.Element)
 }
 
 type PatternElement struct {
@@ -80,24 +97,40 @@ type PatternElement struct {
 func (n *PatternElement) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*PatternElement)
-	for i := range n.Relationships {
-		n.Nodes[i].Accept(v)
-		n.Relationships[i].Accept(v)
+	// nodes and relationships are visited in the order they're written
+	for i := 0; i < len(n.Nodes) || i < len(n.Relationships); i++ {
+		if i < len(n.Nodes) && n.Nodes[i] != nil {
+			node, ok := n.Nodes[i].Accept(v)
+			if !ok {
+				return n, false
+			}
+			n.Nodes[i] = node.(*NodePattern)
+		}
+		if i < len(n.Relationships) && n.Relationships[i] != nil {
+			node, ok := n.Relationships[i].Accept(v)
+			if !ok {
+				return n, false
+			}
+			n.Relationships[i] = node.(*RelationshipPattern)
+		}
 	}
-	n.Nodes[len(n.Nodes)-1].Accept(v)
 	return v.Leave(n)
 }
 
 func (n *PatternElement) Restore(ctx *RestoreContext) {
-	// n.StartNode.Restore(ctx)
-	for i := range n.Relationships {
-		n.Nodes[i].Restore(ctx)
-		n.Relationships[i].Restore(ctx)
-	}
-	n.Nodes[len(n.Nodes)-1].Restore(ctx)
+	ctx.group(func() {
+		ctx.Restore(n.Nodes[0])
+		ctx.withIndent(func() {
+			for i := range n.Relationships {
+				ctx.softBreak("")
+				ctx.Restore(n.Relationships[i])
+				ctx.Restore(n.Nodes[i+1])
+			}
+		})
+	})
 }
 
 type NodePattern struct {
@@ -111,17 +144,32 @@ type NodePattern struct {
 func (n *NodePattern) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*NodePattern)
 	if n.Variable != nil {
-		n.Variable.Accept(v)
+		node, ok := n.Variable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variable = node.(*VariableNode)
 	}
-	for _, label := range n.Labels {
-		label.Accept(v)
+	for i, child := range n.Labels {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Labels[i] = node.(*NodeLabelNode)
 	}
 	if n.Properties != nil {
-		n.Properties.Accept(v)
+		node, ok := n.Properties.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Properties = node.(*Properties)
 	}
 	return v.Leave(n)
 }
@@ -129,13 +177,17 @@ func (n *NodePattern) Accept(v Visitor) (Node, bool) {
 func (n *NodePattern) Restore(ctx *RestoreContext) {
 	ctx.Write("(")
 	if n.Variable != nil {
-		n.Variable.Restore(ctx)
+		ctx.Restore(n.Variable)
 	}
 	for _, label := range n.Labels {
-		label.Restore(ctx)
+		ctx.Restore(label)
 	}
 	if n.Properties != nil {
-		n.Properties.Restore(ctx)
+		// a name followed by `$` would be a longer name
+		if n.Properties.Type == PropertiesParameter && (n.Variable != nil || len(n.Labels) > 0) {
+			ctx.Write(" ")
+		}
+		ctx.Restore(n.Properties)
 	}
 	ctx.Write(")")
 }
@@ -164,10 +216,16 @@ type RelationshipPattern struct {
 func (n *RelationshipPattern) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*RelationshipPattern)
-	n.Detail.Accept(v)
+	if n.Detail != nil {
+		node, ok := n.Detail.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Detail = node.(*RelationshipDetail)
+	}
 	return v.Leave(n)
 }
 
@@ -175,23 +233,29 @@ func (n *RelationshipPattern) Restore(ctx *RestoreContext) {
 	switch n.Type {
 	case RelationshipIn:
 		ctx.Write("<-")
-		n.Detail.Restore(ctx)
+		n.restoreDetail(ctx)
 		ctx.Write("-")
 	case RelationshipOut:
 		ctx.Write("-")
-		n.Detail.Restore(ctx)
+		n.restoreDetail(ctx)
 		ctx.Write("->")
 	case RelationshipBoth:
 		ctx.Write("<-")
-		n.Detail.Restore(ctx)
+		n.restoreDetail(ctx)
 		ctx.Write("->")
 	case RelationshipAll:
 		ctx.Write("-")
-		n.Detail.Restore(ctx)
+		n.restoreDetail(ctx)
 		ctx.Write("-")
 	}
 }
 
+func (n *RelationshipPattern) restoreDetail(ctx *RestoreContext) {
+	if n.Detail != nil {
+		ctx.Restore(n.Detail)
+	}
+}
+
 type RelationshipDetail struct {
 	baseExpr
 
@@ -202,12 +266,13 @@ type RelationshipDetail struct {
 	// [-1, 2] means will be matched for 0 - 2 times
 	// [1, -1] means will be matched for 1 time or more
 	// [-1, -1] means will be matched for any times
-	// There are 5 conditions:
-	// - [*]
-	// - [*1]
-	// - [*1..]
-	// - [*1..2]
-	// - [*..2]
+	// There are 6 conditions:
+	// - []      => [1, 1]
+	// - [*]     => [-1, -1]
+	// - [*2]    => [2, 2]
+	// - [*1..]  => [1, -1]
+	// - [*1..2] => [1, 2]
+	// - [*..2]  => [-1, 2]
 	MinHops    int
 	MaxHops    int
 	Properties *Properties
@@ -216,17 +281,32 @@ type RelationshipDetail struct {
 func (n *RelationshipDetail) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*RelationshipDetail)
 	if n.Variable != nil {
-		n.Variable.Accept(v)
+		node, ok := n.Variable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variable = node.(*VariableNode)
 	}
-	for _, label := range n.RelationshipTypes {
-		label.Accept(v)
+	for i, child := range n.RelationshipTypes {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.RelationshipTypes[i] = node.(*SchemaNameNode)
 	}
 	if n.Properties != nil {
-		n.Properties.Accept(v)
+		node, ok := n.Properties.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Properties = node.(*Properties)
 	}
 	return v.Leave(n)
 }
@@ -234,7 +314,7 @@ func (n *RelationshipDetail) Accept(v Visitor) (Node, bool) {
 func (n *RelationshipDetail) Restore(ctx *RestoreContext) {
 	ctx.Write("[")
 	if n.Variable != nil {
-		n.Variable.Restore(ctx)
+		ctx.Restore(n.Variable)
 	}
 	for i, t := range n.RelationshipTypes {
 		if i == 0 {
@@ -242,20 +322,33 @@ func (n *RelationshipDetail) Restore(ctx *RestoreContext) {
 		} else {
 			ctx.Write("|")
 		}
-		t.Restore(ctx)
+		ctx.Restore(t)
 	}
 
-	ctx.Write("*")
-	if n.MinHops > -1 {
-		ctx.Write(n.MinHops)
-	}
-	ctx.Write("..")
-	if n.MaxHops > -1 {
-		ctx.Write(n.MaxHops)
+	switch {
+	case n.MinHops == 1 && n.MaxHops == 1:
+		// no range literal
+	case n.MinHops == n.MaxHops:
+		ctx.Write("*")
+		if n.MinHops > -1 {
+			ctx.Write(n.MinHops)
+		}
+	default:
+		ctx.Write("*")
+		if n.MinHops > -1 {
+			ctx.Write(n.MinHops)
+		}
+		ctx.Write("..")
+		if n.MaxHops > -1 {
+			ctx.Write(n.MaxHops)
+		}
 	}
 
 	if n.Properties != nil {
-		n.Properties.Restore(ctx)
+		if n.Properties.Type == PropertiesParameter && (n.Variable != nil || len(n.RelationshipTypes) > 0) {
+			ctx.Write(" ")
+		}
+		ctx.Restore(n.Properties)
 	}
 	ctx.Write("]")
 }
@@ -278,14 +371,22 @@ type Properties struct {
 func (n *Properties) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*Properties)
-	switch n.Type {
-	case PropertiesMapLiteral:
-		n.MapLiteral.Accept(v)
-	case PropertiesParameter:
-		n.Parameter.Accept(v)
+	if n.MapLiteral != nil {
+		node, ok := n.MapLiteral.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.MapLiteral = node.(*MapLiteral)
+	}
+	if n.Parameter != nil {
+		node, ok := n.Parameter.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Parameter = node.(*ParameterNode)
 	}
 	return v.Leave(n)
 }
@@ -293,9 +394,9 @@ func (n *Properties) Accept(v Visitor) (Node, bool) {
 func (n *Properties) Restore(ctx *RestoreContext) {
 	switch n.Type {
 	case PropertiesMapLiteral:
-		n.MapLiteral.Restore(ctx)
+		ctx.Restore(n.MapLiteral)
 	case PropertiesParameter:
-		n.Parameter.Restore(ctx)
+		ctx.Restore(n.Parameter)
 	}
 }
 
@@ -311,32 +412,58 @@ type PatternComprehension struct {
 func (n *PatternComprehension) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*PatternComprehension)
 	if n.Variable != nil {
-		n.Variable.Accept(v)
+		node, ok := n.Variable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variable = node.(*VariableNode)
+	}
+	if n.PatternElement != nil {
+		node, ok := n.PatternElement.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.PatternElement = node.(*PatternElement)
 	}
-	n.PatternElement.Accept(v)
 	if n.Where != nil {
-		n.Where.Accept(v)
+		node, ok := n.Where.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Where = node.(Expr)
+	}
+	if n.Expr != nil {
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
 	}
-	n.Expr.Accept(v)
 	return v.Leave(n)
 }
 
 func (n *PatternComprehension) Restore(ctx *RestoreContext) {
-	ctx.Write("[")
-	if n.Variable != nil {
-		n.Variable.Restore(ctx)
-		ctx.Write(" = ")
-	}
-	n.PatternElement.Restore(ctx)
-	if n.Where != nil {
-		ctx.WriteKeyword(" WHERE")
-		n.Where.Restore(ctx)
-	}
-	ctx.Write(" | ")
-	n.Expr.Restore(ctx)
-	ctx.Write("]")
+	ctx.group(func() {
+		ctx.Write("[")
+		if n.Variable != nil {
+			ctx.Restore(n.Variable)
+			ctx.Write(" = ")
+		}
+		ctx.Restore(n.PatternElement)
+		ctx.withIndent(func() {
+			if n.Where != nil {
+				ctx.softBreak(" ")
+				ctx.WriteKeyword("WHERE ")
+				ctx.Restore(n.Where)
+			}
+			ctx.softBreak(" ")
+			ctx.Write("| ")
+			ctx.Restore(n.Expr)
+		})
+		ctx.Write("]")
+	})
 }
@@ -0,0 +1,174 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+// ProcedureInvocation represents invocation of a procedure like `db.labels()`.
+// Implicit invocation omits the arguments along with parentheses,
+// which is only allowed in standalone CALL.
+type ProcedureInvocation struct {
+	baseNode
+
+	// Namespace is the prefix of procedure name, e.g. `db` in `db.labels`.
+	Namespace []*SymbolicNameNode
+	Name      *SymbolicNameNode
+	Implicit  bool
+	Args      []Expr
+}
+
+func (n *ProcedureInvocation) Accept(v Visitor) (Node, bool) {
+	newNode, skip := v.Enter(n)
+	if skip {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ProcedureInvocation)
+	for i, child := range n.Namespace {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Namespace[i] = node.(*SymbolicNameNode)
+	}
+	if n.Name != nil {
+		node, ok := n.Name.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Name = node.(*SymbolicNameNode)
+	}
+	for i, child := range n.Args {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Args[i] = node.(Expr)
+	}
+	return v.Leave(n)
+}
+
+func (n *ProcedureInvocation) Restore(ctx *RestoreContext) {
+	for _, ns := range n.Namespace {
+		ctx.Restore(ns)
+		ctx.Write(".")
+	}
+	ctx.Restore(n.Name)
+	if n.Implicit {
+		return
+	}
+	ctx.Write("(")
+	for i, arg := range n.Args {
+		if i > 0 {
+			ctx.Write(", ")
+		}
+		ctx.Restore(arg)
+	}
+	ctx.Write(")")
+}
+
+// YieldItems represents items after YIELD in CALL, with an optional WHERE.
+type YieldItems struct {
+	baseNode
+
+	// Wildcard is true with `YIELD *`, Items would be empty then.
+	Wildcard bool
+	Items    []*YieldItem
+	Where    Expr
+}
+
+func (n *YieldItems) Accept(v Visitor) (Node, bool) {
+	newNode, skip := v.Enter(n)
+	if skip {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*YieldItems)
+	for i, child := range n.Items {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Items[i] = node.(*YieldItem)
+	}
+	if n.Where != nil {
+		node, ok := n.Where.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Where = node.(Expr)
+	}
+	return v.Leave(n)
+}
+
+func (n *YieldItems) Restore(ctx *RestoreContext) {
+	if n.Wildcard {
+		ctx.Write("*")
+	}
+	for i, item := range n.Items {
+		if i > 0 {
+			ctx.Write(", ")
+		}
+		ctx.Restore(item)
+	}
+	if n.Where != nil {
+		ctx.WriteKeyword(" WHERE ")
+		ctx.Restore(n.Where)
+	}
+}
+
+// YieldItem represents a result field yielded by procedure, like `label` or `name AS n`.
+type YieldItem struct {
+	baseNode
+
+	// Field is the procedure result field before AS, it's nil without AS.
+	Field    *SymbolicNameNode
+	Variable *VariableNode
+}
+
+func (n *YieldItem) Accept(v Visitor) (Node, bool) {
+	newNode, skip := v.Enter(n)
+	if skip {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*YieldItem)
+	if n.Field != nil {
+		node, ok := n.Field.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Field = node.(*SymbolicNameNode)
+	}
+	if n.Variable != nil {
+		node, ok := n.Variable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variable = node.(*VariableNode)
+	}
+	return v.Leave(n)
+}
+
+func (n *YieldItem) Restore(ctx *RestoreContext) {
+	if n.Field != nil {
+		ctx.Restore(n.Field)
+		ctx.WriteKeyword(" AS ")
+	}
+	ctx.Restore(n.Variable)
+}
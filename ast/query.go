@@ -31,14 +31,22 @@ type CypherStmt struct {
 func (n *CypherStmt) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*CypherStmt)
-	switch n.Type {
-	case CypherStmtQuery:
-		n.Query.Accept(v)
-	case CypherStmtStandaloneCall:
-		n.StandaloneCall.Accept(v)
+	if n.Query != nil {
+		node, ok := n.Query.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Query = node.(*QueryStmt)
+	}
+	if n.StandaloneCall != nil {
+		node, ok := n.StandaloneCall.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.StandaloneCall = node.(*StandaloneCall)
 	}
 	return v.Leave(n)
 }
@@ -46,7 +54,9 @@ func (n *CypherStmt) Accept(v Visitor) (Node, bool) {
 func (n *CypherStmt) Restore(ctx *RestoreContext) {
 	switch n.Type {
 	case CypherStmtQuery:
-		n.Query.Restore(ctx)
+		ctx.Restore(n.Query)
+	case CypherStmtStandaloneCall:
+		ctx.Restore(n.StandaloneCall)
 	}
 }
 
@@ -59,11 +69,18 @@ type QueryStmt struct {
 func (n *QueryStmt) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*QueryStmt)
-	for _, c := range n.Clauses {
-		c.Accept(v)
+	for i, child := range n.Clauses {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Clauses[i] = node.(Stmt)
 	}
 	return v.Leave(n)
 }
@@ -71,9 +88,9 @@ func (n *QueryStmt) Accept(v Visitor) (Node, bool) {
 func (n *QueryStmt) Restore(ctx *RestoreContext) {
 	for i, c := range n.Clauses {
 		if i > 0 {
-			ctx.Write(" ")
+			ctx.breakLine(" ")
 		}
-		c.Restore(ctx)
+		ctx.Restore(c)
 	}
 }
 
@@ -87,35 +104,71 @@ type UnionClause struct {
 func (n *UnionClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*UnionClause)
-	for _, c := range n.Clauses {
-		c.Accept(v)
+	for i, child := range n.Clauses {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Clauses[i] = node.(Stmt)
 	}
 	return v.Leave(n)
 }
 
 func (n *UnionClause) Restore(ctx *RestoreContext) {
-	ctx.WriteKeyword("UNION ")
+	ctx.WriteKeyword("UNION")
 	if n.All {
-		ctx.WriteKeyword("ALL ")
+		ctx.WriteKeyword(" ALL")
 	}
 	for _, c := range n.Clauses {
-		c.Restore(ctx)
+		ctx.breakLine(" ")
+		ctx.Restore(c)
 	}
 }
 
+// StandaloneCall represents a CALL statement which is not a part of query,
+// e.g. `CALL db.labels() YIELD label`.
 type StandaloneCall struct {
-	baseNode
+	baseStmt
+
+	Procedure *ProcedureInvocation
+	// Yield is nil without YIELD
+	Yield *YieldItems
 }
 
 func (n *StandaloneCall) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*StandaloneCall)
-	// TODO
+	if n.Procedure != nil {
+		node, ok := n.Procedure.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Procedure = node.(*ProcedureInvocation)
+	}
+	if n.Yield != nil {
+		node, ok := n.Yield.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Yield = node.(*YieldItems)
+	}
 	return v.Leave(n)
 }
+
+func (n *StandaloneCall) Restore(ctx *RestoreContext) {
+	ctx.WriteKeyword("CALL ")
+	ctx.Restore(n.Procedure)
+	if n.Yield != nil {
+		ctx.WriteKeyword(" YIELD ")
+		ctx.Restore(n.Yield)
+	}
+}
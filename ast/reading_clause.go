@@ -20,28 +20,45 @@ type ReadingClauseType byte
 const (
 	ReadingClauseMatch ReadingClauseType = iota
 	ReadingClauseUnwind
+	ReadingClauseInQueryCall
 )
 
 // ReadingClause represents Reading clause in cypher
 type ReadingClause struct {
 	baseStmt
 
-	Type   ReadingClauseType
-	Match  *MatchClause
-	Unwind *UnwindClause
+	Type        ReadingClauseType
+	Match       *MatchClause
+	Unwind      *UnwindClause
+	InQueryCall *InQueryCallClause
 }
 
 func (n *ReadingClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*ReadingClause)
-	switch n.Type {
-	case ReadingClauseMatch:
-		n.Match.Accept(v)
-	case ReadingClauseUnwind:
-		n.Unwind.Accept(v)
+	if n.Match != nil {
+		node, ok := n.Match.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Match = node.(*MatchClause)
+	}
+	if n.Unwind != nil {
+		node, ok := n.Unwind.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Unwind = node.(*UnwindClause)
+	}
+	if n.InQueryCall != nil {
+		node, ok := n.InQueryCall.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.InQueryCall = node.(*InQueryCallClause)
 	}
 	return v.Leave(n)
 }
@@ -49,9 +66,11 @@ func (n *ReadingClause) Accept(v Visitor) (Node, bool) {
 func (n *ReadingClause) Restore(ctx *RestoreContext) {
 	switch n.Type {
 	case ReadingClauseMatch:
-		n.Match.Restore(ctx)
+		ctx.Restore(n.Match)
 	case ReadingClauseUnwind:
-		n.Unwind.Restore(ctx)
+		ctx.Restore(n.Unwind)
+	case ReadingClauseInQueryCall:
+		ctx.Restore(n.InQueryCall)
 	}
 }
 
@@ -67,26 +86,37 @@ type MatchClause struct {
 func (n *MatchClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*MatchClause)
-	n.Pattern.Accept(v)
+	if n.Pattern != nil {
+		node, ok := n.Pattern.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Pattern = node.(*Pattern)
+	}
 	if n.Where != nil {
-		n.Where.Accept(v)
+		node, ok := n.Where.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Where = node.(Expr)
 	}
 	return v.Leave(n)
 }
 
 func (n *MatchClause) Restore(ctx *RestoreContext) {
 	if n.Optional {
-		ctx.Write("OPTIONAL MATCH ")
+		ctx.WriteKeyword("OPTIONAL MATCH ")
 	} else {
-		ctx.Write("MATCH ")
+		ctx.WriteKeyword("MATCH ")
 	}
-	n.Pattern.Restore(ctx)
+	ctx.Restore(n.Pattern)
 	if n.Where != nil {
-		ctx.WriteKeyword(" WHERE ")
-		n.Where.Restore(ctx)
+		ctx.breakLine(" ")
+		ctx.WriteKeyword("WHERE ")
+		ctx.Restore(n.Where)
 	}
 }
 
@@ -94,25 +124,182 @@ type UnwindClause struct {
 	baseStmt
 
 	Expr     Expr
-	Variable *SymbolicNameNode
+	Variable *VariableNode
 }
 
 func (n *UnwindClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*UnwindClause)
-	n.Expr.Accept(v)
+	if n.Expr != nil {
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
+	}
 	if n.Variable != nil {
-		n.Variable.Accept(v)
+		node, ok := n.Variable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variable = node.(*VariableNode)
 	}
 	return v.Leave(n)
 }
 
 func (n *UnwindClause) Restore(ctx *RestoreContext) {
 	ctx.WriteKeyword("UNWIND ")
-	n.Expr.Restore(ctx)
+	ctx.Restore(n.Expr)
 	ctx.WriteKeyword(" AS ")
-	n.Variable.Restore(ctx)
+	ctx.Restore(n.Variable)
+}
+
+// InQueryCallClause represents CALL clause inside a query,
+// e.g. `CALL db.labels() YIELD label`.
+type InQueryCallClause struct {
+	baseStmt
+
+	Procedure *ProcedureInvocation
+	// Yield is nil without YIELD
+	Yield *YieldItems
+}
+
+func (n *InQueryCallClause) Accept(v Visitor) (Node, bool) {
+	newNode, skip := v.Enter(n)
+	if skip {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*InQueryCallClause)
+	if n.Procedure != nil {
+		node, ok := n.Procedure.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Procedure = node.(*ProcedureInvocation)
+	}
+	if n.Yield != nil {
+		node, ok := n.Yield.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Yield = node.(*YieldItems)
+	}
+	return v.Leave(n)
+}
+
+func (n *InQueryCallClause) Restore(ctx *RestoreContext) {
+	ctx.WriteKeyword("CALL ")
+	ctx.Restore(n.Procedure)
+	if n.Yield != nil {
+		ctx.WriteKeyword(" YIELD ")
+		ctx.Restore(n.Yield)
+	}
+}
+
+// OnErrorType is what CALL { ... } IN TRANSACTIONS does when a transaction fails.
+type OnErrorType byte
+
+// Values of OnErrorType
+const (
+	// OnErrorNone is without ON ERROR, which behaves as OnErrorFail.
+	OnErrorNone OnErrorType = iota
+	OnErrorContinue
+	OnErrorBreak
+	OnErrorFail
+)
+
+// SubqueryClause represents CALL clause with a subquery,
+// e.g. `CALL { WITH row CREATE (:Person {name: row.name}) } IN TRANSACTIONS OF 1000 ROWS`.
+type SubqueryClause struct {
+	baseStmt
+
+	// ImportingWith is the leading `WITH` of the subquery which only imports variables,
+	// such as `WITH row`, it's nil if there isn't one and is not in Query.
+	// It's also nil if Query is a UNION, whose branches keep their own importing `WITH`.
+	ImportingWith *WithClause
+	Query         *QueryStmt
+	// InTransactions is true with IN TRANSACTIONS,
+	// Concurrency and BatchSize are nil without `n CONCURRENT` and `OF n ROWS`.
+	// `OF n ROW` is the same as `OF n ROWS`, and is restored as `OF n ROWS`.
+	InTransactions bool
+	Concurrency    Expr
+	BatchSize      Expr
+	OnError        OnErrorType
+}
+
+func (n *SubqueryClause) Accept(v Visitor) (Node, bool) {
+	newNode, skip := v.Enter(n)
+	if skip {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*SubqueryClause)
+	if n.ImportingWith != nil {
+		node, ok := n.ImportingWith.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.ImportingWith = node.(*WithClause)
+	}
+	if n.Query != nil {
+		node, ok := n.Query.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Query = node.(*QueryStmt)
+	}
+	if n.Concurrency != nil {
+		node, ok := n.Concurrency.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Concurrency = node.(Expr)
+	}
+	if n.BatchSize != nil {
+		node, ok := n.BatchSize.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.BatchSize = node.(Expr)
+	}
+	return v.Leave(n)
+}
+
+func (n *SubqueryClause) Restore(ctx *RestoreContext) {
+	ctx.WriteKeyword("CALL")
+	ctx.Write(" {")
+	ctx.withIndent(func() {
+		if n.ImportingWith != nil {
+			ctx.breakLine(" ")
+			ctx.Restore(n.ImportingWith)
+		}
+		ctx.breakLine(" ")
+		ctx.Restore(n.Query)
+	})
+	ctx.breakLine(" ")
+	ctx.Write("}")
+	if !n.InTransactions {
+		return
+	}
+	ctx.WriteKeyword(" IN ")
+	if n.Concurrency != nil {
+		ctx.Restore(n.Concurrency)
+		ctx.WriteKeyword(" CONCURRENT ")
+	}
+	ctx.WriteKeyword("TRANSACTIONS")
+	if n.BatchSize != nil {
+		ctx.WriteKeyword(" OF ")
+		ctx.Restore(n.BatchSize)
+		ctx.WriteKeyword(" ROWS")
+	}
+	switch n.OnError {
+	case OnErrorContinue:
+		ctx.WriteKeyword(" ON ERROR CONTINUE")
+	case OnErrorBreak:
+		ctx.WriteKeyword(" ON ERROR BREAK")
+	case OnErrorFail:
+		ctx.WriteKeyword(" ON ERROR FAIL")
+	}
 }
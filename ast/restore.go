@@ -16,42 +16,459 @@ package ast
 import (
 	"fmt"
 	"io"
+	"math"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
+// RestoreFlags controls the style of restored cypher.
+type RestoreFlags uint64
+
+// RestoreFlags values, the flags of the same kind are listed by precedence.
+const (
+	// RestoreKeywordUppercase writes keywords in upper case.
+	RestoreKeywordUppercase RestoreFlags = 1 << iota
+	// RestoreKeywordLowercase writes keywords in lower case.
+	RestoreKeywordLowercase
+	// RestoreKeywordAsWritten writes keywords as they are written in the parsed cypher,
+	// keywords that can't be found in the source are written in upper case.
+	RestoreKeywordAsWritten
+
+	// RestoreNameBackquotes backquotes variables and property keys even if they don't need it.
+	// Other names, such as labels and function names, are only backquoted if needed.
+	RestoreNameBackquotes
+
+	// RestoreStringSingleQuotes quotes string literals with single quotes.
+	RestoreStringSingleQuotes
+	// RestoreStringDoubleQuotes quotes string literals with double quotes.
+	RestoreStringDoubleQuotes
+
+	// RestoreFloatShortest writes doubles with the fewest digits that parse back to the same value,
+	// instead of 6 digits after the decimal point, which may lose precision.
+	RestoreFloatShortest
+)
+
+// DefaultRestoreFlags is the flags used by NewRestoreContext and NewPrettyRestoreContext.
+// Cypher restored with it is parsed back into the same ast.
+const DefaultRestoreFlags = RestoreKeywordUppercase | RestoreNameBackquotes | RestoreStringSingleQuotes | RestoreFloatShortest
+
+// Has returns true if all the flags in flag are set.
+func (f RestoreFlags) Has(flag RestoreFlags) bool {
+	return f&flag == flag
+}
+
 // RestoreContext is used for restore Cypher
 type RestoreContext struct {
+	// Flags controls the style of restored cypher, it's DefaultRestoreFlags if not changed.
+	Flags RestoreFlags
+
 	w io.Writer
+	// newline is set after a line comment, which must be ended before anything else is written.
+	newline bool
+	// spaces are the trailing spaces written, which are dropped if a line break follows.
+	spaces string
+	column int
+
+	// pretty is set for formatting, see NewPrettyRestoreContext.
+	pretty bool
+	indent string
+	width  int
+	depth  int
+	// wrapping is set if the current group doesn't fit in the line.
+	wrapping bool
+	// chained is set before restoring the left operand of a logical operator
+	// if it's the same operator, which continues the group of the chain.
+	chained bool
+
+	// sources are the nodes being restored, used by RestoreKeywordAsWritten.
+	sources []restoreSource
 }
 
-// NewRestoreContext will create a RestoreContext
+// restoreSource is a node being restored, cursor is the offset in the text of node
+// after which the keywords are searched, the restored children are skipped.
+type restoreSource struct {
+	node   Node
+	cursor int
+}
+
+// NewRestoreContext will create a RestoreContext, which restores cypher in one line.
 func NewRestoreContext(w io.Writer) *RestoreContext {
 	return &RestoreContext{
-		w: w,
+		Flags: DefaultRestoreFlags,
+		w:     w,
+	}
+}
+
+// NewPrettyRestoreContext will create a RestoreContext for formatting cypher.
+// Each clause starts on a new line. Lists, patterns and expressions longer than width
+// are broken into lines, and the continuation lines are indented with indent.
+func NewPrettyRestoreContext(w io.Writer, indent string, width int) *RestoreContext {
+	return &RestoreContext{
+		Flags:  DefaultRestoreFlags,
+		w:      w,
+		pretty: true,
+		indent: indent,
+		width:  width,
 	}
 }
 
 // Write writes plain text into io.Writer.
 func (c *RestoreContext) Write(v ...interface{}) {
-	fmt.Fprint(c.w, v...)
+	c.write(fmt.Sprint(v...))
 }
 
-// WriteIdent writes an identifier with backquote into io.Writer.
+// WriteIdent writes an identifier into io.Writer.
+// It's backquoted if RestoreNameBackquotes is set or the identifier needs it.
 func (c *RestoreContext) WriteIdent(s string) {
-	fmt.Fprint(c.w, "`", s, "`")
+	if c.Flags.Has(RestoreNameBackquotes) {
+		c.write(quoteName(s))
+		return
+	}
+	c.WriteName(s)
 }
 
-// WriteKeyword writes s with full upper case into io.Writer.
+// WriteName writes a name into io.Writer, which is backquoted only if needed.
+func (c *RestoreContext) WriteName(s string) {
+	if NeedsQuote(s) {
+		s = quoteName(s)
+	}
+	c.write(s)
+}
+
+// WriteKeyword writes the keywords in s into io.Writer, the case of which is controlled by Flags.
+// Characters other than letters in s are written as they are.
 func (c *RestoreContext) WriteKeyword(s string) {
-	fmt.Fprint(c.w, strings.ToUpper(s))
+	var b strings.Builder
+	for len(s) > 0 {
+		i := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) })
+		if i < 0 {
+			i = len(s)
+		}
+		if i == 0 {
+			i = strings.IndexFunc(s, unicode.IsLetter)
+			if i < 0 {
+				i = len(s)
+			}
+			b.WriteString(s[:i])
+		} else {
+			b.WriteString(c.keyword(s[:i]))
+		}
+		s = s[i:]
+	}
+	c.write(b.String())
 }
 
-// WriteString writes single-quoted string literal into io.Writer.
+// WriteString writes a string literal into io.Writer.
+// It's quoted with double quotes if RestoreStringDoubleQuotes is set, otherwise single quotes.
 func (c *RestoreContext) WriteString(s string) {
-	fmt.Fprint(c.w, "'", s, "'")
+	quote := '\''
+	if c.Flags.Has(RestoreStringDoubleQuotes) {
+		quote = '"'
+	}
+	c.write(quoteString(s, quote))
+}
+
+// WriteFloat writes a double literal into io.Writer.
+// It's written with the fewest digits if RestoreFloatShortest is set, otherwise with 6 digits after the decimal point.
+// Infinities and NaN, which have no literals in cypher, are written as divisions by zero, e.g. `(1.0 / 0.0)`.
+func (c *RestoreContext) WriteFloat(f float64) {
+	switch {
+	case math.IsNaN(f):
+		c.write("(0.0 / 0.0)")
+		return
+	case math.IsInf(f, 1):
+		c.write("(1.0 / 0.0)")
+		return
+	case math.IsInf(f, -1):
+		c.write("(-1.0 / 0.0)")
+		return
+	}
+	if !c.Flags.Has(RestoreFloatShortest) {
+		c.write(strconv.FormatFloat(f, 'f', 6, 64))
+		return
+	}
+	c.write(formatFloat(f))
 }
 
 // Writef writes values with format into io.Writer.
 func (c *RestoreContext) Writef(format string, v ...interface{}) {
-	fmt.Fprintf(c.w, format, v...)
+	c.write(fmt.Sprintf(format, v...))
+}
+
+// Restore restores n along with its comments.
+func (c *RestoreContext) Restore(n Node) {
+	comments := n.Comments()
+	for _, comment := range comments.Leading {
+		c.breakOwnLine(comment)
+		c.write(comment.Text)
+		if comment.IsBlock() {
+			c.write(" ")
+		} else {
+			c.newline = true
+		}
+	}
+	if c.Flags.Has(RestoreKeywordAsWritten) {
+		c.restoreSource(n)
+	} else {
+		n.Restore(c)
+	}
+	for _, comment := range comments.Trailing {
+		c.breakOwnLine(comment)
+		c.write(" " + comment.Text)
+		if !comment.IsBlock() {
+			c.newline = true
+		}
+	}
+}
+
+// breakOwnLine starts a new line before comment if it's on its own line in the source
+// and something other than indentation is written on the current line.
+func (c *RestoreContext) breakOwnLine(comment *Comment) {
+	if comment.OwnLine && c.column > c.depth*utf8.RuneCountInString(c.indent) {
+		c.newline = true
+	}
+}
+
+// restoreSource restores n and moves the cursor of the parent node past n.
+func (c *RestoreContext) restoreSource(n Node) {
+	c.sources = append(c.sources, restoreSource{node: n})
+	n.Restore(c)
+	c.sources = c.sources[:len(c.sources)-1]
+	if len(c.sources) == 0 || n.Text() == "" {
+		return
+	}
+	parent := &c.sources[len(c.sources)-1]
+	end := n.End().Offset - parent.node.Start().Offset
+	if end > parent.cursor && end <= len(parent.node.Text()) {
+		parent.cursor = end
+	}
+}
+
+// keyword returns word in the case controlled by Flags.
+func (c *RestoreContext) keyword(word string) string {
+	switch {
+	case c.Flags.Has(RestoreKeywordAsWritten):
+		if len(c.sources) > 0 {
+			source := &c.sources[len(c.sources)-1]
+			text := source.node.Text()
+			if i := indexWord(text[source.cursor:], word); i >= 0 {
+				i += source.cursor
+				source.cursor = i + len(word)
+				return text[i:source.cursor]
+			}
+		}
+		return strings.ToUpper(word)
+	case c.Flags.Has(RestoreKeywordLowercase):
+		return strings.ToLower(word)
+	default:
+		return strings.ToUpper(word)
+	}
+}
+
+// breakLine starts a new line when formatting, otherwise writes sep.
+func (c *RestoreContext) breakLine(sep string) {
+	if !c.pretty {
+		c.write(sep)
+		return
+	}
+	c.newline = false
+	c.spaces = ""
+	c.lineBreak()
+}
+
+// softBreak starts a new line if the current group is wrapping, otherwise writes sep.
+func (c *RestoreContext) softBreak(sep string) {
+	if !c.wrapping {
+		c.write(sep)
+		return
+	}
+	c.breakLine(sep)
+}
+
+// group restores a construct, the soft breaks in which break lines
+// if the construct doesn't fit in the line.
+func (c *RestoreContext) group(restore func()) {
+	wrapping := c.wrapping
+	c.wrapping = c.pretty && !c.fits(restore)
+	restore()
+	c.wrapping = wrapping
+}
+
+// withIndent restores with one more level of indentation for the new lines.
+func (c *RestoreContext) withIndent(restore func()) {
+	c.depth++
+	restore()
+	c.depth--
+}
+
+// fits returns true if the output of restore fits in the rest of current line.
+func (c *RestoreContext) fits(restore func()) bool {
+	saved := *c
+	sources := append([]restoreSource(nil), c.sources...)
+	var b strings.Builder
+	c.w, c.pretty, c.wrapping, c.newline, c.spaces = &b, false, false, false, ""
+	restore()
+	*c = saved
+	c.sources = sources
+	column := c.column + len(c.spaces)
+	if c.newline {
+		column = c.depth * utf8.RuneCountInString(c.indent)
+	}
+	s := strings.TrimRight(b.String(), " ")
+	return !strings.Contains(s, "\n") && column+utf8.RuneCountInString(s) <= c.width
+}
+
+// restoreList restores n items separated by ", " between open and close.
+// If the list doesn't fit in the line, each item is on its own line.
+func (c *RestoreContext) restoreList(open, close string, n int, item func(i int)) {
+	c.group(func() {
+		c.write(open)
+		c.withIndent(func() {
+			c.softBreak("")
+			for i := 0; i < n; i++ {
+				if i > 0 {
+					c.write(",")
+					c.softBreak(" ")
+				}
+				item(i)
+			}
+		})
+		if close != "" {
+			c.softBreak("")
+			c.write(close)
+		}
+	})
+}
+
+func (c *RestoreContext) lineBreak() {
+	c.emit("\n" + strings.Repeat(c.indent, c.depth))
+}
+
+func (c *RestoreContext) write(s string) {
+	if s == "" {
+		return
+	}
+	if c.newline {
+		c.newline = false
+		c.spaces = ""
+		c.lineBreak()
+		s = strings.TrimLeft(s, " ")
+	}
+	trimmed := strings.TrimRight(s, " ")
+	if trimmed == "" {
+		c.spaces += s
+		return
+	}
+	c.emit(c.spaces + trimmed)
+	c.spaces = s[len(trimmed):]
+}
+
+func (c *RestoreContext) emit(s string) {
+	fmt.Fprint(c.w, s)
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		c.column = utf8.RuneCountInString(s[i+1:])
+	} else {
+		c.column += utf8.RuneCountInString(s)
+	}
+}
+
+// keywords are the keywords of cypher, names equal to which must be backquoted.
+var keywords = map[string]bool{}
+
+func init() {
+	for _, keyword := range []string{
+		"ADD", "ALL", "AND", "ANY", "AS", "ASC", "ASCENDING", "BY", "CALL", "CASE", "CONSTRAINT",
+		"CONTAINS", "COUNT", "CREATE", "DELETE", "DESC", "DESCENDING", "DETACH", "DISTINCT", "DO",
+		"DROP", "ELSE", "END", "ENDS", "EXISTS", "EXTRACT", "FALSE", "FILTER", "FOR", "IN", "IS",
+		"LIMIT", "MANDATORY", "MATCH", "MERGE", "NONE", "NOT", "NULL", "OF", "ON", "OPTIONAL", "OR",
+		"ORDER", "REMOVE", "REQUIRE", "RETURN", "SCALAR", "SET", "SINGLE", "SKIP", "STARTS", "THEN",
+		"TRUE", "UNION", "UNIQUE", "UNWIND", "WHEN", "WHERE", "WITH", "XOR", "YIELD",
+	} {
+		keywords[keyword] = true
+	}
+}
+
+// NeedsQuote returns true if name must be backquoted to be parsed as a name,
+// which is the case if it's empty, a keyword or contains characters other than letters, digits and '_'.
+func NeedsQuote(name string) bool {
+	if name == "" || keywords[strings.ToUpper(name)] {
+		return true
+	}
+	for i, r := range name {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteName(name string) string {
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
+
+func quoteString(s string, quote rune) string {
+	var b strings.Builder
+	b.WriteRune(quote)
+	for _, r := range s {
+		switch r {
+		case quote, '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			// the lexer only accepts characters in BMP
+			if r > 0xFFFF {
+				fmt.Fprintf(&b, `\U%08x`, r)
+			} else if unicode.IsControl(r) {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteRune(quote)
+	return b.String()
+}
+
+// formatFloat formats f with the fewest digits, in a form that's parsed as a double by cypher,
+// which requires a '.' or an exponent, and doesn't allow '+' in the exponent.
+func formatFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if strings.Contains(s, "e") {
+		return strings.Replace(s, "e+", "e", 1)
+	}
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
+// indexWord returns the index of the first occurrence of word in s ignoring case,
+// which is not a part of a longer word, or -1 if there is no such occurrence.
+func indexWord(s, word string) int {
+	for i := 0; i+len(word) <= len(s); i++ {
+		if !strings.EqualFold(s[i:i+len(word)], word) {
+			continue
+		}
+		if i > 0 && isWordByte(s[i-1]) || i+len(word) < len(s) && isWordByte(s[i+len(word)]) {
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= 0x80
 }
@@ -24,12 +24,22 @@ type WithClause struct {
 func (n *WithClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*WithClause)
-	n.ReturnBody.Accept(v)
+	if n.ReturnBody != nil {
+		node, ok := n.ReturnBody.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.ReturnBody = node.(*ReturnBody)
+	}
 	if n.Where != nil {
-		n.Where.Accept(v)
+		node, ok := n.Where.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Where = node.(Expr)
 	}
 	return v.Leave(n)
 }
@@ -39,10 +49,11 @@ func (n *WithClause) Restore(ctx *RestoreContext) {
 	if n.Distinct {
 		ctx.WriteKeyword("DISTINCT ")
 	}
-	n.ReturnBody.Restore(ctx)
+	ctx.Restore(n.ReturnBody)
 	if n.Where != nil {
-		ctx.Write(" ")
-		n.Where.Restore(ctx)
+		ctx.breakLine(" ")
+		ctx.WriteKeyword("WHERE ")
+		ctx.Restore(n.Where)
 	}
 }
 
@@ -56,19 +67,25 @@ type ReturnClause struct {
 func (n *ReturnClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*ReturnClause)
-	n.ReturnBody.Accept(v)
+	if n.ReturnBody != nil {
+		node, ok := n.ReturnBody.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.ReturnBody = node.(*ReturnBody)
+	}
 	return v.Leave(n)
 }
 
 func (n *ReturnClause) Restore(ctx *RestoreContext) {
-	ctx.Write("RETURN ")
+	ctx.WriteKeyword("RETURN ")
 	if n.Distinct {
 		ctx.WriteKeyword("DISTINCT ")
 	}
-	n.ReturnBody.Restore(ctx)
+	ctx.Restore(n.ReturnBody)
 }
 
 type ReturnBody struct {
@@ -83,42 +100,60 @@ type ReturnBody struct {
 func (n *ReturnBody) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*ReturnBody)
-	for _, item := range n.ReturnItems {
-		item.Accept(v)
+	for i, child := range n.ReturnItems {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.ReturnItems[i] = node.(*ReturnItem)
 	}
 	if n.OrderBy != nil {
-		n.OrderBy.Accept(v)
+		node, ok := n.OrderBy.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.OrderBy = node.(*OrderClause)
 	}
 	if n.Skip != nil {
-		n.Skip.Accept(v)
+		node, ok := n.Skip.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Skip = node.(Expr)
 	}
 	if n.Limit != nil {
-		n.Limit.Accept(v)
+		node, ok := n.Limit.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Limit = node.(Expr)
 	}
 	return v.Leave(n)
 }
 
 func (n *ReturnBody) Restore(ctx *RestoreContext) {
-	for i, item := range n.ReturnItems {
-		if i > 0 {
-			ctx.Write(", ")
-		}
-		item.Restore(ctx)
-	}
+	ctx.restoreList("", "", len(n.ReturnItems), func(i int) {
+		ctx.Restore(n.ReturnItems[i])
+	})
 	if n.OrderBy != nil {
-		ctx.Write(" ")
-		n.OrderBy.Restore(ctx)
+		ctx.breakLine(" ")
+		ctx.Restore(n.OrderBy)
 	}
 	if n.Skip != nil {
-		ctx.Write(" ")
-		n.Skip.Restore(ctx)
+		ctx.breakLine(" ")
+		ctx.WriteKeyword("SKIP ")
+		ctx.Restore(n.Skip)
 	}
 	if n.Limit != nil {
-		ctx.Write(" ")
-		n.Limit.Restore(ctx)
+		ctx.breakLine(" ")
+		ctx.WriteKeyword("LIMIT ")
+		ctx.Restore(n.Limit)
 	}
 }
 
@@ -134,14 +169,22 @@ type ReturnItem struct {
 func (n *ReturnItem) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*ReturnItem)
 	if n.Expr != nil {
-		n.Expr.Accept(v)
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
 	}
-	if n.As {
-		n.Variable.Accept(v)
+	if n.Variable != nil {
+		node, ok := n.Variable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variable = node.(*VariableNode)
 	}
 	return v.Leave(n)
 }
@@ -151,10 +194,10 @@ func (n *ReturnItem) Restore(ctx *RestoreContext) {
 		ctx.Write("*")
 		return
 	}
-	n.Expr.Restore(ctx)
+	ctx.Restore(n.Expr)
 	if n.As {
 		ctx.WriteKeyword(" AS ")
-		n.Variable.Restore(ctx)
+		ctx.Restore(n.Variable)
 	}
 }
 
@@ -167,11 +210,18 @@ type OrderClause struct {
 func (n *OrderClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*OrderClause)
-	for _, item := range n.SortItems {
-		item.Accept(v)
+	for i, child := range n.SortItems {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.SortItems[i] = node.(*SortItem)
 	}
 	return v.Leave(n)
 }
@@ -182,7 +232,7 @@ func (n *OrderClause) Restore(ctx *RestoreContext) {
 		if i > 0 {
 			ctx.Write(", ")
 		}
-		item.Restore(ctx)
+		ctx.Restore(item)
 	}
 }
 
@@ -203,15 +253,21 @@ type SortItem struct {
 func (n *SortItem) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*SortItem)
-	n.Expr.Accept(v)
+	if n.Expr != nil {
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
+	}
 	return v.Leave(n)
 }
 
 func (n *SortItem) Restore(ctx *RestoreContext) {
-	n.Expr.Restore(ctx)
+	ctx.Restore(n.Expr)
 	switch n.Type {
 	case SortAscending:
 		ctx.WriteKeyword(" ASC")
@@ -0,0 +1,356 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by gen_typed_visitor.go. DO NOT EDIT.
+
+package ast
+
+// TypedVisitor has a method for each node type, which is called when entering
+// the node, use AdaptTypedVisitor to drive it through Accept.
+// If skipChildren is true, the children of the node aren't visited.
+//
+// Embed BaseTypedVisitor to implement only the methods needed.
+type TypedVisitor interface {
+	VisitPropertyExpr(n *PropertyExpr) (skipChildren bool)
+	VisitBinaryExpr(n *BinaryExpr) (skipChildren bool)
+	VisitUnaryExpr(n *UnaryExpr) (skipChildren bool)
+	VisitPredicationExpr(n *PredicationExpr) (skipChildren bool)
+	VisitStringOperationExpr(n *StringOperationExpr) (skipChildren bool)
+	VisitListOperationExpr(n *ListOperationExpr) (skipChildren bool)
+	VisitNullOperationExpr(n *NullOperationExpr) (skipChildren bool)
+	VisitPropertyOrLabelsExpr(n *PropertyOrLabelsExpr) (skipChildren bool)
+	VisitPropertyLookup(n *PropertyLookup) (skipChildren bool)
+	VisitCaseExpr(n *CaseExpr) (skipChildren bool)
+	VisitCaseAlt(n *CaseAlt) (skipChildren bool)
+	VisitFilterExpr(n *FilterExpr) (skipChildren bool)
+	VisitListComprehension(n *ListComprehension) (skipChildren bool)
+	VisitFunctionInvocation(n *FunctionInvocation) (skipChildren bool)
+	VisitParenExpr(n *ParenExpr) (skipChildren bool)
+	VisitCountAllExpr(n *CountAllExpr) (skipChildren bool)
+	VisitExistsSubqueryExpr(n *ExistsSubqueryExpr) (skipChildren bool)
+	VisitCountSubqueryExpr(n *CountSubqueryExpr) (skipChildren bool)
+	VisitSchemaNameNode(n *SchemaNameNode) (skipChildren bool)
+	VisitSymbolicNameNode(n *SymbolicNameNode) (skipChildren bool)
+	VisitReservedWordNode(n *ReservedWordNode) (skipChildren bool)
+	VisitVariableNode(n *VariableNode) (skipChildren bool)
+	VisitNodeLabelNode(n *NodeLabelNode) (skipChildren bool)
+	VisitParameterNode(n *ParameterNode) (skipChildren bool)
+	VisitLiteralExpr(n *LiteralExpr) (skipChildren bool)
+	VisitNumberLiteral(n *NumberLiteral) (skipChildren bool)
+	VisitMapLiteral(n *MapLiteral) (skipChildren bool)
+	VisitListLiteral(n *ListLiteral) (skipChildren bool)
+	VisitPattern(n *Pattern) (skipChildren bool)
+	VisitPatternPart(n *PatternPart) (skipChildren bool)
+	VisitPatternElement(n *PatternElement) (skipChildren bool)
+	VisitNodePattern(n *NodePattern) (skipChildren bool)
+	VisitRelationshipPattern(n *RelationshipPattern) (skipChildren bool)
+	VisitRelationshipDetail(n *RelationshipDetail) (skipChildren bool)
+	VisitProperties(n *Properties) (skipChildren bool)
+	VisitPatternComprehension(n *PatternComprehension) (skipChildren bool)
+	VisitProcedureInvocation(n *ProcedureInvocation) (skipChildren bool)
+	VisitYieldItems(n *YieldItems) (skipChildren bool)
+	VisitYieldItem(n *YieldItem) (skipChildren bool)
+	VisitCypherStmt(n *CypherStmt) (skipChildren bool)
+	VisitQueryStmt(n *QueryStmt) (skipChildren bool)
+	VisitUnionClause(n *UnionClause) (skipChildren bool)
+	VisitStandaloneCall(n *StandaloneCall) (skipChildren bool)
+	VisitReadingClause(n *ReadingClause) (skipChildren bool)
+	VisitMatchClause(n *MatchClause) (skipChildren bool)
+	VisitUnwindClause(n *UnwindClause) (skipChildren bool)
+	VisitInQueryCallClause(n *InQueryCallClause) (skipChildren bool)
+	VisitSubqueryClause(n *SubqueryClause) (skipChildren bool)
+	VisitWithClause(n *WithClause) (skipChildren bool)
+	VisitReturnClause(n *ReturnClause) (skipChildren bool)
+	VisitReturnBody(n *ReturnBody) (skipChildren bool)
+	VisitReturnItem(n *ReturnItem) (skipChildren bool)
+	VisitOrderClause(n *OrderClause) (skipChildren bool)
+	VisitSortItem(n *SortItem) (skipChildren bool)
+	VisitCreateClause(n *CreateClause) (skipChildren bool)
+	VisitMergeClause(n *MergeClause) (skipChildren bool)
+	VisitMergeAction(n *MergeAction) (skipChildren bool)
+	VisitSetClause(n *SetClause) (skipChildren bool)
+	VisitSetItem(n *SetItem) (skipChildren bool)
+	VisitDeleteClause(n *DeleteClause) (skipChildren bool)
+	VisitRemoveClause(n *RemoveClause) (skipChildren bool)
+	VisitRemoveItem(n *RemoveItem) (skipChildren bool)
+}
+
+// BaseTypedVisitor implements TypedVisitor, all its methods visit the children.
+type BaseTypedVisitor struct{}
+
+func (BaseTypedVisitor) VisitPropertyExpr(n *PropertyExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitBinaryExpr(n *BinaryExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitUnaryExpr(n *UnaryExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitPredicationExpr(n *PredicationExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitStringOperationExpr(n *StringOperationExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitListOperationExpr(n *ListOperationExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitNullOperationExpr(n *NullOperationExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitPropertyOrLabelsExpr(n *PropertyOrLabelsExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitPropertyLookup(n *PropertyLookup) bool { return false }
+
+func (BaseTypedVisitor) VisitCaseExpr(n *CaseExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitCaseAlt(n *CaseAlt) bool { return false }
+
+func (BaseTypedVisitor) VisitFilterExpr(n *FilterExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitListComprehension(n *ListComprehension) bool { return false }
+
+func (BaseTypedVisitor) VisitFunctionInvocation(n *FunctionInvocation) bool { return false }
+
+func (BaseTypedVisitor) VisitParenExpr(n *ParenExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitCountAllExpr(n *CountAllExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitExistsSubqueryExpr(n *ExistsSubqueryExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitCountSubqueryExpr(n *CountSubqueryExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitSchemaNameNode(n *SchemaNameNode) bool { return false }
+
+func (BaseTypedVisitor) VisitSymbolicNameNode(n *SymbolicNameNode) bool { return false }
+
+func (BaseTypedVisitor) VisitReservedWordNode(n *ReservedWordNode) bool { return false }
+
+func (BaseTypedVisitor) VisitVariableNode(n *VariableNode) bool { return false }
+
+func (BaseTypedVisitor) VisitNodeLabelNode(n *NodeLabelNode) bool { return false }
+
+func (BaseTypedVisitor) VisitParameterNode(n *ParameterNode) bool { return false }
+
+func (BaseTypedVisitor) VisitLiteralExpr(n *LiteralExpr) bool { return false }
+
+func (BaseTypedVisitor) VisitNumberLiteral(n *NumberLiteral) bool { return false }
+
+func (BaseTypedVisitor) VisitMapLiteral(n *MapLiteral) bool { return false }
+
+func (BaseTypedVisitor) VisitListLiteral(n *ListLiteral) bool { return false }
+
+func (BaseTypedVisitor) VisitPattern(n *Pattern) bool { return false }
+
+func (BaseTypedVisitor) VisitPatternPart(n *PatternPart) bool { return false }
+
+func (BaseTypedVisitor) VisitPatternElement(n *PatternElement) bool { return false }
+
+func (BaseTypedVisitor) VisitNodePattern(n *NodePattern) bool { return false }
+
+func (BaseTypedVisitor) VisitRelationshipPattern(n *RelationshipPattern) bool { return false }
+
+func (BaseTypedVisitor) VisitRelationshipDetail(n *RelationshipDetail) bool { return false }
+
+func (BaseTypedVisitor) VisitProperties(n *Properties) bool { return false }
+
+func (BaseTypedVisitor) VisitPatternComprehension(n *PatternComprehension) bool { return false }
+
+func (BaseTypedVisitor) VisitProcedureInvocation(n *ProcedureInvocation) bool { return false }
+
+func (BaseTypedVisitor) VisitYieldItems(n *YieldItems) bool { return false }
+
+func (BaseTypedVisitor) VisitYieldItem(n *YieldItem) bool { return false }
+
+func (BaseTypedVisitor) VisitCypherStmt(n *CypherStmt) bool { return false }
+
+func (BaseTypedVisitor) VisitQueryStmt(n *QueryStmt) bool { return false }
+
+func (BaseTypedVisitor) VisitUnionClause(n *UnionClause) bool { return false }
+
+func (BaseTypedVisitor) VisitStandaloneCall(n *StandaloneCall) bool { return false }
+
+func (BaseTypedVisitor) VisitReadingClause(n *ReadingClause) bool { return false }
+
+func (BaseTypedVisitor) VisitMatchClause(n *MatchClause) bool { return false }
+
+func (BaseTypedVisitor) VisitUnwindClause(n *UnwindClause) bool { return false }
+
+func (BaseTypedVisitor) VisitInQueryCallClause(n *InQueryCallClause) bool { return false }
+
+func (BaseTypedVisitor) VisitSubqueryClause(n *SubqueryClause) bool { return false }
+
+func (BaseTypedVisitor) VisitWithClause(n *WithClause) bool { return false }
+
+func (BaseTypedVisitor) VisitReturnClause(n *ReturnClause) bool { return false }
+
+func (BaseTypedVisitor) VisitReturnBody(n *ReturnBody) bool { return false }
+
+func (BaseTypedVisitor) VisitReturnItem(n *ReturnItem) bool { return false }
+
+func (BaseTypedVisitor) VisitOrderClause(n *OrderClause) bool { return false }
+
+func (BaseTypedVisitor) VisitSortItem(n *SortItem) bool { return false }
+
+func (BaseTypedVisitor) VisitCreateClause(n *CreateClause) bool { return false }
+
+func (BaseTypedVisitor) VisitMergeClause(n *MergeClause) bool { return false }
+
+func (BaseTypedVisitor) VisitMergeAction(n *MergeAction) bool { return false }
+
+func (BaseTypedVisitor) VisitSetClause(n *SetClause) bool { return false }
+
+func (BaseTypedVisitor) VisitSetItem(n *SetItem) bool { return false }
+
+func (BaseTypedVisitor) VisitDeleteClause(n *DeleteClause) bool { return false }
+
+func (BaseTypedVisitor) VisitRemoveClause(n *RemoveClause) bool { return false }
+
+func (BaseTypedVisitor) VisitRemoveItem(n *RemoveItem) bool { return false }
+
+// AdaptTypedVisitor returns a Visitor calling the method of v for each node.
+func AdaptTypedVisitor(v TypedVisitor) Visitor {
+	return typedVisitorAdapter{v}
+}
+
+type typedVisitorAdapter struct {
+	v TypedVisitor
+}
+
+func (a typedVisitorAdapter) Enter(n Node) (Node, bool) {
+	switch n := n.(type) {
+	case *PropertyExpr:
+		return n, a.v.VisitPropertyExpr(n)
+	case *BinaryExpr:
+		return n, a.v.VisitBinaryExpr(n)
+	case *UnaryExpr:
+		return n, a.v.VisitUnaryExpr(n)
+	case *PredicationExpr:
+		return n, a.v.VisitPredicationExpr(n)
+	case *StringOperationExpr:
+		return n, a.v.VisitStringOperationExpr(n)
+	case *ListOperationExpr:
+		return n, a.v.VisitListOperationExpr(n)
+	case *NullOperationExpr:
+		return n, a.v.VisitNullOperationExpr(n)
+	case *PropertyOrLabelsExpr:
+		return n, a.v.VisitPropertyOrLabelsExpr(n)
+	case *PropertyLookup:
+		return n, a.v.VisitPropertyLookup(n)
+	case *CaseExpr:
+		return n, a.v.VisitCaseExpr(n)
+	case *CaseAlt:
+		return n, a.v.VisitCaseAlt(n)
+	case *FilterExpr:
+		return n, a.v.VisitFilterExpr(n)
+	case *ListComprehension:
+		return n, a.v.VisitListComprehension(n)
+	case *FunctionInvocation:
+		return n, a.v.VisitFunctionInvocation(n)
+	case *ParenExpr:
+		return n, a.v.VisitParenExpr(n)
+	case *CountAllExpr:
+		return n, a.v.VisitCountAllExpr(n)
+	case *ExistsSubqueryExpr:
+		return n, a.v.VisitExistsSubqueryExpr(n)
+	case *CountSubqueryExpr:
+		return n, a.v.VisitCountSubqueryExpr(n)
+	case *SchemaNameNode:
+		return n, a.v.VisitSchemaNameNode(n)
+	case *SymbolicNameNode:
+		return n, a.v.VisitSymbolicNameNode(n)
+	case *ReservedWordNode:
+		return n, a.v.VisitReservedWordNode(n)
+	case *VariableNode:
+		return n, a.v.VisitVariableNode(n)
+	case *NodeLabelNode:
+		return n, a.v.VisitNodeLabelNode(n)
+	case *ParameterNode:
+		return n, a.v.VisitParameterNode(n)
+	case *LiteralExpr:
+		return n, a.v.VisitLiteralExpr(n)
+	case *NumberLiteral:
+		return n, a.v.VisitNumberLiteral(n)
+	case *MapLiteral:
+		return n, a.v.VisitMapLiteral(n)
+	case *ListLiteral:
+		return n, a.v.VisitListLiteral(n)
+	case *Pattern:
+		return n, a.v.VisitPattern(n)
+	case *PatternPart:
+		return n, a.v.VisitPatternPart(n)
+	case *PatternElement:
+		return n, a.v.VisitPatternElement(n)
+	case *NodePattern:
+		return n, a.v.VisitNodePattern(n)
+	case *RelationshipPattern:
+		return n, a.v.VisitRelationshipPattern(n)
+	case *RelationshipDetail:
+		return n, a.v.VisitRelationshipDetail(n)
+	case *Properties:
+		return n, a.v.VisitProperties(n)
+	case *PatternComprehension:
+		return n, a.v.VisitPatternComprehension(n)
+	case *ProcedureInvocation:
+		return n, a.v.VisitProcedureInvocation(n)
+	case *YieldItems:
+		return n, a.v.VisitYieldItems(n)
+	case *YieldItem:
+		return n, a.v.VisitYieldItem(n)
+	case *CypherStmt:
+		return n, a.v.VisitCypherStmt(n)
+	case *QueryStmt:
+		return n, a.v.VisitQueryStmt(n)
+	case *UnionClause:
+		return n, a.v.VisitUnionClause(n)
+	case *StandaloneCall:
+		return n, a.v.VisitStandaloneCall(n)
+	case *ReadingClause:
+		return n, a.v.VisitReadingClause(n)
+	case *MatchClause:
+		return n, a.v.VisitMatchClause(n)
+	case *UnwindClause:
+		return n, a.v.VisitUnwindClause(n)
+	case *InQueryCallClause:
+		return n, a.v.VisitInQueryCallClause(n)
+	case *SubqueryClause:
+		return n, a.v.VisitSubqueryClause(n)
+	case *WithClause:
+		return n, a.v.VisitWithClause(n)
+	case *ReturnClause:
+		return n, a.v.VisitReturnClause(n)
+	case *ReturnBody:
+		return n, a.v.VisitReturnBody(n)
+	case *ReturnItem:
+		return n, a.v.VisitReturnItem(n)
+	case *OrderClause:
+		return n, a.v.VisitOrderClause(n)
+	case *SortItem:
+		return n, a.v.VisitSortItem(n)
+	case *CreateClause:
+		return n, a.v.VisitCreateClause(n)
+	case *MergeClause:
+		return n, a.v.VisitMergeClause(n)
+	case *MergeAction:
+		return n, a.v.VisitMergeAction(n)
+	case *SetClause:
+		return n, a.v.VisitSetClause(n)
+	case *SetItem:
+		return n, a.v.VisitSetItem(n)
+	case *DeleteClause:
+		return n, a.v.VisitDeleteClause(n)
+	case *RemoveClause:
+		return n, a.v.VisitRemoveClause(n)
+	case *RemoveItem:
+		return n, a.v.VisitRemoveItem(n)
+	}
+	return n, false
+}
+
+func (a typedVisitorAdapter) Leave(n Node) (Node, bool) {
+	return n, true
+}
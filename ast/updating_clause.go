@@ -23,16 +23,22 @@ type CreateClause struct {
 func (n *CreateClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*CreateClause)
-	n.Pattern.Accept(v)
+	if n.Pattern != nil {
+		node, ok := n.Pattern.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Pattern = node.(*Pattern)
+	}
 	return v.Leave(n)
 }
 
 func (n *CreateClause) Restore(ctx *RestoreContext) {
 	ctx.WriteKeyword("CREATE ")
-	n.Pattern.Restore(ctx)
+	ctx.Restore(n.Pattern)
 }
 
 // MergeClause represents MERGE clause node
@@ -46,24 +52,35 @@ type MergeClause struct {
 func (n *MergeClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*MergeClause)
-	n.PatternPart.Accept(v)
-	for _, action := range n.MergeActions {
-		action.Accept(v)
+	if n.PatternPart != nil {
+		node, ok := n.PatternPart.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.PatternPart = node.(*PatternPart)
+	}
+	for i, child := range n.MergeActions {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.MergeActions[i] = node.(*MergeAction)
 	}
 	return v.Leave(n)
 }
 
 func (n *MergeClause) Restore(ctx *RestoreContext) {
 	ctx.WriteKeyword("MERGE ")
-	n.PatternPart.Restore(ctx)
-	for i, action := range n.MergeActions {
-		if i > 0 {
-			ctx.Write(" ")
-		}
-		action.Restore(ctx)
+	ctx.Restore(n.PatternPart)
+	for _, action := range n.MergeActions {
+		ctx.breakLine(" ")
+		ctx.Restore(action)
 	}
 }
 
@@ -86,10 +103,16 @@ type MergeAction struct {
 func (n *MergeAction) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*MergeAction)
-	n.Set.Accept(v)
+	if n.Set != nil {
+		node, ok := n.Set.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Set = node.(*SetClause)
+	}
 	return v.Leave(n)
 }
 
@@ -100,7 +123,7 @@ func (n *MergeAction) Restore(ctx *RestoreContext) {
 	case MergeActionMatch:
 		ctx.WriteKeyword("ON MATCH ")
 	}
-	n.Set.Restore(ctx)
+	ctx.Restore(n.Set)
 }
 
 // SetClause represents SET clause node
@@ -113,11 +136,18 @@ type SetClause struct {
 func (n *SetClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*SetClause)
-	for _, item := range n.SetItems {
-		item.Accept(v)
+	for i, child := range n.SetItems {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.SetItems[i] = node.(*SetItem)
 	}
 	return v.Leave(n)
 }
@@ -125,10 +155,11 @@ func (n *SetClause) Accept(v Visitor) (Node, bool) {
 func (n *SetClause) Restore(ctx *RestoreContext) {
 	ctx.WriteKeyword("SET ")
 	for i, item := range n.SetItems {
+		// the grammar doesn't allow spaces around ',' between set items
 		if i > 0 {
-			ctx.Write(", ")
+			ctx.Write(",")
 		}
-		item.Restore(ctx)
+		ctx.Restore(item)
 	}
 }
 
@@ -154,23 +185,39 @@ type SetItem struct {
 func (n *SetItem) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*SetItem)
-	switch n.Type {
-	case SetItemProperty:
-		n.Property.Accept(v)
-		n.Expr.Accept(v)
-
-	case SetItemVariableAssignment, SetItemVariableIncrement:
-		n.Variable.Accept(v)
-		n.Expr.Accept(v)
-
-	case SetItemVariableLabel:
-		n.Variable.Accept(v)
-		for _, label := range n.Labels {
-			label.Accept(v)
+	if n.Property != nil {
+		node, ok := n.Property.Accept(v)
+		if !ok {
+			return n, false
 		}
+		n.Property = node.(*PropertyExpr)
+	}
+	if n.Variable != nil {
+		node, ok := n.Variable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variable = node.(*VariableNode)
+	}
+	if n.Expr != nil {
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(Expr)
+	}
+	for i, child := range n.Labels {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Labels[i] = node.(*NodeLabelNode)
 	}
 	return v.Leave(n)
 }
@@ -178,21 +225,21 @@ func (n *SetItem) Accept(v Visitor) (Node, bool) {
 func (n *SetItem) Restore(ctx *RestoreContext) {
 	switch n.Type {
 	case SetItemProperty:
-		n.Property.Restore(ctx)
+		ctx.Restore(n.Property)
 		ctx.Write(" = ")
-		n.Expr.Restore(ctx)
+		ctx.Restore(n.Expr)
 	case SetItemVariableAssignment:
-		n.Variable.Restore(ctx)
+		ctx.Restore(n.Variable)
 		ctx.Write(" = ")
-		n.Expr.Restore(ctx)
+		ctx.Restore(n.Expr)
 	case SetItemVariableIncrement:
-		n.Variable.Restore(ctx)
+		ctx.Restore(n.Variable)
 		ctx.Write(" += ")
-		n.Expr.Restore(ctx)
+		ctx.Restore(n.Expr)
 	case SetItemVariableLabel:
-		n.Variable.Restore(ctx)
+		ctx.Restore(n.Variable)
 		for _, label := range n.Labels {
-			label.Restore(ctx)
+			ctx.Restore(label)
 		}
 	}
 }
@@ -207,11 +254,18 @@ type DeleteClause struct {
 func (n *DeleteClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*DeleteClause)
-	for _, expr := range n.Exprs {
-		expr.Accept(v)
+	for i, child := range n.Exprs {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Exprs[i] = node.(Expr)
 	}
 	return v.Leave(n)
 }
@@ -225,7 +279,7 @@ func (n *DeleteClause) Restore(ctx *RestoreContext) {
 		if i > 0 {
 			ctx.Write(", ")
 		}
-		expr.Restore(ctx)
+		ctx.Restore(expr)
 	}
 }
 
@@ -238,11 +292,18 @@ type RemoveClause struct {
 func (n *RemoveClause) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*RemoveClause)
-	for _, item := range n.RemoveItems {
-		item.Accept(v)
+	for i, child := range n.RemoveItems {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.RemoveItems[i] = node.(*RemoveItem)
 	}
 	return v.Leave(n)
 }
@@ -253,7 +314,7 @@ func (n *RemoveClause) Restore(ctx *RestoreContext) {
 		if i > 0 {
 			ctx.Write(", ")
 		}
-		item.Restore(ctx)
+		ctx.Restore(item)
 	}
 }
 
@@ -276,18 +337,32 @@ type RemoveItem struct {
 func (n *RemoveItem) Accept(v Visitor) (Node, bool) {
 	newNode, skip := v.Enter(n)
 	if skip {
-		return v.Leave(n)
+		return v.Leave(newNode)
 	}
 	n = newNode.(*RemoveItem)
-	switch n.Type {
-	case RemoveItemVariable:
-		n.Variable.Accept(v)
-		for _, label := range n.Labels {
-			label.Accept(v)
+	if n.Variable != nil {
+		node, ok := n.Variable.Accept(v)
+		if !ok {
+			return n, false
 		}
-
-	case RemoveItemProperty:
-		n.Property.Accept(v)
+		n.Variable = node.(*VariableNode)
+	}
+	for i, child := range n.Labels {
+		if child == nil {
+			continue
+		}
+		node, ok := child.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Labels[i] = node.(*NodeLabelNode)
+	}
+	if n.Property != nil {
+		node, ok := n.Property.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Property = node.(*PropertyExpr)
 	}
 	return v.Leave(n)
 }
@@ -295,11 +370,11 @@ func (n *RemoveItem) Accept(v Visitor) (Node, bool) {
 func (n *RemoveItem) Restore(ctx *RestoreContext) {
 	switch n.Type {
 	case RemoveItemVariable:
-		n.Variable.Restore(ctx)
+		ctx.Restore(n.Variable)
 		for _, label := range n.Labels {
-			label.Restore(ctx)
+			ctx.Restore(label)
 		}
 	case RemoveItemProperty:
-		n.Property.Restore(ctx)
+		ctx.Restore(n.Property)
 	}
 }
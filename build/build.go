@@ -0,0 +1,460 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package build builds cypher queries as ast trees, e.g.
+//
+//	build.Match(build.Node("n").Labels("Person")).
+//		Where(build.Prop("n", "age").Gt(build.Param("min"))).
+//		Return("n")
+//
+// Values are written as literals and names are backquoted if needed, so nothing
+// passed to the builder can change the structure of the query. The cypher returned
+// by Query.Cypher is parsed back into the tree returned by Query.Build.
+package build
+
+import (
+	"strings"
+
+	"github.com/leiysky/parser/ast"
+)
+
+// Query is a query being built, its methods append clauses to the query and return it.
+// The first error is kept and returned by Build.
+type Query struct {
+	clauses []ast.Stmt
+	unions  []*ast.UnionClause
+	err     error
+}
+
+// Match starts a query with MATCH.
+func Match(patterns ...*Pattern) *Query { return new(Query).Match(patterns...) }
+
+// OptionalMatch starts a query with OPTIONAL MATCH.
+func OptionalMatch(patterns ...*Pattern) *Query { return new(Query).OptionalMatch(patterns...) }
+
+// Unwind starts a query with UNWIND.
+func Unwind(list interface{}, as string) *Query { return new(Query).Unwind(list, as) }
+
+// With starts a query with WITH.
+func With(items ...interface{}) *Query { return new(Query).With(items...) }
+
+// Create starts a query with CREATE.
+func Create(patterns ...*Pattern) *Query { return new(Query).Create(patterns...) }
+
+// Merge starts a query with MERGE.
+func Merge(pattern *Pattern) *Query { return new(Query).Merge(pattern) }
+
+// Return starts a query with RETURN.
+func Return(items ...interface{}) *Query { return new(Query).Return(items...) }
+
+func (q *Query) fail(err error) *Query {
+	q.err = firstErr(q.err, err)
+	return q
+}
+
+// current returns the clauses of the last single query.
+func (q *Query) current() *[]ast.Stmt {
+	if len(q.unions) > 0 {
+		return &q.unions[len(q.unions)-1].Clauses
+	}
+	return &q.clauses
+}
+
+func (q *Query) add(clause ast.Stmt) *Query {
+	clauses := q.current()
+	*clauses = append(*clauses, clause)
+	return q
+}
+
+func (q *Query) last() ast.Stmt {
+	clauses := *q.current()
+	if len(clauses) == 0 {
+		return nil
+	}
+	return clauses[len(clauses)-1]
+}
+
+func (q *Query) pattern(patterns []*Pattern) *ast.Pattern {
+	if len(patterns) == 0 {
+		q.fail(errorf("empty pattern"))
+	}
+	pattern := &ast.Pattern{}
+	for _, p := range patterns {
+		q.fail(p.err)
+		pattern.Parts = append(pattern.Parts, p.part)
+	}
+	return pattern
+}
+
+// Match appends MATCH.
+func (q *Query) Match(patterns ...*Pattern) *Query {
+	return q.add(&ast.MatchClause{Pattern: q.pattern(patterns)})
+}
+
+// OptionalMatch appends OPTIONAL MATCH.
+func (q *Query) OptionalMatch(patterns ...*Pattern) *Query {
+	return q.add(&ast.MatchClause{Optional: true, Pattern: q.pattern(patterns)})
+}
+
+// Where sets the condition of the last MATCH or WITH, it's joined with AND if there is one.
+func (q *Query) Where(cond interface{}) *Query {
+	e := Lit(cond)
+	if e.err != nil {
+		return q.fail(e.err)
+	}
+	var where *ast.Expr
+	switch clause := q.last().(type) {
+	case *ast.MatchClause:
+		where = &clause.Where
+	case *ast.WithClause:
+		where = &clause.Where
+	default:
+		return q.fail(errorf("WHERE must follow MATCH or WITH"))
+	}
+	if *where != nil {
+		e = Expr{node: *where}.And(e)
+	}
+	*where = e.node
+	return q
+}
+
+// Unwind appends UNWIND list AS variable.
+func (q *Query) Unwind(list interface{}, as string) *Query {
+	e := Lit(list)
+	q.fail(e.err)
+	return q.add(&ast.UnwindClause{Expr: e.node, Variable: variableNode(as)})
+}
+
+// With appends WITH, see Return for the items.
+func (q *Query) With(items ...interface{}) *Query {
+	return q.add(&ast.WithClause{ReturnBody: q.returnBody(items)})
+}
+
+// WithDistinct appends WITH DISTINCT.
+func (q *Query) WithDistinct(items ...interface{}) *Query {
+	return q.add(&ast.WithClause{Distinct: true, ReturnBody: q.returnBody(items)})
+}
+
+// Return appends RETURN. An item is a variable name, "*" for all the variables,
+// an Expr or an alias returned by As.
+func (q *Query) Return(items ...interface{}) *Query {
+	return q.add(&ast.ReturnClause{ReturnBody: q.returnBody(items)})
+}
+
+// ReturnDistinct appends RETURN DISTINCT.
+func (q *Query) ReturnDistinct(items ...interface{}) *Query {
+	return q.add(&ast.ReturnClause{Distinct: true, ReturnBody: q.returnBody(items)})
+}
+
+// Alias is an item of RETURN or WITH with AS.
+type Alias struct {
+	expr  Expr
+	alias string
+}
+
+// As returns the item `v AS alias`, v is a variable name or an Expr.
+func As(v interface{}, alias string) Alias {
+	return Alias{expr: projection(v), alias: alias}
+}
+
+// projection returns v as an expression in RETURN, WITH and ORDER BY, in which strings are variables.
+func projection(v interface{}) Expr {
+	if name, ok := v.(string); ok {
+		return Var(name)
+	}
+	return Lit(v)
+}
+
+func (q *Query) returnBody(items []interface{}) *ast.ReturnBody {
+	if len(items) == 0 {
+		q.fail(errorf("no items to project"))
+	}
+	body := &ast.ReturnBody{}
+	for i, item := range items {
+		returnItem := &ast.ReturnItem{}
+		switch item := item.(type) {
+		case Alias:
+			q.fail(item.expr.err)
+			returnItem.Expr, returnItem.As, returnItem.Variable = item.expr.node, true, variableNode(item.alias)
+		default:
+			if item == "*" {
+				if i > 0 {
+					q.fail(errorf("* must be the first item"))
+				}
+				returnItem.Wildcard = true
+				break
+			}
+			e := projection(item)
+			q.fail(e.err)
+			returnItem.Expr = e.node
+		}
+		body.ReturnItems = append(body.ReturnItems, returnItem)
+	}
+	return body
+}
+
+func (q *Query) lastReturnBody(clause string) *ast.ReturnBody {
+	switch last := q.last().(type) {
+	case *ast.WithClause:
+		return last.ReturnBody
+	case *ast.ReturnClause:
+		return last.ReturnBody
+	}
+	q.fail(errorf("%s must follow RETURN or WITH", clause))
+	return nil
+}
+
+// Sort is an item of ORDER BY.
+type Sort struct {
+	expr Expr
+	desc bool
+}
+
+// Asc returns the ascending sort item, v is a variable name or an Expr.
+func Asc(v interface{}) Sort { return Sort{expr: projection(v)} }
+
+// Desc returns the descending sort item, v is a variable name or an Expr.
+func Desc(v interface{}) Sort { return Sort{expr: projection(v), desc: true} }
+
+// OrderBy sets ORDER BY of the last RETURN or WITH, an item is a variable name,
+// an Expr or a Sort returned by Asc or Desc.
+func (q *Query) OrderBy(items ...interface{}) *Query {
+	body := q.lastReturnBody("ORDER BY")
+	if body == nil {
+		return q
+	}
+	order := &ast.OrderClause{}
+	for _, item := range items {
+		sort, ok := item.(Sort)
+		if !ok {
+			sort = Asc(item)
+		}
+		q.fail(sort.expr.err)
+		sortItem := &ast.SortItem{Type: ast.SortAscending, Expr: sort.expr.node}
+		if sort.desc {
+			sortItem.Type = ast.SortDescending
+		}
+		order.SortItems = append(order.SortItems, sortItem)
+	}
+	body.OrderBy = order
+	return q
+}
+
+// Skip sets SKIP of the last RETURN or WITH.
+func (q *Query) Skip(n interface{}) *Query {
+	if body := q.lastReturnBody("SKIP"); body != nil {
+		e := Lit(n)
+		body.Skip = e.node
+		q.fail(e.err)
+	}
+	return q
+}
+
+// Limit sets LIMIT of the last RETURN or WITH.
+func (q *Query) Limit(n interface{}) *Query {
+	if body := q.lastReturnBody("LIMIT"); body != nil {
+		e := Lit(n)
+		body.Limit = e.node
+		q.fail(e.err)
+	}
+	return q
+}
+
+// Create appends CREATE.
+func (q *Query) Create(patterns ...*Pattern) *Query {
+	return q.add(&ast.CreateClause{Pattern: q.pattern(patterns)})
+}
+
+// Merge appends MERGE.
+func (q *Query) Merge(pattern *Pattern) *Query {
+	q.fail(pattern.err)
+	return q.add(&ast.MergeClause{PatternPart: pattern.part})
+}
+
+// OnCreateSet appends ON CREATE SET to the last MERGE.
+func (q *Query) OnCreateSet(items ...SetItem) *Query {
+	return q.mergeAction(ast.MergeActionCreate, items)
+}
+
+// OnMatchSet appends ON MATCH SET to the last MERGE.
+func (q *Query) OnMatchSet(items ...SetItem) *Query {
+	return q.mergeAction(ast.MergeActionMatch, items)
+}
+
+func (q *Query) mergeAction(typ ast.MergeActionType, items []SetItem) *Query {
+	merge, ok := q.last().(*ast.MergeClause)
+	if !ok {
+		return q.fail(errorf("ON CREATE and ON MATCH must follow MERGE"))
+	}
+	merge.MergeActions = append(merge.MergeActions, &ast.MergeAction{Type: typ, Set: q.setClause(items)})
+	return q
+}
+
+// Set appends SET.
+func (q *Query) Set(items ...SetItem) *Query {
+	return q.add(q.setClause(items))
+}
+
+func (q *Query) setClause(items []SetItem) *ast.SetClause {
+	if len(items) == 0 {
+		q.fail(errorf("no items to set"))
+	}
+	set := &ast.SetClause{}
+	for _, item := range items {
+		q.fail(item.err)
+		set.SetItems = append(set.SetItems, item.item)
+	}
+	return set
+}
+
+// SetItem is an item of SET, see Assign, Increment and AddLabels.
+type SetItem struct {
+	item *ast.SetItem
+	err  error
+}
+
+// Assign returns the item `target = value`, target is a variable or a property returned by Prop.
+func Assign(target Expr, value interface{}) SetItem {
+	e := Lit(value)
+	if err := firstErr(target.err, e.err); err != nil {
+		return SetItem{err: err}
+	}
+	switch t := target.node.(type) {
+	case *ast.VariableNode:
+		return SetItem{item: &ast.SetItem{Type: ast.SetItemVariableAssignment, Variable: t, Expr: e.node}}
+	case *ast.PropertyOrLabelsExpr:
+		if len(t.PropertyLookups) > 0 && len(t.NodeLabels) == 0 {
+			property := &ast.PropertyExpr{Expr: t.Expr, Lookups: t.PropertyLookups}
+			return SetItem{item: &ast.SetItem{Type: ast.SetItemProperty, Property: property, Expr: e.node}}
+		}
+	}
+	return SetItem{err: errorf("can't assign to %T", target.node)}
+}
+
+// Increment returns the item `variable += value`.
+func Increment(variable string, value interface{}) SetItem {
+	e := Lit(value)
+	return SetItem{item: &ast.SetItem{Type: ast.SetItemVariableIncrement, Variable: variableNode(variable), Expr: e.node}, err: e.err}
+}
+
+// AddLabels returns the item `variable:Label1:Label2`.
+func AddLabels(variable string, labels ...string) SetItem {
+	if len(labels) == 0 {
+		return SetItem{err: errorf("no labels to add")}
+	}
+	return SetItem{item: &ast.SetItem{Type: ast.SetItemVariableLabel, Variable: variableNode(variable), Labels: nodeLabels(labels)}}
+}
+
+// Delete appends DELETE, an item is a variable name or an Expr.
+func (q *Query) Delete(items ...interface{}) *Query {
+	return q.add(&ast.DeleteClause{Exprs: q.deleteItems(items)})
+}
+
+// DetachDelete appends DETACH DELETE.
+func (q *Query) DetachDelete(items ...interface{}) *Query {
+	return q.add(&ast.DeleteClause{Detach: true, Exprs: q.deleteItems(items)})
+}
+
+func (q *Query) deleteItems(items []interface{}) []ast.Expr {
+	if len(items) == 0 {
+		q.fail(errorf("no items to delete"))
+	}
+	var exprs []ast.Expr
+	for _, item := range items {
+		e := projection(item)
+		q.fail(e.err)
+		exprs = append(exprs, e.node)
+	}
+	return exprs
+}
+
+// Union appends UNION and the single queries of other, the following clauses are appended to the last one.
+func (q *Query) Union(other *Query) *Query {
+	return q.union(false, other)
+}
+
+// UnionAll appends UNION ALL and the single queries of other.
+func (q *Query) UnionAll(other *Query) *Query {
+	return q.union(true, other)
+}
+
+func (q *Query) union(all bool, other *Query) *Query {
+	q.fail(other.err)
+	// the clauses are copied since the following clauses are appended to them
+	q.unions = append(q.unions, &ast.UnionClause{All: all, Clauses: append([]ast.Stmt{}, other.clauses...)})
+	for _, union := range other.unions {
+		q.unions = append(q.unions, &ast.UnionClause{All: union.All, Clauses: append([]ast.Stmt{}, union.Clauses...)})
+	}
+	return q
+}
+
+// Build returns the tree of the query, which shares nothing with q.
+func (q *Query) Build() (*ast.CypherStmt, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	if err := validate(q.clauses); err != nil {
+		return nil, err
+	}
+	clauses := append([]ast.Stmt{}, q.clauses...)
+	for _, union := range q.unions {
+		if err := validate(union.Clauses); err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, union)
+	}
+	stmt := &ast.CypherStmt{Type: ast.CypherStmtQuery, Query: &ast.QueryStmt{Clauses: clauses}}
+	return ast.Clone(stmt).(*ast.CypherStmt), nil
+}
+
+// validate checks the order of clauses in a single query.
+func validate(clauses []ast.Stmt) error {
+	if len(clauses) == 0 {
+		return errorf("empty query")
+	}
+	updating := false
+	for i, clause := range clauses {
+		switch clause.(type) {
+		case *ast.MatchClause, *ast.UnwindClause:
+			if updating {
+				return errorf("reading clauses can't follow updating clauses without WITH")
+			}
+		case *ast.CreateClause, *ast.MergeClause, *ast.SetClause, *ast.DeleteClause:
+			updating = true
+		case *ast.WithClause:
+			updating = false
+		case *ast.ReturnClause:
+			if i != len(clauses)-1 {
+				return errorf("RETURN must be the last clause")
+			}
+			return nil
+		}
+	}
+	if !updating {
+		return errorf("query must end with RETURN or an updating clause")
+	}
+	return nil
+}
+
+// Cypher returns the query in cypher, names are backquoted only if needed.
+func (q *Query) Cypher() (string, error) {
+	stmt, err := q.Build()
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	ctx := ast.NewRestoreContext(&b)
+	ctx.Flags &^= ast.RestoreNameBackquotes
+	stmt.Restore(ctx)
+	return b.String(), nil
+}
@@ -0,0 +1,502 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/leiysky/parser/ast"
+)
+
+// Expr is an expression, the operands of its methods are converted by Lit
+// unless they're Expr already, e.g. `Prop("n", "age").Gt(18)`.
+//
+// Parentheses are added where the precedence of operators needs them,
+// so `Lit(1).Add(2).Mul(3)` is `(1 + 2) * 3`.
+type Expr struct {
+	node ast.Expr
+	err  error
+}
+
+// Node returns the ast node of e.
+func (e Expr) Node() ast.Expr {
+	return e.node
+}
+
+// Err returns the error of e, if there is any invalid operand.
+func (e Expr) Err() error {
+	return e.err
+}
+
+func errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("build: "+format, args...)
+}
+
+// Var returns the variable name.
+func Var(name string) Expr {
+	return Expr{node: variableNode(name)}
+}
+
+// Param returns the parameter $name.
+func Param(name string) Expr {
+	return Expr{node: &ast.ParameterNode{Type: ast.ParameterSymbolicName, SymbolicName: symbolicName(name)}}
+}
+
+// Prop returns the property lookup variable.key, multiple keys are looked up in order.
+func Prop(variable string, keys ...string) Expr {
+	return Var(variable).Prop(keys...)
+}
+
+// CountAll returns count(*).
+func CountAll() Expr {
+	return Expr{node: &ast.CountAllExpr{}}
+}
+
+// Func returns the invocation of function name, which may have a namespace such as `apoc.coll.sum`.
+func Func(name string, args ...interface{}) Expr {
+	return function(name, false, args)
+}
+
+// FuncDistinct returns the invocation of function name with DISTINCT, such as `count(DISTINCT n)`.
+func FuncDistinct(name string, args ...interface{}) Expr {
+	return function(name, true, args)
+}
+
+func function(name string, distinct bool, args []interface{}) Expr {
+	invocation := &ast.FunctionInvocation{Distinct: distinct}
+	parts := strings.Split(name, ".")
+	for _, part := range parts[:len(parts)-1] {
+		invocation.Namespace = append(invocation.Namespace, symbolicName(part))
+	}
+	invocation.Name = functionName(parts[len(parts)-1], len(parts) > 1)
+	exprs, err := exprs(args)
+	invocation.Args = exprs
+	return Expr{node: invocation, err: err}
+}
+
+// List returns the list literal of items.
+func List(items ...interface{}) Expr {
+	exprs, err := exprs(items)
+	return Expr{node: &ast.LiteralExpr{Type: ast.LiteralList, List: &ast.ListLiteral{Exprs: exprs}}, err: err}
+}
+
+// Map is a map literal, its keys are written in sorted order.
+type Map map[string]interface{}
+
+func (m Map) literal() (*ast.MapLiteral, error) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	literal := &ast.MapLiteral{}
+	for _, key := range keys {
+		e := Lit(m[key])
+		if e.err != nil {
+			return nil, e.err
+		}
+		literal.PropertyKeys = append(literal.PropertyKeys, schemaName(key))
+		literal.Exprs = append(literal.Exprs, e.node)
+	}
+	return literal, nil
+}
+
+// Lit returns the literal of v, which can be nil, a bool, a number, a string,
+// a slice, a Map or a map with string keys. Values in slices and maps are converted
+// by Lit as well. Expr is returned as it is.
+//
+// Negative numbers are negations of their absolute values, as they're parsed.
+func Lit(v interface{}) Expr {
+	switch v := v.(type) {
+	case Expr:
+		if v.node == nil && v.err == nil {
+			return Expr{err: errorf("empty expression")}
+		}
+		return v
+	case nil:
+		return Expr{node: &ast.LiteralExpr{Type: ast.LiteralNull}}
+	case bool:
+		return Expr{node: &ast.LiteralExpr{Type: ast.LiteralBoolean, Boolean: v}}
+	case string:
+		return Expr{node: &ast.LiteralExpr{Type: ast.LiteralString, String: v}}
+	case Map:
+		literal, err := v.literal()
+		return Expr{node: &ast.LiteralExpr{Type: ast.LiteralMap, Map: literal}, err: err}
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		if i == math.MinInt64 {
+			return Expr{err: errorf("%d can't be written as a literal", i)}
+		}
+		if i < 0 {
+			return Neg(integer(-i))
+		}
+		return integer(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return Expr{err: errorf("%d is out of the range of integers", u)}
+		}
+		return integer(int64(u))
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return Expr{err: errorf("%v can't be written as a literal", f)}
+		}
+		if math.Signbit(f) {
+			return Neg(double(-f))
+		}
+		return double(f)
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, rv.Len())
+		for i := range items {
+			items[i] = rv.Index(i).Interface()
+		}
+		return List(items...)
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return Expr{err: errorf("keys of map %s must be strings", rv.Type())}
+		}
+		m := Map{}
+		for _, key := range rv.MapKeys() {
+			m[key.String()] = rv.MapIndex(key).Interface()
+		}
+		return Lit(m)
+	}
+	return Expr{err: errorf("unsupported literal %T", v)}
+}
+
+func integer(i int64) Expr {
+	return Expr{node: &ast.LiteralExpr{Type: ast.LiteralNumber, Number: &ast.NumberLiteral{Type: ast.NumberLiteralInteger, Integer: int(i)}}}
+}
+
+func double(f float64) Expr {
+	return Expr{node: &ast.LiteralExpr{Type: ast.LiteralNumber, Number: &ast.NumberLiteral{Type: ast.NumberLiteralDouble, Double: f}}}
+}
+
+func exprs(values []interface{}) ([]ast.Expr, error) {
+	var exprs []ast.Expr
+	for _, v := range values {
+		e := Lit(v)
+		if e.err != nil {
+			return nil, e.err
+		}
+		exprs = append(exprs, e.node)
+	}
+	return exprs, nil
+}
+
+// Precedences of expressions, from the loosest binding one.
+const (
+	precOr = iota + 1
+	precXor
+	precAnd
+	precNot
+	precComparison
+	precAdd
+	precMul
+	precPow
+	precUnary
+	precPredication
+	precAtom
+)
+
+func opPrecedence(op ast.OpType) int {
+	switch op {
+	case ast.OpOr:
+		return precOr
+	case ast.OpXor:
+		return precXor
+	case ast.OpAnd:
+		return precAnd
+	case ast.OpNot:
+		return precNot
+	case ast.OpEQ, ast.OpNE, ast.OpLT, ast.OpGT, ast.OpLTE, ast.OpGTE:
+		return precComparison
+	case ast.OpAdd, ast.OpSub:
+		return precAdd
+	case ast.OpMul, ast.OpDiv, ast.OpMod:
+		return precMul
+	case ast.OpPow:
+		return precPow
+	default:
+		return precUnary
+	}
+}
+
+func precedence(e ast.Expr) int {
+	switch e := e.(type) {
+	case *ast.BinaryExpr:
+		return opPrecedence(e.Op)
+	case *ast.UnaryExpr:
+		return opPrecedence(e.Op)
+	case *ast.PredicationExpr:
+		return precPredication
+	default:
+		return precAtom
+	}
+}
+
+// paren parenthesizes e if it binds looser than prec.
+func paren(e ast.Expr, prec int) ast.Expr {
+	if precedence(e) < prec {
+		return &ast.ParenExpr{Expr: e}
+	}
+	return e
+}
+
+// binary returns `l op r`, the operators of the same precedence are left associative as they're parsed.
+func binary(op ast.OpType, l Expr, r interface{}) Expr {
+	l, right := Lit(l), Lit(r)
+	if err := firstErr(l.err, right.err); err != nil {
+		return Expr{err: err}
+	}
+	prec := opPrecedence(op)
+	return Expr{node: &ast.BinaryExpr{Op: op, L: paren(l.node, prec), R: paren(right.node, prec+1)}}
+}
+
+func firstErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Or returns `e OR v`.
+func (e Expr) Or(v interface{}) Expr { return binary(ast.OpOr, e, v) }
+
+// Xor returns `e XOR v`.
+func (e Expr) Xor(v interface{}) Expr { return binary(ast.OpXor, e, v) }
+
+// And returns `e AND v`.
+func (e Expr) And(v interface{}) Expr { return binary(ast.OpAnd, e, v) }
+
+// Eq returns `e = v`.
+func (e Expr) Eq(v interface{}) Expr { return binary(ast.OpEQ, e, v) }
+
+// Ne returns `e <> v`.
+func (e Expr) Ne(v interface{}) Expr { return binary(ast.OpNE, e, v) }
+
+// Lt returns `e < v`.
+func (e Expr) Lt(v interface{}) Expr { return binary(ast.OpLT, e, v) }
+
+// Gt returns `e > v`.
+func (e Expr) Gt(v interface{}) Expr { return binary(ast.OpGT, e, v) }
+
+// Lte returns `e <= v`.
+func (e Expr) Lte(v interface{}) Expr { return binary(ast.OpLTE, e, v) }
+
+// Gte returns `e >= v`.
+func (e Expr) Gte(v interface{}) Expr { return binary(ast.OpGTE, e, v) }
+
+// Add returns `e + v`.
+func (e Expr) Add(v interface{}) Expr { return binary(ast.OpAdd, e, v) }
+
+// Sub returns `e - v`.
+func (e Expr) Sub(v interface{}) Expr { return binary(ast.OpSub, e, v) }
+
+// Mul returns `e * v`.
+func (e Expr) Mul(v interface{}) Expr { return binary(ast.OpMul, e, v) }
+
+// Div returns `e / v`.
+func (e Expr) Div(v interface{}) Expr { return binary(ast.OpDiv, e, v) }
+
+// Mod returns `e % v`.
+func (e Expr) Mod(v interface{}) Expr { return binary(ast.OpMod, e, v) }
+
+// Pow returns `e ^ v`.
+func (e Expr) Pow(v interface{}) Expr { return binary(ast.OpPow, e, v) }
+
+// Not returns `NOT v`.
+func Not(v interface{}) Expr {
+	e := Lit(v)
+	if e.err != nil {
+		return e
+	}
+	return Expr{node: &ast.UnaryExpr{Op: ast.OpNot, V: paren(e.node, precNot)}}
+}
+
+// Neg returns `-v`.
+func Neg(v interface{}) Expr {
+	e := Lit(v)
+	if e.err != nil {
+		return e
+	}
+	return Expr{node: &ast.UnaryExpr{Op: ast.OpMinus, V: paren(e.node, precUnary)}}
+}
+
+// predication returns e with the operation op, the right operand of which is operand if it's not nil.
+func (e Expr) predication(typ ast.PredicationType, op ast.Expr) Expr {
+	if e = Lit(e); e.err != nil {
+		return e
+	}
+	return Expr{node: &ast.PredicationExpr{Type: typ, Expr: paren(e.node, precPredication), Op: op}}
+}
+
+// operand returns v as the right operand of IN and string operations, which must be an atom.
+func operand(v interface{}) (ast.Expr, error) {
+	e := Lit(v)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return paren(e.node, precAtom), nil
+}
+
+// In returns `e IN v`.
+func (e Expr) In(v interface{}) Expr {
+	r, err := operand(v)
+	if err != nil {
+		return Expr{err: err}
+	}
+	return e.predication(ast.PredicationListOp, &ast.ListOperationExpr{InExpr: r})
+}
+
+// Index returns `e[v]`.
+func (e Expr) Index(v interface{}) Expr {
+	i := Lit(v)
+	if i.err != nil {
+		return i
+	}
+	return e.predication(ast.PredicationListOp, &ast.ListOperationExpr{SingleExpr: i.node})
+}
+
+func (e Expr) stringOperation(typ ast.StringOperationType, v interface{}) Expr {
+	r, err := operand(v)
+	if err != nil {
+		return Expr{err: err}
+	}
+	return e.predication(ast.PredicationStringOp, &ast.StringOperationExpr{Type: typ, Expr: r})
+}
+
+// StartsWith returns `e STARTS WITH v`.
+func (e Expr) StartsWith(v interface{}) Expr {
+	return e.stringOperation(ast.StringOperationStartsWith, v)
+}
+
+// EndsWith returns `e ENDS WITH v`.
+func (e Expr) EndsWith(v interface{}) Expr {
+	return e.stringOperation(ast.StringOperationEndsWith, v)
+}
+
+// Contains returns `e CONTAINS v`.
+func (e Expr) Contains(v interface{}) Expr {
+	return e.stringOperation(ast.StringOperationContains, v)
+}
+
+// IsNull returns `e IS NULL`.
+func (e Expr) IsNull() Expr {
+	return e.predication(ast.PredicationNullOp, &ast.NullOperationExpr{IsIsNull: true})
+}
+
+// IsNotNull returns `e IS NOT NULL`.
+func (e Expr) IsNotNull() Expr {
+	return e.predication(ast.PredicationNullOp, &ast.NullOperationExpr{})
+}
+
+// Prop returns the property lookup e.key, multiple keys are looked up in order.
+func (e Expr) Prop(keys ...string) Expr {
+	if e = Lit(e); e.err != nil {
+		return e
+	}
+	var lookups []*ast.PropertyLookup
+	for _, key := range keys {
+		lookups = append(lookups, &ast.PropertyLookup{PropertyKey: schemaName(key)})
+	}
+	// lookups are appended to the ones of e, which must be before labels
+	if p, ok := e.node.(*ast.PropertyOrLabelsExpr); ok && len(p.NodeLabels) == 0 {
+		return Expr{node: &ast.PropertyOrLabelsExpr{
+			Expr:            p.Expr,
+			PropertyLookups: append(append([]*ast.PropertyLookup{}, p.PropertyLookups...), lookups...),
+		}}
+	}
+	return Expr{node: &ast.PropertyOrLabelsExpr{Expr: atom(e.node), PropertyLookups: lookups}}
+}
+
+// HasLabels returns `e:Label1:Label2`.
+func (e Expr) HasLabels(labels ...string) Expr {
+	if e = Lit(e); e.err != nil {
+		return e
+	}
+	if p, ok := e.node.(*ast.PropertyOrLabelsExpr); ok {
+		return Expr{node: &ast.PropertyOrLabelsExpr{
+			Expr:            p.Expr,
+			PropertyLookups: p.PropertyLookups,
+			NodeLabels:      append(append([]*ast.NodeLabelNode{}, p.NodeLabels...), nodeLabels(labels)...),
+		}}
+	}
+	return Expr{node: &ast.PropertyOrLabelsExpr{Expr: atom(e.node), NodeLabels: nodeLabels(labels)}}
+}
+
+// atom parenthesizes e unless it's an atom, which is followed by lookups and labels.
+// Numbers are parenthesized as well, since `1.a` isn't a lookup.
+func atom(e ast.Expr) ast.Expr {
+	if _, ok := e.(*ast.PropertyOrLabelsExpr); ok {
+		return &ast.ParenExpr{Expr: e}
+	}
+	if l, ok := e.(*ast.LiteralExpr); ok && l.Type == ast.LiteralNumber {
+		return &ast.ParenExpr{Expr: e}
+	}
+	return paren(e, precAtom)
+}
+
+// symbolicName returns a name, which is escaped if it needs backquotes.
+func symbolicName(name string) *ast.SymbolicNameNode {
+	if ast.NeedsQuote(name) {
+		return &ast.SymbolicNameNode{Type: ast.SymbolicNameEscaped, Value: name}
+	}
+	return &ast.SymbolicNameNode{Type: ast.SymbolicNameUnescaped, Value: name}
+}
+
+// functionName returns the name of a function, names spelled as keywords such as `count`
+// are written as they are, except `exists` in a namespace.
+func functionName(name string, namespaced bool) *ast.SymbolicNameNode {
+	types := map[string]ast.SymbolicNameType{
+		"COUNT":   ast.SymbolicNameCount,
+		"FILTER":  ast.SymbolicNameFilter,
+		"EXTRACT": ast.SymbolicNameExtract,
+		"ANY":     ast.SymbolicNameAny,
+		"NONE":    ast.SymbolicNameNone,
+		"SINGLE":  ast.SymbolicNameSingle,
+		"EXISTS":  ast.SymbolicNameExists,
+	}
+	if typ, ok := types[strings.ToUpper(name)]; ok && !(namespaced && typ == ast.SymbolicNameExists) {
+		return &ast.SymbolicNameNode{Type: typ, Value: name}
+	}
+	return symbolicName(name)
+}
+
+func schemaName(name string) *ast.SchemaNameNode {
+	return &ast.SchemaNameNode{Type: ast.SchemaNameSymbolicName, SymbolicName: symbolicName(name)}
+}
+
+func variableNode(name string) *ast.VariableNode {
+	return &ast.VariableNode{SymbolicName: symbolicName(name)}
+}
+
+func nodeLabels(labels []string) []*ast.NodeLabelNode {
+	var nodes []*ast.NodeLabelNode
+	for _, label := range labels {
+		nodes = append(nodes, &ast.NodeLabelNode{LabelName: schemaName(label)})
+	}
+	return nodes
+}
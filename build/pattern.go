@@ -0,0 +1,138 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import "github.com/leiysky/parser/ast"
+
+// Pattern is a path of nodes and relationships, e.g.
+// `Node("n").Labels("Person").Out(Rel("", "KNOWS"), Node("m"))` is `(n:Person)-[:KNOWS]->(m)`.
+type Pattern struct {
+	part *ast.PatternPart
+	err  error
+}
+
+// Node returns the pattern of a node, variable can be empty.
+func Node(variable string) *Pattern {
+	node := &ast.NodePattern{}
+	if variable != "" {
+		node.Variable = variableNode(variable)
+	}
+	return &Pattern{part: &ast.PatternPart{Element: &ast.PatternElement{Nodes: []*ast.NodePattern{node}}}}
+}
+
+func (p *Pattern) last() *ast.NodePattern {
+	return p.part.Element.Nodes[len(p.part.Element.Nodes)-1]
+}
+
+// Labels adds labels to the last node of p.
+func (p *Pattern) Labels(labels ...string) *Pattern {
+	node := p.last()
+	node.Labels = append(node.Labels, nodeLabels(labels)...)
+	return p
+}
+
+// Props sets the properties of the last node of p, which is a Map or a parameter.
+func (p *Pattern) Props(props interface{}) *Pattern {
+	properties, err := propertiesNode(props)
+	p.last().Properties = properties
+	p.err = firstErr(p.err, err)
+	return p
+}
+
+// Named names the path, e.g. `p = (n)-->(m)`.
+func (p *Pattern) Named(variable string) *Pattern {
+	p.part.Variable = variableNode(variable)
+	return p
+}
+
+// Out appends the relationship pointing to the right and the nodes of next, rel can be nil.
+func (p *Pattern) Out(rel *Relationship, next *Pattern) *Pattern {
+	return p.chain(ast.RelationshipOut, rel, next)
+}
+
+// In appends the relationship pointing to the left and the nodes of next, rel can be nil.
+func (p *Pattern) In(rel *Relationship, next *Pattern) *Pattern {
+	return p.chain(ast.RelationshipIn, rel, next)
+}
+
+// Related appends the relationship without direction and the nodes of next, rel can be nil.
+func (p *Pattern) Related(rel *Relationship, next *Pattern) *Pattern {
+	return p.chain(ast.RelationshipAll, rel, next)
+}
+
+func (p *Pattern) chain(typ ast.RelationshipType, rel *Relationship, next *Pattern) *Pattern {
+	relationship := &ast.RelationshipPattern{Type: typ}
+	if rel != nil {
+		relationship.Detail = rel.detail
+		p.err = firstErr(p.err, rel.err)
+	}
+	p.err = firstErr(p.err, next.err)
+	element := p.part.Element
+	element.Relationships = append(element.Relationships, relationship)
+	element.Relationships = append(element.Relationships, next.part.Element.Relationships...)
+	element.Nodes = append(element.Nodes, next.part.Element.Nodes...)
+	return p
+}
+
+// Relationship is the detail of a relationship in Pattern, such as `[r:KNOWS*1..3]`.
+type Relationship struct {
+	detail *ast.RelationshipDetail
+	err    error
+}
+
+// Rel returns a relationship, variable can be empty and there can be any types.
+func Rel(variable string, types ...string) *Relationship {
+	detail := &ast.RelationshipDetail{MinHops: 1, MaxHops: 1}
+	if variable != "" {
+		detail.Variable = variableNode(variable)
+	}
+	for _, typ := range types {
+		detail.RelationshipTypes = append(detail.RelationshipTypes, schemaName(typ))
+	}
+	return &Relationship{detail: detail}
+}
+
+// Hops sets the range of the length of the relationship, -1 means unbounded,
+// e.g. `Hops(1, -1)` is `*1..` and `Hops(-1, -1)` is `*`.
+func (r *Relationship) Hops(min, max int) *Relationship {
+	if min < -1 || max < -1 {
+		r.err = firstErr(r.err, errorf("invalid hops %d..%d", min, max))
+	}
+	r.detail.MinHops, r.detail.MaxHops = min, max
+	return r
+}
+
+// Props sets the properties of the relationship, which is a Map or a parameter.
+func (r *Relationship) Props(props interface{}) *Relationship {
+	properties, err := propertiesNode(props)
+	r.detail.Properties = properties
+	r.err = firstErr(r.err, err)
+	return r
+}
+
+func propertiesNode(props interface{}) (*ast.Properties, error) {
+	e := Lit(props)
+	if e.err != nil {
+		return nil, e.err
+	}
+	switch n := e.node.(type) {
+	case *ast.LiteralExpr:
+		if n.Type == ast.LiteralMap {
+			return &ast.Properties{Type: ast.PropertiesMapLiteral, MapLiteral: n.Map}, nil
+		}
+	case *ast.ParameterNode:
+		return &ast.Properties{Type: ast.PropertiesParameter, Parameter: n}, nil
+	}
+	return nil, errorf("properties must be a map or a parameter")
+}
@@ -14,28 +14,123 @@
 package parser
 
 import (
+	"fmt"
+
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/leiysky/parser/ast"
 	ps "github.com/leiysky/parser/parser"
 )
 
+// Error is the error type returned by Parse.
+type Error = ps.Error
+
 // New will create a Parser
 func New() *Parser {
 	return &Parser{}
 }
 
-// Parser is used for parsing cypher
-type Parser struct {
-	l *ps.CypherLexer
+// instances is shared by all Parsers, so the DFA cache keeps warm
+// even if Parser is created for every query.
+var instances ps.Pool
+
+// Parser is used for parsing cypher.
+//
+// Parser holds no state, so a single Parser can be shared by multiple goroutines.
+// Every Parse call takes a lexer and parser instance from an internal pool,
+// which owns its DFA cache and is used by one goroutine at a time.
+type Parser struct{}
+
+// Parse will parse the cypher into ast.Stmt.
+// The returned error is an *Error if the cypher is invalid or not supported.
+// It is safe to call Parse concurrently.
+func (p *Parser) Parse(cypher string) (ast.Stmt, error) {
+	instance := instances.Get()
+	defer instances.Put(instance)
+
+	listener := ps.NewErrorListener(cypher)
+	lexer := instance.NewLexer(antlr.NewInputStream(cypher))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(listener)
+	return parse(instance, lexer, listener)
+}
+
+// ParseScript will parse a script of cypher statements separated by ';'.
+// Positions of the returned statements are relative to the whole script,
+// so each statement can be located by Start and End.
+// It stops at the first invalid statement and returns the *Error of it.
+// It is safe to call ParseScript concurrently.
+func (p *Parser) ParseScript(script string) ([]ast.Stmt, error) {
+	instance := instances.Get()
+	defer instances.Put(instance)
+
+	listener := ps.NewErrorListener(script)
+	lexer := instance.NewLexer(antlr.NewInputStream(script))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(listener)
+	statements := ps.SplitStatements(lexer)
+	if err := listener.Err(); err != nil {
+		return nil, err
+	}
+	var stmts []ast.Stmt
+	for _, statement := range statements {
+		stmt, err := parse(instance, statement, listener)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
+	}
+	return stmts, nil
 }
 
-// Parse will parse the cypher into ast.Stmt
-func (p *Parser) Parse(cypher string) ast.Stmt {
-	p.l = ps.NewCypherLexer(antlr.NewInputStream(cypher))
-	tokenStream := antlr.NewCommonTokenStream(p.l, antlr.LexerDefaultTokenChannel)
-	parser := ps.NewCypherParser(tokenStream)
-	tree := parser.Cypher()
+// parse will parse tokens from lexer into ast.Stmt,
+// listener should have been added to lexer.
+func parse(instance *ps.Instance, lexer antlr.Lexer, listener *ps.ErrorListener) (stmt ast.Stmt, err error) {
+	tokenStream := antlr.NewCommonTokenStream(lexer, antlr.LexerDefaultTokenChannel)
+	parser := instance.NewParser(tokenStream)
+	parser.RemoveErrorListeners()
+	tree, ok := parseSLL(parser)
+	if !ok {
+		// SLL may fail on valid cypher, so retry with full LL,
+		// which reports the syntax errors if there is any.
+		tokenStream.Seek(0)
+		parser.SetInputStream(tokenStream)
+		parser.Interpreter.SetPredictionMode(antlr.PredictionModeLL)
+		parser.SetErrorHandler(antlr.NewDefaultErrorStrategy())
+		parser.AddErrorListener(listener)
+		tree = parser.Cypher()
+	}
+	// never convert a broken tree
+	if err := listener.Err(); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			stmt = nil
+			if e, ok := r.(*Error); ok {
+				err = e
+			} else {
+				err = &Error{Msg: fmt.Sprint(r)}
+			}
+		}
+	}()
 	v := ps.NewConvertVisitor(parser)
-	cypherStmt := v.Visit(tree).(ast.Stmt)
-	return cypherStmt
+	stmt = v.Visit(tree).(ast.Stmt)
+	return stmt, nil
+}
+
+// parseSLL parses with SLL prediction mode, which is much faster than LL
+// but may fail on some valid inputs. It returns false on any syntax error.
+func parseSLL(parser *ps.CypherParser) (tree ps.ICypherContext, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, bail := r.(*ps.BailError); !bail {
+				panic(r)
+			}
+			tree, ok = nil, false
+		}
+	}()
+	parser.Interpreter.SetPredictionMode(antlr.PredictionModeSLL)
+	parser.SetErrorHandler(ps.NewBailErrorStrategy())
+	return parser.Cypher(), true
 }
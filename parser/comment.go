@@ -0,0 +1,166 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/leiysky/parser/ast"
+)
+
+// attachComments attaches the comments in cypher to the nearest nodes converted before.
+//
+// Comments are the Comment tokens on the hidden channel, see CommentLexer.
+//
+// A comment is attached as trailing comment of the outermost node ending right before it
+// if they are on the same line, otherwise as leading comment of the outermost node
+// starting right after it. root never gets any comment, so every comment is restored
+// as long as root is restored. A comment is marked as ast.Comment.OwnLine if no token
+// or comment ends on its line before it.
+func (v *ConvertVisitor) attachComments(root ast.Node) {
+	stream, ok := v.parser.GetTokenStream().(*antlr.CommonTokenStream)
+	if !ok {
+		return
+	}
+	tokens := stream.GetAllTokens()
+	var prev antlr.Token
+	// line is the line where the last token or comment ends
+	line := 0
+	for i, token := range tokens {
+		switch token.GetTokenType() {
+		case CypherLexerSP:
+			continue
+		case CypherLexerComment:
+		default:
+			prev = token
+			line = v.endPos(token).Line
+			continue
+		}
+		comment := v.comment(token)
+		comment.OwnLine = comment.Start.Line > line
+		line = comment.End.Line
+		var next antlr.Token
+		for _, t := range tokens[i+1:] {
+			if t.GetTokenType() != CypherLexerSP && t.GetTokenType() != CypherLexerComment {
+				next = t
+				break
+			}
+		}
+		v.attachComment(root, comment, prev, next)
+	}
+}
+
+func (v *ConvertVisitor) attachComment(root ast.Node, comment *ast.Comment, prev, next antlr.Token) {
+	var before, after ast.Node
+	if prev != nil {
+		before = v.outermostEndingAt(root, v.endPos(prev).Offset)
+	}
+	if next != nil && next.GetTokenType() != antlr.TokenEOF {
+		after = v.outermostStartingAt(root, v.startPos(next).Offset)
+	}
+	switch {
+	case before != nil && (after == nil || v.endPos(prev).Line == comment.Start.Line):
+		before.Comments().Trailing = append(before.Comments().Trailing, comment)
+	case after != nil:
+		after.Comments().Leading = append(after.Comments().Leading, comment)
+	default:
+		// e.g. comments after ';', or between tokens which don't start or end any node,
+		// which are attached to the nearest nodes in the innermost node containing them,
+		// or to the containing node if there's none, so they are restored inside it.
+		parent := v.innermostContaining(root, comment)
+		end, start := -1, -1
+		for _, node := range v.nodes {
+			if node == root || node == parent {
+				continue
+			}
+			if parent != nil && (node.Start().Offset < parent.Start().Offset || node.End().Offset > parent.End().Offset) {
+				continue
+			}
+			if offset := node.End().Offset; offset <= comment.Start.Offset && offset > end {
+				end = offset
+			}
+			if offset := node.Start().Offset; offset >= comment.End.Offset && (start < 0 || offset < start) {
+				start = offset
+			}
+		}
+		before, after = v.outermostEndingAt(root, end), v.outermostStartingAt(root, start)
+		switch {
+		case before != nil && (after == nil || before.End().Line == comment.Start.Line):
+			before.Comments().Trailing = append(before.Comments().Trailing, comment)
+		case after != nil:
+			after.Comments().Leading = append(after.Comments().Leading, comment)
+		case parent != nil:
+			parent.Comments().Trailing = append(parent.Comments().Trailing, comment)
+		}
+	}
+}
+
+// innermostContaining returns the innermost node except root which contains comment.
+func (v *ConvertVisitor) innermostContaining(root ast.Node, comment *ast.Comment) ast.Node {
+	var found ast.Node
+	for _, node := range v.nodes {
+		if node == root || node.Start().Offset > comment.Start.Offset || node.End().Offset < comment.End.Offset {
+			continue
+		}
+		if found == nil || node.End().Offset-node.Start().Offset < found.End().Offset-found.Start().Offset {
+			found = node
+		}
+	}
+	return found
+}
+
+// outermostEndingAt returns the outermost node except root which ends at offset.
+func (v *ConvertVisitor) outermostEndingAt(root ast.Node, offset int) ast.Node {
+	var found ast.Node
+	for _, node := range v.nodes {
+		if node == root || node.End().Offset != offset {
+			continue
+		}
+		// nodes with the same span are converted from inner to outer
+		if found == nil || node.Start().Offset <= found.Start().Offset {
+			found = node
+		}
+	}
+	return found
+}
+
+// outermostStartingAt returns the outermost node except root which starts at offset.
+func (v *ConvertVisitor) outermostStartingAt(root ast.Node, offset int) ast.Node {
+	var found ast.Node
+	for _, node := range v.nodes {
+		if node == root || node.Start().Offset != offset {
+			continue
+		}
+		if found == nil || node.End().Offset >= found.End().Offset {
+			found = node
+		}
+	}
+	return found
+}
+
+// comment returns the comment of a Comment token,
+// the line break ending a line comment is not a part of it.
+func (v *ConvertVisitor) comment(token antlr.Token) *ast.Comment {
+	text := token.GetText()
+	if strings.HasPrefix(text, "//") {
+		text = strings.TrimSuffix(strings.TrimSuffix(text, "\n"), "\r")
+	}
+	start := v.startPos(token)
+	return &ast.Comment{
+		Text:  text,
+		Start: start,
+		End:   advancePos(start, text),
+	}
+}
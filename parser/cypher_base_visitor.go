@@ -96,6 +96,14 @@ func (v *BaseCypherVisitor) VisitInQueryCall(ctx *InQueryCallContext) interface{
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCypherVisitor) VisitSubqueryClause(ctx *SubqueryClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCypherVisitor) VisitInTransactions(ctx *InTransactionsContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCypherVisitor) VisitStandaloneCall(ctx *StandaloneCallContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -280,6 +288,18 @@ func (v *BaseCypherVisitor) VisitAtom(ctx *AtomContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCypherVisitor) VisitExistsSubquery(ctx *ExistsSubqueryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCypherVisitor) VisitCountSubquery(ctx *CountSubqueryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCypherVisitor) VisitSubqueryBody(ctx *SubqueryBodyContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCypherVisitor) VisitLiteral(ctx *LiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 129, 995,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 137, 1071,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -44,569 +44,604 @@ var serializedLexerAtn = []uint16{
 	9, 133, 4, 134, 9, 134, 4, 135, 9, 135, 4, 136, 9, 136, 4, 137, 9, 137,
 	4, 138, 9, 138, 4, 139, 9, 139, 4, 140, 9, 140, 4, 141, 9, 141, 4, 142,
 	9, 142, 4, 143, 9, 143, 4, 144, 9, 144, 4, 145, 9, 145, 4, 146, 9, 146,
-	4, 147, 9, 147, 4, 148, 9, 148, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3,
-	5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3,
-	10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 15,
-	3, 15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3,
-	20, 3, 20, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 24,
-	3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 29, 3,
-	29, 3, 30, 3, 30, 3, 31, 3, 31, 3, 32, 3, 32, 3, 33, 3, 33, 3, 34, 3, 34,
-	3, 35, 3, 35, 3, 36, 3, 36, 3, 37, 3, 37, 3, 38, 3, 38, 3, 39, 3, 39, 3,
-	40, 3, 40, 3, 41, 3, 41, 3, 42, 3, 42, 3, 43, 3, 43, 3, 44, 3, 44, 3, 45,
-	3, 45, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3,
-	48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49,
-	3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3,
-	51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53,
-	3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3,
-	55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57,
-	3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3,
-	59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60,
-	3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 3,
-	62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 64,
-	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3,
-	65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 68,
+	4, 147, 9, 147, 4, 148, 9, 148, 4, 149, 9, 149, 4, 150, 9, 150, 4, 151,
+	9, 151, 4, 152, 9, 152, 4, 153, 9, 153, 4, 154, 9, 154, 4, 155, 9, 155,
+	4, 156, 9, 156, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3,
+	6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3, 11, 3, 11,
+	3, 12, 3, 12, 3, 13, 3, 13, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 16, 3,
+	16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 20, 3, 20, 3, 21, 3, 21,
+	3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3,
+	25, 3, 26, 3, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 29, 3, 29, 3, 30, 3, 30,
+	3, 31, 3, 31, 3, 32, 3, 32, 3, 33, 3, 33, 3, 34, 3, 34, 3, 35, 3, 35, 3,
+	36, 3, 36, 3, 37, 3, 37, 3, 38, 3, 38, 3, 39, 3, 39, 3, 40, 3, 40, 3, 41,
+	3, 41, 3, 42, 3, 42, 3, 43, 3, 43, 3, 44, 3, 44, 3, 45, 3, 45, 3, 46, 3,
+	46, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48,
+	3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3,
+	50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51,
+	3, 51, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3,
+	54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56,
+	3, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3,
+	58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59,
+	3, 59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3,
+	61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62,
+	3, 62, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3,
+	63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64,
+	3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
+	66, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 68,
 	3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3,
-	69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3, 71,
-	3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 72, 3,
-	72, 3, 72, 3, 72, 3, 72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74,
-	3, 74, 3, 74, 3, 75, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3,
-	77, 3, 77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78, 3, 79, 3, 79, 3, 79, 3, 79,
-	3, 79, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3,
-	81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 83,
-	3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3,
-	85, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 87, 3, 87,
-	3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3,
-	89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90,
-	3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3, 92, 3,
-	92, 3, 92, 3, 93, 3, 93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94,
-	3, 95, 3, 95, 3, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 7, 96, 671, 10,
-	96, 12, 96, 14, 96, 674, 11, 96, 3, 96, 3, 96, 3, 96, 3, 96, 7, 96, 680,
-	10, 96, 12, 96, 14, 96, 683, 11, 96, 3, 96, 5, 96, 686, 10, 96, 3, 97,
-	3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3,
-	97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 5, 97, 706, 10, 97, 3, 98,
-	3, 98, 3, 98, 3, 98, 6, 98, 712, 10, 98, 13, 98, 14, 98, 713, 3, 99, 3,
-	99, 3, 99, 7, 99, 719, 10, 99, 12, 99, 14, 99, 722, 11, 99, 5, 99, 724,
-	10, 99, 3, 100, 3, 100, 6, 100, 728, 10, 100, 13, 100, 14, 100, 729, 3,
-	101, 5, 101, 733, 10, 101, 3, 102, 3, 102, 5, 102, 737, 10, 102, 3, 103,
-	3, 103, 5, 103, 741, 10, 103, 3, 104, 3, 104, 5, 104, 745, 10, 104, 3,
-	105, 3, 105, 3, 106, 3, 106, 5, 106, 751, 10, 106, 3, 107, 3, 107, 3, 108,
-	6, 108, 756, 10, 108, 13, 108, 14, 108, 757, 3, 108, 6, 108, 761, 10, 108,
-	13, 108, 14, 108, 762, 3, 108, 3, 108, 6, 108, 767, 10, 108, 13, 108, 14,
-	108, 768, 3, 108, 3, 108, 6, 108, 773, 10, 108, 13, 108, 14, 108, 774,
-	5, 108, 777, 10, 108, 3, 108, 5, 108, 780, 10, 108, 3, 108, 5, 108, 783,
-	10, 108, 3, 108, 6, 108, 786, 10, 108, 13, 108, 14, 108, 787, 3, 109, 7,
-	109, 791, 10, 109, 12, 109, 14, 109, 794, 11, 109, 3, 109, 3, 109, 6, 109,
-	798, 10, 109, 13, 109, 14, 109, 799, 3, 110, 3, 110, 3, 110, 3, 110, 3,
-	110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 111, 3, 111, 3,
-	111, 3, 112, 3, 112, 3, 112, 3, 112, 3, 113, 3, 113, 3, 113, 3, 113, 3,
-	113, 3, 113, 3, 113, 3, 113, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3,
-	114, 3, 114, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3,
-	115, 3, 115, 3, 115, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3,
-	116, 3, 117, 3, 117, 3, 117, 3, 118, 3, 118, 3, 118, 3, 118, 3, 119, 3,
-	119, 3, 119, 3, 119, 3, 119, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3,
-	120, 3, 120, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3,
-	121, 3, 122, 3, 122, 7, 122, 881, 10, 122, 12, 122, 14, 122, 884, 11, 122,
-	3, 123, 3, 123, 5, 123, 888, 10, 123, 3, 124, 3, 124, 5, 124, 892, 10,
-	124, 3, 125, 3, 125, 7, 125, 896, 10, 125, 12, 125, 14, 125, 899, 11, 125,
-	3, 125, 6, 125, 902, 10, 125, 13, 125, 14, 125, 903, 3, 126, 6, 126, 907,
-	10, 126, 13, 126, 14, 126, 908, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127,
-	3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 5, 127, 923, 10,
-	127, 3, 128, 3, 128, 3, 128, 3, 128, 3, 128, 3, 128, 7, 128, 931, 10, 128,
-	12, 128, 14, 128, 934, 11, 128, 3, 128, 3, 128, 3, 128, 3, 128, 3, 128,
-	3, 128, 7, 128, 942, 10, 128, 12, 128, 14, 128, 945, 11, 128, 3, 128, 5,
-	128, 948, 10, 128, 3, 128, 3, 128, 5, 128, 952, 10, 128, 5, 128, 954, 10,
-	128, 3, 129, 3, 129, 3, 130, 3, 130, 3, 131, 3, 131, 3, 132, 3, 132, 3,
-	133, 3, 133, 3, 134, 3, 134, 3, 135, 3, 135, 3, 136, 3, 136, 3, 137, 3,
-	137, 3, 138, 3, 138, 3, 139, 3, 139, 3, 140, 3, 140, 3, 141, 3, 141, 3,
-	142, 3, 142, 3, 143, 3, 143, 3, 144, 3, 144, 3, 145, 3, 145, 3, 146, 3,
-	146, 3, 147, 3, 147, 3, 148, 3, 148, 2, 2, 149, 3, 3, 5, 4, 7, 5, 9, 6,
-	11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29,
-	16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47,
-	25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65,
-	34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83,
-	43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51, 101,
-	52, 103, 53, 105, 54, 107, 55, 109, 56, 111, 57, 113, 58, 115, 59, 117,
-	60, 119, 61, 121, 62, 123, 63, 125, 64, 127, 65, 129, 66, 131, 67, 133,
-	68, 135, 69, 137, 70, 139, 71, 141, 72, 143, 73, 145, 74, 147, 75, 149,
-	76, 151, 77, 153, 78, 155, 79, 157, 80, 159, 81, 161, 82, 163, 83, 165,
-	84, 167, 85, 169, 86, 171, 87, 173, 88, 175, 89, 177, 90, 179, 91, 181,
-	92, 183, 93, 185, 94, 187, 95, 189, 96, 191, 97, 193, 98, 195, 99, 197,
-	100, 199, 101, 201, 102, 203, 103, 205, 104, 207, 105, 209, 106, 211, 107,
-	213, 108, 215, 109, 217, 110, 219, 111, 221, 112, 223, 113, 225, 114, 227,
-	115, 229, 116, 231, 117, 233, 118, 235, 119, 237, 120, 239, 121, 241, 122,
-	243, 123, 245, 124, 247, 125, 249, 126, 251, 127, 253, 128, 255, 129, 257,
-	2, 259, 2, 261, 2, 263, 2, 265, 2, 267, 2, 269, 2, 271, 2, 273, 2, 275,
-	2, 277, 2, 279, 2, 281, 2, 283, 2, 285, 2, 287, 2, 289, 2, 291, 2, 293,
-	2, 295, 2, 3, 2, 49, 4, 2, 87, 87, 119, 119, 4, 2, 80, 80, 112, 112, 4,
-	2, 75, 75, 107, 107, 4, 2, 81, 81, 113, 113, 4, 2, 67, 67, 99, 99, 4, 2,
-	78, 78, 110, 110, 4, 2, 82, 82, 114, 114, 4, 2, 86, 86, 118, 118, 4, 2,
-	79, 79, 111, 111, 4, 2, 69, 69, 101, 101, 4, 2, 74, 74, 106, 106, 4, 2,
-	89, 89, 121, 121, 4, 2, 70, 70, 102, 102, 4, 2, 85, 85, 117, 117, 4, 2,
-	71, 71, 103, 103, 4, 2, 84, 84, 116, 116, 4, 2, 73, 73, 105, 105, 4, 2,
-	88, 88, 120, 120, 4, 2, 91, 91, 123, 123, 4, 2, 68, 68, 100, 100, 4, 2,
-	77, 77, 109, 109, 4, 2, 90, 90, 122, 122, 4, 2, 72, 72, 104, 104, 15, 2,
-	36, 36, 41, 41, 68, 68, 72, 72, 80, 80, 84, 84, 86, 86, 94, 94, 100, 100,
-	104, 104, 112, 112, 116, 116, 118, 118, 4, 2, 67, 72, 99, 104, 4, 2, 83,
-	83, 115, 115, 10, 2, 162, 162, 5762, 5762, 6160, 6160, 8194, 8204, 8234,
-	8235, 8241, 8241, 8289, 8289, 12290, 12290, 3, 2, 14, 14, 4, 2, 2, 97,
-	99, 1, 3, 2, 32, 32, 431, 2, 50, 59, 67, 92, 97, 97, 99, 124, 172, 172,
-	183, 183, 185, 185, 188, 188, 194, 216, 218, 248, 250, 707, 712, 723, 738,
-	742, 750, 750, 752, 752, 770, 886, 888, 889, 892, 895, 904, 908, 910, 910,
-	912, 931, 933, 1015, 1017, 1155, 1157, 1161, 1164, 1321, 1331, 1368, 1371,
-	1371, 1379, 1417, 1427, 1471, 1473, 1473, 1475, 1476, 1478, 1479, 1481,
-	1481, 1490, 1516, 1522, 1524, 1554, 1564, 1570, 1643, 1648, 1749, 1751,
-	1758, 1761, 1770, 1772, 1790, 1793, 1793, 1810, 1868, 1871, 1971, 1986,
-	2039, 2044, 2044, 2050, 2095, 2114, 2141, 2210, 2210, 2212, 2222, 2278,
-	2304, 2306, 2405, 2408, 2417, 2419, 2425, 2427, 2433, 2435, 2437, 2439,
-	2446, 2449, 2450, 2453, 2474, 2476, 2482, 2484, 2484, 2488, 2491, 2494,
-	2502, 2505, 2506, 2509, 2512, 2521, 2521, 2526, 2527, 2529, 2533, 2536,
-	2547, 2563, 2565, 2567, 2572, 2577, 2578, 2581, 2602, 2604, 2610, 2612,
-	2613, 2615, 2616, 2618, 2619, 2622, 2622, 2624, 2628, 2633, 2634, 2637,
-	2639, 2643, 2643, 2651, 2654, 2656, 2656, 2664, 2679, 2691, 2693, 2695,
-	2703, 2705, 2707, 2709, 2730, 2732, 2738, 2740, 2741, 2743, 2747, 2750,
-	2759, 2761, 2763, 2765, 2767, 2770, 2770, 2786, 2789, 2792, 2801, 2819,
-	2821, 2823, 2830, 2833, 2834, 2837, 2858, 2860, 2866, 2868, 2869, 2871,
-	2875, 2878, 2886, 2889, 2890, 2893, 2895, 2904, 2905, 2910, 2911, 2913,
-	2917, 2920, 2929, 2931, 2931, 2948, 2949, 2951, 2956, 2960, 2962, 2964,
-	2967, 2971, 2972, 2974, 2974, 2976, 2977, 2981, 2982, 2986, 2988, 2992,
-	3003, 3008, 3012, 3016, 3018, 3020, 3023, 3026, 3026, 3033, 3033, 3048,
-	3057, 3075, 3077, 3079, 3086, 3088, 3090, 3092, 3114, 3116, 3125, 3127,
-	3131, 3135, 3142, 3144, 3146, 3148, 3151, 3159, 3160, 3162, 3163, 3170,
-	3173, 3176, 3185, 3204, 3205, 3207, 3214, 3216, 3218, 3220, 3242, 3244,
-	3253, 3255, 3259, 3262, 3270, 3272, 3274, 3276, 3279, 3287, 3288, 3296,
-	3296, 3298, 3301, 3304, 3313, 3315, 3316, 3332, 3333, 3335, 3342, 3344,
-	3346, 3348, 3388, 3391, 3398, 3400, 3402, 3404, 3408, 3417, 3417, 3426,
-	3429, 3432, 3441, 3452, 3457, 3460, 3461, 3463, 3480, 3484, 3507, 3509,
-	3517, 3519, 3519, 3522, 3528, 3532, 3532, 3537, 3542, 3544, 3544, 3546,
-	3553, 3572, 3573, 3587, 3644, 3650, 3664, 3666, 3675, 3715, 3716, 3718,
-	3718, 3721, 3722, 3724, 3724, 3727, 3727, 3734, 3737, 3739, 3745, 3747,
-	3749, 3751, 3751, 3753, 3753, 3756, 3757, 3759, 3771, 3773, 3775, 3778,
-	3782, 3784, 3784, 3786, 3791, 3794, 3803, 3806, 3809, 3842, 3842, 3866,
-	3867, 3874, 3883, 3895, 3895, 3897, 3897, 3899, 3899, 3904, 3913, 3915,
-	3950, 3955, 3974, 3976, 3993, 3995, 4030, 4040, 4040, 4098, 4171, 4178,
-	4255, 4258, 4295, 4297, 4297, 4303, 4303, 4306, 4348, 4350, 4682, 4684,
-	4687, 4690, 4696, 4698, 4698, 4700, 4703, 4706, 4746, 4748, 4751, 4754,
-	4786, 4788, 4791, 4794, 4800, 4802, 4802, 4804, 4807, 4810, 4824, 4826,
-	4882, 4884, 4887, 4890, 4956, 4959, 4961, 4971, 4979, 4994, 5009, 5026,
-	5110, 5123, 5742, 5745, 5761, 5763, 5788, 5794, 5868, 5872, 5874, 5890,
-	5902, 5904, 5910, 5922, 5942, 5954, 5973, 5986, 5998, 6000, 6002, 6004,
-	6005, 6018, 6101, 6105, 6105, 6110, 6111, 6114, 6123, 6157, 6159, 6162,
-	6171, 6178, 6265, 6274, 6316, 6322, 6391, 6402, 6430, 6434, 6445, 6450,
-	6461, 6472, 6511, 6514, 6518, 6530, 6573, 6578, 6603, 6610, 6620, 6658,
-	6685, 6690, 6752, 6754, 6782, 6785, 6795, 6802, 6811, 6825, 6825, 6914,
-	6989, 6994, 7003, 7021, 7029, 7042, 7157, 7170, 7225, 7234, 7243, 7247,
-	7295, 7378, 7380, 7382, 7416, 7426, 7656, 7678, 7959, 7962, 7967, 7970,
-	8007, 8010, 8015, 8018, 8025, 8027, 8027, 8029, 8029, 8031, 8031, 8033,
-	8063, 8066, 8118, 8120, 8126, 8128, 8128, 8132, 8134, 8136, 8142, 8146,
-	8149, 8152, 8157, 8162, 8174, 8180, 8182, 8184, 8190, 8257, 8258, 8278,
-	8278, 8307, 8307, 8321, 8321, 8338, 8350, 8402, 8414, 8419, 8419, 8423,
-	8434, 8452, 8452, 8457, 8457, 8460, 8469, 8471, 8471, 8474, 8479, 8486,
-	8486, 8488, 8488, 8490, 8490, 8492, 8507, 8510, 8513, 8519, 8523, 8528,
-	8528, 8546, 8586, 11266, 11312, 11314, 11360, 11362, 11494, 11501, 11509,
-	11522, 11559, 11561, 11561, 11567, 11567, 11570, 11625, 11633, 11633, 11649,
-	11672, 11682, 11688, 11690, 11696, 11698, 11704, 11706, 11712, 11714, 11720,
-	11722, 11728, 11730, 11736, 11738, 11744, 11746, 11777, 12295, 12297, 12323,
-	12337, 12339, 12343, 12346, 12350, 12355, 12440, 12443, 12449, 12451, 12540,
-	12542, 12545, 12551, 12591, 12595, 12688, 12706, 12732, 12786, 12801, 13314,
-	19895, 19970, 40910, 40962, 42126, 42194, 42239, 42242, 42510, 42514, 42541,
-	42562, 42609, 42614, 42623, 42625, 42649, 42657, 42739, 42777, 42785, 42788,
-	42890, 42893, 42896, 42898, 42901, 42914, 42924, 43002, 43049, 43074, 43125,
-	43138, 43206, 43218, 43227, 43234, 43257, 43261, 43261, 43266, 43311, 43314,
-	43349, 43362, 43390, 43394, 43458, 43473, 43483, 43522, 43576, 43586, 43599,
-	43602, 43611, 43618, 43640, 43644, 43645, 43650, 43716, 43741, 43743, 43746,
-	43761, 43764, 43768, 43779, 43784, 43787, 43792, 43795, 43800, 43810, 43816,
-	43818, 43824, 43970, 44012, 44014, 44015, 44018, 44027, 44034, 55205, 55218,
-	55240, 55245, 55293, 63746, 64111, 64114, 64219, 64258, 64264, 64277, 64281,
-	64287, 64298, 64300, 64312, 64314, 64318, 64320, 64320, 64322, 64323, 64325,
-	64326, 64328, 64435, 64469, 64831, 64850, 64913, 64916, 64969, 65010, 65021,
-	65026, 65041, 65058, 65064, 65077, 65078, 65103, 65105, 65138, 65142, 65144,
-	65278, 65298, 65307, 65315, 65340, 65345, 65345, 65347, 65372, 65384, 65472,
-	65476, 65481, 65484, 65489, 65492, 65497, 65500, 65502, 4, 2, 2, 43, 45,
-	1, 5, 2, 2, 40, 42, 93, 95, 1, 5, 2, 2, 11, 13, 14, 16, 1, 4, 2, 2, 48,
-	50, 1, 3, 2, 31, 31, 3, 2, 30, 30, 3, 2, 15, 15, 19, 2, 38, 38, 164, 167,
-	1425, 1425, 1549, 1549, 2548, 2549, 2557, 2557, 2803, 2803, 3067, 3067,
-	3649, 3649, 6109, 6109, 8354, 8380, 43066, 43066, 65022, 65022, 65131,
-	65131, 65286, 65286, 65506, 65507, 65511, 65512, 3, 2, 34, 34, 8, 2, 97,
-	97, 8257, 8258, 8278, 8278, 65077, 65078, 65103, 65105, 65345, 65345, 3,
-	2, 11, 11, 5, 2, 2, 35, 37, 93, 95, 1, 3, 2, 12, 12, 3, 2, 13, 13, 3, 2,
-	33, 33, 372, 2, 67, 92, 99, 124, 172, 172, 183, 183, 188, 188, 194, 216,
-	218, 248, 250, 707, 712, 723, 738, 742, 750, 750, 752, 752, 882, 886, 888,
-	889, 892, 895, 904, 904, 906, 908, 910, 910, 912, 931, 933, 1015, 1017,
-	1155, 1164, 1321, 1331, 1368, 1371, 1371, 1379, 1417, 1490, 1516, 1522,
-	1524, 1570, 1612, 1648, 1649, 1651, 1749, 1751, 1751, 1767, 1768, 1776,
-	1777, 1788, 1790, 1793, 1793, 1810, 1810, 1812, 1841, 1871, 1959, 1971,
-	1971, 1996, 2028, 2038, 2039, 2044, 2044, 2050, 2071, 2076, 2076, 2086,
-	2086, 2090, 2090, 2114, 2138, 2210, 2210, 2212, 2222, 2310, 2363, 2367,
-	2367, 2386, 2386, 2394, 2403, 2419, 2425, 2427, 2433, 2439, 2446, 2449,
-	2450, 2453, 2474, 2476, 2482, 2484, 2484, 2488, 2491, 2495, 2495, 2512,
-	2512, 2526, 2527, 2529, 2531, 2546, 2547, 2567, 2572, 2577, 2578, 2581,
-	2602, 2604, 2610, 2612, 2613, 2615, 2616, 2618, 2619, 2651, 2654, 2656,
-	2656, 2676, 2678, 2695, 2703, 2705, 2707, 2709, 2730, 2732, 2738, 2740,
-	2741, 2743, 2747, 2751, 2751, 2770, 2770, 2786, 2787, 2823, 2830, 2833,
-	2834, 2837, 2858, 2860, 2866, 2868, 2869, 2871, 2875, 2879, 2879, 2910,
-	2911, 2913, 2915, 2931, 2931, 2949, 2949, 2951, 2956, 2960, 2962, 2964,
-	2967, 2971, 2972, 2974, 2974, 2976, 2977, 2981, 2982, 2986, 2988, 2992,
-	3003, 3026, 3026, 3079, 3086, 3088, 3090, 3092, 3114, 3116, 3125, 3127,
-	3131, 3135, 3135, 3162, 3163, 3170, 3171, 3207, 3214, 3216, 3218, 3220,
-	3242, 3244, 3253, 3255, 3259, 3263, 3263, 3296, 3296, 3298, 3299, 3315,
-	3316, 3335, 3342, 3344, 3346, 3348, 3388, 3391, 3391, 3408, 3408, 3426,
-	3427, 3452, 3457, 3463, 3480, 3484, 3507, 3509, 3517, 3519, 3519, 3522,
-	3528, 3587, 3634, 3636, 3637, 3650, 3656, 3715, 3716, 3718, 3718, 3721,
+	70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71,
+	3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3,
+	73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75,
+	3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 77, 3,
+	77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 78, 3, 78,
+	3, 78, 3, 78, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3,
+	79, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81,
+	3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 83, 3, 83, 3, 83, 3, 83, 3,
+	84, 3, 84, 3, 84, 3, 84, 3, 85, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86,
+	3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 88, 3, 88, 3, 88, 3,
+	88, 3, 88, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89,
+	3, 90, 3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3,
+	92, 3, 92, 3, 92, 3, 92, 3, 93, 3, 93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94,
+	3, 94, 3, 94, 3, 95, 3, 95, 3, 95, 3, 95, 3, 95, 3, 95, 3, 95, 3, 96, 3,
+	96, 3, 96, 3, 96, 3, 96, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 98,
+	3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 99, 3, 99, 3, 99, 3, 99, 3,
+	99, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 101, 3, 101, 3, 101, 3,
+	101, 3, 102, 3, 102, 3, 102, 3, 102, 3, 102, 3, 103, 3, 103, 3, 103, 3,
+	103, 3, 103, 3, 104, 3, 104, 3, 104, 7, 104, 746, 10, 104, 12, 104, 14,
+	104, 749, 11, 104, 3, 104, 3, 104, 3, 104, 3, 104, 7, 104, 755, 10, 104,
+	12, 104, 14, 104, 758, 11, 104, 3, 104, 5, 104, 761, 10, 104, 3, 105, 3,
+	105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3,
+	105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 5, 105, 781,
+	10, 105, 3, 106, 3, 106, 3, 106, 3, 106, 6, 106, 787, 10, 106, 13, 106,
+	14, 106, 788, 3, 107, 3, 107, 3, 107, 7, 107, 794, 10, 107, 12, 107, 14,
+	107, 797, 11, 107, 5, 107, 799, 10, 107, 3, 108, 3, 108, 6, 108, 803, 10,
+	108, 13, 108, 14, 108, 804, 3, 109, 5, 109, 808, 10, 109, 3, 110, 3, 110,
+	5, 110, 812, 10, 110, 3, 111, 3, 111, 5, 111, 816, 10, 111, 3, 112, 3,
+	112, 5, 112, 820, 10, 112, 3, 113, 3, 113, 3, 114, 3, 114, 5, 114, 826,
+	10, 114, 3, 115, 3, 115, 3, 116, 6, 116, 831, 10, 116, 13, 116, 14, 116,
+	832, 3, 116, 6, 116, 836, 10, 116, 13, 116, 14, 116, 837, 3, 116, 3, 116,
+	6, 116, 842, 10, 116, 13, 116, 14, 116, 843, 3, 116, 3, 116, 6, 116, 848,
+	10, 116, 13, 116, 14, 116, 849, 5, 116, 852, 10, 116, 3, 116, 5, 116, 855,
+	10, 116, 3, 116, 5, 116, 858, 10, 116, 3, 116, 6, 116, 861, 10, 116, 13,
+	116, 14, 116, 862, 3, 117, 7, 117, 866, 10, 117, 12, 117, 14, 117, 869,
+	11, 117, 3, 117, 3, 117, 6, 117, 873, 10, 117, 13, 117, 14, 117, 874, 3,
+	118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3,
+	118, 3, 118, 3, 119, 3, 119, 3, 119, 3, 120, 3, 120, 3, 120, 3, 120, 3,
+	121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 122, 3,
+	122, 3, 122, 3, 122, 3, 122, 3, 122, 3, 122, 3, 123, 3, 123, 3, 123, 3,
+	123, 3, 123, 3, 123, 3, 123, 3, 123, 3, 123, 3, 123, 3, 124, 3, 124, 3,
+	124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 125, 3, 125, 3, 125, 3, 126, 3,
+	126, 3, 126, 3, 126, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3, 128, 3,
+	128, 3, 128, 3, 128, 3, 128, 3, 128, 3, 128, 3, 129, 3, 129, 3, 129, 3,
+	129, 3, 129, 3, 129, 3, 129, 3, 129, 3, 130, 3, 130, 7, 130, 956, 10, 130,
+	12, 130, 14, 130, 959, 11, 130, 3, 131, 3, 131, 5, 131, 963, 10, 131, 3,
+	132, 3, 132, 5, 132, 967, 10, 132, 3, 133, 3, 133, 7, 133, 971, 10, 133,
+	12, 133, 14, 133, 974, 11, 133, 3, 133, 6, 133, 977, 10, 133, 13, 133,
+	14, 133, 978, 3, 134, 6, 134, 982, 10, 134, 13, 134, 14, 134, 983, 3, 135,
+	3, 135, 3, 135, 3, 135, 3, 135, 3, 135, 3, 135, 3, 135, 3, 135, 3, 135,
+	3, 135, 5, 135, 997, 10, 135, 3, 136, 3, 136, 3, 136, 3, 136, 3, 136, 3,
+	136, 7, 136, 1005, 10, 136, 12, 136, 14, 136, 1008, 11, 136, 3, 136, 3,
+	136, 3, 136, 3, 136, 3, 136, 3, 136, 7, 136, 1016, 10, 136, 12, 136, 14,
+	136, 1019, 11, 136, 3, 136, 5, 136, 1022, 10, 136, 3, 136, 3, 136, 5, 136,
+	1026, 10, 136, 5, 136, 1028, 10, 136, 3, 136, 3, 136, 3, 137, 3, 137, 3,
+	138, 3, 138, 3, 139, 3, 139, 3, 140, 3, 140, 3, 141, 3, 141, 3, 142, 3,
+	142, 3, 143, 3, 143, 3, 144, 3, 144, 3, 145, 3, 145, 3, 146, 3, 146, 3,
+	147, 3, 147, 3, 148, 3, 148, 3, 149, 3, 149, 3, 150, 3, 150, 3, 151, 3,
+	151, 3, 152, 3, 152, 3, 153, 3, 153, 3, 154, 3, 154, 3, 155, 3, 155, 3,
+	156, 3, 156, 2, 2, 157, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17,
+	10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35,
+	19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53,
+	28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71,
+	37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89,
+	46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105, 54,
+	107, 55, 109, 56, 111, 57, 113, 58, 115, 59, 117, 60, 119, 61, 121, 62,
+	123, 63, 125, 64, 127, 65, 129, 66, 131, 67, 133, 68, 135, 69, 137, 70,
+	139, 71, 141, 72, 143, 73, 145, 74, 147, 75, 149, 76, 151, 77, 153, 78,
+	155, 79, 157, 80, 159, 81, 161, 82, 163, 83, 165, 84, 167, 85, 169, 86,
+	171, 87, 173, 88, 175, 89, 177, 90, 179, 91, 181, 92, 183, 93, 185, 94,
+	187, 95, 189, 96, 191, 97, 193, 98, 195, 99, 197, 100, 199, 101, 201, 102,
+	203, 103, 205, 104, 207, 105, 209, 106, 211, 107, 213, 108, 215, 109, 217,
+	110, 219, 111, 221, 112, 223, 113, 225, 114, 227, 115, 229, 116, 231, 117,
+	233, 118, 235, 119, 237, 120, 239, 121, 241, 122, 243, 123, 245, 124, 247,
+	125, 249, 126, 251, 127, 253, 128, 255, 129, 257, 130, 259, 131, 261, 132,
+	263, 133, 265, 134, 267, 135, 269, 136, 271, 137, 273, 2, 275, 2, 277,
+	2, 279, 2, 281, 2, 283, 2, 285, 2, 287, 2, 289, 2, 291, 2, 293, 2, 295,
+	2, 297, 2, 299, 2, 301, 2, 303, 2, 305, 2, 307, 2, 309, 2, 311, 2, 3, 2,
+	49, 4, 2, 87, 87, 119, 119, 4, 2, 80, 80, 112, 112, 4, 2, 75, 75, 107,
+	107, 4, 2, 81, 81, 113, 113, 4, 2, 67, 67, 99, 99, 4, 2, 78, 78, 110, 110,
+	4, 2, 82, 82, 114, 114, 4, 2, 86, 86, 118, 118, 4, 2, 79, 79, 111, 111,
+	4, 2, 69, 69, 101, 101, 4, 2, 74, 74, 106, 106, 4, 2, 89, 89, 121, 121,
+	4, 2, 70, 70, 102, 102, 4, 2, 85, 85, 117, 117, 4, 2, 71, 71, 103, 103,
+	4, 2, 84, 84, 116, 116, 4, 2, 73, 73, 105, 105, 4, 2, 88, 88, 120, 120,
+	4, 2, 91, 91, 123, 123, 4, 2, 68, 68, 100, 100, 4, 2, 77, 77, 109, 109,
+	4, 2, 72, 72, 104, 104, 4, 2, 90, 90, 122, 122, 15, 2, 36, 36, 41, 41,
+	68, 68, 72, 72, 80, 80, 84, 84, 86, 86, 94, 94, 100, 100, 104, 104, 112,
+	112, 116, 116, 118, 118, 4, 2, 67, 72, 99, 104, 4, 2, 83, 83, 115, 115,
+	10, 2, 162, 162, 5762, 5762, 6160, 6160, 8194, 8204, 8234, 8235, 8241,
+	8241, 8289, 8289, 12290, 12290, 3, 2, 14, 14, 4, 2, 2, 97, 99, 1, 3, 2,
+	32, 32, 431, 2, 50, 59, 67, 92, 97, 97, 99, 124, 172, 172, 183, 183, 185,
+	185, 188, 188, 194, 216, 218, 248, 250, 707, 712, 723, 738, 742, 750, 750,
+	752, 752, 770, 886, 888, 889, 892, 895, 904, 908, 910, 910, 912, 931, 933,
+	1015, 1017, 1155, 1157, 1161, 1164, 1321, 1331, 1368, 1371, 1371, 1379,
+	1417, 1427, 1471, 1473, 1473, 1475, 1476, 1478, 1479, 1481, 1481, 1490,
+	1516, 1522, 1524, 1554, 1564, 1570, 1643, 1648, 1749, 1751, 1758, 1761,
+	1770, 1772, 1790, 1793, 1793, 1810, 1868, 1871, 1971, 1986, 2039, 2044,
+	2044, 2050, 2095, 2114, 2141, 2210, 2210, 2212, 2222, 2278, 2304, 2306,
+	2405, 2408, 2417, 2419, 2425, 2427, 2433, 2435, 2437, 2439, 2446, 2449,
+	2450, 2453, 2474, 2476, 2482, 2484, 2484, 2488, 2491, 2494, 2502, 2505,
+	2506, 2509, 2512, 2521, 2521, 2526, 2527, 2529, 2533, 2536, 2547, 2563,
+	2565, 2567, 2572, 2577, 2578, 2581, 2602, 2604, 2610, 2612, 2613, 2615,
+	2616, 2618, 2619, 2622, 2622, 2624, 2628, 2633, 2634, 2637, 2639, 2643,
+	2643, 2651, 2654, 2656, 2656, 2664, 2679, 2691, 2693, 2695, 2703, 2705,
+	2707, 2709, 2730, 2732, 2738, 2740, 2741, 2743, 2747, 2750, 2759, 2761,
+	2763, 2765, 2767, 2770, 2770, 2786, 2789, 2792, 2801, 2819, 2821, 2823,
+	2830, 2833, 2834, 2837, 2858, 2860, 2866, 2868, 2869, 2871, 2875, 2878,
+	2886, 2889, 2890, 2893, 2895, 2904, 2905, 2910, 2911, 2913, 2917, 2920,
+	2929, 2931, 2931, 2948, 2949, 2951, 2956, 2960, 2962, 2964, 2967, 2971,
+	2972, 2974, 2974, 2976, 2977, 2981, 2982, 2986, 2988, 2992, 3003, 3008,
+	3012, 3016, 3018, 3020, 3023, 3026, 3026, 3033, 3033, 3048, 3057, 3075,
+	3077, 3079, 3086, 3088, 3090, 3092, 3114, 3116, 3125, 3127, 3131, 3135,
+	3142, 3144, 3146, 3148, 3151, 3159, 3160, 3162, 3163, 3170, 3173, 3176,
+	3185, 3204, 3205, 3207, 3214, 3216, 3218, 3220, 3242, 3244, 3253, 3255,
+	3259, 3262, 3270, 3272, 3274, 3276, 3279, 3287, 3288, 3296, 3296, 3298,
+	3301, 3304, 3313, 3315, 3316, 3332, 3333, 3335, 3342, 3344, 3346, 3348,
+	3388, 3391, 3398, 3400, 3402, 3404, 3408, 3417, 3417, 3426, 3429, 3432,
+	3441, 3452, 3457, 3460, 3461, 3463, 3480, 3484, 3507, 3509, 3517, 3519,
+	3519, 3522, 3528, 3532, 3532, 3537, 3542, 3544, 3544, 3546, 3553, 3572,
+	3573, 3587, 3644, 3650, 3664, 3666, 3675, 3715, 3716, 3718, 3718, 3721,
 	3722, 3724, 3724, 3727, 3727, 3734, 3737, 3739, 3745, 3747, 3749, 3751,
-	3751, 3753, 3753, 3756, 3757, 3759, 3762, 3764, 3765, 3775, 3775, 3778,
-	3782, 3784, 3784, 3806, 3809, 3842, 3842, 3906, 3913, 3915, 3950, 3978,
-	3982, 4098, 4140, 4161, 4161, 4178, 4183, 4188, 4191, 4195, 4195, 4199,
-	4200, 4208, 4210, 4215, 4227, 4240, 4240, 4258, 4295, 4297, 4297, 4303,
-	4303, 4306, 4348, 4350, 4682, 4684, 4687, 4690, 4696, 4698, 4698, 4700,
-	4703, 4706, 4746, 4748, 4751, 4754, 4786, 4788, 4791, 4794, 4800, 4802,
-	4802, 4804, 4807, 4810, 4824, 4826, 4882, 4884, 4887, 4890, 4956, 4994,
-	5009, 5026, 5110, 5123, 5742, 5745, 5761, 5763, 5788, 5794, 5868, 5872,
-	5874, 5890, 5902, 5904, 5907, 5922, 5939, 5954, 5971, 5986, 5998, 6000,
-	6002, 6018, 6069, 6105, 6105, 6110, 6110, 6178, 6265, 6274, 6314, 6316,
-	6316, 6322, 6391, 6402, 6430, 6482, 6511, 6514, 6518, 6530, 6573, 6595,
-	6601, 6658, 6680, 6690, 6742, 6825, 6825, 6919, 6965, 6983, 6989, 7045,
-	7074, 7088, 7089, 7100, 7143, 7170, 7205, 7247, 7249, 7260, 7295, 7403,
-	7406, 7408, 7411, 7415, 7416, 7426, 7617, 7682, 7959, 7962, 7967, 7970,
-	8007, 8010, 8015, 8018, 8025, 8027, 8027, 8029, 8029, 8031, 8031, 8033,
-	8063, 8066, 8118, 8120, 8126, 8128, 8128, 8132, 8134, 8136, 8142, 8146,
-	8149, 8152, 8157, 8162, 8174, 8180, 8182, 8184, 8190, 8307, 8307, 8321,
-	8321, 8338, 8350, 8452, 8452, 8457, 8457, 8460, 8469, 8471, 8471, 8474,
-	8479, 8486, 8486, 8488, 8488, 8490, 8490, 8492, 8507, 8510, 8513, 8519,
-	8523, 8528, 8528, 8546, 8586, 11266, 11312, 11314, 11360, 11362, 11494,
-	11501, 11504, 11508, 11509, 11522, 11559, 11561, 11561, 11567, 11567, 11570,
-	11625, 11633, 11633, 11650, 11672, 11682, 11688, 11690, 11696, 11698, 11704,
-	11706, 11712, 11714, 11720, 11722, 11728, 11730, 11736, 11738, 11744, 12295,
-	12297, 12323, 12331, 12339, 12343, 12346, 12350, 12355, 12440, 12445, 12449,
-	12451, 12540, 12542, 12545, 12551, 12591, 12595, 12688, 12706, 12732, 12786,
-	12801, 13314, 19895, 19970, 40910, 40962, 42126, 42194, 42239, 42242, 42510,
-	42514, 42529, 42540, 42541, 42562, 42608, 42625, 42649, 42658, 42737, 42777,
-	42785, 42788, 42890, 42893, 42896, 42898, 42901, 42914, 42924, 43002, 43011,
-	43013, 43015, 43017, 43020, 43022, 43044, 43074, 43125, 43140, 43189, 43252,
-	43257, 43261, 43261, 43276, 43303, 43314, 43336, 43362, 43390, 43398, 43444,
-	43473, 43473, 43522, 43562, 43586, 43588, 43590, 43597, 43618, 43640, 43644,
-	43644, 43650, 43697, 43699, 43699, 43703, 43704, 43707, 43711, 43714, 43714,
-	43716, 43716, 43741, 43743, 43746, 43756, 43764, 43766, 43779, 43784, 43787,
-	43792, 43795, 43800, 43810, 43816, 43818, 43824, 43970, 44004, 44034, 55205,
-	55218, 55240, 55245, 55293, 63746, 64111, 64114, 64219, 64258, 64264, 64277,
-	64281, 64287, 64287, 64289, 64298, 64300, 64312, 64314, 64318, 64320, 64320,
-	64322, 64323, 64325, 64326, 64328, 64435, 64469, 64831, 64850, 64913, 64916,
-	64969, 65010, 65021, 65138, 65142, 65144, 65278, 65315, 65340, 65347, 65372,
-	65384, 65472, 65476, 65481, 65484, 65489, 65492, 65497, 65500, 65502, 2,
-	1022, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2,
-	2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3,
-	2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25,
-	3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2,
-	33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2,
-	2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2,
-	2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2,
-	2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3,
-	2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71,
-	3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2,
-	79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2,
-	2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2,
-	2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3,
-	2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2,
-	109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2,
-	2, 2, 2, 117, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123,
-	3, 2, 2, 2, 2, 125, 3, 2, 2, 2, 2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2,
-	2, 131, 3, 2, 2, 2, 2, 133, 3, 2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3,
-	2, 2, 2, 2, 139, 3, 2, 2, 2, 2, 141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2,
-	145, 3, 2, 2, 2, 2, 147, 3, 2, 2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2,
-	2, 2, 2, 153, 3, 2, 2, 2, 2, 155, 3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159,
-	3, 2, 2, 2, 2, 161, 3, 2, 2, 2, 2, 163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2,
-	2, 167, 3, 2, 2, 2, 2, 169, 3, 2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3,
-	2, 2, 2, 2, 175, 3, 2, 2, 2, 2, 177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2,
-	181, 3, 2, 2, 2, 2, 183, 3, 2, 2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3, 2,
-	2, 2, 2, 189, 3, 2, 2, 2, 2, 191, 3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195,
-	3, 2, 2, 2, 2, 197, 3, 2, 2, 2, 2, 199, 3, 2, 2, 2, 2, 201, 3, 2, 2, 2,
-	2, 203, 3, 2, 2, 2, 2, 205, 3, 2, 2, 2, 2, 207, 3, 2, 2, 2, 2, 209, 3,
-	2, 2, 2, 2, 211, 3, 2, 2, 2, 2, 213, 3, 2, 2, 2, 2, 215, 3, 2, 2, 2, 2,
-	217, 3, 2, 2, 2, 2, 219, 3, 2, 2, 2, 2, 221, 3, 2, 2, 2, 2, 223, 3, 2,
-	2, 2, 2, 225, 3, 2, 2, 2, 2, 227, 3, 2, 2, 2, 2, 229, 3, 2, 2, 2, 2, 231,
-	3, 2, 2, 2, 2, 233, 3, 2, 2, 2, 2, 235, 3, 2, 2, 2, 2, 237, 3, 2, 2, 2,
-	2, 239, 3, 2, 2, 2, 2, 241, 3, 2, 2, 2, 2, 243, 3, 2, 2, 2, 2, 245, 3,
-	2, 2, 2, 2, 247, 3, 2, 2, 2, 2, 249, 3, 2, 2, 2, 2, 251, 3, 2, 2, 2, 2,
-	253, 3, 2, 2, 2, 2, 255, 3, 2, 2, 2, 3, 297, 3, 2, 2, 2, 5, 299, 3, 2,
-	2, 2, 7, 301, 3, 2, 2, 2, 9, 303, 3, 2, 2, 2, 11, 306, 3, 2, 2, 2, 13,
-	308, 3, 2, 2, 2, 15, 310, 3, 2, 2, 2, 17, 312, 3, 2, 2, 2, 19, 314, 3,
-	2, 2, 2, 21, 316, 3, 2, 2, 2, 23, 318, 3, 2, 2, 2, 25, 320, 3, 2, 2, 2,
-	27, 323, 3, 2, 2, 2, 29, 325, 3, 2, 2, 2, 31, 327, 3, 2, 2, 2, 33, 329,
-	3, 2, 2, 2, 35, 331, 3, 2, 2, 2, 37, 333, 3, 2, 2, 2, 39, 336, 3, 2, 2,
-	2, 41, 338, 3, 2, 2, 2, 43, 340, 3, 2, 2, 2, 45, 343, 3, 2, 2, 2, 47, 346,
-	3, 2, 2, 2, 49, 348, 3, 2, 2, 2, 51, 350, 3, 2, 2, 2, 53, 352, 3, 2, 2,
-	2, 55, 354, 3, 2, 2, 2, 57, 356, 3, 2, 2, 2, 59, 358, 3, 2, 2, 2, 61, 360,
-	3, 2, 2, 2, 63, 362, 3, 2, 2, 2, 65, 364, 3, 2, 2, 2, 67, 366, 3, 2, 2,
-	2, 69, 368, 3, 2, 2, 2, 71, 370, 3, 2, 2, 2, 73, 372, 3, 2, 2, 2, 75, 374,
-	3, 2, 2, 2, 77, 376, 3, 2, 2, 2, 79, 378, 3, 2, 2, 2, 81, 380, 3, 2, 2,
-	2, 83, 382, 3, 2, 2, 2, 85, 384, 3, 2, 2, 2, 87, 386, 3, 2, 2, 2, 89, 388,
-	3, 2, 2, 2, 91, 390, 3, 2, 2, 2, 93, 392, 3, 2, 2, 2, 95, 398, 3, 2, 2,
-	2, 97, 402, 3, 2, 2, 2, 99, 411, 3, 2, 2, 2, 101, 417, 3, 2, 2, 2, 103,
-	424, 3, 2, 2, 2, 105, 427, 3, 2, 2, 2, 107, 433, 3, 2, 2, 2, 109, 436,
-	3, 2, 2, 2, 111, 443, 3, 2, 2, 2, 113, 447, 3, 2, 2, 2, 115, 454, 3, 2,
-	2, 2, 117, 461, 3, 2, 2, 2, 119, 468, 3, 2, 2, 2, 121, 473, 3, 2, 2, 2,
-	123, 479, 3, 2, 2, 2, 125, 484, 3, 2, 2, 2, 127, 493, 3, 2, 2, 2, 129,
-	500, 3, 2, 2, 2, 131, 506, 3, 2, 2, 2, 133, 509, 3, 2, 2, 2, 135, 514,
-	3, 2, 2, 2, 137, 520, 3, 2, 2, 2, 139, 530, 3, 2, 2, 2, 141, 534, 3, 2,
-	2, 2, 143, 545, 3, 2, 2, 2, 145, 550, 3, 2, 2, 2, 147, 556, 3, 2, 2, 2,
-	149, 559, 3, 2, 2, 2, 151, 563, 3, 2, 2, 2, 153, 567, 3, 2, 2, 2, 155,
-	571, 3, 2, 2, 2, 157, 574, 3, 2, 2, 2, 159, 581, 3, 2, 2, 2, 161, 586,
-	3, 2, 2, 2, 163, 595, 3, 2, 2, 2, 165, 598, 3, 2, 2, 2, 167, 603, 3, 2,
-	2, 2, 169, 609, 3, 2, 2, 2, 171, 613, 3, 2, 2, 2, 173, 618, 3, 2, 2, 2,
-	175, 625, 3, 2, 2, 2, 177, 630, 3, 2, 2, 2, 179, 636, 3, 2, 2, 2, 181,
-	643, 3, 2, 2, 2, 183, 648, 3, 2, 2, 2, 185, 653, 3, 2, 2, 2, 187, 657,
-	3, 2, 2, 2, 189, 662, 3, 2, 2, 2, 191, 685, 3, 2, 2, 2, 193, 687, 3, 2,
-	2, 2, 195, 707, 3, 2, 2, 2, 197, 723, 3, 2, 2, 2, 199, 725, 3, 2, 2, 2,
-	201, 732, 3, 2, 2, 2, 203, 736, 3, 2, 2, 2, 205, 740, 3, 2, 2, 2, 207,
-	744, 3, 2, 2, 2, 209, 746, 3, 2, 2, 2, 211, 750, 3, 2, 2, 2, 213, 752,
-	3, 2, 2, 2, 215, 776, 3, 2, 2, 2, 217, 792, 3, 2, 2, 2, 219, 801, 3, 2,
-	2, 2, 221, 812, 3, 2, 2, 2, 223, 815, 3, 2, 2, 2, 225, 819, 3, 2, 2, 2,
-	227, 827, 3, 2, 2, 2, 229, 834, 3, 2, 2, 2, 231, 844, 3, 2, 2, 2, 233,
-	851, 3, 2, 2, 2, 235, 854, 3, 2, 2, 2, 237, 858, 3, 2, 2, 2, 239, 863,
-	3, 2, 2, 2, 241, 870, 3, 2, 2, 2, 243, 878, 3, 2, 2, 2, 245, 887, 3, 2,
-	2, 2, 247, 891, 3, 2, 2, 2, 249, 901, 3, 2, 2, 2, 251, 906, 3, 2, 2, 2,
-	253, 922, 3, 2, 2, 2, 255, 953, 3, 2, 2, 2, 257, 955, 3, 2, 2, 2, 259,
-	957, 3, 2, 2, 2, 261, 959, 3, 2, 2, 2, 263, 961, 3, 2, 2, 2, 265, 963,
-	3, 2, 2, 2, 267, 965, 3, 2, 2, 2, 269, 967, 3, 2, 2, 2, 271, 969, 3, 2,
-	2, 2, 273, 971, 3, 2, 2, 2, 275, 973, 3, 2, 2, 2, 277, 975, 3, 2, 2, 2,
-	279, 977, 3, 2, 2, 2, 281, 979, 3, 2, 2, 2, 283, 981, 3, 2, 2, 2, 285,
-	983, 3, 2, 2, 2, 287, 985, 3, 2, 2, 2, 289, 987, 3, 2, 2, 2, 291, 989,
-	3, 2, 2, 2, 293, 991, 3, 2, 2, 2, 295, 993, 3, 2, 2, 2, 297, 298, 7, 61,
-	2, 2, 298, 4, 3, 2, 2, 2, 299, 300, 7, 46, 2, 2, 300, 6, 3, 2, 2, 2, 301,
-	302, 7, 63, 2, 2, 302, 8, 3, 2, 2, 2, 303, 304, 7, 45, 2, 2, 304, 305,
-	7, 63, 2, 2, 305, 10, 3, 2, 2, 2, 306, 307, 7, 44, 2, 2, 307, 12, 3, 2,
-	2, 2, 308, 309, 7, 42, 2, 2, 309, 14, 3, 2, 2, 2, 310, 311, 7, 43, 2, 2,
-	311, 16, 3, 2, 2, 2, 312, 313, 7, 93, 2, 2, 313, 18, 3, 2, 2, 2, 314, 315,
-	7, 95, 2, 2, 315, 20, 3, 2, 2, 2, 316, 317, 7, 60, 2, 2, 317, 22, 3, 2,
-	2, 2, 318, 319, 7, 126, 2, 2, 319, 24, 3, 2, 2, 2, 320, 321, 7, 48, 2,
-	2, 321, 322, 7, 48, 2, 2, 322, 26, 3, 2, 2, 2, 323, 324, 7, 45, 2, 2, 324,
-	28, 3, 2, 2, 2, 325, 326, 7, 47, 2, 2, 326, 30, 3, 2, 2, 2, 327, 328, 7,
-	49, 2, 2, 328, 32, 3, 2, 2, 2, 329, 330, 7, 39, 2, 2, 330, 34, 3, 2, 2,
-	2, 331, 332, 7, 96, 2, 2, 332, 36, 3, 2, 2, 2, 333, 334, 7, 62, 2, 2, 334,
-	335, 7, 64, 2, 2, 335, 38, 3, 2, 2, 2, 336, 337, 7, 62, 2, 2, 337, 40,
-	3, 2, 2, 2, 338, 339, 7, 64, 2, 2, 339, 42, 3, 2, 2, 2, 340, 341, 7, 62,
-	2, 2, 341, 342, 7, 63, 2, 2, 342, 44, 3, 2, 2, 2, 343, 344, 7, 64, 2, 2,
-	344, 345, 7, 63, 2, 2, 345, 46, 3, 2, 2, 2, 346, 347, 7, 48, 2, 2, 347,
-	48, 3, 2, 2, 2, 348, 349, 7, 125, 2, 2, 349, 50, 3, 2, 2, 2, 350, 351,
-	7, 127, 2, 2, 351, 52, 3, 2, 2, 2, 352, 353, 7, 38, 2, 2, 353, 54, 3, 2,
-	2, 2, 354, 355, 7, 10218, 2, 2, 355, 56, 3, 2, 2, 2, 356, 357, 7, 12298,
-	2, 2, 357, 58, 3, 2, 2, 2, 358, 359, 7, 65126, 2, 2, 359, 60, 3, 2, 2,
-	2, 360, 361, 7, 65310, 2, 2, 361, 62, 3, 2, 2, 2, 362, 363, 7, 10219, 2,
-	2, 363, 64, 3, 2, 2, 2, 364, 365, 7, 12299, 2, 2, 365, 66, 3, 2, 2, 2,
-	366, 367, 7, 65127, 2, 2, 367, 68, 3, 2, 2, 2, 368, 369, 7, 65312, 2, 2,
-	369, 70, 3, 2, 2, 2, 370, 371, 7, 175, 2, 2, 371, 72, 3, 2, 2, 2, 372,
-	373, 7, 8210, 2, 2, 373, 74, 3, 2, 2, 2, 374, 375, 7, 8211, 2, 2, 375,
-	76, 3, 2, 2, 2, 376, 377, 7, 8212, 2, 2, 377, 78, 3, 2, 2, 2, 378, 379,
-	7, 8213, 2, 2, 379, 80, 3, 2, 2, 2, 380, 381, 7, 8214, 2, 2, 381, 82, 3,
-	2, 2, 2, 382, 383, 7, 8215, 2, 2, 383, 84, 3, 2, 2, 2, 384, 385, 7, 8724,
-	2, 2, 385, 86, 3, 2, 2, 2, 386, 387, 7, 65114, 2, 2, 387, 88, 3, 2, 2,
-	2, 388, 389, 7, 65125, 2, 2, 389, 90, 3, 2, 2, 2, 390, 391, 7, 65295, 2,
-	2, 391, 92, 3, 2, 2, 2, 392, 393, 9, 2, 2, 2, 393, 394, 9, 3, 2, 2, 394,
-	395, 9, 4, 2, 2, 395, 396, 9, 5, 2, 2, 396, 397, 9, 3, 2, 2, 397, 94, 3,
-	2, 2, 2, 398, 399, 9, 6, 2, 2, 399, 400, 9, 7, 2, 2, 400, 401, 9, 7, 2,
-	2, 401, 96, 3, 2, 2, 2, 402, 403, 9, 5, 2, 2, 403, 404, 9, 8, 2, 2, 404,
-	405, 9, 9, 2, 2, 405, 406, 9, 4, 2, 2, 406, 407, 9, 5, 2, 2, 407, 408,
-	9, 3, 2, 2, 408, 409, 9, 6, 2, 2, 409, 410, 9, 7, 2, 2, 410, 98, 3, 2,
-	2, 2, 411, 412, 9, 10, 2, 2, 412, 413, 9, 6, 2, 2, 413, 414, 9, 9, 2, 2,
-	414, 415, 9, 11, 2, 2, 415, 416, 9, 12, 2, 2, 416, 100, 3, 2, 2, 2, 417,
-	418, 9, 2, 2, 2, 418, 419, 9, 3, 2, 2, 419, 420, 9, 13, 2, 2, 420, 421,
-	9, 4, 2, 2, 421, 422, 9, 3, 2, 2, 422, 423, 9, 14, 2, 2, 423, 102, 3, 2,
-	2, 2, 424, 425, 9, 6, 2, 2, 425, 426, 9, 15, 2, 2, 426, 104, 3, 2, 2, 2,
-	427, 428, 9, 10, 2, 2, 428, 429, 9, 16, 2, 2, 429, 430, 9, 17, 2, 2, 430,
-	431, 9, 18, 2, 2, 431, 432, 9, 16, 2, 2, 432, 106, 3, 2, 2, 2, 433, 434,
-	9, 5, 2, 2, 434, 435, 9, 3, 2, 2, 435, 108, 3, 2, 2, 2, 436, 437, 9, 11,
-	2, 2, 437, 438, 9, 17, 2, 2, 438, 439, 9, 16, 2, 2, 439, 440, 9, 6, 2,
-	2, 440, 441, 9, 9, 2, 2, 441, 442, 9, 16, 2, 2, 442, 110, 3, 2, 2, 2, 443,
-	444, 9, 15, 2, 2, 444, 445, 9, 16, 2, 2, 445, 446, 9, 9, 2, 2, 446, 112,
-	3, 2, 2, 2, 447, 448, 9, 14, 2, 2, 448, 449, 9, 16, 2, 2, 449, 450, 9,
-	9, 2, 2, 450, 451, 9, 6, 2, 2, 451, 452, 9, 11, 2, 2, 452, 453, 9, 12,
-	2, 2, 453, 114, 3, 2, 2, 2, 454, 455, 9, 14, 2, 2, 455, 456, 9, 16, 2,
-	2, 456, 457, 9, 7, 2, 2, 457, 458, 9, 16, 2, 2, 458, 459, 9, 9, 2, 2, 459,
-	460, 9, 16, 2, 2, 460, 116, 3, 2, 2, 2, 461, 462, 9, 17, 2, 2, 462, 463,
-	9, 16, 2, 2, 463, 464, 9, 10, 2, 2, 464, 465, 9, 5, 2, 2, 465, 466, 9,
-	19, 2, 2, 466, 467, 9, 16, 2, 2, 467, 118, 3, 2, 2, 2, 468, 469, 9, 11,
-	2, 2, 469, 470, 9, 6, 2, 2, 470, 471, 9, 7, 2, 2, 471, 472, 9, 7, 2, 2,
-	472, 120, 3, 2, 2, 2, 473, 474, 9, 20, 2, 2, 474, 475, 9, 4, 2, 2, 475,
-	476, 9, 16, 2, 2, 476, 477, 9, 7, 2, 2, 477, 478, 9, 14, 2, 2, 478, 122,
-	3, 2, 2, 2, 479, 480, 9, 13, 2, 2, 480, 481, 9, 4, 2, 2, 481, 482, 9, 9,
-	2, 2, 482, 483, 9, 12, 2, 2, 483, 124, 3, 2, 2, 2, 484, 485, 9, 14, 2,
-	2, 485, 486, 9, 4, 2, 2, 486, 487, 9, 15, 2, 2, 487, 488, 9, 9, 2, 2, 488,
-	489, 9, 4, 2, 2, 489, 490, 9, 3, 2, 2, 490, 491, 9, 11, 2, 2, 491, 492,
-	9, 9, 2, 2, 492, 126, 3, 2, 2, 2, 493, 494, 9, 17, 2, 2, 494, 495, 9, 16,
-	2, 2, 495, 496, 9, 9, 2, 2, 496, 497, 9, 2, 2, 2, 497, 498, 9, 17, 2, 2,
-	498, 499, 9, 3, 2, 2, 499, 128, 3, 2, 2, 2, 500, 501, 9, 5, 2, 2, 501,
-	502, 9, 17, 2, 2, 502, 503, 9, 14, 2, 2, 503, 504, 9, 16, 2, 2, 504, 505,
-	9, 17, 2, 2, 505, 130, 3, 2, 2, 2, 506, 507, 9, 21, 2, 2, 507, 508, 9,
-	20, 2, 2, 508, 132, 3, 2, 2, 2, 509, 510, 9, 15, 2, 2, 510, 511, 9, 22,
-	2, 2, 511, 512, 9, 4, 2, 2, 512, 513, 9, 8, 2, 2, 513, 134, 3, 2, 2, 2,
-	514, 515, 9, 7, 2, 2, 515, 516, 9, 4, 2, 2, 516, 517, 9, 10, 2, 2, 517,
-	518, 9, 4, 2, 2, 518, 519, 9, 9, 2, 2, 519, 136, 3, 2, 2, 2, 520, 521,
-	9, 6, 2, 2, 521, 522, 9, 15, 2, 2, 522, 523, 9, 11, 2, 2, 523, 524, 9,
-	16, 2, 2, 524, 525, 9, 3, 2, 2, 525, 526, 9, 14, 2, 2, 526, 527, 9, 4,
-	2, 2, 527, 528, 9, 3, 2, 2, 528, 529, 9, 18, 2, 2, 529, 138, 3, 2, 2, 2,
-	530, 531, 9, 6, 2, 2, 531, 532, 9, 15, 2, 2, 532, 533, 9, 11, 2, 2, 533,
-	140, 3, 2, 2, 2, 534, 535, 9, 14, 2, 2, 535, 536, 9, 16, 2, 2, 536, 537,
-	9, 15, 2, 2, 537, 538, 9, 11, 2, 2, 538, 539, 9, 16, 2, 2, 539, 540, 9,
-	3, 2, 2, 540, 541, 9, 14, 2, 2, 541, 542, 9, 4, 2, 2, 542, 543, 9, 3, 2,
-	2, 543, 544, 9, 18, 2, 2, 544, 142, 3, 2, 2, 2, 545, 546, 9, 14, 2, 2,
-	546, 547, 9, 16, 2, 2, 547, 548, 9, 15, 2, 2, 548, 549, 9, 11, 2, 2, 549,
-	144, 3, 2, 2, 2, 550, 551, 9, 13, 2, 2, 551, 552, 9, 12, 2, 2, 552, 553,
-	9, 16, 2, 2, 553, 554, 9, 17, 2, 2, 554, 555, 9, 16, 2, 2, 555, 146, 3,
-	2, 2, 2, 556, 557, 9, 5, 2, 2, 557, 558, 9, 17, 2, 2, 558, 148, 3, 2, 2,
-	2, 559, 560, 9, 23, 2, 2, 560, 561, 9, 5, 2, 2, 561, 562, 9, 17, 2, 2,
-	562, 150, 3, 2, 2, 2, 563, 564, 9, 6, 2, 2, 564, 565, 9, 3, 2, 2, 565,
-	566, 9, 14, 2, 2, 566, 152, 3, 2, 2, 2, 567, 568, 9, 3, 2, 2, 568, 569,
-	9, 5, 2, 2, 569, 570, 9, 9, 2, 2, 570, 154, 3, 2, 2, 2, 571, 572, 9, 4,
-	2, 2, 572, 573, 9, 3, 2, 2, 573, 156, 3, 2, 2, 2, 574, 575, 9, 15, 2, 2,
-	575, 576, 9, 9, 2, 2, 576, 577, 9, 6, 2, 2, 577, 578, 9, 17, 2, 2, 578,
-	579, 9, 9, 2, 2, 579, 580, 9, 15, 2, 2, 580, 158, 3, 2, 2, 2, 581, 582,
-	9, 16, 2, 2, 582, 583, 9, 3, 2, 2, 583, 584, 9, 14, 2, 2, 584, 585, 9,
-	15, 2, 2, 585, 160, 3, 2, 2, 2, 586, 587, 9, 11, 2, 2, 587, 588, 9, 5,
-	2, 2, 588, 589, 9, 3, 2, 2, 589, 590, 9, 9, 2, 2, 590, 591, 9, 6, 2, 2,
-	591, 592, 9, 4, 2, 2, 592, 593, 9, 3, 2, 2, 593, 594, 9, 15, 2, 2, 594,
-	162, 3, 2, 2, 2, 595, 596, 9, 4, 2, 2, 596, 597, 9, 15, 2, 2, 597, 164,
-	3, 2, 2, 2, 598, 599, 9, 3, 2, 2, 599, 600, 9, 2, 2, 2, 600, 601, 9, 7,
-	2, 2, 601, 602, 9, 7, 2, 2, 602, 166, 3, 2, 2, 2, 603, 604, 9, 11, 2, 2,
-	604, 605, 9, 5, 2, 2, 605, 606, 9, 2, 2, 2, 606, 607, 9, 3, 2, 2, 607,
-	608, 9, 9, 2, 2, 608, 168, 3, 2, 2, 2, 609, 610, 9, 6, 2, 2, 610, 611,
-	9, 3, 2, 2, 611, 612, 9, 20, 2, 2, 612, 170, 3, 2, 2, 2, 613, 614, 9, 3,
-	2, 2, 614, 615, 9, 5, 2, 2, 615, 616, 9, 3, 2, 2, 616, 617, 9, 16, 2, 2,
-	617, 172, 3, 2, 2, 2, 618, 619, 9, 15, 2, 2, 619, 620, 9, 4, 2, 2, 620,
-	621, 9, 3, 2, 2, 621, 622, 9, 18, 2, 2, 622, 623, 9, 7, 2, 2, 623, 624,
-	9, 16, 2, 2, 624, 174, 3, 2, 2, 2, 625, 626, 9, 9, 2, 2, 626, 627, 9, 17,
-	2, 2, 627, 628, 9, 2, 2, 2, 628, 629, 9, 16, 2, 2, 629, 176, 3, 2, 2, 2,
-	630, 631, 9, 24, 2, 2, 631, 632, 9, 6, 2, 2, 632, 633, 9, 7, 2, 2, 633,
-	634, 9, 15, 2, 2, 634, 635, 9, 16, 2, 2, 635, 178, 3, 2, 2, 2, 636, 637,
-	9, 16, 2, 2, 637, 638, 9, 23, 2, 2, 638, 639, 9, 4, 2, 2, 639, 640, 9,
-	15, 2, 2, 640, 641, 9, 9, 2, 2, 641, 642, 9, 15, 2, 2, 642, 180, 3, 2,
-	2, 2, 643, 644, 9, 11, 2, 2, 644, 645, 9, 6, 2, 2, 645, 646, 9, 15, 2,
-	2, 646, 647, 9, 16, 2, 2, 647, 182, 3, 2, 2, 2, 648, 649, 9, 16, 2, 2,
-	649, 650, 9, 7, 2, 2, 650, 651, 9, 15, 2, 2, 651, 652, 9, 16, 2, 2, 652,
-	184, 3, 2, 2, 2, 653, 654, 9, 16, 2, 2, 654, 655, 9, 3, 2, 2, 655, 656,
-	9, 14, 2, 2, 656, 186, 3, 2, 2, 2, 657, 658, 9, 13, 2, 2, 658, 659, 9,
-	12, 2, 2, 659, 660, 9, 16, 2, 2, 660, 661, 9, 3, 2, 2, 661, 188, 3, 2,
-	2, 2, 662, 663, 9, 9, 2, 2, 663, 664, 9, 12, 2, 2, 664, 665, 9, 16, 2,
-	2, 665, 666, 9, 3, 2, 2, 666, 190, 3, 2, 2, 2, 667, 672, 7, 36, 2, 2, 668,
-	671, 5, 287, 144, 2, 669, 671, 5, 193, 97, 2, 670, 668, 3, 2, 2, 2, 670,
-	669, 3, 2, 2, 2, 671, 674, 3, 2, 2, 2, 672, 670, 3, 2, 2, 2, 672, 673,
-	3, 2, 2, 2, 673, 675, 3, 2, 2, 2, 674, 672, 3, 2, 2, 2, 675, 686, 7, 36,
-	2, 2, 676, 681, 7, 41, 2, 2, 677, 680, 5, 267, 134, 2, 678, 680, 5, 193,
-	97, 2, 679, 677, 3, 2, 2, 2, 679, 678, 3, 2, 2, 2, 680, 683, 3, 2, 2, 2,
-	681, 679, 3, 2, 2, 2, 681, 682, 3, 2, 2, 2, 682, 684, 3, 2, 2, 2, 683,
-	681, 3, 2, 2, 2, 684, 686, 7, 41, 2, 2, 685, 667, 3, 2, 2, 2, 685, 676,
-	3, 2, 2, 2, 686, 192, 3, 2, 2, 2, 687, 705, 7, 94, 2, 2, 688, 706, 9, 25,
-	2, 2, 689, 690, 9, 2, 2, 2, 690, 691, 5, 203, 102, 2, 691, 692, 5, 203,
-	102, 2, 692, 693, 5, 203, 102, 2, 693, 694, 5, 203, 102, 2, 694, 706, 3,
-	2, 2, 2, 695, 696, 9, 2, 2, 2, 696, 697, 5, 203, 102, 2, 697, 698, 5, 203,
-	102, 2, 698, 699, 5, 203, 102, 2, 699, 700, 5, 203, 102, 2, 700, 701, 5,
-	203, 102, 2, 701, 702, 5, 203, 102, 2, 702, 703, 5, 203, 102, 2, 703, 704,
-	5, 203, 102, 2, 704, 706, 3, 2, 2, 2, 705, 688, 3, 2, 2, 2, 705, 689, 3,
-	2, 2, 2, 705, 695, 3, 2, 2, 2, 706, 194, 3, 2, 2, 2, 707, 708, 7, 50, 2,
-	2, 708, 709, 7, 122, 2, 2, 709, 711, 3, 2, 2, 2, 710, 712, 5, 203, 102,
-	2, 711, 710, 3, 2, 2, 2, 712, 713, 3, 2, 2, 2, 713, 711, 3, 2, 2, 2, 713,
-	714, 3, 2, 2, 2, 714, 196, 3, 2, 2, 2, 715, 724, 5, 213, 107, 2, 716, 720,
-	5, 207, 104, 2, 717, 719, 5, 205, 103, 2, 718, 717, 3, 2, 2, 2, 719, 722,
-	3, 2, 2, 2, 720, 718, 3, 2, 2, 2, 720, 721, 3, 2, 2, 2, 721, 724, 3, 2,
-	2, 2, 722, 720, 3, 2, 2, 2, 723, 715, 3, 2, 2, 2, 723, 716, 3, 2, 2, 2,
-	724, 198, 3, 2, 2, 2, 725, 727, 5, 213, 107, 2, 726, 728, 5, 211, 106,
-	2, 727, 726, 3, 2, 2, 2, 728, 729, 3, 2, 2, 2, 729, 727, 3, 2, 2, 2, 729,
-	730, 3, 2, 2, 2, 730, 200, 3, 2, 2, 2, 731, 733, 9, 26, 2, 2, 732, 731,
-	3, 2, 2, 2, 733, 202, 3, 2, 2, 2, 734, 737, 5, 205, 103, 2, 735, 737, 5,
-	201, 101, 2, 736, 734, 3, 2, 2, 2, 736, 735, 3, 2, 2, 2, 737, 204, 3, 2,
-	2, 2, 738, 741, 5, 213, 107, 2, 739, 741, 5, 207, 104, 2, 740, 738, 3,
-	2, 2, 2, 740, 739, 3, 2, 2, 2, 741, 206, 3, 2, 2, 2, 742, 745, 5, 209,
-	105, 2, 743, 745, 4, 58, 59, 2, 744, 742, 3, 2, 2, 2, 744, 743, 3, 2, 2,
-	2, 745, 208, 3, 2, 2, 2, 746, 747, 4, 51, 57, 2, 747, 210, 3, 2, 2, 2,
-	748, 751, 5, 213, 107, 2, 749, 751, 5, 209, 105, 2, 750, 748, 3, 2, 2,
-	2, 750, 749, 3, 2, 2, 2, 751, 212, 3, 2, 2, 2, 752, 753, 7, 50, 2, 2, 753,
-	214, 3, 2, 2, 2, 754, 756, 5, 205, 103, 2, 755, 754, 3, 2, 2, 2, 756, 757,
-	3, 2, 2, 2, 757, 755, 3, 2, 2, 2, 757, 758, 3, 2, 2, 2, 758, 777, 3, 2,
-	2, 2, 759, 761, 5, 205, 103, 2, 760, 759, 3, 2, 2, 2, 761, 762, 3, 2, 2,
-	2, 762, 760, 3, 2, 2, 2, 762, 763, 3, 2, 2, 2, 763, 764, 3, 2, 2, 2, 764,
-	766, 7, 48, 2, 2, 765, 767, 5, 205, 103, 2, 766, 765, 3, 2, 2, 2, 767,
-	768, 3, 2, 2, 2, 768, 766, 3, 2, 2, 2, 768, 769, 3, 2, 2, 2, 769, 777,
-	3, 2, 2, 2, 770, 772, 7, 48, 2, 2, 771, 773, 5, 205, 103, 2, 772, 771,
-	3, 2, 2, 2, 773, 774, 3, 2, 2, 2, 774, 772, 3, 2, 2, 2, 774, 775, 3, 2,
-	2, 2, 775, 777, 3, 2, 2, 2, 776, 755, 3, 2, 2, 2, 776, 760, 3, 2, 2, 2,
-	776, 770, 3, 2, 2, 2, 777, 779, 3, 2, 2, 2, 778, 780, 9, 16, 2, 2, 779,
-	778, 3, 2, 2, 2, 780, 782, 3, 2, 2, 2, 781, 783, 7, 47, 2, 2, 782, 781,
-	3, 2, 2, 2, 782, 783, 3, 2, 2, 2, 783, 785, 3, 2, 2, 2, 784, 786, 5, 205,
-	103, 2, 785, 784, 3, 2, 2, 2, 786, 787, 3, 2, 2, 2, 787, 785, 3, 2, 2,
-	2, 787, 788, 3, 2, 2, 2, 788, 216, 3, 2, 2, 2, 789, 791, 5, 205, 103, 2,
-	790, 789, 3, 2, 2, 2, 791, 794, 3, 2, 2, 2, 792, 790, 3, 2, 2, 2, 792,
-	793, 3, 2, 2, 2, 793, 795, 3, 2, 2, 2, 794, 792, 3, 2, 2, 2, 795, 797,
-	7, 48, 2, 2, 796, 798, 5, 205, 103, 2, 797, 796, 3, 2, 2, 2, 798, 799,
-	3, 2, 2, 2, 799, 797, 3, 2, 2, 2, 799, 800, 3, 2, 2, 2, 800, 218, 3, 2,
-	2, 2, 801, 802, 9, 11, 2, 2, 802, 803, 9, 5, 2, 2, 803, 804, 9, 3, 2, 2,
-	804, 805, 9, 15, 2, 2, 805, 806, 9, 9, 2, 2, 806, 807, 9, 17, 2, 2, 807,
-	808, 9, 6, 2, 2, 808, 809, 9, 4, 2, 2, 809, 810, 9, 3, 2, 2, 810, 811,
-	9, 9, 2, 2, 811, 220, 3, 2, 2, 2, 812, 813, 9, 14, 2, 2, 813, 814, 9, 5,
-	2, 2, 814, 222, 3, 2, 2, 2, 815, 816, 9, 24, 2, 2, 816, 817, 9, 5, 2, 2,
-	817, 818, 9, 17, 2, 2, 818, 224, 3, 2, 2, 2, 819, 820, 9, 17, 2, 2, 820,
-	821, 9, 16, 2, 2, 821, 822, 9, 27, 2, 2, 822, 823, 9, 2, 2, 2, 823, 824,
-	9, 4, 2, 2, 824, 825, 9, 17, 2, 2, 825, 826, 9, 16, 2, 2, 826, 226, 3,
-	2, 2, 2, 827, 828, 9, 2, 2, 2, 828, 829, 9, 3, 2, 2, 829, 830, 9, 4, 2,
-	2, 830, 831, 9, 27, 2, 2, 831, 832, 9, 2, 2, 2, 832, 833, 9, 16, 2, 2,
-	833, 228, 3, 2, 2, 2, 834, 835, 9, 10, 2, 2, 835, 836, 9, 6, 2, 2, 836,
-	837, 9, 3, 2, 2, 837, 838, 9, 14, 2, 2, 838, 839, 9, 6, 2, 2, 839, 840,
-	9, 9, 2, 2, 840, 841, 9, 5, 2, 2, 841, 842, 9, 17, 2, 2, 842, 843, 9, 20,
-	2, 2, 843, 230, 3, 2, 2, 2, 844, 845, 9, 15, 2, 2, 845, 846, 9, 11, 2,
-	2, 846, 847, 9, 6, 2, 2, 847, 848, 9, 7, 2, 2, 848, 849, 9, 6, 2, 2, 849,
-	850, 9, 17, 2, 2, 850, 232, 3, 2, 2, 2, 851, 852, 9, 5, 2, 2, 852, 853,
-	9, 24, 2, 2, 853, 234, 3, 2, 2, 2, 854, 855, 9, 6, 2, 2, 855, 856, 9, 14,
-	2, 2, 856, 857, 9, 14, 2, 2, 857, 236, 3, 2, 2, 2, 858, 859, 9, 14, 2,
-	2, 859, 860, 9, 17, 2, 2, 860, 861, 9, 5, 2, 2, 861, 862, 9, 8, 2, 2, 862,
-	238, 3, 2, 2, 2, 863, 864, 9, 24, 2, 2, 864, 865, 9, 4, 2, 2, 865, 866,
-	9, 7, 2, 2, 866, 867, 9, 9, 2, 2, 867, 868, 9, 16, 2, 2, 868, 869, 9, 17,
-	2, 2, 869, 240, 3, 2, 2, 2, 870, 871, 9, 16, 2, 2, 871, 872, 9, 23, 2,
-	2, 872, 873, 9, 9, 2, 2, 873, 874, 9, 17, 2, 2, 874, 875, 9, 6, 2, 2, 875,
-	876, 9, 11, 2, 2, 876, 877, 9, 9, 2, 2, 877, 242, 3, 2, 2, 2, 878, 882,
-	5, 245, 123, 2, 879, 881, 5, 247, 124, 2, 880, 879, 3, 2, 2, 2, 881, 884,
-	3, 2, 2, 2, 882, 880, 3, 2, 2, 2, 882, 883, 3, 2, 2, 2, 883, 244, 3, 2,
-	2, 2, 884, 882, 3, 2, 2, 2, 885, 888, 5, 295, 148, 2, 886, 888, 5, 283,
-	142, 2, 887, 885, 3, 2, 2, 2, 887, 886, 3, 2, 2, 2, 888, 246, 3, 2, 2,
-	2, 889, 892, 5, 263, 132, 2, 890, 892, 5, 279, 140, 2, 891, 889, 3, 2,
-	2, 2, 891, 890, 3, 2, 2, 2, 892, 248, 3, 2, 2, 2, 893, 897, 7, 98, 2, 2,
-	894, 896, 5, 259, 130, 2, 895, 894, 3, 2, 2, 2, 896, 899, 3, 2, 2, 2, 897,
-	895, 3, 2, 2, 2, 897, 898, 3, 2, 2, 2, 898, 900, 3, 2, 2, 2, 899, 897,
-	3, 2, 2, 2, 900, 902, 7, 98, 2, 2, 901, 893, 3, 2, 2, 2, 902, 903, 3, 2,
-	2, 2, 903, 901, 3, 2, 2, 2, 903, 904, 3, 2, 2, 2, 904, 250, 3, 2, 2, 2,
-	905, 907, 5, 253, 127, 2, 906, 905, 3, 2, 2, 2, 907, 908, 3, 2, 2, 2, 908,
-	906, 3, 2, 2, 2, 908, 909, 3, 2, 2, 2, 909, 252, 3, 2, 2, 2, 910, 923,
-	5, 281, 141, 2, 911, 923, 5, 285, 143, 2, 912, 923, 5, 289, 145, 2, 913,
-	923, 5, 291, 146, 2, 914, 923, 5, 257, 129, 2, 915, 923, 5, 277, 139, 2,
-	916, 923, 5, 275, 138, 2, 917, 923, 5, 273, 137, 2, 918, 923, 5, 261, 131,
-	2, 919, 923, 5, 293, 147, 2, 920, 923, 9, 28, 2, 2, 921, 923, 5, 255, 128,
-	2, 922, 910, 3, 2, 2, 2, 922, 911, 3, 2, 2, 2, 922, 912, 3, 2, 2, 2, 922,
-	913, 3, 2, 2, 2, 922, 914, 3, 2, 2, 2, 922, 915, 3, 2, 2, 2, 922, 916,
-	3, 2, 2, 2, 922, 917, 3, 2, 2, 2, 922, 918, 3, 2, 2, 2, 922, 919, 3, 2,
-	2, 2, 922, 920, 3, 2, 2, 2, 922, 921, 3, 2, 2, 2, 923, 254, 3, 2, 2, 2,
-	924, 925, 7, 49, 2, 2, 925, 926, 7, 44, 2, 2, 926, 932, 3, 2, 2, 2, 927,
-	931, 5, 265, 133, 2, 928, 929, 7, 44, 2, 2, 929, 931, 5, 271, 136, 2, 930,
-	927, 3, 2, 2, 2, 930, 928, 3, 2, 2, 2, 931, 934, 3, 2, 2, 2, 932, 930,
-	3, 2, 2, 2, 932, 933, 3, 2, 2, 2, 933, 935, 3, 2, 2, 2, 934, 932, 3, 2,
-	2, 2, 935, 936, 7, 44, 2, 2, 936, 954, 7, 49, 2, 2, 937, 938, 7, 49, 2,
-	2, 938, 939, 7, 49, 2, 2, 939, 943, 3, 2, 2, 2, 940, 942, 5, 269, 135,
-	2, 941, 940, 3, 2, 2, 2, 942, 945, 3, 2, 2, 2, 943, 941, 3, 2, 2, 2, 943,
-	944, 3, 2, 2, 2, 944, 947, 3, 2, 2, 2, 945, 943, 3, 2, 2, 2, 946, 948,
-	5, 277, 139, 2, 947, 946, 3, 2, 2, 2, 947, 948, 3, 2, 2, 2, 948, 951, 3,
-	2, 2, 2, 949, 952, 5, 289, 145, 2, 950, 952, 7, 2, 2, 3, 951, 949, 3, 2,
-	2, 2, 951, 950, 3, 2, 2, 2, 952, 954, 3, 2, 2, 2, 953, 924, 3, 2, 2, 2,
-	953, 937, 3, 2, 2, 2, 954, 256, 3, 2, 2, 2, 955, 956, 9, 29, 2, 2, 956,
-	258, 3, 2, 2, 2, 957, 958, 9, 30, 2, 2, 958, 260, 3, 2, 2, 2, 959, 960,
-	9, 31, 2, 2, 960, 262, 3, 2, 2, 2, 961, 962, 9, 32, 2, 2, 962, 264, 3,
-	2, 2, 2, 963, 964, 9, 33, 2, 2, 964, 266, 3, 2, 2, 2, 965, 966, 9, 34,
-	2, 2, 966, 268, 3, 2, 2, 2, 967, 968, 9, 35, 2, 2, 968, 270, 3, 2, 2, 2,
-	969, 970, 9, 36, 2, 2, 970, 272, 3, 2, 2, 2, 971, 972, 9, 37, 2, 2, 972,
-	274, 3, 2, 2, 2, 973, 974, 9, 38, 2, 2, 974, 276, 3, 2, 2, 2, 975, 976,
-	9, 39, 2, 2, 976, 278, 3, 2, 2, 2, 977, 978, 9, 40, 2, 2, 978, 280, 3,
-	2, 2, 2, 979, 980, 9, 41, 2, 2, 980, 282, 3, 2, 2, 2, 981, 982, 9, 42,
-	2, 2, 982, 284, 3, 2, 2, 2, 983, 984, 9, 43, 2, 2, 984, 286, 3, 2, 2, 2,
-	985, 986, 9, 44, 2, 2, 986, 288, 3, 2, 2, 2, 987, 988, 9, 45, 2, 2, 988,
-	290, 3, 2, 2, 2, 989, 990, 9, 46, 2, 2, 990, 292, 3, 2, 2, 2, 991, 992,
-	9, 47, 2, 2, 992, 294, 3, 2, 2, 2, 993, 994, 9, 48, 2, 2, 994, 296, 3,
-	2, 2, 2, 41, 2, 670, 672, 679, 681, 685, 705, 713, 720, 723, 729, 732,
-	736, 740, 744, 750, 757, 762, 768, 774, 776, 779, 782, 787, 792, 799, 882,
-	887, 891, 897, 903, 908, 922, 930, 932, 943, 947, 951, 953, 2,
+	3751, 3753, 3753, 3756, 3757, 3759, 3771, 3773, 3775, 3778, 3782, 3784,
+	3784, 3786, 3791, 3794, 3803, 3806, 3809, 3842, 3842, 3866, 3867, 3874,
+	3883, 3895, 3895, 3897, 3897, 3899, 3899, 3904, 3913, 3915, 3950, 3955,
+	3974, 3976, 3993, 3995, 4030, 4040, 4040, 4098, 4171, 4178, 4255, 4258,
+	4295, 4297, 4297, 4303, 4303, 4306, 4348, 4350, 4682, 4684, 4687, 4690,
+	4696, 4698, 4698, 4700, 4703, 4706, 4746, 4748, 4751, 4754, 4786, 4788,
+	4791, 4794, 4800, 4802, 4802, 4804, 4807, 4810, 4824, 4826, 4882, 4884,
+	4887, 4890, 4956, 4959, 4961, 4971, 4979, 4994, 5009, 5026, 5110, 5123,
+	5742, 5745, 5761, 5763, 5788, 5794, 5868, 5872, 5874, 5890, 5902, 5904,
+	5910, 5922, 5942, 5954, 5973, 5986, 5998, 6000, 6002, 6004, 6005, 6018,
+	6101, 6105, 6105, 6110, 6111, 6114, 6123, 6157, 6159, 6162, 6171, 6178,
+	6265, 6274, 6316, 6322, 6391, 6402, 6430, 6434, 6445, 6450, 6461, 6472,
+	6511, 6514, 6518, 6530, 6573, 6578, 6603, 6610, 6620, 6658, 6685, 6690,
+	6752, 6754, 6782, 6785, 6795, 6802, 6811, 6825, 6825, 6914, 6989, 6994,
+	7003, 7021, 7029, 7042, 7157, 7170, 7225, 7234, 7243, 7247, 7295, 7378,
+	7380, 7382, 7416, 7426, 7656, 7678, 7959, 7962, 7967, 7970, 8007, 8010,
+	8015, 8018, 8025, 8027, 8027, 8029, 8029, 8031, 8031, 8033, 8063, 8066,
+	8118, 8120, 8126, 8128, 8128, 8132, 8134, 8136, 8142, 8146, 8149, 8152,
+	8157, 8162, 8174, 8180, 8182, 8184, 8190, 8257, 8258, 8278, 8278, 8307,
+	8307, 8321, 8321, 8338, 8350, 8402, 8414, 8419, 8419, 8423, 8434, 8452,
+	8452, 8457, 8457, 8460, 8469, 8471, 8471, 8474, 8479, 8486, 8486, 8488,
+	8488, 8490, 8490, 8492, 8507, 8510, 8513, 8519, 8523, 8528, 8528, 8546,
+	8586, 11266, 11312, 11314, 11360, 11362, 11494, 11501, 11509, 11522, 11559,
+	11561, 11561, 11567, 11567, 11570, 11625, 11633, 11633, 11649, 11672, 11682,
+	11688, 11690, 11696, 11698, 11704, 11706, 11712, 11714, 11720, 11722, 11728,
+	11730, 11736, 11738, 11744, 11746, 11777, 12295, 12297, 12323, 12337, 12339,
+	12343, 12346, 12350, 12355, 12440, 12443, 12449, 12451, 12540, 12542, 12545,
+	12551, 12591, 12595, 12688, 12706, 12732, 12786, 12801, 13314, 19895, 19970,
+	40910, 40962, 42126, 42194, 42239, 42242, 42510, 42514, 42541, 42562, 42609,
+	42614, 42623, 42625, 42649, 42657, 42739, 42777, 42785, 42788, 42890, 42893,
+	42896, 42898, 42901, 42914, 42924, 43002, 43049, 43074, 43125, 43138, 43206,
+	43218, 43227, 43234, 43257, 43261, 43261, 43266, 43311, 43314, 43349, 43362,
+	43390, 43394, 43458, 43473, 43483, 43522, 43576, 43586, 43599, 43602, 43611,
+	43618, 43640, 43644, 43645, 43650, 43716, 43741, 43743, 43746, 43761, 43764,
+	43768, 43779, 43784, 43787, 43792, 43795, 43800, 43810, 43816, 43818, 43824,
+	43970, 44012, 44014, 44015, 44018, 44027, 44034, 55205, 55218, 55240, 55245,
+	55293, 63746, 64111, 64114, 64219, 64258, 64264, 64277, 64281, 64287, 64298,
+	64300, 64312, 64314, 64318, 64320, 64320, 64322, 64323, 64325, 64326, 64328,
+	64435, 64469, 64831, 64850, 64913, 64916, 64969, 65010, 65021, 65026, 65041,
+	65058, 65064, 65077, 65078, 65103, 65105, 65138, 65142, 65144, 65278, 65298,
+	65307, 65315, 65340, 65345, 65345, 65347, 65372, 65384, 65472, 65476, 65481,
+	65484, 65489, 65492, 65497, 65500, 65502, 4, 2, 2, 43, 45, 1, 5, 2, 2,
+	40, 42, 93, 95, 1, 5, 2, 2, 11, 13, 14, 16, 1, 4, 2, 2, 48, 50, 1, 3, 2,
+	31, 31, 3, 2, 30, 30, 3, 2, 15, 15, 19, 2, 38, 38, 164, 167, 1425, 1425,
+	1549, 1549, 2548, 2549, 2557, 2557, 2803, 2803, 3067, 3067, 3649, 3649,
+	6109, 6109, 8354, 8380, 43066, 43066, 65022, 65022, 65131, 65131, 65286,
+	65286, 65506, 65507, 65511, 65512, 3, 2, 34, 34, 8, 2, 97, 97, 8257, 8258,
+	8278, 8278, 65077, 65078, 65103, 65105, 65345, 65345, 3, 2, 11, 11, 5,
+	2, 2, 35, 37, 93, 95, 1, 3, 2, 12, 12, 3, 2, 13, 13, 3, 2, 33, 33, 372,
+	2, 67, 92, 99, 124, 172, 172, 183, 183, 188, 188, 194, 216, 218, 248, 250,
+	707, 712, 723, 738, 742, 750, 750, 752, 752, 882, 886, 888, 889, 892, 895,
+	904, 904, 906, 908, 910, 910, 912, 931, 933, 1015, 1017, 1155, 1164, 1321,
+	1331, 1368, 1371, 1371, 1379, 1417, 1490, 1516, 1522, 1524, 1570, 1612,
+	1648, 1649, 1651, 1749, 1751, 1751, 1767, 1768, 1776, 1777, 1788, 1790,
+	1793, 1793, 1810, 1810, 1812, 1841, 1871, 1959, 1971, 1971, 1996, 2028,
+	2038, 2039, 2044, 2044, 2050, 2071, 2076, 2076, 2086, 2086, 2090, 2090,
+	2114, 2138, 2210, 2210, 2212, 2222, 2310, 2363, 2367, 2367, 2386, 2386,
+	2394, 2403, 2419, 2425, 2427, 2433, 2439, 2446, 2449, 2450, 2453, 2474,
+	2476, 2482, 2484, 2484, 2488, 2491, 2495, 2495, 2512, 2512, 2526, 2527,
+	2529, 2531, 2546, 2547, 2567, 2572, 2577, 2578, 2581, 2602, 2604, 2610,
+	2612, 2613, 2615, 2616, 2618, 2619, 2651, 2654, 2656, 2656, 2676, 2678,
+	2695, 2703, 2705, 2707, 2709, 2730, 2732, 2738, 2740, 2741, 2743, 2747,
+	2751, 2751, 2770, 2770, 2786, 2787, 2823, 2830, 2833, 2834, 2837, 2858,
+	2860, 2866, 2868, 2869, 2871, 2875, 2879, 2879, 2910, 2911, 2913, 2915,
+	2931, 2931, 2949, 2949, 2951, 2956, 2960, 2962, 2964, 2967, 2971, 2972,
+	2974, 2974, 2976, 2977, 2981, 2982, 2986, 2988, 2992, 3003, 3026, 3026,
+	3079, 3086, 3088, 3090, 3092, 3114, 3116, 3125, 3127, 3131, 3135, 3135,
+	3162, 3163, 3170, 3171, 3207, 3214, 3216, 3218, 3220, 3242, 3244, 3253,
+	3255, 3259, 3263, 3263, 3296, 3296, 3298, 3299, 3315, 3316, 3335, 3342,
+	3344, 3346, 3348, 3388, 3391, 3391, 3408, 3408, 3426, 3427, 3452, 3457,
+	3463, 3480, 3484, 3507, 3509, 3517, 3519, 3519, 3522, 3528, 3587, 3634,
+	3636, 3637, 3650, 3656, 3715, 3716, 3718, 3718, 3721, 3722, 3724, 3724,
+	3727, 3727, 3734, 3737, 3739, 3745, 3747, 3749, 3751, 3751, 3753, 3753,
+	3756, 3757, 3759, 3762, 3764, 3765, 3775, 3775, 3778, 3782, 3784, 3784,
+	3806, 3809, 3842, 3842, 3906, 3913, 3915, 3950, 3978, 3982, 4098, 4140,
+	4161, 4161, 4178, 4183, 4188, 4191, 4195, 4195, 4199, 4200, 4208, 4210,
+	4215, 4227, 4240, 4240, 4258, 4295, 4297, 4297, 4303, 4303, 4306, 4348,
+	4350, 4682, 4684, 4687, 4690, 4696, 4698, 4698, 4700, 4703, 4706, 4746,
+	4748, 4751, 4754, 4786, 4788, 4791, 4794, 4800, 4802, 4802, 4804, 4807,
+	4810, 4824, 4826, 4882, 4884, 4887, 4890, 4956, 4994, 5009, 5026, 5110,
+	5123, 5742, 5745, 5761, 5763, 5788, 5794, 5868, 5872, 5874, 5890, 5902,
+	5904, 5907, 5922, 5939, 5954, 5971, 5986, 5998, 6000, 6002, 6018, 6069,
+	6105, 6105, 6110, 6110, 6178, 6265, 6274, 6314, 6316, 6316, 6322, 6391,
+	6402, 6430, 6482, 6511, 6514, 6518, 6530, 6573, 6595, 6601, 6658, 6680,
+	6690, 6742, 6825, 6825, 6919, 6965, 6983, 6989, 7045, 7074, 7088, 7089,
+	7100, 7143, 7170, 7205, 7247, 7249, 7260, 7295, 7403, 7406, 7408, 7411,
+	7415, 7416, 7426, 7617, 7682, 7959, 7962, 7967, 7970, 8007, 8010, 8015,
+	8018, 8025, 8027, 8027, 8029, 8029, 8031, 8031, 8033, 8063, 8066, 8118,
+	8120, 8126, 8128, 8128, 8132, 8134, 8136, 8142, 8146, 8149, 8152, 8157,
+	8162, 8174, 8180, 8182, 8184, 8190, 8307, 8307, 8321, 8321, 8338, 8350,
+	8452, 8452, 8457, 8457, 8460, 8469, 8471, 8471, 8474, 8479, 8486, 8486,
+	8488, 8488, 8490, 8490, 8492, 8507, 8510, 8513, 8519, 8523, 8528, 8528,
+	8546, 8586, 11266, 11312, 11314, 11360, 11362, 11494, 11501, 11504, 11508,
+	11509, 11522, 11559, 11561, 11561, 11567, 11567, 11570, 11625, 11633, 11633,
+	11650, 11672, 11682, 11688, 11690, 11696, 11698, 11704, 11706, 11712, 11714,
+	11720, 11722, 11728, 11730, 11736, 11738, 11744, 12295, 12297, 12323, 12331,
+	12339, 12343, 12346, 12350, 12355, 12440, 12445, 12449, 12451, 12540, 12542,
+	12545, 12551, 12591, 12595, 12688, 12706, 12732, 12786, 12801, 13314, 19895,
+	19970, 40910, 40962, 42126, 42194, 42239, 42242, 42510, 42514, 42529, 42540,
+	42541, 42562, 42608, 42625, 42649, 42658, 42737, 42777, 42785, 42788, 42890,
+	42893, 42896, 42898, 42901, 42914, 42924, 43002, 43011, 43013, 43015, 43017,
+	43020, 43022, 43044, 43074, 43125, 43140, 43189, 43252, 43257, 43261, 43261,
+	43276, 43303, 43314, 43336, 43362, 43390, 43398, 43444, 43473, 43473, 43522,
+	43562, 43586, 43588, 43590, 43597, 43618, 43640, 43644, 43644, 43650, 43697,
+	43699, 43699, 43703, 43704, 43707, 43711, 43714, 43714, 43716, 43716, 43741,
+	43743, 43746, 43756, 43764, 43766, 43779, 43784, 43787, 43792, 43795, 43800,
+	43810, 43816, 43818, 43824, 43970, 44004, 44034, 55205, 55218, 55240, 55245,
+	55293, 63746, 64111, 64114, 64219, 64258, 64264, 64277, 64281, 64287, 64287,
+	64289, 64298, 64300, 64312, 64314, 64318, 64320, 64320, 64322, 64323, 64325,
+	64326, 64328, 64435, 64469, 64831, 64850, 64913, 64916, 64969, 65010, 65021,
+	65138, 65142, 65144, 65278, 65315, 65340, 65347, 65372, 65384, 65472, 65476,
+	65481, 65484, 65489, 65492, 65497, 65500, 65502, 2, 1097, 2, 3, 3, 2, 2,
+	2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2,
+	2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2,
+	2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3,
+	2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35,
+	3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2,
+	43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2,
+	2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2,
+	2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2,
+	2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3,
+	2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81,
+	3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2,
+	89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2,
+	2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2,
+	2, 2, 2, 105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111,
+	3, 2, 2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2,
+	2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3,
+	2, 2, 2, 2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2,
+	133, 3, 2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2, 139, 3, 2,
+	2, 2, 2, 141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2, 145, 3, 2, 2, 2, 2, 147,
+	3, 2, 2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2, 2, 2, 2, 153, 3, 2, 2, 2,
+	2, 155, 3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159, 3, 2, 2, 2, 2, 161, 3,
+	2, 2, 2, 2, 163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2, 2, 167, 3, 2, 2, 2, 2,
+	169, 3, 2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3, 2, 2, 2, 2, 175, 3, 2,
+	2, 2, 2, 177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2, 181, 3, 2, 2, 2, 2, 183,
+	3, 2, 2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3, 2, 2, 2, 2, 189, 3, 2, 2, 2,
+	2, 191, 3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195, 3, 2, 2, 2, 2, 197, 3,
+	2, 2, 2, 2, 199, 3, 2, 2, 2, 2, 201, 3, 2, 2, 2, 2, 203, 3, 2, 2, 2, 2,
+	205, 3, 2, 2, 2, 2, 207, 3, 2, 2, 2, 2, 209, 3, 2, 2, 2, 2, 211, 3, 2,
+	2, 2, 2, 213, 3, 2, 2, 2, 2, 215, 3, 2, 2, 2, 2, 217, 3, 2, 2, 2, 2, 219,
+	3, 2, 2, 2, 2, 221, 3, 2, 2, 2, 2, 223, 3, 2, 2, 2, 2, 225, 3, 2, 2, 2,
+	2, 227, 3, 2, 2, 2, 2, 229, 3, 2, 2, 2, 2, 231, 3, 2, 2, 2, 2, 233, 3,
+	2, 2, 2, 2, 235, 3, 2, 2, 2, 2, 237, 3, 2, 2, 2, 2, 239, 3, 2, 2, 2, 2,
+	241, 3, 2, 2, 2, 2, 243, 3, 2, 2, 2, 2, 245, 3, 2, 2, 2, 2, 247, 3, 2,
+	2, 2, 2, 249, 3, 2, 2, 2, 2, 251, 3, 2, 2, 2, 2, 253, 3, 2, 2, 2, 2, 255,
+	3, 2, 2, 2, 2, 257, 3, 2, 2, 2, 2, 259, 3, 2, 2, 2, 2, 261, 3, 2, 2, 2,
+	2, 263, 3, 2, 2, 2, 2, 265, 3, 2, 2, 2, 2, 267, 3, 2, 2, 2, 2, 269, 3,
+	2, 2, 2, 2, 271, 3, 2, 2, 2, 3, 313, 3, 2, 2, 2, 5, 315, 3, 2, 2, 2, 7,
+	317, 3, 2, 2, 2, 9, 319, 3, 2, 2, 2, 11, 322, 3, 2, 2, 2, 13, 324, 3, 2,
+	2, 2, 15, 326, 3, 2, 2, 2, 17, 328, 3, 2, 2, 2, 19, 330, 3, 2, 2, 2, 21,
+	332, 3, 2, 2, 2, 23, 334, 3, 2, 2, 2, 25, 336, 3, 2, 2, 2, 27, 338, 3,
+	2, 2, 2, 29, 340, 3, 2, 2, 2, 31, 343, 3, 2, 2, 2, 33, 345, 3, 2, 2, 2,
+	35, 347, 3, 2, 2, 2, 37, 349, 3, 2, 2, 2, 39, 351, 3, 2, 2, 2, 41, 353,
+	3, 2, 2, 2, 43, 356, 3, 2, 2, 2, 45, 358, 3, 2, 2, 2, 47, 360, 3, 2, 2,
+	2, 49, 363, 3, 2, 2, 2, 51, 366, 3, 2, 2, 2, 53, 368, 3, 2, 2, 2, 55, 370,
+	3, 2, 2, 2, 57, 372, 3, 2, 2, 2, 59, 374, 3, 2, 2, 2, 61, 376, 3, 2, 2,
+	2, 63, 378, 3, 2, 2, 2, 65, 380, 3, 2, 2, 2, 67, 382, 3, 2, 2, 2, 69, 384,
+	3, 2, 2, 2, 71, 386, 3, 2, 2, 2, 73, 388, 3, 2, 2, 2, 75, 390, 3, 2, 2,
+	2, 77, 392, 3, 2, 2, 2, 79, 394, 3, 2, 2, 2, 81, 396, 3, 2, 2, 2, 83, 398,
+	3, 2, 2, 2, 85, 400, 3, 2, 2, 2, 87, 402, 3, 2, 2, 2, 89, 404, 3, 2, 2,
+	2, 91, 406, 3, 2, 2, 2, 93, 408, 3, 2, 2, 2, 95, 414, 3, 2, 2, 2, 97, 418,
+	3, 2, 2, 2, 99, 427, 3, 2, 2, 2, 101, 433, 3, 2, 2, 2, 103, 440, 3, 2,
+	2, 2, 105, 443, 3, 2, 2, 2, 107, 449, 3, 2, 2, 2, 109, 452, 3, 2, 2, 2,
+	111, 459, 3, 2, 2, 2, 113, 463, 3, 2, 2, 2, 115, 470, 3, 2, 2, 2, 117,
+	477, 3, 2, 2, 2, 119, 484, 3, 2, 2, 2, 121, 489, 3, 2, 2, 2, 123, 495,
+	3, 2, 2, 2, 125, 506, 3, 2, 2, 2, 127, 519, 3, 2, 2, 2, 129, 523, 3, 2,
+	2, 2, 131, 528, 3, 2, 2, 2, 133, 534, 3, 2, 2, 2, 135, 543, 3, 2, 2, 2,
+	137, 549, 3, 2, 2, 2, 139, 554, 3, 2, 2, 2, 141, 559, 3, 2, 2, 2, 143,
+	568, 3, 2, 2, 2, 145, 575, 3, 2, 2, 2, 147, 581, 3, 2, 2, 2, 149, 584,
+	3, 2, 2, 2, 151, 589, 3, 2, 2, 2, 153, 595, 3, 2, 2, 2, 155, 605, 3, 2,
+	2, 2, 157, 609, 3, 2, 2, 2, 159, 620, 3, 2, 2, 2, 161, 625, 3, 2, 2, 2,
+	163, 631, 3, 2, 2, 2, 165, 634, 3, 2, 2, 2, 167, 638, 3, 2, 2, 2, 169,
+	642, 3, 2, 2, 2, 171, 646, 3, 2, 2, 2, 173, 649, 3, 2, 2, 2, 175, 656,
+	3, 2, 2, 2, 177, 661, 3, 2, 2, 2, 179, 670, 3, 2, 2, 2, 181, 673, 3, 2,
+	2, 2, 183, 678, 3, 2, 2, 2, 185, 684, 3, 2, 2, 2, 187, 688, 3, 2, 2, 2,
+	189, 693, 3, 2, 2, 2, 191, 700, 3, 2, 2, 2, 193, 705, 3, 2, 2, 2, 195,
+	711, 3, 2, 2, 2, 197, 718, 3, 2, 2, 2, 199, 723, 3, 2, 2, 2, 201, 728,
+	3, 2, 2, 2, 203, 732, 3, 2, 2, 2, 205, 737, 3, 2, 2, 2, 207, 760, 3, 2,
+	2, 2, 209, 762, 3, 2, 2, 2, 211, 782, 3, 2, 2, 2, 213, 798, 3, 2, 2, 2,
+	215, 800, 3, 2, 2, 2, 217, 807, 3, 2, 2, 2, 219, 811, 3, 2, 2, 2, 221,
+	815, 3, 2, 2, 2, 223, 819, 3, 2, 2, 2, 225, 821, 3, 2, 2, 2, 227, 825,
+	3, 2, 2, 2, 229, 827, 3, 2, 2, 2, 231, 851, 3, 2, 2, 2, 233, 867, 3, 2,
+	2, 2, 235, 876, 3, 2, 2, 2, 237, 887, 3, 2, 2, 2, 239, 890, 3, 2, 2, 2,
+	241, 894, 3, 2, 2, 2, 243, 902, 3, 2, 2, 2, 245, 909, 3, 2, 2, 2, 247,
+	919, 3, 2, 2, 2, 249, 926, 3, 2, 2, 2, 251, 929, 3, 2, 2, 2, 253, 933,
+	3, 2, 2, 2, 255, 938, 3, 2, 2, 2, 257, 945, 3, 2, 2, 2, 259, 953, 3, 2,
+	2, 2, 261, 962, 3, 2, 2, 2, 263, 966, 3, 2, 2, 2, 265, 976, 3, 2, 2, 2,
+	267, 981, 3, 2, 2, 2, 269, 996, 3, 2, 2, 2, 271, 1027, 3, 2, 2, 2, 273,
+	1031, 3, 2, 2, 2, 275, 1033, 3, 2, 2, 2, 277, 1035, 3, 2, 2, 2, 279, 1037,
+	3, 2, 2, 2, 281, 1039, 3, 2, 2, 2, 283, 1041, 3, 2, 2, 2, 285, 1043, 3,
+	2, 2, 2, 287, 1045, 3, 2, 2, 2, 289, 1047, 3, 2, 2, 2, 291, 1049, 3, 2,
+	2, 2, 293, 1051, 3, 2, 2, 2, 295, 1053, 3, 2, 2, 2, 297, 1055, 3, 2, 2,
+	2, 299, 1057, 3, 2, 2, 2, 301, 1059, 3, 2, 2, 2, 303, 1061, 3, 2, 2, 2,
+	305, 1063, 3, 2, 2, 2, 307, 1065, 3, 2, 2, 2, 309, 1067, 3, 2, 2, 2, 311,
+	1069, 3, 2, 2, 2, 313, 314, 7, 61, 2, 2, 314, 4, 3, 2, 2, 2, 315, 316,
+	7, 46, 2, 2, 316, 6, 3, 2, 2, 2, 317, 318, 7, 63, 2, 2, 318, 8, 3, 2, 2,
+	2, 319, 320, 7, 45, 2, 2, 320, 321, 7, 63, 2, 2, 321, 10, 3, 2, 2, 2, 322,
+	323, 7, 125, 2, 2, 323, 12, 3, 2, 2, 2, 324, 325, 7, 127, 2, 2, 325, 14,
+	3, 2, 2, 2, 326, 327, 7, 44, 2, 2, 327, 16, 3, 2, 2, 2, 328, 329, 7, 42,
+	2, 2, 329, 18, 3, 2, 2, 2, 330, 331, 7, 43, 2, 2, 331, 20, 3, 2, 2, 2,
+	332, 333, 7, 93, 2, 2, 333, 22, 3, 2, 2, 2, 334, 335, 7, 95, 2, 2, 335,
+	24, 3, 2, 2, 2, 336, 337, 7, 60, 2, 2, 337, 26, 3, 2, 2, 2, 338, 339, 7,
+	126, 2, 2, 339, 28, 3, 2, 2, 2, 340, 341, 7, 48, 2, 2, 341, 342, 7, 48,
+	2, 2, 342, 30, 3, 2, 2, 2, 343, 344, 7, 45, 2, 2, 344, 32, 3, 2, 2, 2,
+	345, 346, 7, 47, 2, 2, 346, 34, 3, 2, 2, 2, 347, 348, 7, 49, 2, 2, 348,
+	36, 3, 2, 2, 2, 349, 350, 7, 39, 2, 2, 350, 38, 3, 2, 2, 2, 351, 352, 7,
+	96, 2, 2, 352, 40, 3, 2, 2, 2, 353, 354, 7, 62, 2, 2, 354, 355, 7, 64,
+	2, 2, 355, 42, 3, 2, 2, 2, 356, 357, 7, 62, 2, 2, 357, 44, 3, 2, 2, 2,
+	358, 359, 7, 64, 2, 2, 359, 46, 3, 2, 2, 2, 360, 361, 7, 62, 2, 2, 361,
+	362, 7, 63, 2, 2, 362, 48, 3, 2, 2, 2, 363, 364, 7, 64, 2, 2, 364, 365,
+	7, 63, 2, 2, 365, 50, 3, 2, 2, 2, 366, 367, 7, 48, 2, 2, 367, 52, 3, 2,
+	2, 2, 368, 369, 7, 38, 2, 2, 369, 54, 3, 2, 2, 2, 370, 371, 7, 10218, 2,
+	2, 371, 56, 3, 2, 2, 2, 372, 373, 7, 12298, 2, 2, 373, 58, 3, 2, 2, 2,
+	374, 375, 7, 65126, 2, 2, 375, 60, 3, 2, 2, 2, 376, 377, 7, 65310, 2, 2,
+	377, 62, 3, 2, 2, 2, 378, 379, 7, 10219, 2, 2, 379, 64, 3, 2, 2, 2, 380,
+	381, 7, 12299, 2, 2, 381, 66, 3, 2, 2, 2, 382, 383, 7, 65127, 2, 2, 383,
+	68, 3, 2, 2, 2, 384, 385, 7, 65312, 2, 2, 385, 70, 3, 2, 2, 2, 386, 387,
+	7, 175, 2, 2, 387, 72, 3, 2, 2, 2, 388, 389, 7, 8210, 2, 2, 389, 74, 3,
+	2, 2, 2, 390, 391, 7, 8211, 2, 2, 391, 76, 3, 2, 2, 2, 392, 393, 7, 8212,
+	2, 2, 393, 78, 3, 2, 2, 2, 394, 395, 7, 8213, 2, 2, 395, 80, 3, 2, 2, 2,
+	396, 397, 7, 8214, 2, 2, 397, 82, 3, 2, 2, 2, 398, 399, 7, 8215, 2, 2,
+	399, 84, 3, 2, 2, 2, 400, 401, 7, 8724, 2, 2, 401, 86, 3, 2, 2, 2, 402,
+	403, 7, 65114, 2, 2, 403, 88, 3, 2, 2, 2, 404, 405, 7, 65125, 2, 2, 405,
+	90, 3, 2, 2, 2, 406, 407, 7, 65295, 2, 2, 407, 92, 3, 2, 2, 2, 408, 409,
+	9, 2, 2, 2, 409, 410, 9, 3, 2, 2, 410, 411, 9, 4, 2, 2, 411, 412, 9, 5,
+	2, 2, 412, 413, 9, 3, 2, 2, 413, 94, 3, 2, 2, 2, 414, 415, 9, 6, 2, 2,
+	415, 416, 9, 7, 2, 2, 416, 417, 9, 7, 2, 2, 417, 96, 3, 2, 2, 2, 418, 419,
+	9, 5, 2, 2, 419, 420, 9, 8, 2, 2, 420, 421, 9, 9, 2, 2, 421, 422, 9, 4,
+	2, 2, 422, 423, 9, 5, 2, 2, 423, 424, 9, 3, 2, 2, 424, 425, 9, 6, 2, 2,
+	425, 426, 9, 7, 2, 2, 426, 98, 3, 2, 2, 2, 427, 428, 9, 10, 2, 2, 428,
+	429, 9, 6, 2, 2, 429, 430, 9, 9, 2, 2, 430, 431, 9, 11, 2, 2, 431, 432,
+	9, 12, 2, 2, 432, 100, 3, 2, 2, 2, 433, 434, 9, 2, 2, 2, 434, 435, 9, 3,
+	2, 2, 435, 436, 9, 13, 2, 2, 436, 437, 9, 4, 2, 2, 437, 438, 9, 3, 2, 2,
+	438, 439, 9, 14, 2, 2, 439, 102, 3, 2, 2, 2, 440, 441, 9, 6, 2, 2, 441,
+	442, 9, 15, 2, 2, 442, 104, 3, 2, 2, 2, 443, 444, 9, 10, 2, 2, 444, 445,
+	9, 16, 2, 2, 445, 446, 9, 17, 2, 2, 446, 447, 9, 18, 2, 2, 447, 448, 9,
+	16, 2, 2, 448, 106, 3, 2, 2, 2, 449, 450, 9, 5, 2, 2, 450, 451, 9, 3, 2,
+	2, 451, 108, 3, 2, 2, 2, 452, 453, 9, 11, 2, 2, 453, 454, 9, 17, 2, 2,
+	454, 455, 9, 16, 2, 2, 455, 456, 9, 6, 2, 2, 456, 457, 9, 9, 2, 2, 457,
+	458, 9, 16, 2, 2, 458, 110, 3, 2, 2, 2, 459, 460, 9, 15, 2, 2, 460, 461,
+	9, 16, 2, 2, 461, 462, 9, 9, 2, 2, 462, 112, 3, 2, 2, 2, 463, 464, 9, 14,
+	2, 2, 464, 465, 9, 16, 2, 2, 465, 466, 9, 9, 2, 2, 466, 467, 9, 6, 2, 2,
+	467, 468, 9, 11, 2, 2, 468, 469, 9, 12, 2, 2, 469, 114, 3, 2, 2, 2, 470,
+	471, 9, 14, 2, 2, 471, 472, 9, 16, 2, 2, 472, 473, 9, 7, 2, 2, 473, 474,
+	9, 16, 2, 2, 474, 475, 9, 9, 2, 2, 475, 476, 9, 16, 2, 2, 476, 116, 3,
+	2, 2, 2, 477, 478, 9, 17, 2, 2, 478, 479, 9, 16, 2, 2, 479, 480, 9, 10,
+	2, 2, 480, 481, 9, 5, 2, 2, 481, 482, 9, 19, 2, 2, 482, 483, 9, 16, 2,
+	2, 483, 118, 3, 2, 2, 2, 484, 485, 9, 11, 2, 2, 485, 486, 9, 6, 2, 2, 486,
+	487, 9, 7, 2, 2, 487, 488, 9, 7, 2, 2, 488, 120, 3, 2, 2, 2, 489, 490,
+	9, 20, 2, 2, 490, 491, 9, 4, 2, 2, 491, 492, 9, 16, 2, 2, 492, 493, 9,
+	7, 2, 2, 493, 494, 9, 14, 2, 2, 494, 122, 3, 2, 2, 2, 495, 496, 9, 11,
+	2, 2, 496, 497, 9, 5, 2, 2, 497, 498, 9, 3, 2, 2, 498, 499, 9, 11, 2, 2,
+	499, 500, 9, 2, 2, 2, 500, 501, 9, 17, 2, 2, 501, 502, 9, 17, 2, 2, 502,
+	503, 9, 16, 2, 2, 503, 504, 9, 3, 2, 2, 504, 505, 9, 9, 2, 2, 505, 124,
+	3, 2, 2, 2, 506, 507, 9, 9, 2, 2, 507, 508, 9, 17, 2, 2, 508, 509, 9, 6,
+	2, 2, 509, 510, 9, 3, 2, 2, 510, 511, 9, 15, 2, 2, 511, 512, 9, 6, 2, 2,
+	512, 513, 9, 11, 2, 2, 513, 514, 9, 9, 2, 2, 514, 515, 9, 4, 2, 2, 515,
+	516, 9, 5, 2, 2, 516, 517, 9, 3, 2, 2, 517, 518, 9, 15, 2, 2, 518, 126,
+	3, 2, 2, 2, 519, 520, 9, 17, 2, 2, 520, 521, 9, 5, 2, 2, 521, 522, 9, 13,
+	2, 2, 522, 128, 3, 2, 2, 2, 523, 524, 9, 17, 2, 2, 524, 525, 9, 5, 2, 2,
+	525, 526, 9, 13, 2, 2, 526, 527, 9, 15, 2, 2, 527, 130, 3, 2, 2, 2, 528,
+	529, 9, 16, 2, 2, 529, 530, 9, 17, 2, 2, 530, 531, 9, 17, 2, 2, 531, 532,
+	9, 5, 2, 2, 532, 533, 9, 17, 2, 2, 533, 132, 3, 2, 2, 2, 534, 535, 9, 11,
+	2, 2, 535, 536, 9, 5, 2, 2, 536, 537, 9, 3, 2, 2, 537, 538, 9, 9, 2, 2,
+	538, 539, 9, 4, 2, 2, 539, 540, 9, 3, 2, 2, 540, 541, 9, 2, 2, 2, 541,
+	542, 9, 16, 2, 2, 542, 134, 3, 2, 2, 2, 543, 544, 9, 21, 2, 2, 544, 545,
+	9, 17, 2, 2, 545, 546, 9, 16, 2, 2, 546, 547, 9, 6, 2, 2, 547, 548, 9,
+	22, 2, 2, 548, 136, 3, 2, 2, 2, 549, 550, 9, 23, 2, 2, 550, 551, 9, 6,
+	2, 2, 551, 552, 9, 4, 2, 2, 552, 553, 9, 7, 2, 2, 553, 138, 3, 2, 2, 2,
+	554, 555, 9, 13, 2, 2, 555, 556, 9, 4, 2, 2, 556, 557, 9, 9, 2, 2, 557,
+	558, 9, 12, 2, 2, 558, 140, 3, 2, 2, 2, 559, 560, 9, 14, 2, 2, 560, 561,
+	9, 4, 2, 2, 561, 562, 9, 15, 2, 2, 562, 563, 9, 9, 2, 2, 563, 564, 9, 4,
+	2, 2, 564, 565, 9, 3, 2, 2, 565, 566, 9, 11, 2, 2, 566, 567, 9, 9, 2, 2,
+	567, 142, 3, 2, 2, 2, 568, 569, 9, 17, 2, 2, 569, 570, 9, 16, 2, 2, 570,
+	571, 9, 9, 2, 2, 571, 572, 9, 2, 2, 2, 572, 573, 9, 17, 2, 2, 573, 574,
+	9, 3, 2, 2, 574, 144, 3, 2, 2, 2, 575, 576, 9, 5, 2, 2, 576, 577, 9, 17,
+	2, 2, 577, 578, 9, 14, 2, 2, 578, 579, 9, 16, 2, 2, 579, 580, 9, 17, 2,
+	2, 580, 146, 3, 2, 2, 2, 581, 582, 9, 21, 2, 2, 582, 583, 9, 20, 2, 2,
+	583, 148, 3, 2, 2, 2, 584, 585, 9, 15, 2, 2, 585, 586, 9, 22, 2, 2, 586,
+	587, 9, 4, 2, 2, 587, 588, 9, 8, 2, 2, 588, 150, 3, 2, 2, 2, 589, 590,
+	9, 7, 2, 2, 590, 591, 9, 4, 2, 2, 591, 592, 9, 10, 2, 2, 592, 593, 9, 4,
+	2, 2, 593, 594, 9, 9, 2, 2, 594, 152, 3, 2, 2, 2, 595, 596, 9, 6, 2, 2,
+	596, 597, 9, 15, 2, 2, 597, 598, 9, 11, 2, 2, 598, 599, 9, 16, 2, 2, 599,
+	600, 9, 3, 2, 2, 600, 601, 9, 14, 2, 2, 601, 602, 9, 4, 2, 2, 602, 603,
+	9, 3, 2, 2, 603, 604, 9, 18, 2, 2, 604, 154, 3, 2, 2, 2, 605, 606, 9, 6,
+	2, 2, 606, 607, 9, 15, 2, 2, 607, 608, 9, 11, 2, 2, 608, 156, 3, 2, 2,
+	2, 609, 610, 9, 14, 2, 2, 610, 611, 9, 16, 2, 2, 611, 612, 9, 15, 2, 2,
+	612, 613, 9, 11, 2, 2, 613, 614, 9, 16, 2, 2, 614, 615, 9, 3, 2, 2, 615,
+	616, 9, 14, 2, 2, 616, 617, 9, 4, 2, 2, 617, 618, 9, 3, 2, 2, 618, 619,
+	9, 18, 2, 2, 619, 158, 3, 2, 2, 2, 620, 621, 9, 14, 2, 2, 621, 622, 9,
+	16, 2, 2, 622, 623, 9, 15, 2, 2, 623, 624, 9, 11, 2, 2, 624, 160, 3, 2,
+	2, 2, 625, 626, 9, 13, 2, 2, 626, 627, 9, 12, 2, 2, 627, 628, 9, 16, 2,
+	2, 628, 629, 9, 17, 2, 2, 629, 630, 9, 16, 2, 2, 630, 162, 3, 2, 2, 2,
+	631, 632, 9, 5, 2, 2, 632, 633, 9, 17, 2, 2, 633, 164, 3, 2, 2, 2, 634,
+	635, 9, 24, 2, 2, 635, 636, 9, 5, 2, 2, 636, 637, 9, 17, 2, 2, 637, 166,
+	3, 2, 2, 2, 638, 639, 9, 6, 2, 2, 639, 640, 9, 3, 2, 2, 640, 641, 9, 14,
+	2, 2, 641, 168, 3, 2, 2, 2, 642, 643, 9, 3, 2, 2, 643, 644, 9, 5, 2, 2,
+	644, 645, 9, 9, 2, 2, 645, 170, 3, 2, 2, 2, 646, 647, 9, 4, 2, 2, 647,
+	648, 9, 3, 2, 2, 648, 172, 3, 2, 2, 2, 649, 650, 9, 15, 2, 2, 650, 651,
+	9, 9, 2, 2, 651, 652, 9, 6, 2, 2, 652, 653, 9, 17, 2, 2, 653, 654, 9, 9,
+	2, 2, 654, 655, 9, 15, 2, 2, 655, 174, 3, 2, 2, 2, 656, 657, 9, 16, 2,
+	2, 657, 658, 9, 3, 2, 2, 658, 659, 9, 14, 2, 2, 659, 660, 9, 15, 2, 2,
+	660, 176, 3, 2, 2, 2, 661, 662, 9, 11, 2, 2, 662, 663, 9, 5, 2, 2, 663,
+	664, 9, 3, 2, 2, 664, 665, 9, 9, 2, 2, 665, 666, 9, 6, 2, 2, 666, 667,
+	9, 4, 2, 2, 667, 668, 9, 3, 2, 2, 668, 669, 9, 15, 2, 2, 669, 178, 3, 2,
+	2, 2, 670, 671, 9, 4, 2, 2, 671, 672, 9, 15, 2, 2, 672, 180, 3, 2, 2, 2,
+	673, 674, 9, 3, 2, 2, 674, 675, 9, 2, 2, 2, 675, 676, 9, 7, 2, 2, 676,
+	677, 9, 7, 2, 2, 677, 182, 3, 2, 2, 2, 678, 679, 9, 11, 2, 2, 679, 680,
+	9, 5, 2, 2, 680, 681, 9, 2, 2, 2, 681, 682, 9, 3, 2, 2, 682, 683, 9, 9,
+	2, 2, 683, 184, 3, 2, 2, 2, 684, 685, 9, 6, 2, 2, 685, 686, 9, 3, 2, 2,
+	686, 687, 9, 20, 2, 2, 687, 186, 3, 2, 2, 2, 688, 689, 9, 3, 2, 2, 689,
+	690, 9, 5, 2, 2, 690, 691, 9, 3, 2, 2, 691, 692, 9, 16, 2, 2, 692, 188,
+	3, 2, 2, 2, 693, 694, 9, 15, 2, 2, 694, 695, 9, 4, 2, 2, 695, 696, 9, 3,
+	2, 2, 696, 697, 9, 18, 2, 2, 697, 698, 9, 7, 2, 2, 698, 699, 9, 16, 2,
+	2, 699, 190, 3, 2, 2, 2, 700, 701, 9, 9, 2, 2, 701, 702, 9, 17, 2, 2, 702,
+	703, 9, 2, 2, 2, 703, 704, 9, 16, 2, 2, 704, 192, 3, 2, 2, 2, 705, 706,
+	9, 23, 2, 2, 706, 707, 9, 6, 2, 2, 707, 708, 9, 7, 2, 2, 708, 709, 9, 15,
+	2, 2, 709, 710, 9, 16, 2, 2, 710, 194, 3, 2, 2, 2, 711, 712, 9, 16, 2,
+	2, 712, 713, 9, 24, 2, 2, 713, 714, 9, 4, 2, 2, 714, 715, 9, 15, 2, 2,
+	715, 716, 9, 9, 2, 2, 716, 717, 9, 15, 2, 2, 717, 196, 3, 2, 2, 2, 718,
+	719, 9, 11, 2, 2, 719, 720, 9, 6, 2, 2, 720, 721, 9, 15, 2, 2, 721, 722,
+	9, 16, 2, 2, 722, 198, 3, 2, 2, 2, 723, 724, 9, 16, 2, 2, 724, 725, 9,
+	7, 2, 2, 725, 726, 9, 15, 2, 2, 726, 727, 9, 16, 2, 2, 727, 200, 3, 2,
+	2, 2, 728, 729, 9, 16, 2, 2, 729, 730, 9, 3, 2, 2, 730, 731, 9, 14, 2,
+	2, 731, 202, 3, 2, 2, 2, 732, 733, 9, 13, 2, 2, 733, 734, 9, 12, 2, 2,
+	734, 735, 9, 16, 2, 2, 735, 736, 9, 3, 2, 2, 736, 204, 3, 2, 2, 2, 737,
+	738, 9, 9, 2, 2, 738, 739, 9, 12, 2, 2, 739, 740, 9, 16, 2, 2, 740, 741,
+	9, 3, 2, 2, 741, 206, 3, 2, 2, 2, 742, 747, 7, 36, 2, 2, 743, 746, 5, 303,
+	152, 2, 744, 746, 5, 209, 105, 2, 745, 743, 3, 2, 2, 2, 745, 744, 3, 2,
+	2, 2, 746, 749, 3, 2, 2, 2, 747, 745, 3, 2, 2, 2, 747, 748, 3, 2, 2, 2,
+	748, 750, 3, 2, 2, 2, 749, 747, 3, 2, 2, 2, 750, 761, 7, 36, 2, 2, 751,
+	756, 7, 41, 2, 2, 752, 755, 5, 283, 142, 2, 753, 755, 5, 209, 105, 2, 754,
+	752, 3, 2, 2, 2, 754, 753, 3, 2, 2, 2, 755, 758, 3, 2, 2, 2, 756, 754,
+	3, 2, 2, 2, 756, 757, 3, 2, 2, 2, 757, 759, 3, 2, 2, 2, 758, 756, 3, 2,
+	2, 2, 759, 761, 7, 41, 2, 2, 760, 742, 3, 2, 2, 2, 760, 751, 3, 2, 2, 2,
+	761, 208, 3, 2, 2, 2, 762, 780, 7, 94, 2, 2, 763, 781, 9, 25, 2, 2, 764,
+	765, 9, 2, 2, 2, 765, 766, 5, 219, 110, 2, 766, 767, 5, 219, 110, 2, 767,
+	768, 5, 219, 110, 2, 768, 769, 5, 219, 110, 2, 769, 781, 3, 2, 2, 2, 770,
+	771, 9, 2, 2, 2, 771, 772, 5, 219, 110, 2, 772, 773, 5, 219, 110, 2, 773,
+	774, 5, 219, 110, 2, 774, 775, 5, 219, 110, 2, 775, 776, 5, 219, 110, 2,
+	776, 777, 5, 219, 110, 2, 777, 778, 5, 219, 110, 2, 778, 779, 5, 219, 110,
+	2, 779, 781, 3, 2, 2, 2, 780, 763, 3, 2, 2, 2, 780, 764, 3, 2, 2, 2, 780,
+	770, 3, 2, 2, 2, 781, 210, 3, 2, 2, 2, 782, 783, 7, 50, 2, 2, 783, 784,
+	7, 122, 2, 2, 784, 786, 3, 2, 2, 2, 785, 787, 5, 219, 110, 2, 786, 785,
+	3, 2, 2, 2, 787, 788, 3, 2, 2, 2, 788, 786, 3, 2, 2, 2, 788, 789, 3, 2,
+	2, 2, 789, 212, 3, 2, 2, 2, 790, 799, 5, 229, 115, 2, 791, 795, 5, 223,
+	112, 2, 792, 794, 5, 221, 111, 2, 793, 792, 3, 2, 2, 2, 794, 797, 3, 2,
+	2, 2, 795, 793, 3, 2, 2, 2, 795, 796, 3, 2, 2, 2, 796, 799, 3, 2, 2, 2,
+	797, 795, 3, 2, 2, 2, 798, 790, 3, 2, 2, 2, 798, 791, 3, 2, 2, 2, 799,
+	214, 3, 2, 2, 2, 800, 802, 5, 229, 115, 2, 801, 803, 5, 227, 114, 2, 802,
+	801, 3, 2, 2, 2, 803, 804, 3, 2, 2, 2, 804, 802, 3, 2, 2, 2, 804, 805,
+	3, 2, 2, 2, 805, 216, 3, 2, 2, 2, 806, 808, 9, 26, 2, 2, 807, 806, 3, 2,
+	2, 2, 808, 218, 3, 2, 2, 2, 809, 812, 5, 221, 111, 2, 810, 812, 5, 217,
+	109, 2, 811, 809, 3, 2, 2, 2, 811, 810, 3, 2, 2, 2, 812, 220, 3, 2, 2,
+	2, 813, 816, 5, 229, 115, 2, 814, 816, 5, 223, 112, 2, 815, 813, 3, 2,
+	2, 2, 815, 814, 3, 2, 2, 2, 816, 222, 3, 2, 2, 2, 817, 820, 5, 225, 113,
+	2, 818, 820, 4, 58, 59, 2, 819, 817, 3, 2, 2, 2, 819, 818, 3, 2, 2, 2,
+	820, 224, 3, 2, 2, 2, 821, 822, 4, 51, 57, 2, 822, 226, 3, 2, 2, 2, 823,
+	826, 5, 229, 115, 2, 824, 826, 5, 225, 113, 2, 825, 823, 3, 2, 2, 2, 825,
+	824, 3, 2, 2, 2, 826, 228, 3, 2, 2, 2, 827, 828, 7, 50, 2, 2, 828, 230,
+	3, 2, 2, 2, 829, 831, 5, 221, 111, 2, 830, 829, 3, 2, 2, 2, 831, 832, 3,
+	2, 2, 2, 832, 830, 3, 2, 2, 2, 832, 833, 3, 2, 2, 2, 833, 852, 3, 2, 2,
+	2, 834, 836, 5, 221, 111, 2, 835, 834, 3, 2, 2, 2, 836, 837, 3, 2, 2, 2,
+	837, 835, 3, 2, 2, 2, 837, 838, 3, 2, 2, 2, 838, 839, 3, 2, 2, 2, 839,
+	841, 7, 48, 2, 2, 840, 842, 5, 221, 111, 2, 841, 840, 3, 2, 2, 2, 842,
+	843, 3, 2, 2, 2, 843, 841, 3, 2, 2, 2, 843, 844, 3, 2, 2, 2, 844, 852,
+	3, 2, 2, 2, 845, 847, 7, 48, 2, 2, 846, 848, 5, 221, 111, 2, 847, 846,
+	3, 2, 2, 2, 848, 849, 3, 2, 2, 2, 849, 847, 3, 2, 2, 2, 849, 850, 3, 2,
+	2, 2, 850, 852, 3, 2, 2, 2, 851, 830, 3, 2, 2, 2, 851, 835, 3, 2, 2, 2,
+	851, 845, 3, 2, 2, 2, 852, 854, 3, 2, 2, 2, 853, 855, 9, 16, 2, 2, 854,
+	853, 3, 2, 2, 2, 855, 857, 3, 2, 2, 2, 856, 858, 7, 47, 2, 2, 857, 856,
+	3, 2, 2, 2, 857, 858, 3, 2, 2, 2, 858, 860, 3, 2, 2, 2, 859, 861, 5, 221,
+	111, 2, 860, 859, 3, 2, 2, 2, 861, 862, 3, 2, 2, 2, 862, 860, 3, 2, 2,
+	2, 862, 863, 3, 2, 2, 2, 863, 232, 3, 2, 2, 2, 864, 866, 5, 221, 111, 2,
+	865, 864, 3, 2, 2, 2, 866, 869, 3, 2, 2, 2, 867, 865, 3, 2, 2, 2, 867,
+	868, 3, 2, 2, 2, 868, 870, 3, 2, 2, 2, 869, 867, 3, 2, 2, 2, 870, 872,
+	7, 48, 2, 2, 871, 873, 5, 221, 111, 2, 872, 871, 3, 2, 2, 2, 873, 874,
+	3, 2, 2, 2, 874, 872, 3, 2, 2, 2, 874, 875, 3, 2, 2, 2, 875, 234, 3, 2,
+	2, 2, 876, 877, 9, 11, 2, 2, 877, 878, 9, 5, 2, 2, 878, 879, 9, 3, 2, 2,
+	879, 880, 9, 15, 2, 2, 880, 881, 9, 9, 2, 2, 881, 882, 9, 17, 2, 2, 882,
+	883, 9, 6, 2, 2, 883, 884, 9, 4, 2, 2, 884, 885, 9, 3, 2, 2, 885, 886,
+	9, 9, 2, 2, 886, 236, 3, 2, 2, 2, 887, 888, 9, 14, 2, 2, 888, 889, 9, 5,
+	2, 2, 889, 238, 3, 2, 2, 2, 890, 891, 9, 23, 2, 2, 891, 892, 9, 5, 2, 2,
+	892, 893, 9, 17, 2, 2, 893, 240, 3, 2, 2, 2, 894, 895, 9, 17, 2, 2, 895,
+	896, 9, 16, 2, 2, 896, 897, 9, 27, 2, 2, 897, 898, 9, 2, 2, 2, 898, 899,
+	9, 4, 2, 2, 899, 900, 9, 17, 2, 2, 900, 901, 9, 16, 2, 2, 901, 242, 3,
+	2, 2, 2, 902, 903, 9, 2, 2, 2, 903, 904, 9, 3, 2, 2, 904, 905, 9, 4, 2,
+	2, 905, 906, 9, 27, 2, 2, 906, 907, 9, 2, 2, 2, 907, 908, 9, 16, 2, 2,
+	908, 244, 3, 2, 2, 2, 909, 910, 9, 10, 2, 2, 910, 911, 9, 6, 2, 2, 911,
+	912, 9, 3, 2, 2, 912, 913, 9, 14, 2, 2, 913, 914, 9, 6, 2, 2, 914, 915,
+	9, 9, 2, 2, 915, 916, 9, 5, 2, 2, 916, 917, 9, 17, 2, 2, 917, 918, 9, 20,
+	2, 2, 918, 246, 3, 2, 2, 2, 919, 920, 9, 15, 2, 2, 920, 921, 9, 11, 2,
+	2, 921, 922, 9, 6, 2, 2, 922, 923, 9, 7, 2, 2, 923, 924, 9, 6, 2, 2, 924,
+	925, 9, 17, 2, 2, 925, 248, 3, 2, 2, 2, 926, 927, 9, 5, 2, 2, 927, 928,
+	9, 23, 2, 2, 928, 250, 3, 2, 2, 2, 929, 930, 9, 6, 2, 2, 930, 931, 9, 14,
+	2, 2, 931, 932, 9, 14, 2, 2, 932, 252, 3, 2, 2, 2, 933, 934, 9, 14, 2,
+	2, 934, 935, 9, 17, 2, 2, 935, 936, 9, 5, 2, 2, 936, 937, 9, 8, 2, 2, 937,
+	254, 3, 2, 2, 2, 938, 939, 9, 23, 2, 2, 939, 940, 9, 4, 2, 2, 940, 941,
+	9, 7, 2, 2, 941, 942, 9, 9, 2, 2, 942, 943, 9, 16, 2, 2, 943, 944, 9, 17,
+	2, 2, 944, 256, 3, 2, 2, 2, 945, 946, 9, 16, 2, 2, 946, 947, 9, 24, 2,
+	2, 947, 948, 9, 9, 2, 2, 948, 949, 9, 17, 2, 2, 949, 950, 9, 6, 2, 2, 950,
+	951, 9, 11, 2, 2, 951, 952, 9, 9, 2, 2, 952, 258, 3, 2, 2, 2, 953, 957,
+	5, 261, 131, 2, 954, 956, 5, 263, 132, 2, 955, 954, 3, 2, 2, 2, 956, 959,
+	3, 2, 2, 2, 957, 955, 3, 2, 2, 2, 957, 958, 3, 2, 2, 2, 958, 260, 3, 2,
+	2, 2, 959, 957, 3, 2, 2, 2, 960, 963, 5, 311, 156, 2, 961, 963, 5, 299,
+	150, 2, 962, 960, 3, 2, 2, 2, 962, 961, 3, 2, 2, 2, 963, 262, 3, 2, 2,
+	2, 964, 967, 5, 279, 140, 2, 965, 967, 5, 295, 148, 2, 966, 964, 3, 2,
+	2, 2, 966, 965, 3, 2, 2, 2, 967, 264, 3, 2, 2, 2, 968, 972, 7, 98, 2, 2,
+	969, 971, 5, 275, 138, 2, 970, 969, 3, 2, 2, 2, 971, 974, 3, 2, 2, 2, 972,
+	970, 3, 2, 2, 2, 972, 973, 3, 2, 2, 2, 973, 975, 3, 2, 2, 2, 974, 972,
+	3, 2, 2, 2, 975, 977, 7, 98, 2, 2, 976, 968, 3, 2, 2, 2, 977, 978, 3, 2,
+	2, 2, 978, 976, 3, 2, 2, 2, 978, 979, 3, 2, 2, 2, 979, 266, 3, 2, 2, 2,
+	980, 982, 5, 269, 135, 2, 981, 980, 3, 2, 2, 2, 982, 983, 3, 2, 2, 2, 983,
+	981, 3, 2, 2, 2, 983, 984, 3, 2, 2, 2, 984, 268, 3, 2, 2, 2, 985, 997,
+	5, 297, 149, 2, 986, 997, 5, 301, 151, 2, 987, 997, 5, 305, 153, 2, 988,
+	997, 5, 307, 154, 2, 989, 997, 5, 273, 137, 2, 990, 997, 5, 293, 147, 2,
+	991, 997, 5, 291, 146, 2, 992, 997, 5, 289, 145, 2, 993, 997, 5, 277, 139,
+	2, 994, 997, 5, 309, 155, 2, 995, 997, 9, 28, 2, 2, 996, 985, 3, 2, 2,
+	2, 996, 986, 3, 2, 2, 2, 996, 987, 3, 2, 2, 2, 996, 988, 3, 2, 2, 2, 996,
+	989, 3, 2, 2, 2, 996, 990, 3, 2, 2, 2, 996, 991, 3, 2, 2, 2, 996, 992,
+	3, 2, 2, 2, 996, 993, 3, 2, 2, 2, 996, 994, 3, 2, 2, 2, 996, 995, 3, 2,
+	2, 2, 997, 270, 3, 2, 2, 2, 998, 999, 7, 49, 2, 2, 999, 1000, 7, 44, 2,
+	2, 1000, 1006, 3, 2, 2, 2, 1001, 1005, 5, 281, 141, 2, 1002, 1003, 7, 44,
+	2, 2, 1003, 1005, 5, 287, 144, 2, 1004, 1001, 3, 2, 2, 2, 1004, 1002, 3,
+	2, 2, 2, 1005, 1008, 3, 2, 2, 2, 1006, 1004, 3, 2, 2, 2, 1006, 1007, 3,
+	2, 2, 2, 1007, 1009, 3, 2, 2, 2, 1008, 1006, 3, 2, 2, 2, 1009, 1010, 7,
+	44, 2, 2, 1010, 1028, 7, 49, 2, 2, 1011, 1012, 7, 49, 2, 2, 1012, 1013,
+	7, 49, 2, 2, 1013, 1017, 3, 2, 2, 2, 1014, 1016, 5, 285, 143, 2, 1015,
+	1014, 3, 2, 2, 2, 1016, 1019, 3, 2, 2, 2, 1017, 1015, 3, 2, 2, 2, 1017,
+	1018, 3, 2, 2, 2, 1018, 1021, 3, 2, 2, 2, 1019, 1017, 3, 2, 2, 2, 1020,
+	1022, 5, 293, 147, 2, 1021, 1020, 3, 2, 2, 2, 1021, 1022, 3, 2, 2, 2, 1022,
+	1025, 3, 2, 2, 2, 1023, 1026, 5, 305, 153, 2, 1024, 1026, 7, 2, 2, 3, 1025,
+	1023, 3, 2, 2, 2, 1025, 1024, 3, 2, 2, 2, 1026, 1028, 3, 2, 2, 2, 1027,
+	998, 3, 2, 2, 2, 1027, 1011, 3, 2, 2, 2, 1028, 1029, 3, 2, 2, 2, 1029,
+	1030, 8, 136, 2, 2, 1030, 272, 3, 2, 2, 2, 1031, 1032, 9, 29, 2, 2, 1032,
+	274, 3, 2, 2, 2, 1033, 1034, 9, 30, 2, 2, 1034, 276, 3, 2, 2, 2, 1035,
+	1036, 9, 31, 2, 2, 1036, 278, 3, 2, 2, 2, 1037, 1038, 9, 32, 2, 2, 1038,
+	280, 3, 2, 2, 2, 1039, 1040, 9, 33, 2, 2, 1040, 282, 3, 2, 2, 2, 1041,
+	1042, 9, 34, 2, 2, 1042, 284, 3, 2, 2, 2, 1043, 1044, 9, 35, 2, 2, 1044,
+	286, 3, 2, 2, 2, 1045, 1046, 9, 36, 2, 2, 1046, 288, 3, 2, 2, 2, 1047,
+	1048, 9, 37, 2, 2, 1048, 290, 3, 2, 2, 2, 1049, 1050, 9, 38, 2, 2, 1050,
+	292, 3, 2, 2, 2, 1051, 1052, 9, 39, 2, 2, 1052, 294, 3, 2, 2, 2, 1053,
+	1054, 9, 40, 2, 2, 1054, 296, 3, 2, 2, 2, 1055, 1056, 9, 41, 2, 2, 1056,
+	298, 3, 2, 2, 2, 1057, 1058, 9, 42, 2, 2, 1058, 300, 3, 2, 2, 2, 1059,
+	1060, 9, 43, 2, 2, 1060, 302, 3, 2, 2, 2, 1061, 1062, 9, 44, 2, 2, 1062,
+	304, 3, 2, 2, 2, 1063, 1064, 9, 45, 2, 2, 1064, 306, 3, 2, 2, 2, 1065,
+	1066, 9, 46, 2, 2, 1066, 308, 3, 2, 2, 2, 1067, 1068, 9, 47, 2, 2, 1068,
+	310, 3, 2, 2, 2, 1069, 1070, 9, 48, 2, 2, 1070, 312, 3, 2, 2, 2, 41, 2,
+	745, 747, 754, 756, 760, 780, 788, 795, 798, 804, 807, 811, 815, 819, 825,
+	832, 837, 843, 849, 851, 854, 857, 862, 867, 874, 957, 962, 966, 972, 978,
+	983, 996, 1004, 1006, 1017, 1021, 1025, 1027, 3, 2, 3, 2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -621,16 +656,16 @@ var lexerModeNames = []string{
 }
 
 var lexerLiteralNames = []string{
-	"", "';'", "','", "'='", "'+='", "'*'", "'('", "')'", "'['", "']'", "':'",
-	"'|'", "'..'", "'+'", "'-'", "'/'", "'%'", "'^'", "'<>'", "'<'", "'>'",
-	"'<='", "'>='", "'.'", "'{'", "'}'", "'$'", "'\u27E8'", "'\u3008'", "'\uFE64'",
+	"", "';'", "','", "'='", "'+='", "'{'", "'}'", "'*'", "'('", "')'", "'['",
+	"']'", "':'", "'|'", "'..'", "'+'", "'-'", "'/'", "'%'", "'^'", "'<>'",
+	"'<'", "'>'", "'<='", "'>='", "'.'", "'$'", "'\u27E8'", "'\u3008'", "'\uFE64'",
 	"'\uFF1C'", "'\u27E9'", "'\u3009'", "'\uFE65'", "'\uFF1E'", "'\u00AD'",
 	"'\u2010'", "'\u2011'", "'\u2012'", "'\u2013'", "'\u2014'", "'\u2015'",
 	"'\u2212'", "'\uFE58'", "'\uFE63'", "'\uFF0D'", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"'0'",
+	"", "", "", "", "", "", "", "", "'0'",
 }
 
 var lexerSymbolicNames = []string{
@@ -638,11 +673,12 @@ var lexerSymbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "UNION", "ALL", "OPTIONAL", "MATCH",
 	"UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE", "REMOVE",
-	"CALL", "YIELD", "WITH", "DISTINCT", "RETURN", "ORDER", "BY", "L_SKIP",
-	"LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR", "XOR",
-	"AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL", "COUNT",
-	"ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE", "END",
-	"WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
+	"CALL", "YIELD", "CONCURRENT", "TRANSACTIONS", "ROW", "ROWS", "ERROR",
+	"CONTINUE", "BREAK", "FAIL", "WITH", "DISTINCT", "RETURN", "ORDER", "BY",
+	"L_SKIP", "LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR",
+	"XOR", "AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL",
+	"COUNT", "ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE",
+	"END", "WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
 	"OctalInteger", "HexLetter", "HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit",
 	"OctDigit", "ZeroDigit", "ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT",
 	"DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP",
@@ -658,11 +694,12 @@ var lexerRuleNames = []string{
 	"T__33", "T__34", "T__35", "T__36", "T__37", "T__38", "T__39", "T__40",
 	"T__41", "T__42", "T__43", "T__44", "UNION", "ALL", "OPTIONAL", "MATCH",
 	"UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE", "REMOVE",
-	"CALL", "YIELD", "WITH", "DISTINCT", "RETURN", "ORDER", "BY", "L_SKIP",
-	"LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR", "XOR",
-	"AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL", "COUNT",
-	"ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE", "END",
-	"WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
+	"CALL", "YIELD", "CONCURRENT", "TRANSACTIONS", "ROW", "ROWS", "ERROR",
+	"CONTINUE", "BREAK", "FAIL", "WITH", "DISTINCT", "RETURN", "ORDER", "BY",
+	"L_SKIP", "LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR",
+	"XOR", "AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL",
+	"COUNT", "ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE",
+	"END", "WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
 	"OctalInteger", "HexLetter", "HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit",
 	"OctDigit", "ZeroDigit", "ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT",
 	"DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP",
@@ -768,71 +805,79 @@ const (
 	CypherLexerREMOVE                = 58
 	CypherLexerCALL                  = 59
 	CypherLexerYIELD                 = 60
-	CypherLexerWITH                  = 61
-	CypherLexerDISTINCT              = 62
-	CypherLexerRETURN                = 63
-	CypherLexerORDER                 = 64
-	CypherLexerBY                    = 65
-	CypherLexerL_SKIP                = 66
-	CypherLexerLIMIT                 = 67
-	CypherLexerASCENDING             = 68
-	CypherLexerASC                   = 69
-	CypherLexerDESCENDING            = 70
-	CypherLexerDESC                  = 71
-	CypherLexerWHERE                 = 72
-	CypherLexerOR                    = 73
-	CypherLexerXOR                   = 74
-	CypherLexerAND                   = 75
-	CypherLexerNOT                   = 76
-	CypherLexerIN                    = 77
-	CypherLexerSTARTS                = 78
-	CypherLexerENDS                  = 79
-	CypherLexerCONTAINS              = 80
-	CypherLexerIS                    = 81
-	CypherLexerNULL                  = 82
-	CypherLexerCOUNT                 = 83
-	CypherLexerANY                   = 84
-	CypherLexerNONE                  = 85
-	CypherLexerSINGLE                = 86
-	CypherLexerTRUE                  = 87
-	CypherLexerFALSE                 = 88
-	CypherLexerEXISTS                = 89
-	CypherLexerCASE                  = 90
-	CypherLexerELSE                  = 91
-	CypherLexerEND                   = 92
-	CypherLexerWHEN                  = 93
-	CypherLexerTHEN                  = 94
-	CypherLexerStringLiteral         = 95
-	CypherLexerEscapedChar           = 96
-	CypherLexerHexInteger            = 97
-	CypherLexerDecimalInteger        = 98
-	CypherLexerOctalInteger          = 99
-	CypherLexerHexLetter             = 100
-	CypherLexerHexDigit              = 101
-	CypherLexerDigit                 = 102
-	CypherLexerNonZeroDigit          = 103
-	CypherLexerNonZeroOctDigit       = 104
-	CypherLexerOctDigit              = 105
-	CypherLexerZeroDigit             = 106
-	CypherLexerExponentDecimalReal   = 107
-	CypherLexerRegularDecimalReal    = 108
-	CypherLexerCONSTRAINT            = 109
-	CypherLexerDO                    = 110
-	CypherLexerFOR                   = 111
-	CypherLexerREQUIRE               = 112
-	CypherLexerUNIQUE                = 113
-	CypherLexerMANDATORY             = 114
-	CypherLexerSCALAR                = 115
-	CypherLexerOF                    = 116
-	CypherLexerADD                   = 117
-	CypherLexerDROP                  = 118
-	CypherLexerFILTER                = 119
-	CypherLexerEXTRACT               = 120
-	CypherLexerUnescapedSymbolicName = 121
-	CypherLexerIdentifierStart       = 122
-	CypherLexerIdentifierPart        = 123
-	CypherLexerEscapedSymbolicName   = 124
-	CypherLexerSP                    = 125
-	CypherLexerWHITESPACE            = 126
-	CypherLexerComment               = 127
+	CypherLexerCONCURRENT            = 61
+	CypherLexerTRANSACTIONS          = 62
+	CypherLexerROW                   = 63
+	CypherLexerROWS                  = 64
+	CypherLexerERROR                 = 65
+	CypherLexerCONTINUE              = 66
+	CypherLexerBREAK                 = 67
+	CypherLexerFAIL                  = 68
+	CypherLexerWITH                  = 69
+	CypherLexerDISTINCT              = 70
+	CypherLexerRETURN                = 71
+	CypherLexerORDER                 = 72
+	CypherLexerBY                    = 73
+	CypherLexerL_SKIP                = 74
+	CypherLexerLIMIT                 = 75
+	CypherLexerASCENDING             = 76
+	CypherLexerASC                   = 77
+	CypherLexerDESCENDING            = 78
+	CypherLexerDESC                  = 79
+	CypherLexerWHERE                 = 80
+	CypherLexerOR                    = 81
+	CypherLexerXOR                   = 82
+	CypherLexerAND                   = 83
+	CypherLexerNOT                   = 84
+	CypherLexerIN                    = 85
+	CypherLexerSTARTS                = 86
+	CypherLexerENDS                  = 87
+	CypherLexerCONTAINS              = 88
+	CypherLexerIS                    = 89
+	CypherLexerNULL                  = 90
+	CypherLexerCOUNT                 = 91
+	CypherLexerANY                   = 92
+	CypherLexerNONE                  = 93
+	CypherLexerSINGLE                = 94
+	CypherLexerTRUE                  = 95
+	CypherLexerFALSE                 = 96
+	CypherLexerEXISTS                = 97
+	CypherLexerCASE                  = 98
+	CypherLexerELSE                  = 99
+	CypherLexerEND                   = 100
+	CypherLexerWHEN                  = 101
+	CypherLexerTHEN                  = 102
+	CypherLexerStringLiteral         = 103
+	CypherLexerEscapedChar           = 104
+	CypherLexerHexInteger            = 105
+	CypherLexerDecimalInteger        = 106
+	CypherLexerOctalInteger          = 107
+	CypherLexerHexLetter             = 108
+	CypherLexerHexDigit              = 109
+	CypherLexerDigit                 = 110
+	CypherLexerNonZeroDigit          = 111
+	CypherLexerNonZeroOctDigit       = 112
+	CypherLexerOctDigit              = 113
+	CypherLexerZeroDigit             = 114
+	CypherLexerExponentDecimalReal   = 115
+	CypherLexerRegularDecimalReal    = 116
+	CypherLexerCONSTRAINT            = 117
+	CypherLexerDO                    = 118
+	CypherLexerFOR                   = 119
+	CypherLexerREQUIRE               = 120
+	CypherLexerUNIQUE                = 121
+	CypherLexerMANDATORY             = 122
+	CypherLexerSCALAR                = 123
+	CypherLexerOF                    = 124
+	CypherLexerADD                   = 125
+	CypherLexerDROP                  = 126
+	CypherLexerFILTER                = 127
+	CypherLexerEXTRACT               = 128
+	CypherLexerUnescapedSymbolicName = 129
+	CypherLexerIdentifierStart       = 130
+	CypherLexerIdentifierPart        = 131
+	CypherLexerEscapedSymbolicName   = 132
+	CypherLexerSP                    = 133
+	CypherLexerWHITESPACE            = 134
+	CypherLexerComment               = 135
 )
@@ -16,7 +16,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 129, 1561,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 137, 1678,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -36,794 +36,859 @@ var parserATN = []uint16{
 	4, 87, 9, 87, 4, 88, 9, 88, 4, 89, 9, 89, 4, 90, 9, 90, 4, 91, 9, 91, 4,
 	92, 9, 92, 4, 93, 9, 93, 4, 94, 9, 94, 4, 95, 9, 95, 4, 96, 9, 96, 4, 97,
 	9, 97, 4, 98, 9, 98, 4, 99, 9, 99, 4, 100, 9, 100, 4, 101, 9, 101, 4, 102,
-	9, 102, 4, 103, 9, 103, 3, 2, 5, 2, 208, 10, 2, 3, 2, 3, 2, 5, 2, 212,
-	10, 2, 3, 2, 5, 2, 215, 10, 2, 3, 2, 5, 2, 218, 10, 2, 3, 2, 3, 2, 3, 3,
-	3, 3, 3, 4, 3, 4, 5, 4, 226, 10, 4, 3, 5, 3, 5, 5, 5, 230, 10, 5, 3, 5,
-	7, 5, 233, 10, 5, 12, 5, 14, 5, 236, 11, 5, 3, 6, 3, 6, 3, 6, 3, 6, 5,
-	6, 242, 10, 6, 3, 6, 3, 6, 3, 6, 5, 6, 247, 10, 6, 3, 6, 5, 6, 250, 10,
-	6, 3, 7, 3, 7, 5, 7, 254, 10, 7, 3, 8, 3, 8, 5, 8, 258, 10, 8, 7, 8, 260,
-	10, 8, 12, 8, 14, 8, 263, 11, 8, 3, 8, 3, 8, 3, 8, 5, 8, 268, 10, 8, 7,
-	8, 270, 10, 8, 12, 8, 14, 8, 273, 11, 8, 3, 8, 3, 8, 5, 8, 277, 10, 8,
-	3, 8, 7, 8, 280, 10, 8, 12, 8, 14, 8, 283, 11, 8, 3, 8, 5, 8, 286, 10,
-	8, 3, 8, 5, 8, 289, 10, 8, 5, 8, 291, 10, 8, 3, 9, 6, 9, 294, 10, 9, 13,
-	9, 14, 9, 295, 3, 9, 3, 9, 3, 10, 3, 10, 5, 10, 302, 10, 10, 7, 10, 304,
-	10, 10, 12, 10, 14, 10, 307, 11, 10, 3, 10, 3, 10, 5, 10, 311, 10, 10,
-	7, 10, 313, 10, 10, 12, 10, 14, 10, 316, 11, 10, 3, 10, 3, 10, 5, 10, 320,
-	10, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 5, 11, 327, 10, 11, 3, 12, 3,
-	12, 3, 12, 5, 12, 332, 10, 12, 3, 13, 3, 13, 5, 13, 336, 10, 13, 3, 13,
-	3, 13, 5, 13, 340, 10, 13, 3, 13, 3, 13, 5, 13, 344, 10, 13, 3, 13, 5,
-	13, 347, 10, 13, 3, 14, 3, 14, 5, 14, 351, 10, 14, 3, 14, 3, 14, 3, 14,
-	3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 5, 15, 361, 10, 15, 3, 15, 3, 15, 3,
-	15, 7, 15, 366, 10, 15, 12, 15, 14, 15, 369, 11, 15, 3, 16, 3, 16, 3, 16,
-	3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 5, 16, 381, 10, 16, 3,
-	17, 3, 17, 5, 17, 385, 10, 17, 3, 17, 3, 17, 3, 18, 3, 18, 5, 18, 391,
-	10, 18, 3, 18, 3, 18, 3, 18, 7, 18, 396, 10, 18, 12, 18, 14, 18, 399, 11,
-	18, 3, 19, 3, 19, 5, 19, 403, 10, 19, 3, 19, 3, 19, 5, 19, 407, 10, 19,
-	3, 19, 3, 19, 3, 19, 3, 19, 5, 19, 413, 10, 19, 3, 19, 3, 19, 5, 19, 417,
-	10, 19, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19, 423, 10, 19, 3, 19, 3, 19, 5,
-	19, 427, 10, 19, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19, 433, 10, 19, 3, 19,
-	3, 19, 5, 19, 437, 10, 19, 3, 20, 3, 20, 5, 20, 441, 10, 20, 3, 20, 3,
-	20, 5, 20, 445, 10, 20, 3, 20, 3, 20, 5, 20, 449, 10, 20, 3, 20, 3, 20,
-	5, 20, 453, 10, 20, 3, 20, 7, 20, 456, 10, 20, 12, 20, 14, 20, 459, 11,
-	20, 3, 21, 3, 21, 3, 21, 3, 21, 5, 21, 465, 10, 21, 3, 21, 3, 21, 5, 21,
-	469, 10, 21, 3, 21, 7, 21, 472, 10, 21, 12, 21, 14, 21, 475, 11, 21, 3,
-	22, 3, 22, 3, 22, 3, 22, 5, 22, 481, 10, 22, 3, 23, 3, 23, 3, 23, 3, 23,
-	5, 23, 487, 10, 23, 3, 23, 3, 23, 3, 23, 5, 23, 492, 10, 23, 3, 24, 3,
-	24, 3, 24, 3, 24, 5, 24, 498, 10, 24, 3, 24, 3, 24, 3, 24, 3, 24, 5, 24,
-	504, 10, 24, 3, 25, 3, 25, 3, 25, 5, 25, 509, 10, 25, 3, 25, 3, 25, 5,
-	25, 513, 10, 25, 3, 25, 7, 25, 516, 10, 25, 12, 25, 14, 25, 519, 11, 25,
-	5, 25, 521, 10, 25, 3, 25, 5, 25, 524, 10, 25, 3, 25, 5, 25, 527, 10, 25,
-	3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 5, 26, 534, 10, 26, 3, 26, 3, 26, 3,
-	27, 3, 27, 5, 27, 540, 10, 27, 3, 27, 5, 27, 543, 10, 27, 3, 27, 3, 27,
-	3, 27, 5, 27, 548, 10, 27, 3, 27, 5, 27, 551, 10, 27, 3, 28, 3, 28, 5,
-	28, 555, 10, 28, 3, 28, 5, 28, 558, 10, 28, 3, 28, 3, 28, 3, 28, 3, 29,
-	3, 29, 3, 29, 5, 29, 566, 10, 29, 3, 29, 3, 29, 5, 29, 570, 10, 29, 3,
-	29, 3, 29, 5, 29, 574, 10, 29, 3, 30, 3, 30, 5, 30, 578, 10, 30, 3, 30,
-	3, 30, 5, 30, 582, 10, 30, 3, 30, 7, 30, 585, 10, 30, 12, 30, 14, 30, 588,
-	11, 30, 3, 30, 3, 30, 5, 30, 592, 10, 30, 3, 30, 3, 30, 5, 30, 596, 10,
-	30, 3, 30, 7, 30, 599, 10, 30, 12, 30, 14, 30, 602, 11, 30, 5, 30, 604,
-	10, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 5, 31, 613, 10,
-	31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 5, 32, 622, 10, 32,
-	3, 32, 7, 32, 625, 10, 32, 12, 32, 14, 32, 628, 11, 32, 3, 33, 3, 33, 3,
-	33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 5, 35, 640, 10, 35,
-	3, 35, 5, 35, 643, 10, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 5,
-	37, 651, 10, 37, 3, 37, 3, 37, 5, 37, 655, 10, 37, 3, 37, 7, 37, 658, 10,
-	37, 12, 37, 14, 37, 661, 11, 37, 3, 38, 3, 38, 5, 38, 665, 10, 38, 3, 38,
-	3, 38, 5, 38, 669, 10, 38, 3, 38, 3, 38, 3, 38, 5, 38, 674, 10, 38, 3,
-	39, 3, 39, 3, 40, 3, 40, 5, 40, 680, 10, 40, 3, 40, 7, 40, 683, 10, 40,
-	12, 40, 14, 40, 686, 11, 40, 3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 692, 10,
-	40, 3, 41, 3, 41, 5, 41, 696, 10, 41, 3, 41, 3, 41, 5, 41, 700, 10, 41,
-	5, 41, 702, 10, 41, 3, 41, 3, 41, 5, 41, 706, 10, 41, 5, 41, 708, 10, 41,
-	3, 41, 3, 41, 5, 41, 712, 10, 41, 5, 41, 714, 10, 41, 3, 41, 3, 41, 3,
-	42, 3, 42, 5, 42, 720, 10, 42, 3, 42, 3, 42, 3, 43, 3, 43, 5, 43, 726,
-	10, 43, 3, 43, 3, 43, 5, 43, 730, 10, 43, 3, 43, 5, 43, 733, 10, 43, 3,
-	43, 5, 43, 736, 10, 43, 3, 43, 3, 43, 5, 43, 740, 10, 43, 3, 43, 3, 43,
-	3, 43, 3, 43, 5, 43, 746, 10, 43, 3, 43, 3, 43, 5, 43, 750, 10, 43, 3,
-	43, 5, 43, 753, 10, 43, 3, 43, 5, 43, 756, 10, 43, 3, 43, 3, 43, 3, 43,
-	3, 43, 5, 43, 762, 10, 43, 3, 43, 5, 43, 765, 10, 43, 3, 43, 5, 43, 768,
-	10, 43, 3, 43, 3, 43, 5, 43, 772, 10, 43, 3, 43, 3, 43, 3, 43, 3, 43, 5,
-	43, 778, 10, 43, 3, 43, 5, 43, 781, 10, 43, 3, 43, 5, 43, 784, 10, 43,
-	3, 43, 3, 43, 5, 43, 788, 10, 43, 3, 44, 3, 44, 5, 44, 792, 10, 44, 3,
-	44, 3, 44, 5, 44, 796, 10, 44, 5, 44, 798, 10, 44, 3, 44, 3, 44, 5, 44,
-	802, 10, 44, 5, 44, 804, 10, 44, 3, 44, 5, 44, 807, 10, 44, 3, 44, 3, 44,
-	5, 44, 811, 10, 44, 5, 44, 813, 10, 44, 3, 44, 3, 44, 3, 45, 3, 45, 5,
-	45, 819, 10, 45, 3, 46, 3, 46, 5, 46, 823, 10, 46, 3, 46, 3, 46, 5, 46,
-	827, 10, 46, 3, 46, 3, 46, 5, 46, 831, 10, 46, 3, 46, 5, 46, 834, 10, 46,
-	3, 46, 7, 46, 837, 10, 46, 12, 46, 14, 46, 840, 11, 46, 3, 47, 3, 47, 5,
-	47, 844, 10, 47, 3, 47, 7, 47, 847, 10, 47, 12, 47, 14, 47, 850, 11, 47,
-	3, 48, 3, 48, 5, 48, 854, 10, 48, 3, 48, 3, 48, 3, 49, 3, 49, 5, 49, 860,
-	10, 49, 3, 49, 3, 49, 5, 49, 864, 10, 49, 5, 49, 866, 10, 49, 3, 49, 3,
-	49, 5, 49, 870, 10, 49, 3, 49, 3, 49, 5, 49, 874, 10, 49, 5, 49, 876, 10,
-	49, 5, 49, 878, 10, 49, 3, 50, 3, 50, 3, 51, 3, 51, 3, 52, 3, 52, 3, 53,
-	3, 53, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 7, 55, 895, 10,
-	55, 12, 55, 14, 55, 898, 11, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 7,
-	56, 905, 10, 56, 12, 56, 14, 56, 908, 11, 56, 3, 57, 3, 57, 3, 57, 3, 57,
-	3, 57, 7, 57, 915, 10, 57, 12, 57, 14, 57, 918, 11, 57, 3, 58, 3, 58, 5,
-	58, 922, 10, 58, 7, 58, 924, 10, 58, 12, 58, 14, 58, 927, 11, 58, 3, 58,
-	3, 58, 3, 59, 3, 59, 5, 59, 933, 10, 59, 3, 59, 7, 59, 936, 10, 59, 12,
-	59, 14, 59, 939, 11, 59, 3, 60, 3, 60, 5, 60, 943, 10, 60, 3, 60, 3, 60,
-	5, 60, 947, 10, 60, 3, 60, 3, 60, 5, 60, 951, 10, 60, 3, 60, 3, 60, 5,
-	60, 955, 10, 60, 3, 60, 7, 60, 958, 10, 60, 12, 60, 14, 60, 961, 11, 60,
-	3, 61, 3, 61, 5, 61, 965, 10, 61, 3, 61, 3, 61, 5, 61, 969, 10, 61, 3,
-	61, 3, 61, 5, 61, 973, 10, 61, 3, 61, 3, 61, 5, 61, 977, 10, 61, 3, 61,
-	3, 61, 5, 61, 981, 10, 61, 3, 61, 3, 61, 5, 61, 985, 10, 61, 3, 61, 7,
-	61, 988, 10, 61, 12, 61, 14, 61, 991, 11, 61, 3, 62, 3, 62, 5, 62, 995,
-	10, 62, 3, 62, 3, 62, 5, 62, 999, 10, 62, 3, 62, 7, 62, 1002, 10, 62, 12,
-	62, 14, 62, 1005, 11, 62, 3, 63, 3, 63, 5, 63, 1009, 10, 63, 7, 63, 1011,
-	10, 63, 12, 63, 14, 63, 1014, 11, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64,
-	3, 64, 7, 64, 1022, 10, 64, 12, 64, 14, 64, 1025, 11, 64, 3, 65, 3, 65,
-	3, 65, 5, 65, 1030, 10, 65, 3, 65, 3, 65, 5, 65, 1034, 10, 65, 3, 65, 3,
-	65, 3, 65, 3, 65, 3, 65, 5, 65, 1041, 10, 65, 3, 65, 3, 65, 5, 65, 1045,
-	10, 65, 3, 65, 3, 65, 5, 65, 1049, 10, 65, 3, 65, 5, 65, 1052, 10, 65,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 5,
-	66, 1064, 10, 66, 3, 66, 5, 66, 1067, 10, 66, 3, 66, 3, 66, 3, 67, 3, 67,
-	3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 5, 67, 1081, 10,
-	67, 3, 68, 3, 68, 5, 68, 1085, 10, 68, 3, 68, 7, 68, 1088, 10, 68, 12,
-	68, 14, 68, 1091, 11, 68, 3, 68, 5, 68, 1094, 10, 68, 3, 68, 5, 68, 1097,
-	10, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 5, 69, 1104, 10, 69, 3, 69,
-	3, 69, 5, 69, 1108, 10, 69, 3, 69, 3, 69, 5, 69, 1112, 10, 69, 3, 69, 3,
-	69, 3, 69, 3, 69, 3, 69, 5, 69, 1119, 10, 69, 3, 69, 3, 69, 5, 69, 1123,
-	10, 69, 3, 69, 3, 69, 5, 69, 1127, 10, 69, 3, 69, 3, 69, 3, 69, 3, 69,
-	5, 69, 1133, 10, 69, 3, 69, 3, 69, 5, 69, 1137, 10, 69, 3, 69, 3, 69, 5,
-	69, 1141, 10, 69, 3, 69, 3, 69, 3, 69, 3, 69, 5, 69, 1147, 10, 69, 3, 69,
-	3, 69, 5, 69, 1151, 10, 69, 3, 69, 3, 69, 5, 69, 1155, 10, 69, 3, 69, 3,
-	69, 3, 69, 3, 69, 5, 69, 1161, 10, 69, 3, 69, 3, 69, 5, 69, 1165, 10, 69,
-	3, 69, 3, 69, 5, 69, 1169, 10, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3,
-	69, 5, 69, 1177, 10, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 5, 70,
-	1185, 10, 70, 3, 71, 3, 71, 3, 72, 3, 72, 5, 72, 1191, 10, 72, 3, 72, 3,
-	72, 5, 72, 1195, 10, 72, 3, 72, 3, 72, 5, 72, 1199, 10, 72, 3, 72, 3, 72,
-	5, 72, 1203, 10, 72, 7, 72, 1205, 10, 72, 12, 72, 14, 72, 1208, 11, 72,
-	5, 72, 1210, 10, 72, 3, 72, 3, 72, 3, 73, 3, 73, 5, 73, 1216, 10, 73, 3,
-	73, 3, 73, 3, 73, 5, 73, 1221, 10, 73, 3, 73, 3, 73, 3, 73, 5, 73, 1226,
-	10, 73, 3, 73, 3, 73, 3, 73, 5, 73, 1231, 10, 73, 3, 73, 3, 73, 3, 73,
-	5, 73, 1236, 10, 73, 3, 73, 3, 73, 3, 73, 5, 73, 1241, 10, 73, 3, 73, 5,
-	73, 1244, 10, 73, 3, 74, 3, 74, 5, 74, 1248, 10, 74, 3, 74, 3, 74, 5, 74,
-	1252, 10, 74, 3, 74, 3, 74, 3, 75, 3, 75, 5, 75, 1258, 10, 75, 3, 75, 6,
-	75, 1261, 10, 75, 13, 75, 14, 75, 1262, 3, 76, 3, 76, 5, 76, 1267, 10,
-	76, 3, 76, 5, 76, 1270, 10, 76, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77,
-	3, 78, 3, 78, 5, 78, 1280, 10, 78, 3, 78, 3, 78, 5, 78, 1284, 10, 78, 3,
-	78, 3, 78, 5, 78, 1288, 10, 78, 5, 78, 1290, 10, 78, 3, 78, 3, 78, 5, 78,
-	1294, 10, 78, 3, 78, 3, 78, 5, 78, 1298, 10, 78, 3, 78, 3, 78, 5, 78, 1302,
-	10, 78, 7, 78, 1304, 10, 78, 12, 78, 14, 78, 1307, 11, 78, 5, 78, 1309,
-	10, 78, 3, 78, 3, 78, 3, 79, 3, 79, 3, 79, 3, 79, 5, 79, 1317, 10, 79,
-	3, 80, 3, 80, 5, 80, 1321, 10, 80, 3, 80, 3, 80, 5, 80, 1325, 10, 80, 3,
-	80, 3, 80, 5, 80, 1329, 10, 80, 3, 80, 3, 80, 5, 80, 1333, 10, 80, 3, 80,
-	3, 80, 5, 80, 1337, 10, 80, 7, 80, 1339, 10, 80, 12, 80, 14, 80, 1342,
-	11, 80, 5, 80, 1344, 10, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 82, 3, 82,
-	3, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3, 84, 7, 84, 1358, 10, 84, 12, 84,
-	14, 84, 1361, 11, 84, 3, 85, 3, 85, 5, 85, 1365, 10, 85, 3, 85, 3, 85,
-	5, 85, 1369, 10, 85, 3, 85, 3, 85, 5, 85, 1373, 10, 85, 3, 85, 5, 85, 1376,
-	10, 85, 3, 85, 5, 85, 1379, 10, 85, 3, 85, 3, 85, 3, 86, 3, 86, 5, 86,
-	1385, 10, 86, 3, 86, 3, 86, 5, 86, 1389, 10, 86, 3, 86, 3, 86, 5, 86, 1393,
-	10, 86, 5, 86, 1395, 10, 86, 3, 86, 3, 86, 5, 86, 1399, 10, 86, 3, 86,
-	3, 86, 5, 86, 1403, 10, 86, 3, 86, 3, 86, 5, 86, 1407, 10, 86, 5, 86, 1409,
-	10, 86, 3, 86, 3, 86, 5, 86, 1413, 10, 86, 3, 86, 3, 86, 5, 86, 1417, 10,
-	86, 3, 86, 3, 86, 3, 87, 3, 87, 5, 87, 1423, 10, 87, 3, 87, 3, 87, 3, 88,
-	3, 88, 5, 88, 1429, 10, 88, 3, 88, 6, 88, 1432, 10, 88, 13, 88, 14, 88,
-	1433, 3, 88, 3, 88, 5, 88, 1438, 10, 88, 3, 88, 3, 88, 5, 88, 1442, 10,
-	88, 3, 88, 6, 88, 1445, 10, 88, 13, 88, 14, 88, 1446, 5, 88, 1449, 10,
-	88, 3, 88, 5, 88, 1452, 10, 88, 3, 88, 3, 88, 5, 88, 1456, 10, 88, 3, 88,
-	5, 88, 1459, 10, 88, 3, 88, 5, 88, 1462, 10, 88, 3, 88, 3, 88, 3, 89, 3,
-	89, 5, 89, 1468, 10, 89, 3, 89, 3, 89, 5, 89, 1472, 10, 89, 3, 89, 3, 89,
-	5, 89, 1476, 10, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 91, 3, 91, 5, 91, 1484,
-	10, 91, 3, 92, 3, 92, 5, 92, 1488, 10, 92, 3, 92, 3, 92, 5, 92, 1492, 10,
-	92, 3, 92, 3, 92, 5, 92, 1496, 10, 92, 3, 92, 3, 92, 5, 92, 1500, 10, 92,
-	3, 92, 3, 92, 5, 92, 1504, 10, 92, 3, 92, 3, 92, 5, 92, 1508, 10, 92, 3,
-	92, 3, 92, 5, 92, 1512, 10, 92, 3, 92, 3, 92, 5, 92, 1516, 10, 92, 7, 92,
-	1518, 10, 92, 12, 92, 14, 92, 1521, 11, 92, 5, 92, 1523, 10, 92, 3, 92,
-	3, 92, 3, 93, 3, 93, 3, 93, 5, 93, 1530, 10, 93, 3, 94, 3, 94, 5, 94, 1534,
-	10, 94, 3, 94, 6, 94, 1537, 10, 94, 13, 94, 14, 94, 1538, 3, 95, 3, 95,
-	3, 96, 3, 96, 3, 97, 3, 97, 3, 98, 3, 98, 5, 98, 1549, 10, 98, 3, 99, 3,
-	99, 3, 100, 3, 100, 3, 101, 3, 101, 3, 102, 3, 102, 3, 103, 3, 103, 3,
-	103, 2, 2, 104, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30,
-	32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66,
-	68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102,
-	104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132,
-	134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160, 162,
-	164, 166, 168, 170, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190, 192,
-	194, 196, 198, 200, 202, 204, 2, 12, 3, 2, 70, 73, 3, 2, 15, 16, 3, 2,
-	89, 90, 3, 2, 99, 101, 3, 2, 109, 110, 6, 2, 48, 60, 63, 84, 89, 96, 111,
-	120, 6, 2, 85, 88, 102, 102, 121, 123, 126, 126, 4, 2, 21, 21, 29, 32,
-	4, 2, 22, 22, 33, 36, 4, 2, 16, 16, 37, 47, 2, 1775, 2, 207, 3, 2, 2, 2,
-	4, 221, 3, 2, 2, 2, 6, 225, 3, 2, 2, 2, 8, 227, 3, 2, 2, 2, 10, 249, 3,
-	2, 2, 2, 12, 253, 3, 2, 2, 2, 14, 290, 3, 2, 2, 2, 16, 293, 3, 2, 2, 2,
-	18, 305, 3, 2, 2, 2, 20, 326, 3, 2, 2, 2, 22, 331, 3, 2, 2, 2, 24, 335,
-	3, 2, 2, 2, 26, 348, 3, 2, 2, 2, 28, 358, 3, 2, 2, 2, 30, 380, 3, 2, 2,
-	2, 32, 382, 3, 2, 2, 2, 34, 388, 3, 2, 2, 2, 36, 436, 3, 2, 2, 2, 38, 440,
-	3, 2, 2, 2, 40, 460, 3, 2, 2, 2, 42, 480, 3, 2, 2, 2, 44, 482, 3, 2, 2,
-	2, 46, 493, 3, 2, 2, 2, 48, 520, 3, 2, 2, 2, 50, 533, 3, 2, 2, 2, 52, 537,
-	3, 2, 2, 2, 54, 552, 3, 2, 2, 2, 56, 562, 3, 2, 2, 2, 58, 603, 3, 2, 2,
-	2, 60, 612, 3, 2, 2, 2, 62, 614, 3, 2, 2, 2, 64, 629, 3, 2, 2, 2, 66, 633,
-	3, 2, 2, 2, 68, 637, 3, 2, 2, 2, 70, 644, 3, 2, 2, 2, 72, 648, 3, 2, 2,
-	2, 74, 673, 3, 2, 2, 2, 76, 675, 3, 2, 2, 2, 78, 691, 3, 2, 2, 2, 80, 693,
-	3, 2, 2, 2, 82, 717, 3, 2, 2, 2, 84, 787, 3, 2, 2, 2, 86, 789, 3, 2, 2,
-	2, 88, 818, 3, 2, 2, 2, 90, 820, 3, 2, 2, 2, 92, 841, 3, 2, 2, 2, 94, 851,
-	3, 2, 2, 2, 96, 857, 3, 2, 2, 2, 98, 879, 3, 2, 2, 2, 100, 881, 3, 2, 2,
-	2, 102, 883, 3, 2, 2, 2, 104, 885, 3, 2, 2, 2, 106, 887, 3, 2, 2, 2, 108,
-	889, 3, 2, 2, 2, 110, 899, 3, 2, 2, 2, 112, 909, 3, 2, 2, 2, 114, 925,
-	3, 2, 2, 2, 116, 930, 3, 2, 2, 2, 118, 940, 3, 2, 2, 2, 120, 962, 3, 2,
-	2, 2, 122, 992, 3, 2, 2, 2, 124, 1012, 3, 2, 2, 2, 126, 1017, 3, 2, 2,
-	2, 128, 1051, 3, 2, 2, 2, 130, 1063, 3, 2, 2, 2, 132, 1080, 3, 2, 2, 2,
-	134, 1082, 3, 2, 2, 2, 136, 1176, 3, 2, 2, 2, 138, 1184, 3, 2, 2, 2, 140,
-	1186, 3, 2, 2, 2, 142, 1188, 3, 2, 2, 2, 144, 1243, 3, 2, 2, 2, 146, 1245,
-	3, 2, 2, 2, 148, 1255, 3, 2, 2, 2, 150, 1264, 3, 2, 2, 2, 152, 1271, 3,
-	2, 2, 2, 154, 1277, 3, 2, 2, 2, 156, 1316, 3, 2, 2, 2, 158, 1318, 3, 2,
-	2, 2, 160, 1347, 3, 2, 2, 2, 162, 1349, 3, 2, 2, 2, 164, 1351, 3, 2, 2,
-	2, 166, 1359, 3, 2, 2, 2, 168, 1362, 3, 2, 2, 2, 170, 1382, 3, 2, 2, 2,
-	172, 1420, 3, 2, 2, 2, 174, 1448, 3, 2, 2, 2, 176, 1465, 3, 2, 2, 2, 178,
-	1479, 3, 2, 2, 2, 180, 1483, 3, 2, 2, 2, 182, 1485, 3, 2, 2, 2, 184, 1526,
-	3, 2, 2, 2, 186, 1531, 3, 2, 2, 2, 188, 1540, 3, 2, 2, 2, 190, 1542, 3,
-	2, 2, 2, 192, 1544, 3, 2, 2, 2, 194, 1548, 3, 2, 2, 2, 196, 1550, 3, 2,
-	2, 2, 198, 1552, 3, 2, 2, 2, 200, 1554, 3, 2, 2, 2, 202, 1556, 3, 2, 2,
-	2, 204, 1558, 3, 2, 2, 2, 206, 208, 7, 127, 2, 2, 207, 206, 3, 2, 2, 2,
-	207, 208, 3, 2, 2, 2, 208, 209, 3, 2, 2, 2, 209, 214, 5, 4, 3, 2, 210,
-	212, 7, 127, 2, 2, 211, 210, 3, 2, 2, 2, 211, 212, 3, 2, 2, 2, 212, 213,
-	3, 2, 2, 2, 213, 215, 7, 3, 2, 2, 214, 211, 3, 2, 2, 2, 214, 215, 3, 2,
-	2, 2, 215, 217, 3, 2, 2, 2, 216, 218, 7, 127, 2, 2, 217, 216, 3, 2, 2,
-	2, 217, 218, 3, 2, 2, 2, 218, 219, 3, 2, 2, 2, 219, 220, 7, 2, 2, 3, 220,
-	3, 3, 2, 2, 2, 221, 222, 5, 6, 4, 2, 222, 5, 3, 2, 2, 2, 223, 226, 5, 8,
-	5, 2, 224, 226, 5, 46, 24, 2, 225, 223, 3, 2, 2, 2, 225, 224, 3, 2, 2,
-	2, 226, 7, 3, 2, 2, 2, 227, 234, 5, 12, 7, 2, 228, 230, 7, 127, 2, 2, 229,
-	228, 3, 2, 2, 2, 229, 230, 3, 2, 2, 2, 230, 231, 3, 2, 2, 2, 231, 233,
-	5, 10, 6, 2, 232, 229, 3, 2, 2, 2, 233, 236, 3, 2, 2, 2, 234, 232, 3, 2,
-	2, 2, 234, 235, 3, 2, 2, 2, 235, 9, 3, 2, 2, 2, 236, 234, 3, 2, 2, 2, 237,
-	238, 7, 48, 2, 2, 238, 239, 7, 127, 2, 2, 239, 241, 7, 49, 2, 2, 240, 242,
-	7, 127, 2, 2, 241, 240, 3, 2, 2, 2, 241, 242, 3, 2, 2, 2, 242, 243, 3,
-	2, 2, 2, 243, 250, 5, 12, 7, 2, 244, 246, 7, 48, 2, 2, 245, 247, 7, 127,
-	2, 2, 246, 245, 3, 2, 2, 2, 246, 247, 3, 2, 2, 2, 247, 248, 3, 2, 2, 2,
-	248, 250, 5, 12, 7, 2, 249, 237, 3, 2, 2, 2, 249, 244, 3, 2, 2, 2, 250,
-	11, 3, 2, 2, 2, 251, 254, 5, 14, 8, 2, 252, 254, 5, 16, 9, 2, 253, 251,
-	3, 2, 2, 2, 253, 252, 3, 2, 2, 2, 254, 13, 3, 2, 2, 2, 255, 257, 5, 22,
-	12, 2, 256, 258, 7, 127, 2, 2, 257, 256, 3, 2, 2, 2, 257, 258, 3, 2, 2,
-	2, 258, 260, 3, 2, 2, 2, 259, 255, 3, 2, 2, 2, 260, 263, 3, 2, 2, 2, 261,
-	259, 3, 2, 2, 2, 261, 262, 3, 2, 2, 2, 262, 264, 3, 2, 2, 2, 263, 261,
-	3, 2, 2, 2, 264, 291, 5, 54, 28, 2, 265, 267, 5, 22, 12, 2, 266, 268, 7,
-	127, 2, 2, 267, 266, 3, 2, 2, 2, 267, 268, 3, 2, 2, 2, 268, 270, 3, 2,
-	2, 2, 269, 265, 3, 2, 2, 2, 270, 273, 3, 2, 2, 2, 271, 269, 3, 2, 2, 2,
-	271, 272, 3, 2, 2, 2, 272, 274, 3, 2, 2, 2, 273, 271, 3, 2, 2, 2, 274,
-	281, 5, 20, 11, 2, 275, 277, 7, 127, 2, 2, 276, 275, 3, 2, 2, 2, 276, 277,
-	3, 2, 2, 2, 277, 278, 3, 2, 2, 2, 278, 280, 5, 20, 11, 2, 279, 276, 3,
-	2, 2, 2, 280, 283, 3, 2, 2, 2, 281, 279, 3, 2, 2, 2, 281, 282, 3, 2, 2,
-	2, 282, 288, 3, 2, 2, 2, 283, 281, 3, 2, 2, 2, 284, 286, 7, 127, 2, 2,
-	285, 284, 3, 2, 2, 2, 285, 286, 3, 2, 2, 2, 286, 287, 3, 2, 2, 2, 287,
-	289, 5, 54, 28, 2, 288, 285, 3, 2, 2, 2, 288, 289, 3, 2, 2, 2, 289, 291,
-	3, 2, 2, 2, 290, 261, 3, 2, 2, 2, 290, 271, 3, 2, 2, 2, 291, 15, 3, 2,
-	2, 2, 292, 294, 5, 18, 10, 2, 293, 292, 3, 2, 2, 2, 294, 295, 3, 2, 2,
-	2, 295, 293, 3, 2, 2, 2, 295, 296, 3, 2, 2, 2, 296, 297, 3, 2, 2, 2, 297,
-	298, 5, 14, 8, 2, 298, 17, 3, 2, 2, 2, 299, 301, 5, 22, 12, 2, 300, 302,
-	7, 127, 2, 2, 301, 300, 3, 2, 2, 2, 301, 302, 3, 2, 2, 2, 302, 304, 3,
-	2, 2, 2, 303, 299, 3, 2, 2, 2, 304, 307, 3, 2, 2, 2, 305, 303, 3, 2, 2,
-	2, 305, 306, 3, 2, 2, 2, 306, 314, 3, 2, 2, 2, 307, 305, 3, 2, 2, 2, 308,
-	310, 5, 20, 11, 2, 309, 311, 7, 127, 2, 2, 310, 309, 3, 2, 2, 2, 310, 311,
-	3, 2, 2, 2, 311, 313, 3, 2, 2, 2, 312, 308, 3, 2, 2, 2, 313, 316, 3, 2,
-	2, 2, 314, 312, 3, 2, 2, 2, 314, 315, 3, 2, 2, 2, 315, 317, 3, 2, 2, 2,
-	316, 314, 3, 2, 2, 2, 317, 319, 5, 52, 27, 2, 318, 320, 7, 127, 2, 2, 319,
-	318, 3, 2, 2, 2, 319, 320, 3, 2, 2, 2, 320, 19, 3, 2, 2, 2, 321, 327, 5,
-	32, 17, 2, 322, 327, 5, 28, 15, 2, 323, 327, 5, 38, 20, 2, 324, 327, 5,
-	34, 18, 2, 325, 327, 5, 40, 21, 2, 326, 321, 3, 2, 2, 2, 326, 322, 3, 2,
-	2, 2, 326, 323, 3, 2, 2, 2, 326, 324, 3, 2, 2, 2, 326, 325, 3, 2, 2, 2,
-	327, 21, 3, 2, 2, 2, 328, 332, 5, 24, 13, 2, 329, 332, 5, 26, 14, 2, 330,
-	332, 5, 44, 23, 2, 331, 328, 3, 2, 2, 2, 331, 329, 3, 2, 2, 2, 331, 330,
-	3, 2, 2, 2, 332, 23, 3, 2, 2, 2, 333, 334, 7, 50, 2, 2, 334, 336, 7, 127,
-	2, 2, 335, 333, 3, 2, 2, 2, 335, 336, 3, 2, 2, 2, 336, 337, 3, 2, 2, 2,
-	337, 339, 7, 51, 2, 2, 338, 340, 7, 127, 2, 2, 339, 338, 3, 2, 2, 2, 339,
-	340, 3, 2, 2, 2, 340, 341, 3, 2, 2, 2, 341, 346, 5, 72, 37, 2, 342, 344,
-	7, 127, 2, 2, 343, 342, 3, 2, 2, 2, 343, 344, 3, 2, 2, 2, 344, 345, 3,
-	2, 2, 2, 345, 347, 5, 70, 36, 2, 346, 343, 3, 2, 2, 2, 346, 347, 3, 2,
-	2, 2, 347, 25, 3, 2, 2, 2, 348, 350, 7, 52, 2, 2, 349, 351, 7, 127, 2,
-	2, 350, 349, 3, 2, 2, 2, 350, 351, 3, 2, 2, 2, 351, 352, 3, 2, 2, 2, 352,
-	353, 5, 106, 54, 2, 353, 354, 7, 127, 2, 2, 354, 355, 7, 53, 2, 2, 355,
-	356, 7, 127, 2, 2, 356, 357, 5, 178, 90, 2, 357, 27, 3, 2, 2, 2, 358, 360,
-	7, 54, 2, 2, 359, 361, 7, 127, 2, 2, 360, 359, 3, 2, 2, 2, 360, 361, 3,
-	2, 2, 2, 361, 362, 3, 2, 2, 2, 362, 367, 5, 74, 38, 2, 363, 364, 7, 127,
-	2, 2, 364, 366, 5, 30, 16, 2, 365, 363, 3, 2, 2, 2, 366, 369, 3, 2, 2,
-	2, 367, 365, 3, 2, 2, 2, 367, 368, 3, 2, 2, 2, 368, 29, 3, 2, 2, 2, 369,
-	367, 3, 2, 2, 2, 370, 371, 7, 55, 2, 2, 371, 372, 7, 127, 2, 2, 372, 373,
-	7, 51, 2, 2, 373, 374, 7, 127, 2, 2, 374, 381, 5, 34, 18, 2, 375, 376,
-	7, 55, 2, 2, 376, 377, 7, 127, 2, 2, 377, 378, 7, 56, 2, 2, 378, 379, 7,
-	127, 2, 2, 379, 381, 5, 34, 18, 2, 380, 370, 3, 2, 2, 2, 380, 375, 3, 2,
-	2, 2, 381, 31, 3, 2, 2, 2, 382, 384, 7, 56, 2, 2, 383, 385, 7, 127, 2,
-	2, 384, 383, 3, 2, 2, 2, 384, 385, 3, 2, 2, 2, 385, 386, 3, 2, 2, 2, 386,
-	387, 5, 72, 37, 2, 387, 33, 3, 2, 2, 2, 388, 390, 7, 57, 2, 2, 389, 391,
-	7, 127, 2, 2, 390, 389, 3, 2, 2, 2, 390, 391, 3, 2, 2, 2, 391, 392, 3,
-	2, 2, 2, 392, 397, 5, 36, 19, 2, 393, 394, 7, 4, 2, 2, 394, 396, 5, 36,
-	19, 2, 395, 393, 3, 2, 2, 2, 396, 399, 3, 2, 2, 2, 397, 395, 3, 2, 2, 2,
-	397, 398, 3, 2, 2, 2, 398, 35, 3, 2, 2, 2, 399, 397, 3, 2, 2, 2, 400, 402,
-	5, 186, 94, 2, 401, 403, 7, 127, 2, 2, 402, 401, 3, 2, 2, 2, 402, 403,
-	3, 2, 2, 2, 403, 404, 3, 2, 2, 2, 404, 406, 7, 5, 2, 2, 405, 407, 7, 127,
-	2, 2, 406, 405, 3, 2, 2, 2, 406, 407, 3, 2, 2, 2, 407, 408, 3, 2, 2, 2,
-	408, 409, 5, 106, 54, 2, 409, 437, 3, 2, 2, 2, 410, 412, 5, 178, 90, 2,
-	411, 413, 7, 127, 2, 2, 412, 411, 3, 2, 2, 2, 412, 413, 3, 2, 2, 2, 413,
-	414, 3, 2, 2, 2, 414, 416, 7, 5, 2, 2, 415, 417, 7, 127, 2, 2, 416, 415,
-	3, 2, 2, 2, 416, 417, 3, 2, 2, 2, 417, 418, 3, 2, 2, 2, 418, 419, 5, 106,
-	54, 2, 419, 437, 3, 2, 2, 2, 420, 422, 5, 178, 90, 2, 421, 423, 7, 127,
-	2, 2, 422, 421, 3, 2, 2, 2, 422, 423, 3, 2, 2, 2, 423, 424, 3, 2, 2, 2,
-	424, 426, 7, 6, 2, 2, 425, 427, 7, 127, 2, 2, 426, 425, 3, 2, 2, 2, 426,
-	427, 3, 2, 2, 2, 427, 428, 3, 2, 2, 2, 428, 429, 5, 106, 54, 2, 429, 437,
-	3, 2, 2, 2, 430, 432, 5, 178, 90, 2, 431, 433, 7, 127, 2, 2, 432, 431,
-	3, 2, 2, 2, 432, 433, 3, 2, 2, 2, 433, 434, 3, 2, 2, 2, 434, 435, 5, 92,
-	47, 2, 435, 437, 3, 2, 2, 2, 436, 400, 3, 2, 2, 2, 436, 410, 3, 2, 2, 2,
-	436, 420, 3, 2, 2, 2, 436, 430, 3, 2, 2, 2, 437, 37, 3, 2, 2, 2, 438, 439,
-	7, 58, 2, 2, 439, 441, 7, 127, 2, 2, 440, 438, 3, 2, 2, 2, 440, 441, 3,
-	2, 2, 2, 441, 442, 3, 2, 2, 2, 442, 444, 7, 59, 2, 2, 443, 445, 7, 127,
-	2, 2, 444, 443, 3, 2, 2, 2, 444, 445, 3, 2, 2, 2, 445, 446, 3, 2, 2, 2,
-	446, 457, 5, 106, 54, 2, 447, 449, 7, 127, 2, 2, 448, 447, 3, 2, 2, 2,
-	448, 449, 3, 2, 2, 2, 449, 450, 3, 2, 2, 2, 450, 452, 7, 4, 2, 2, 451,
-	453, 7, 127, 2, 2, 452, 451, 3, 2, 2, 2, 452, 453, 3, 2, 2, 2, 453, 454,
-	3, 2, 2, 2, 454, 456, 5, 106, 54, 2, 455, 448, 3, 2, 2, 2, 456, 459, 3,
-	2, 2, 2, 457, 455, 3, 2, 2, 2, 457, 458, 3, 2, 2, 2, 458, 39, 3, 2, 2,
-	2, 459, 457, 3, 2, 2, 2, 460, 461, 7, 60, 2, 2, 461, 462, 7, 127, 2, 2,
-	462, 473, 5, 42, 22, 2, 463, 465, 7, 127, 2, 2, 464, 463, 3, 2, 2, 2, 464,
-	465, 3, 2, 2, 2, 465, 466, 3, 2, 2, 2, 466, 468, 7, 4, 2, 2, 467, 469,
-	7, 127, 2, 2, 468, 467, 3, 2, 2, 2, 468, 469, 3, 2, 2, 2, 469, 470, 3,
-	2, 2, 2, 470, 472, 5, 42, 22, 2, 471, 464, 3, 2, 2, 2, 472, 475, 3, 2,
-	2, 2, 473, 471, 3, 2, 2, 2, 473, 474, 3, 2, 2, 2, 474, 41, 3, 2, 2, 2,
-	475, 473, 3, 2, 2, 2, 476, 477, 5, 178, 90, 2, 477, 478, 5, 92, 47, 2,
-	478, 481, 3, 2, 2, 2, 479, 481, 5, 186, 94, 2, 480, 476, 3, 2, 2, 2, 480,
-	479, 3, 2, 2, 2, 481, 43, 3, 2, 2, 2, 482, 483, 7, 61, 2, 2, 483, 484,
-	7, 127, 2, 2, 484, 491, 5, 158, 80, 2, 485, 487, 7, 127, 2, 2, 486, 485,
-	3, 2, 2, 2, 486, 487, 3, 2, 2, 2, 487, 488, 3, 2, 2, 2, 488, 489, 7, 62,
-	2, 2, 489, 490, 7, 127, 2, 2, 490, 492, 5, 48, 25, 2, 491, 486, 3, 2, 2,
-	2, 491, 492, 3, 2, 2, 2, 492, 45, 3, 2, 2, 2, 493, 494, 7, 61, 2, 2, 494,
-	497, 7, 127, 2, 2, 495, 498, 5, 158, 80, 2, 496, 498, 5, 160, 81, 2, 497,
-	495, 3, 2, 2, 2, 497, 496, 3, 2, 2, 2, 498, 503, 3, 2, 2, 2, 499, 500,
-	7, 127, 2, 2, 500, 501, 7, 62, 2, 2, 501, 502, 7, 127, 2, 2, 502, 504,
-	5, 48, 25, 2, 503, 499, 3, 2, 2, 2, 503, 504, 3, 2, 2, 2, 504, 47, 3, 2,
-	2, 2, 505, 521, 7, 7, 2, 2, 506, 517, 5, 50, 26, 2, 507, 509, 7, 127, 2,
-	2, 508, 507, 3, 2, 2, 2, 508, 509, 3, 2, 2, 2, 509, 510, 3, 2, 2, 2, 510,
-	512, 7, 4, 2, 2, 511, 513, 7, 127, 2, 2, 512, 511, 3, 2, 2, 2, 512, 513,
-	3, 2, 2, 2, 513, 514, 3, 2, 2, 2, 514, 516, 5, 50, 26, 2, 515, 508, 3,
-	2, 2, 2, 516, 519, 3, 2, 2, 2, 517, 515, 3, 2, 2, 2, 517, 518, 3, 2, 2,
-	2, 518, 521, 3, 2, 2, 2, 519, 517, 3, 2, 2, 2, 520, 505, 3, 2, 2, 2, 520,
-	506, 3, 2, 2, 2, 521, 526, 3, 2, 2, 2, 522, 524, 7, 127, 2, 2, 523, 522,
-	3, 2, 2, 2, 523, 524, 3, 2, 2, 2, 524, 525, 3, 2, 2, 2, 525, 527, 5, 70,
-	36, 2, 526, 523, 3, 2, 2, 2, 526, 527, 3, 2, 2, 2, 527, 49, 3, 2, 2, 2,
-	528, 529, 5, 162, 82, 2, 529, 530, 7, 127, 2, 2, 530, 531, 7, 53, 2, 2,
-	531, 532, 7, 127, 2, 2, 532, 534, 3, 2, 2, 2, 533, 528, 3, 2, 2, 2, 533,
-	534, 3, 2, 2, 2, 534, 535, 3, 2, 2, 2, 535, 536, 5, 178, 90, 2, 536, 51,
-	3, 2, 2, 2, 537, 542, 7, 63, 2, 2, 538, 540, 7, 127, 2, 2, 539, 538, 3,
-	2, 2, 2, 539, 540, 3, 2, 2, 2, 540, 541, 3, 2, 2, 2, 541, 543, 7, 64, 2,
-	2, 542, 539, 3, 2, 2, 2, 542, 543, 3, 2, 2, 2, 543, 544, 3, 2, 2, 2, 544,
-	545, 7, 127, 2, 2, 545, 550, 5, 56, 29, 2, 546, 548, 7, 127, 2, 2, 547,
-	546, 3, 2, 2, 2, 547, 548, 3, 2, 2, 2, 548, 549, 3, 2, 2, 2, 549, 551,
-	5, 70, 36, 2, 550, 547, 3, 2, 2, 2, 550, 551, 3, 2, 2, 2, 551, 53, 3, 2,
-	2, 2, 552, 557, 7, 65, 2, 2, 553, 555, 7, 127, 2, 2, 554, 553, 3, 2, 2,
-	2, 554, 555, 3, 2, 2, 2, 555, 556, 3, 2, 2, 2, 556, 558, 7, 64, 2, 2, 557,
-	554, 3, 2, 2, 2, 557, 558, 3, 2, 2, 2, 558, 559, 3, 2, 2, 2, 559, 560,
-	7, 127, 2, 2, 560, 561, 5, 56, 29, 2, 561, 55, 3, 2, 2, 2, 562, 565, 5,
-	58, 30, 2, 563, 564, 7, 127, 2, 2, 564, 566, 5, 62, 32, 2, 565, 563, 3,
-	2, 2, 2, 565, 566, 3, 2, 2, 2, 566, 569, 3, 2, 2, 2, 567, 568, 7, 127,
-	2, 2, 568, 570, 5, 64, 33, 2, 569, 567, 3, 2, 2, 2, 569, 570, 3, 2, 2,
-	2, 570, 573, 3, 2, 2, 2, 571, 572, 7, 127, 2, 2, 572, 574, 5, 66, 34, 2,
-	573, 571, 3, 2, 2, 2, 573, 574, 3, 2, 2, 2, 574, 57, 3, 2, 2, 2, 575, 586,
-	7, 7, 2, 2, 576, 578, 7, 127, 2, 2, 577, 576, 3, 2, 2, 2, 577, 578, 3,
-	2, 2, 2, 578, 579, 3, 2, 2, 2, 579, 581, 7, 4, 2, 2, 580, 582, 7, 127,
-	2, 2, 581, 580, 3, 2, 2, 2, 581, 582, 3, 2, 2, 2, 582, 583, 3, 2, 2, 2,
-	583, 585, 5, 60, 31, 2, 584, 577, 3, 2, 2, 2, 585, 588, 3, 2, 2, 2, 586,
-	584, 3, 2, 2, 2, 586, 587, 3, 2, 2, 2, 587, 604, 3, 2, 2, 2, 588, 586,
-	3, 2, 2, 2, 589, 600, 5, 60, 31, 2, 590, 592, 7, 127, 2, 2, 591, 590, 3,
-	2, 2, 2, 591, 592, 3, 2, 2, 2, 592, 593, 3, 2, 2, 2, 593, 595, 7, 4, 2,
-	2, 594, 596, 7, 127, 2, 2, 595, 594, 3, 2, 2, 2, 595, 596, 3, 2, 2, 2,
-	596, 597, 3, 2, 2, 2, 597, 599, 5, 60, 31, 2, 598, 591, 3, 2, 2, 2, 599,
-	602, 3, 2, 2, 2, 600, 598, 3, 2, 2, 2, 600, 601, 3, 2, 2, 2, 601, 604,
-	3, 2, 2, 2, 602, 600, 3, 2, 2, 2, 603, 575, 3, 2, 2, 2, 603, 589, 3, 2,
-	2, 2, 604, 59, 3, 2, 2, 2, 605, 606, 5, 106, 54, 2, 606, 607, 7, 127, 2,
-	2, 607, 608, 7, 53, 2, 2, 608, 609, 7, 127, 2, 2, 609, 610, 5, 178, 90,
-	2, 610, 613, 3, 2, 2, 2, 611, 613, 5, 106, 54, 2, 612, 605, 3, 2, 2, 2,
-	612, 611, 3, 2, 2, 2, 613, 61, 3, 2, 2, 2, 614, 615, 7, 66, 2, 2, 615,
-	616, 7, 127, 2, 2, 616, 617, 7, 67, 2, 2, 617, 618, 7, 127, 2, 2, 618,
-	626, 5, 68, 35, 2, 619, 621, 7, 4, 2, 2, 620, 622, 7, 127, 2, 2, 621, 620,
-	3, 2, 2, 2, 621, 622, 3, 2, 2, 2, 622, 623, 3, 2, 2, 2, 623, 625, 5, 68,
-	35, 2, 624, 619, 3, 2, 2, 2, 625, 628, 3, 2, 2, 2, 626, 624, 3, 2, 2, 2,
-	626, 627, 3, 2, 2, 2, 627, 63, 3, 2, 2, 2, 628, 626, 3, 2, 2, 2, 629, 630,
-	7, 68, 2, 2, 630, 631, 7, 127, 2, 2, 631, 632, 5, 106, 54, 2, 632, 65,
-	3, 2, 2, 2, 633, 634, 7, 69, 2, 2, 634, 635, 7, 127, 2, 2, 635, 636, 5,
-	106, 54, 2, 636, 67, 3, 2, 2, 2, 637, 642, 5, 106, 54, 2, 638, 640, 7,
-	127, 2, 2, 639, 638, 3, 2, 2, 2, 639, 640, 3, 2, 2, 2, 640, 641, 3, 2,
-	2, 2, 641, 643, 9, 2, 2, 2, 642, 639, 3, 2, 2, 2, 642, 643, 3, 2, 2, 2,
-	643, 69, 3, 2, 2, 2, 644, 645, 7, 74, 2, 2, 645, 646, 7, 127, 2, 2, 646,
-	647, 5, 106, 54, 2, 647, 71, 3, 2, 2, 2, 648, 659, 5, 74, 38, 2, 649, 651,
-	7, 127, 2, 2, 650, 649, 3, 2, 2, 2, 650, 651, 3, 2, 2, 2, 651, 652, 3,
-	2, 2, 2, 652, 654, 7, 4, 2, 2, 653, 655, 7, 127, 2, 2, 654, 653, 3, 2,
-	2, 2, 654, 655, 3, 2, 2, 2, 655, 656, 3, 2, 2, 2, 656, 658, 5, 74, 38,
-	2, 657, 650, 3, 2, 2, 2, 658, 661, 3, 2, 2, 2, 659, 657, 3, 2, 2, 2, 659,
-	660, 3, 2, 2, 2, 660, 73, 3, 2, 2, 2, 661, 659, 3, 2, 2, 2, 662, 664, 5,
-	178, 90, 2, 663, 665, 7, 127, 2, 2, 664, 663, 3, 2, 2, 2, 664, 665, 3,
-	2, 2, 2, 665, 666, 3, 2, 2, 2, 666, 668, 7, 5, 2, 2, 667, 669, 7, 127,
-	2, 2, 668, 667, 3, 2, 2, 2, 668, 669, 3, 2, 2, 2, 669, 670, 3, 2, 2, 2,
-	670, 671, 5, 76, 39, 2, 671, 674, 3, 2, 2, 2, 672, 674, 5, 76, 39, 2, 673,
-	662, 3, 2, 2, 2, 673, 672, 3, 2, 2, 2, 674, 75, 3, 2, 2, 2, 675, 676, 5,
-	78, 40, 2, 676, 77, 3, 2, 2, 2, 677, 684, 5, 80, 41, 2, 678, 680, 7, 127,
-	2, 2, 679, 678, 3, 2, 2, 2, 679, 680, 3, 2, 2, 2, 680, 681, 3, 2, 2, 2,
-	681, 683, 5, 82, 42, 2, 682, 679, 3, 2, 2, 2, 683, 686, 3, 2, 2, 2, 684,
-	682, 3, 2, 2, 2, 684, 685, 3, 2, 2, 2, 685, 692, 3, 2, 2, 2, 686, 684,
-	3, 2, 2, 2, 687, 688, 7, 8, 2, 2, 688, 689, 5, 78, 40, 2, 689, 690, 7,
-	9, 2, 2, 690, 692, 3, 2, 2, 2, 691, 677, 3, 2, 2, 2, 691, 687, 3, 2, 2,
-	2, 692, 79, 3, 2, 2, 2, 693, 695, 7, 8, 2, 2, 694, 696, 7, 127, 2, 2, 695,
-	694, 3, 2, 2, 2, 695, 696, 3, 2, 2, 2, 696, 701, 3, 2, 2, 2, 697, 699,
-	5, 178, 90, 2, 698, 700, 7, 127, 2, 2, 699, 698, 3, 2, 2, 2, 699, 700,
-	3, 2, 2, 2, 700, 702, 3, 2, 2, 2, 701, 697, 3, 2, 2, 2, 701, 702, 3, 2,
-	2, 2, 702, 707, 3, 2, 2, 2, 703, 705, 5, 92, 47, 2, 704, 706, 7, 127, 2,
-	2, 705, 704, 3, 2, 2, 2, 705, 706, 3, 2, 2, 2, 706, 708, 3, 2, 2, 2, 707,
-	703, 3, 2, 2, 2, 707, 708, 3, 2, 2, 2, 708, 713, 3, 2, 2, 2, 709, 711,
-	5, 88, 45, 2, 710, 712, 7, 127, 2, 2, 711, 710, 3, 2, 2, 2, 711, 712, 3,
-	2, 2, 2, 712, 714, 3, 2, 2, 2, 713, 709, 3, 2, 2, 2, 713, 714, 3, 2, 2,
-	2, 714, 715, 3, 2, 2, 2, 715, 716, 7, 9, 2, 2, 716, 81, 3, 2, 2, 2, 717,
-	719, 5, 84, 43, 2, 718, 720, 7, 127, 2, 2, 719, 718, 3, 2, 2, 2, 719, 720,
-	3, 2, 2, 2, 720, 721, 3, 2, 2, 2, 721, 722, 5, 80, 41, 2, 722, 83, 3, 2,
-	2, 2, 723, 725, 5, 200, 101, 2, 724, 726, 7, 127, 2, 2, 725, 724, 3, 2,
-	2, 2, 725, 726, 3, 2, 2, 2, 726, 727, 3, 2, 2, 2, 727, 729, 5, 204, 103,
-	2, 728, 730, 7, 127, 2, 2, 729, 728, 3, 2, 2, 2, 729, 730, 3, 2, 2, 2,
-	730, 732, 3, 2, 2, 2, 731, 733, 5, 86, 44, 2, 732, 731, 3, 2, 2, 2, 732,
-	733, 3, 2, 2, 2, 733, 735, 3, 2, 2, 2, 734, 736, 7, 127, 2, 2, 735, 734,
-	3, 2, 2, 2, 735, 736, 3, 2, 2, 2, 736, 737, 3, 2, 2, 2, 737, 739, 5, 204,
-	103, 2, 738, 740, 7, 127, 2, 2, 739, 738, 3, 2, 2, 2, 739, 740, 3, 2, 2,
-	2, 740, 741, 3, 2, 2, 2, 741, 742, 5, 202, 102, 2, 742, 788, 3, 2, 2, 2,
-	743, 745, 5, 200, 101, 2, 744, 746, 7, 127, 2, 2, 745, 744, 3, 2, 2, 2,
-	745, 746, 3, 2, 2, 2, 746, 747, 3, 2, 2, 2, 747, 749, 5, 204, 103, 2, 748,
-	750, 7, 127, 2, 2, 749, 748, 3, 2, 2, 2, 749, 750, 3, 2, 2, 2, 750, 752,
-	3, 2, 2, 2, 751, 753, 5, 86, 44, 2, 752, 751, 3, 2, 2, 2, 752, 753, 3,
-	2, 2, 2, 753, 755, 3, 2, 2, 2, 754, 756, 7, 127, 2, 2, 755, 754, 3, 2,
-	2, 2, 755, 756, 3, 2, 2, 2, 756, 757, 3, 2, 2, 2, 757, 758, 5, 204, 103,
-	2, 758, 788, 3, 2, 2, 2, 759, 761, 5, 204, 103, 2, 760, 762, 7, 127, 2,
-	2, 761, 760, 3, 2, 2, 2, 761, 762, 3, 2, 2, 2, 762, 764, 3, 2, 2, 2, 763,
-	765, 5, 86, 44, 2, 764, 763, 3, 2, 2, 2, 764, 765, 3, 2, 2, 2, 765, 767,
-	3, 2, 2, 2, 766, 768, 7, 127, 2, 2, 767, 766, 3, 2, 2, 2, 767, 768, 3,
-	2, 2, 2, 768, 769, 3, 2, 2, 2, 769, 771, 5, 204, 103, 2, 770, 772, 7, 127,
-	2, 2, 771, 770, 3, 2, 2, 2, 771, 772, 3, 2, 2, 2, 772, 773, 3, 2, 2, 2,
-	773, 774, 5, 202, 102, 2, 774, 788, 3, 2, 2, 2, 775, 777, 5, 204, 103,
-	2, 776, 778, 7, 127, 2, 2, 777, 776, 3, 2, 2, 2, 777, 778, 3, 2, 2, 2,
-	778, 780, 3, 2, 2, 2, 779, 781, 5, 86, 44, 2, 780, 779, 3, 2, 2, 2, 780,
-	781, 3, 2, 2, 2, 781, 783, 3, 2, 2, 2, 782, 784, 7, 127, 2, 2, 783, 782,
-	3, 2, 2, 2, 783, 784, 3, 2, 2, 2, 784, 785, 3, 2, 2, 2, 785, 786, 5, 204,
-	103, 2, 786, 788, 3, 2, 2, 2, 787, 723, 3, 2, 2, 2, 787, 743, 3, 2, 2,
-	2, 787, 759, 3, 2, 2, 2, 787, 775, 3, 2, 2, 2, 788, 85, 3, 2, 2, 2, 789,
-	791, 7, 10, 2, 2, 790, 792, 7, 127, 2, 2, 791, 790, 3, 2, 2, 2, 791, 792,
-	3, 2, 2, 2, 792, 797, 3, 2, 2, 2, 793, 795, 5, 178, 90, 2, 794, 796, 7,
-	127, 2, 2, 795, 794, 3, 2, 2, 2, 795, 796, 3, 2, 2, 2, 796, 798, 3, 2,
-	2, 2, 797, 793, 3, 2, 2, 2, 797, 798, 3, 2, 2, 2, 798, 803, 3, 2, 2, 2,
-	799, 801, 5, 90, 46, 2, 800, 802, 7, 127, 2, 2, 801, 800, 3, 2, 2, 2, 801,
-	802, 3, 2, 2, 2, 802, 804, 3, 2, 2, 2, 803, 799, 3, 2, 2, 2, 803, 804,
-	3, 2, 2, 2, 804, 806, 3, 2, 2, 2, 805, 807, 5, 96, 49, 2, 806, 805, 3,
-	2, 2, 2, 806, 807, 3, 2, 2, 2, 807, 812, 3, 2, 2, 2, 808, 810, 5, 88, 45,
-	2, 809, 811, 7, 127, 2, 2, 810, 809, 3, 2, 2, 2, 810, 811, 3, 2, 2, 2,
-	811, 813, 3, 2, 2, 2, 812, 808, 3, 2, 2, 2, 812, 813, 3, 2, 2, 2, 813,
-	814, 3, 2, 2, 2, 814, 815, 7, 11, 2, 2, 815, 87, 3, 2, 2, 2, 816, 819,
-	5, 182, 92, 2, 817, 819, 5, 184, 93, 2, 818, 816, 3, 2, 2, 2, 818, 817,
-	3, 2, 2, 2, 819, 89, 3, 2, 2, 2, 820, 822, 7, 12, 2, 2, 821, 823, 7, 127,
+	9, 102, 4, 103, 9, 103, 4, 104, 9, 104, 4, 105, 9, 105, 4, 106, 9, 106,
+	4, 107, 9, 107, 4, 108, 9, 108, 3, 2, 5, 2, 218, 10, 2, 3, 2, 3, 2, 5,
+	2, 222, 10, 2, 3, 2, 5, 2, 225, 10, 2, 3, 2, 5, 2, 228, 10, 2, 3, 2, 3,
+	2, 3, 3, 3, 3, 3, 4, 3, 4, 5, 4, 236, 10, 4, 3, 5, 3, 5, 5, 5, 240, 10,
+	5, 3, 5, 7, 5, 243, 10, 5, 12, 5, 14, 5, 246, 11, 5, 3, 6, 3, 6, 3, 6,
+	3, 6, 5, 6, 252, 10, 6, 3, 6, 3, 6, 3, 6, 5, 6, 257, 10, 6, 3, 6, 5, 6,
+	260, 10, 6, 3, 7, 3, 7, 5, 7, 264, 10, 7, 3, 8, 3, 8, 5, 8, 268, 10, 8,
+	7, 8, 270, 10, 8, 12, 8, 14, 8, 273, 11, 8, 3, 8, 3, 8, 3, 8, 5, 8, 278,
+	10, 8, 7, 8, 280, 10, 8, 12, 8, 14, 8, 283, 11, 8, 3, 8, 3, 8, 5, 8, 287,
+	10, 8, 3, 8, 7, 8, 290, 10, 8, 12, 8, 14, 8, 293, 11, 8, 3, 8, 5, 8, 296,
+	10, 8, 3, 8, 5, 8, 299, 10, 8, 3, 8, 3, 8, 5, 8, 303, 10, 8, 7, 8, 305,
+	10, 8, 12, 8, 14, 8, 308, 11, 8, 3, 8, 5, 8, 311, 10, 8, 3, 9, 6, 9, 314,
+	10, 9, 13, 9, 14, 9, 315, 3, 9, 3, 9, 3, 10, 3, 10, 5, 10, 322, 10, 10,
+	7, 10, 324, 10, 10, 12, 10, 14, 10, 327, 11, 10, 3, 10, 3, 10, 5, 10, 331,
+	10, 10, 7, 10, 333, 10, 10, 12, 10, 14, 10, 336, 11, 10, 3, 10, 3, 10,
+	5, 10, 340, 10, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 5, 11, 347, 10,
+	11, 3, 12, 3, 12, 3, 12, 3, 12, 5, 12, 353, 10, 12, 3, 13, 3, 13, 5, 13,
+	357, 10, 13, 3, 13, 3, 13, 5, 13, 361, 10, 13, 3, 13, 3, 13, 5, 13, 365,
+	10, 13, 3, 13, 5, 13, 368, 10, 13, 3, 14, 3, 14, 5, 14, 372, 10, 14, 3,
+	14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 5, 15, 382, 10, 15,
+	3, 15, 3, 15, 3, 15, 7, 15, 387, 10, 15, 12, 15, 14, 15, 390, 11, 15, 3,
+	16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 5, 16,
+	402, 10, 16, 3, 17, 3, 17, 5, 17, 406, 10, 17, 3, 17, 3, 17, 3, 18, 3,
+	18, 5, 18, 412, 10, 18, 3, 18, 3, 18, 3, 18, 7, 18, 417, 10, 18, 12, 18,
+	14, 18, 420, 11, 18, 3, 19, 3, 19, 5, 19, 424, 10, 19, 3, 19, 3, 19, 5,
+	19, 428, 10, 19, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19, 434, 10, 19, 3, 19,
+	3, 19, 5, 19, 438, 10, 19, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19, 444, 10,
+	19, 3, 19, 3, 19, 5, 19, 448, 10, 19, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19,
+	454, 10, 19, 3, 19, 3, 19, 5, 19, 458, 10, 19, 3, 20, 3, 20, 5, 20, 462,
+	10, 20, 3, 20, 3, 20, 5, 20, 466, 10, 20, 3, 20, 3, 20, 5, 20, 470, 10,
+	20, 3, 20, 3, 20, 5, 20, 474, 10, 20, 3, 20, 7, 20, 477, 10, 20, 12, 20,
+	14, 20, 480, 11, 20, 3, 21, 3, 21, 3, 21, 3, 21, 5, 21, 486, 10, 21, 3,
+	21, 3, 21, 5, 21, 490, 10, 21, 3, 21, 7, 21, 493, 10, 21, 12, 21, 14, 21,
+	496, 11, 21, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 502, 10, 22, 3, 23, 3,
+	23, 3, 23, 3, 23, 5, 23, 508, 10, 23, 3, 23, 3, 23, 3, 23, 5, 23, 513,
+	10, 23, 3, 24, 3, 24, 5, 24, 517, 10, 24, 3, 24, 3, 24, 5, 24, 521, 10,
+	24, 3, 24, 3, 24, 5, 24, 525, 10, 24, 3, 24, 3, 24, 5, 24, 529, 10, 24,
+	3, 24, 5, 24, 532, 10, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3,
+	25, 5, 25, 541, 10, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25,
+	3, 25, 5, 25, 551, 10, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5,
+	25, 559, 10, 25, 3, 26, 3, 26, 3, 26, 3, 26, 5, 26, 565, 10, 26, 3, 26,
+	3, 26, 3, 26, 3, 26, 5, 26, 571, 10, 26, 3, 27, 3, 27, 3, 27, 5, 27, 576,
+	10, 27, 3, 27, 3, 27, 5, 27, 580, 10, 27, 3, 27, 7, 27, 583, 10, 27, 12,
+	27, 14, 27, 586, 11, 27, 5, 27, 588, 10, 27, 3, 27, 5, 27, 591, 10, 27,
+	3, 27, 5, 27, 594, 10, 27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 5, 28, 601,
+	10, 28, 3, 28, 3, 28, 3, 29, 3, 29, 5, 29, 607, 10, 29, 3, 29, 5, 29, 610,
+	10, 29, 3, 29, 3, 29, 3, 29, 5, 29, 615, 10, 29, 3, 29, 5, 29, 618, 10,
+	29, 3, 30, 3, 30, 5, 30, 622, 10, 30, 3, 30, 5, 30, 625, 10, 30, 3, 30,
+	3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 5, 31, 633, 10, 31, 3, 31, 3, 31, 5,
+	31, 637, 10, 31, 3, 31, 3, 31, 5, 31, 641, 10, 31, 3, 32, 3, 32, 5, 32,
+	645, 10, 32, 3, 32, 3, 32, 5, 32, 649, 10, 32, 3, 32, 7, 32, 652, 10, 32,
+	12, 32, 14, 32, 655, 11, 32, 3, 32, 3, 32, 5, 32, 659, 10, 32, 3, 32, 3,
+	32, 5, 32, 663, 10, 32, 3, 32, 7, 32, 666, 10, 32, 12, 32, 14, 32, 669,
+	11, 32, 5, 32, 671, 10, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3,
+	33, 5, 33, 680, 10, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34,
+	5, 34, 689, 10, 34, 3, 34, 7, 34, 692, 10, 34, 12, 34, 14, 34, 695, 11,
+	34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37,
+	5, 37, 707, 10, 37, 3, 37, 5, 37, 710, 10, 37, 3, 38, 3, 38, 3, 38, 3,
+	38, 3, 39, 3, 39, 5, 39, 718, 10, 39, 3, 39, 3, 39, 5, 39, 722, 10, 39,
+	3, 39, 7, 39, 725, 10, 39, 12, 39, 14, 39, 728, 11, 39, 3, 40, 3, 40, 5,
+	40, 732, 10, 40, 3, 40, 3, 40, 5, 40, 736, 10, 40, 3, 40, 3, 40, 3, 40,
+	5, 40, 741, 10, 40, 3, 41, 3, 41, 3, 42, 3, 42, 5, 42, 747, 10, 42, 3,
+	42, 7, 42, 750, 10, 42, 12, 42, 14, 42, 753, 11, 42, 3, 42, 3, 42, 3, 42,
+	3, 42, 5, 42, 759, 10, 42, 3, 43, 3, 43, 5, 43, 763, 10, 43, 3, 43, 3,
+	43, 5, 43, 767, 10, 43, 5, 43, 769, 10, 43, 3, 43, 3, 43, 5, 43, 773, 10,
+	43, 5, 43, 775, 10, 43, 3, 43, 3, 43, 5, 43, 779, 10, 43, 5, 43, 781, 10,
+	43, 3, 43, 3, 43, 3, 44, 3, 44, 5, 44, 787, 10, 44, 3, 44, 3, 44, 3, 45,
+	3, 45, 5, 45, 793, 10, 45, 3, 45, 3, 45, 5, 45, 797, 10, 45, 3, 45, 5,
+	45, 800, 10, 45, 3, 45, 5, 45, 803, 10, 45, 3, 45, 3, 45, 5, 45, 807, 10,
+	45, 3, 45, 3, 45, 3, 45, 3, 45, 5, 45, 813, 10, 45, 3, 45, 3, 45, 5, 45,
+	817, 10, 45, 3, 45, 5, 45, 820, 10, 45, 3, 45, 5, 45, 823, 10, 45, 3, 45,
+	3, 45, 3, 45, 3, 45, 5, 45, 829, 10, 45, 3, 45, 5, 45, 832, 10, 45, 3,
+	45, 5, 45, 835, 10, 45, 3, 45, 3, 45, 5, 45, 839, 10, 45, 3, 45, 3, 45,
+	3, 45, 3, 45, 5, 45, 845, 10, 45, 3, 45, 5, 45, 848, 10, 45, 3, 45, 5,
+	45, 851, 10, 45, 3, 45, 3, 45, 5, 45, 855, 10, 45, 3, 46, 3, 46, 5, 46,
+	859, 10, 46, 3, 46, 3, 46, 5, 46, 863, 10, 46, 5, 46, 865, 10, 46, 3, 46,
+	3, 46, 5, 46, 869, 10, 46, 5, 46, 871, 10, 46, 3, 46, 5, 46, 874, 10, 46,
+	3, 46, 3, 46, 5, 46, 878, 10, 46, 5, 46, 880, 10, 46, 3, 46, 3, 46, 3,
+	47, 3, 47, 5, 47, 886, 10, 47, 3, 48, 3, 48, 5, 48, 890, 10, 48, 3, 48,
+	3, 48, 5, 48, 894, 10, 48, 3, 48, 3, 48, 5, 48, 898, 10, 48, 3, 48, 5,
+	48, 901, 10, 48, 3, 48, 7, 48, 904, 10, 48, 12, 48, 14, 48, 907, 11, 48,
+	3, 49, 3, 49, 5, 49, 911, 10, 49, 3, 49, 7, 49, 914, 10, 49, 12, 49, 14,
+	49, 917, 11, 49, 3, 50, 3, 50, 5, 50, 921, 10, 50, 3, 50, 3, 50, 3, 51,
+	3, 51, 5, 51, 927, 10, 51, 3, 51, 3, 51, 5, 51, 931, 10, 51, 5, 51, 933,
+	10, 51, 3, 51, 3, 51, 5, 51, 937, 10, 51, 3, 51, 3, 51, 5, 51, 941, 10,
+	51, 5, 51, 943, 10, 51, 5, 51, 945, 10, 51, 3, 52, 3, 52, 3, 53, 3, 53,
+	3, 54, 3, 54, 3, 55, 3, 55, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3,
+	57, 7, 57, 962, 10, 57, 12, 57, 14, 57, 965, 11, 57, 3, 58, 3, 58, 3, 58,
+	3, 58, 3, 58, 7, 58, 972, 10, 58, 12, 58, 14, 58, 975, 11, 58, 3, 59, 3,
+	59, 3, 59, 3, 59, 3, 59, 7, 59, 982, 10, 59, 12, 59, 14, 59, 985, 11, 59,
+	3, 60, 3, 60, 5, 60, 989, 10, 60, 7, 60, 991, 10, 60, 12, 60, 14, 60, 994,
+	11, 60, 3, 60, 3, 60, 3, 61, 3, 61, 5, 61, 1000, 10, 61, 3, 61, 7, 61,
+	1003, 10, 61, 12, 61, 14, 61, 1006, 11, 61, 3, 62, 3, 62, 5, 62, 1010,
+	10, 62, 3, 62, 3, 62, 5, 62, 1014, 10, 62, 3, 62, 3, 62, 5, 62, 1018, 10,
+	62, 3, 62, 3, 62, 5, 62, 1022, 10, 62, 3, 62, 7, 62, 1025, 10, 62, 12,
+	62, 14, 62, 1028, 11, 62, 3, 63, 3, 63, 5, 63, 1032, 10, 63, 3, 63, 3,
+	63, 5, 63, 1036, 10, 63, 3, 63, 3, 63, 5, 63, 1040, 10, 63, 3, 63, 3, 63,
+	5, 63, 1044, 10, 63, 3, 63, 3, 63, 5, 63, 1048, 10, 63, 3, 63, 3, 63, 5,
+	63, 1052, 10, 63, 3, 63, 7, 63, 1055, 10, 63, 12, 63, 14, 63, 1058, 11,
+	63, 3, 64, 3, 64, 5, 64, 1062, 10, 64, 3, 64, 3, 64, 5, 64, 1066, 10, 64,
+	3, 64, 7, 64, 1069, 10, 64, 12, 64, 14, 64, 1072, 11, 64, 3, 65, 3, 65,
+	5, 65, 1076, 10, 65, 7, 65, 1078, 10, 65, 12, 65, 14, 65, 1081, 11, 65,
+	3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 7, 66, 1089, 10, 66, 12, 66,
+	14, 66, 1092, 11, 66, 3, 67, 3, 67, 3, 67, 5, 67, 1097, 10, 67, 3, 67,
+	3, 67, 5, 67, 1101, 10, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 5, 67, 1108,
+	10, 67, 3, 67, 3, 67, 5, 67, 1112, 10, 67, 3, 67, 3, 67, 5, 67, 1116, 10,
+	67, 3, 67, 5, 67, 1119, 10, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68,
+	3, 68, 3, 68, 3, 68, 3, 68, 5, 68, 1131, 10, 68, 3, 68, 5, 68, 1134, 10,
+	68, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69,
+	3, 69, 3, 69, 5, 69, 1148, 10, 69, 3, 70, 3, 70, 5, 70, 1152, 10, 70, 3,
+	70, 7, 70, 1155, 10, 70, 12, 70, 14, 70, 1158, 11, 70, 3, 70, 5, 70, 1161,
+	10, 70, 3, 70, 5, 70, 1164, 10, 70, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71,
+	5, 71, 1171, 10, 71, 3, 71, 3, 71, 5, 71, 1175, 10, 71, 3, 71, 3, 71, 5,
+	71, 1179, 10, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 5, 71,
+	1188, 10, 71, 3, 71, 3, 71, 5, 71, 1192, 10, 71, 3, 71, 3, 71, 5, 71, 1196,
+	10, 71, 3, 71, 3, 71, 3, 71, 3, 71, 5, 71, 1202, 10, 71, 3, 71, 3, 71,
+	5, 71, 1206, 10, 71, 3, 71, 3, 71, 5, 71, 1210, 10, 71, 3, 71, 3, 71, 3,
+	71, 3, 71, 5, 71, 1216, 10, 71, 3, 71, 3, 71, 5, 71, 1220, 10, 71, 3, 71,
+	3, 71, 5, 71, 1224, 10, 71, 3, 71, 3, 71, 3, 71, 3, 71, 5, 71, 1230, 10,
+	71, 3, 71, 3, 71, 5, 71, 1234, 10, 71, 3, 71, 3, 71, 5, 71, 1238, 10, 71,
+	3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 5, 71, 1246, 10, 71, 3, 72, 3,
+	72, 5, 72, 1250, 10, 72, 3, 72, 3, 72, 5, 72, 1254, 10, 72, 3, 72, 3, 72,
+	5, 72, 1258, 10, 72, 3, 72, 3, 72, 3, 73, 3, 73, 5, 73, 1264, 10, 73, 3,
+	73, 3, 73, 5, 73, 1268, 10, 73, 3, 73, 3, 73, 5, 73, 1272, 10, 73, 3, 73,
+	3, 73, 3, 74, 3, 74, 3, 74, 5, 74, 1279, 10, 74, 3, 74, 7, 74, 1282, 10,
+	74, 12, 74, 14, 74, 1285, 11, 74, 3, 74, 3, 74, 5, 74, 1289, 10, 74, 3,
+	74, 5, 74, 1292, 10, 74, 5, 74, 1294, 10, 74, 3, 75, 3, 75, 3, 75, 3, 75,
+	3, 75, 3, 75, 5, 75, 1302, 10, 75, 3, 76, 3, 76, 3, 77, 3, 77, 5, 77, 1308,
+	10, 77, 3, 77, 3, 77, 5, 77, 1312, 10, 77, 3, 77, 3, 77, 5, 77, 1316, 10,
+	77, 3, 77, 3, 77, 5, 77, 1320, 10, 77, 7, 77, 1322, 10, 77, 12, 77, 14,
+	77, 1325, 11, 77, 5, 77, 1327, 10, 77, 3, 77, 3, 77, 3, 78, 3, 78, 5, 78,
+	1333, 10, 78, 3, 78, 3, 78, 3, 78, 5, 78, 1338, 10, 78, 3, 78, 3, 78, 3,
+	78, 5, 78, 1343, 10, 78, 3, 78, 3, 78, 3, 78, 5, 78, 1348, 10, 78, 3, 78,
+	3, 78, 3, 78, 5, 78, 1353, 10, 78, 3, 78, 3, 78, 3, 78, 5, 78, 1358, 10,
+	78, 3, 78, 5, 78, 1361, 10, 78, 3, 79, 3, 79, 5, 79, 1365, 10, 79, 3, 79,
+	3, 79, 5, 79, 1369, 10, 79, 3, 79, 3, 79, 3, 80, 3, 80, 5, 80, 1375, 10,
+	80, 3, 80, 6, 80, 1378, 10, 80, 13, 80, 14, 80, 1379, 3, 81, 3, 81, 5,
+	81, 1384, 10, 81, 3, 81, 5, 81, 1387, 10, 81, 3, 82, 3, 82, 3, 82, 3, 82,
+	3, 82, 3, 82, 3, 83, 3, 83, 5, 83, 1397, 10, 83, 3, 83, 3, 83, 5, 83, 1401,
+	10, 83, 3, 83, 3, 83, 5, 83, 1405, 10, 83, 5, 83, 1407, 10, 83, 3, 83,
+	3, 83, 5, 83, 1411, 10, 83, 3, 83, 3, 83, 5, 83, 1415, 10, 83, 3, 83, 3,
+	83, 5, 83, 1419, 10, 83, 7, 83, 1421, 10, 83, 12, 83, 14, 83, 1424, 11,
+	83, 5, 83, 1426, 10, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 5, 84,
+	1434, 10, 84, 3, 85, 3, 85, 5, 85, 1438, 10, 85, 3, 85, 3, 85, 5, 85, 1442,
+	10, 85, 3, 85, 3, 85, 5, 85, 1446, 10, 85, 3, 85, 3, 85, 5, 85, 1450, 10,
+	85, 3, 85, 3, 85, 5, 85, 1454, 10, 85, 7, 85, 1456, 10, 85, 12, 85, 14,
+	85, 1459, 11, 85, 5, 85, 1461, 10, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 87,
+	3, 87, 3, 88, 3, 88, 3, 88, 3, 89, 3, 89, 3, 89, 7, 89, 1475, 10, 89, 12,
+	89, 14, 89, 1478, 11, 89, 3, 90, 3, 90, 5, 90, 1482, 10, 90, 3, 90, 3,
+	90, 5, 90, 1486, 10, 90, 3, 90, 3, 90, 5, 90, 1490, 10, 90, 3, 90, 5, 90,
+	1493, 10, 90, 3, 90, 5, 90, 1496, 10, 90, 3, 90, 3, 90, 3, 91, 3, 91, 5,
+	91, 1502, 10, 91, 3, 91, 3, 91, 5, 91, 1506, 10, 91, 3, 91, 3, 91, 5, 91,
+	1510, 10, 91, 5, 91, 1512, 10, 91, 3, 91, 3, 91, 5, 91, 1516, 10, 91, 3,
+	91, 3, 91, 5, 91, 1520, 10, 91, 3, 91, 3, 91, 5, 91, 1524, 10, 91, 5, 91,
+	1526, 10, 91, 3, 91, 3, 91, 5, 91, 1530, 10, 91, 3, 91, 3, 91, 5, 91, 1534,
+	10, 91, 3, 91, 3, 91, 3, 92, 3, 92, 5, 92, 1540, 10, 92, 3, 92, 3, 92,
+	3, 93, 3, 93, 5, 93, 1546, 10, 93, 3, 93, 6, 93, 1549, 10, 93, 13, 93,
+	14, 93, 1550, 3, 93, 3, 93, 5, 93, 1555, 10, 93, 3, 93, 3, 93, 5, 93, 1559,
+	10, 93, 3, 93, 6, 93, 1562, 10, 93, 13, 93, 14, 93, 1563, 5, 93, 1566,
+	10, 93, 3, 93, 5, 93, 1569, 10, 93, 3, 93, 3, 93, 5, 93, 1573, 10, 93,
+	3, 93, 5, 93, 1576, 10, 93, 3, 93, 5, 93, 1579, 10, 93, 3, 93, 3, 93, 3,
+	94, 3, 94, 5, 94, 1585, 10, 94, 3, 94, 3, 94, 5, 94, 1589, 10, 94, 3, 94,
+	3, 94, 5, 94, 1593, 10, 94, 3, 94, 3, 94, 3, 95, 3, 95, 3, 96, 3, 96, 5,
+	96, 1601, 10, 96, 3, 97, 3, 97, 5, 97, 1605, 10, 97, 3, 97, 3, 97, 5, 97,
+	1609, 10, 97, 3, 97, 3, 97, 5, 97, 1613, 10, 97, 3, 97, 3, 97, 5, 97, 1617,
+	10, 97, 3, 97, 3, 97, 5, 97, 1621, 10, 97, 3, 97, 3, 97, 5, 97, 1625, 10,
+	97, 3, 97, 3, 97, 5, 97, 1629, 10, 97, 3, 97, 3, 97, 5, 97, 1633, 10, 97,
+	7, 97, 1635, 10, 97, 12, 97, 14, 97, 1638, 11, 97, 5, 97, 1640, 10, 97,
+	3, 97, 3, 97, 3, 98, 3, 98, 3, 98, 5, 98, 1647, 10, 98, 3, 99, 3, 99, 5,
+	99, 1651, 10, 99, 3, 99, 6, 99, 1654, 10, 99, 13, 99, 14, 99, 1655, 3,
+	100, 3, 100, 3, 101, 3, 101, 3, 102, 3, 102, 3, 103, 3, 103, 5, 103, 1666,
+	10, 103, 3, 104, 3, 104, 3, 105, 3, 105, 3, 106, 3, 106, 3, 107, 3, 107,
+	3, 108, 3, 108, 3, 108, 2, 2, 109, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20,
+	22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56,
+	58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92,
+	94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122,
+	124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150, 152,
+	154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182,
+	184, 186, 188, 190, 192, 194, 196, 198, 200, 202, 204, 206, 208, 210, 212,
+	214, 2, 14, 3, 2, 65, 66, 3, 2, 68, 70, 3, 2, 78, 81, 3, 2, 17, 18, 3,
+	2, 97, 98, 3, 2, 107, 109, 3, 2, 117, 118, 6, 2, 48, 60, 71, 92, 97, 104,
+	119, 128, 7, 2, 63, 70, 93, 96, 110, 110, 129, 131, 134, 134, 4, 2, 23,
+	23, 29, 32, 4, 2, 24, 24, 33, 36, 4, 2, 18, 18, 37, 47, 2, 1913, 2, 217,
+	3, 2, 2, 2, 4, 231, 3, 2, 2, 2, 6, 235, 3, 2, 2, 2, 8, 237, 3, 2, 2, 2,
+	10, 259, 3, 2, 2, 2, 12, 263, 3, 2, 2, 2, 14, 310, 3, 2, 2, 2, 16, 313,
+	3, 2, 2, 2, 18, 325, 3, 2, 2, 2, 20, 346, 3, 2, 2, 2, 22, 352, 3, 2, 2,
+	2, 24, 356, 3, 2, 2, 2, 26, 369, 3, 2, 2, 2, 28, 379, 3, 2, 2, 2, 30, 401,
+	3, 2, 2, 2, 32, 403, 3, 2, 2, 2, 34, 409, 3, 2, 2, 2, 36, 457, 3, 2, 2,
+	2, 38, 461, 3, 2, 2, 2, 40, 481, 3, 2, 2, 2, 42, 501, 3, 2, 2, 2, 44, 503,
+	3, 2, 2, 2, 46, 514, 3, 2, 2, 2, 48, 533, 3, 2, 2, 2, 50, 560, 3, 2, 2,
+	2, 52, 587, 3, 2, 2, 2, 54, 600, 3, 2, 2, 2, 56, 604, 3, 2, 2, 2, 58, 619,
+	3, 2, 2, 2, 60, 629, 3, 2, 2, 2, 62, 670, 3, 2, 2, 2, 64, 679, 3, 2, 2,
+	2, 66, 681, 3, 2, 2, 2, 68, 696, 3, 2, 2, 2, 70, 700, 3, 2, 2, 2, 72, 704,
+	3, 2, 2, 2, 74, 711, 3, 2, 2, 2, 76, 715, 3, 2, 2, 2, 78, 740, 3, 2, 2,
+	2, 80, 742, 3, 2, 2, 2, 82, 758, 3, 2, 2, 2, 84, 760, 3, 2, 2, 2, 86, 784,
+	3, 2, 2, 2, 88, 854, 3, 2, 2, 2, 90, 856, 3, 2, 2, 2, 92, 885, 3, 2, 2,
+	2, 94, 887, 3, 2, 2, 2, 96, 908, 3, 2, 2, 2, 98, 918, 3, 2, 2, 2, 100,
+	924, 3, 2, 2, 2, 102, 946, 3, 2, 2, 2, 104, 948, 3, 2, 2, 2, 106, 950,
+	3, 2, 2, 2, 108, 952, 3, 2, 2, 2, 110, 954, 3, 2, 2, 2, 112, 956, 3, 2,
+	2, 2, 114, 966, 3, 2, 2, 2, 116, 976, 3, 2, 2, 2, 118, 992, 3, 2, 2, 2,
+	120, 997, 3, 2, 2, 2, 122, 1007, 3, 2, 2, 2, 124, 1029, 3, 2, 2, 2, 126,
+	1059, 3, 2, 2, 2, 128, 1079, 3, 2, 2, 2, 130, 1084, 3, 2, 2, 2, 132, 1118,
+	3, 2, 2, 2, 134, 1130, 3, 2, 2, 2, 136, 1147, 3, 2, 2, 2, 138, 1149, 3,
+	2, 2, 2, 140, 1245, 3, 2, 2, 2, 142, 1247, 3, 2, 2, 2, 144, 1261, 3, 2,
+	2, 2, 146, 1293, 3, 2, 2, 2, 148, 1301, 3, 2, 2, 2, 150, 1303, 3, 2, 2,
+	2, 152, 1305, 3, 2, 2, 2, 154, 1360, 3, 2, 2, 2, 156, 1362, 3, 2, 2, 2,
+	158, 1372, 3, 2, 2, 2, 160, 1381, 3, 2, 2, 2, 162, 1388, 3, 2, 2, 2, 164,
+	1394, 3, 2, 2, 2, 166, 1433, 3, 2, 2, 2, 168, 1435, 3, 2, 2, 2, 170, 1464,
+	3, 2, 2, 2, 172, 1466, 3, 2, 2, 2, 174, 1468, 3, 2, 2, 2, 176, 1476, 3,
+	2, 2, 2, 178, 1479, 3, 2, 2, 2, 180, 1499, 3, 2, 2, 2, 182, 1537, 3, 2,
+	2, 2, 184, 1565, 3, 2, 2, 2, 186, 1582, 3, 2, 2, 2, 188, 1596, 3, 2, 2,
+	2, 190, 1600, 3, 2, 2, 2, 192, 1602, 3, 2, 2, 2, 194, 1643, 3, 2, 2, 2,
+	196, 1648, 3, 2, 2, 2, 198, 1657, 3, 2, 2, 2, 200, 1659, 3, 2, 2, 2, 202,
+	1661, 3, 2, 2, 2, 204, 1665, 3, 2, 2, 2, 206, 1667, 3, 2, 2, 2, 208, 1669,
+	3, 2, 2, 2, 210, 1671, 3, 2, 2, 2, 212, 1673, 3, 2, 2, 2, 214, 1675, 3,
+	2, 2, 2, 216, 218, 7, 135, 2, 2, 217, 216, 3, 2, 2, 2, 217, 218, 3, 2,
+	2, 2, 218, 219, 3, 2, 2, 2, 219, 224, 5, 4, 3, 2, 220, 222, 7, 135, 2,
+	2, 221, 220, 3, 2, 2, 2, 221, 222, 3, 2, 2, 2, 222, 223, 3, 2, 2, 2, 223,
+	225, 7, 3, 2, 2, 224, 221, 3, 2, 2, 2, 224, 225, 3, 2, 2, 2, 225, 227,
+	3, 2, 2, 2, 226, 228, 7, 135, 2, 2, 227, 226, 3, 2, 2, 2, 227, 228, 3,
+	2, 2, 2, 228, 229, 3, 2, 2, 2, 229, 230, 7, 2, 2, 3, 230, 3, 3, 2, 2, 2,
+	231, 232, 5, 6, 4, 2, 232, 5, 3, 2, 2, 2, 233, 236, 5, 8, 5, 2, 234, 236,
+	5, 50, 26, 2, 235, 233, 3, 2, 2, 2, 235, 234, 3, 2, 2, 2, 236, 7, 3, 2,
+	2, 2, 237, 244, 5, 12, 7, 2, 238, 240, 7, 135, 2, 2, 239, 238, 3, 2, 2,
+	2, 239, 240, 3, 2, 2, 2, 240, 241, 3, 2, 2, 2, 241, 243, 5, 10, 6, 2, 242,
+	239, 3, 2, 2, 2, 243, 246, 3, 2, 2, 2, 244, 242, 3, 2, 2, 2, 244, 245,
+	3, 2, 2, 2, 245, 9, 3, 2, 2, 2, 246, 244, 3, 2, 2, 2, 247, 248, 7, 48,
+	2, 2, 248, 249, 7, 135, 2, 2, 249, 251, 7, 49, 2, 2, 250, 252, 7, 135,
+	2, 2, 251, 250, 3, 2, 2, 2, 251, 252, 3, 2, 2, 2, 252, 253, 3, 2, 2, 2,
+	253, 260, 5, 12, 7, 2, 254, 256, 7, 48, 2, 2, 255, 257, 7, 135, 2, 2, 256,
+	255, 3, 2, 2, 2, 256, 257, 3, 2, 2, 2, 257, 258, 3, 2, 2, 2, 258, 260,
+	5, 12, 7, 2, 259, 247, 3, 2, 2, 2, 259, 254, 3, 2, 2, 2, 260, 11, 3, 2,
+	2, 2, 261, 264, 5, 14, 8, 2, 262, 264, 5, 16, 9, 2, 263, 261, 3, 2, 2,
+	2, 263, 262, 3, 2, 2, 2, 264, 13, 3, 2, 2, 2, 265, 267, 5, 22, 12, 2, 266,
+	268, 7, 135, 2, 2, 267, 266, 3, 2, 2, 2, 267, 268, 3, 2, 2, 2, 268, 270,
+	3, 2, 2, 2, 269, 265, 3, 2, 2, 2, 270, 273, 3, 2, 2, 2, 271, 269, 3, 2,
+	2, 2, 271, 272, 3, 2, 2, 2, 272, 274, 3, 2, 2, 2, 273, 271, 3, 2, 2, 2,
+	274, 311, 5, 58, 30, 2, 275, 277, 5, 22, 12, 2, 276, 278, 7, 135, 2, 2,
+	277, 276, 3, 2, 2, 2, 277, 278, 3, 2, 2, 2, 278, 280, 3, 2, 2, 2, 279,
+	275, 3, 2, 2, 2, 280, 283, 3, 2, 2, 2, 281, 279, 3, 2, 2, 2, 281, 282,
+	3, 2, 2, 2, 282, 284, 3, 2, 2, 2, 283, 281, 3, 2, 2, 2, 284, 291, 5, 20,
+	11, 2, 285, 287, 7, 135, 2, 2, 286, 285, 3, 2, 2, 2, 286, 287, 3, 2, 2,
+	2, 287, 288, 3, 2, 2, 2, 288, 290, 5, 20, 11, 2, 289, 286, 3, 2, 2, 2,
+	290, 293, 3, 2, 2, 2, 291, 289, 3, 2, 2, 2, 291, 292, 3, 2, 2, 2, 292,
+	298, 3, 2, 2, 2, 293, 291, 3, 2, 2, 2, 294, 296, 7, 135, 2, 2, 295, 294,
+	3, 2, 2, 2, 295, 296, 3, 2, 2, 2, 296, 297, 3, 2, 2, 2, 297, 299, 5, 58,
+	30, 2, 298, 295, 3, 2, 2, 2, 298, 299, 3, 2, 2, 2, 299, 311, 3, 2, 2, 2,
+	300, 302, 5, 22, 12, 2, 301, 303, 7, 135, 2, 2, 302, 301, 3, 2, 2, 2, 302,
+	303, 3, 2, 2, 2, 303, 305, 3, 2, 2, 2, 304, 300, 3, 2, 2, 2, 305, 308,
+	3, 2, 2, 2, 306, 304, 3, 2, 2, 2, 306, 307, 3, 2, 2, 2, 307, 309, 3, 2,
+	2, 2, 308, 306, 3, 2, 2, 2, 309, 311, 5, 46, 24, 2, 310, 271, 3, 2, 2,
+	2, 310, 281, 3, 2, 2, 2, 310, 306, 3, 2, 2, 2, 311, 15, 3, 2, 2, 2, 312,
+	314, 5, 18, 10, 2, 313, 312, 3, 2, 2, 2, 314, 315, 3, 2, 2, 2, 315, 313,
+	3, 2, 2, 2, 315, 316, 3, 2, 2, 2, 316, 317, 3, 2, 2, 2, 317, 318, 5, 14,
+	8, 2, 318, 17, 3, 2, 2, 2, 319, 321, 5, 22, 12, 2, 320, 322, 7, 135, 2,
+	2, 321, 320, 3, 2, 2, 2, 321, 322, 3, 2, 2, 2, 322, 324, 3, 2, 2, 2, 323,
+	319, 3, 2, 2, 2, 324, 327, 3, 2, 2, 2, 325, 323, 3, 2, 2, 2, 325, 326,
+	3, 2, 2, 2, 326, 334, 3, 2, 2, 2, 327, 325, 3, 2, 2, 2, 328, 330, 5, 20,
+	11, 2, 329, 331, 7, 135, 2, 2, 330, 329, 3, 2, 2, 2, 330, 331, 3, 2, 2,
+	2, 331, 333, 3, 2, 2, 2, 332, 328, 3, 2, 2, 2, 333, 336, 3, 2, 2, 2, 334,
+	332, 3, 2, 2, 2, 334, 335, 3, 2, 2, 2, 335, 337, 3, 2, 2, 2, 336, 334,
+	3, 2, 2, 2, 337, 339, 5, 56, 29, 2, 338, 340, 7, 135, 2, 2, 339, 338, 3,
+	2, 2, 2, 339, 340, 3, 2, 2, 2, 340, 19, 3, 2, 2, 2, 341, 347, 5, 32, 17,
+	2, 342, 347, 5, 28, 15, 2, 343, 347, 5, 38, 20, 2, 344, 347, 5, 34, 18,
+	2, 345, 347, 5, 40, 21, 2, 346, 341, 3, 2, 2, 2, 346, 342, 3, 2, 2, 2,
+	346, 343, 3, 2, 2, 2, 346, 344, 3, 2, 2, 2, 346, 345, 3, 2, 2, 2, 347,
+	21, 3, 2, 2, 2, 348, 353, 5, 24, 13, 2, 349, 353, 5, 26, 14, 2, 350, 353,
+	5, 44, 23, 2, 351, 353, 5, 46, 24, 2, 352, 348, 3, 2, 2, 2, 352, 349, 3,
+	2, 2, 2, 352, 350, 3, 2, 2, 2, 352, 351, 3, 2, 2, 2, 353, 23, 3, 2, 2,
+	2, 354, 355, 7, 50, 2, 2, 355, 357, 7, 135, 2, 2, 356, 354, 3, 2, 2, 2,
+	356, 357, 3, 2, 2, 2, 357, 358, 3, 2, 2, 2, 358, 360, 7, 51, 2, 2, 359,
+	361, 7, 135, 2, 2, 360, 359, 3, 2, 2, 2, 360, 361, 3, 2, 2, 2, 361, 362,
+	3, 2, 2, 2, 362, 367, 5, 76, 39, 2, 363, 365, 7, 135, 2, 2, 364, 363, 3,
+	2, 2, 2, 364, 365, 3, 2, 2, 2, 365, 366, 3, 2, 2, 2, 366, 368, 5, 74, 38,
+	2, 367, 364, 3, 2, 2, 2, 367, 368, 3, 2, 2, 2, 368, 25, 3, 2, 2, 2, 369,
+	371, 7, 52, 2, 2, 370, 372, 7, 135, 2, 2, 371, 370, 3, 2, 2, 2, 371, 372,
+	3, 2, 2, 2, 372, 373, 3, 2, 2, 2, 373, 374, 5, 110, 56, 2, 374, 375, 7,
+	135, 2, 2, 375, 376, 7, 53, 2, 2, 376, 377, 7, 135, 2, 2, 377, 378, 5,
+	188, 95, 2, 378, 27, 3, 2, 2, 2, 379, 381, 7, 54, 2, 2, 380, 382, 7, 135,
+	2, 2, 381, 380, 3, 2, 2, 2, 381, 382, 3, 2, 2, 2, 382, 383, 3, 2, 2, 2,
+	383, 388, 5, 78, 40, 2, 384, 385, 7, 135, 2, 2, 385, 387, 5, 30, 16, 2,
+	386, 384, 3, 2, 2, 2, 387, 390, 3, 2, 2, 2, 388, 386, 3, 2, 2, 2, 388,
+	389, 3, 2, 2, 2, 389, 29, 3, 2, 2, 2, 390, 388, 3, 2, 2, 2, 391, 392, 7,
+	55, 2, 2, 392, 393, 7, 135, 2, 2, 393, 394, 7, 51, 2, 2, 394, 395, 7, 135,
+	2, 2, 395, 402, 5, 34, 18, 2, 396, 397, 7, 55, 2, 2, 397, 398, 7, 135,
+	2, 2, 398, 399, 7, 56, 2, 2, 399, 400, 7, 135, 2, 2, 400, 402, 5, 34, 18,
+	2, 401, 391, 3, 2, 2, 2, 401, 396, 3, 2, 2, 2, 402, 31, 3, 2, 2, 2, 403,
+	405, 7, 56, 2, 2, 404, 406, 7, 135, 2, 2, 405, 404, 3, 2, 2, 2, 405, 406,
+	3, 2, 2, 2, 406, 407, 3, 2, 2, 2, 407, 408, 5, 76, 39, 2, 408, 33, 3, 2,
+	2, 2, 409, 411, 7, 57, 2, 2, 410, 412, 7, 135, 2, 2, 411, 410, 3, 2, 2,
+	2, 411, 412, 3, 2, 2, 2, 412, 413, 3, 2, 2, 2, 413, 418, 5, 36, 19, 2,
+	414, 415, 7, 4, 2, 2, 415, 417, 5, 36, 19, 2, 416, 414, 3, 2, 2, 2, 417,
+	420, 3, 2, 2, 2, 418, 416, 3, 2, 2, 2, 418, 419, 3, 2, 2, 2, 419, 35, 3,
+	2, 2, 2, 420, 418, 3, 2, 2, 2, 421, 423, 5, 196, 99, 2, 422, 424, 7, 135,
+	2, 2, 423, 422, 3, 2, 2, 2, 423, 424, 3, 2, 2, 2, 424, 425, 3, 2, 2, 2,
+	425, 427, 7, 5, 2, 2, 426, 428, 7, 135, 2, 2, 427, 426, 3, 2, 2, 2, 427,
+	428, 3, 2, 2, 2, 428, 429, 3, 2, 2, 2, 429, 430, 5, 110, 56, 2, 430, 458,
+	3, 2, 2, 2, 431, 433, 5, 188, 95, 2, 432, 434, 7, 135, 2, 2, 433, 432,
+	3, 2, 2, 2, 433, 434, 3, 2, 2, 2, 434, 435, 3, 2, 2, 2, 435, 437, 7, 5,
+	2, 2, 436, 438, 7, 135, 2, 2, 437, 436, 3, 2, 2, 2, 437, 438, 3, 2, 2,
+	2, 438, 439, 3, 2, 2, 2, 439, 440, 5, 110, 56, 2, 440, 458, 3, 2, 2, 2,
+	441, 443, 5, 188, 95, 2, 442, 444, 7, 135, 2, 2, 443, 442, 3, 2, 2, 2,
+	443, 444, 3, 2, 2, 2, 444, 445, 3, 2, 2, 2, 445, 447, 7, 6, 2, 2, 446,
+	448, 7, 135, 2, 2, 447, 446, 3, 2, 2, 2, 447, 448, 3, 2, 2, 2, 448, 449,
+	3, 2, 2, 2, 449, 450, 5, 110, 56, 2, 450, 458, 3, 2, 2, 2, 451, 453, 5,
+	188, 95, 2, 452, 454, 7, 135, 2, 2, 453, 452, 3, 2, 2, 2, 453, 454, 3,
+	2, 2, 2, 454, 455, 3, 2, 2, 2, 455, 456, 5, 96, 49, 2, 456, 458, 3, 2,
+	2, 2, 457, 421, 3, 2, 2, 2, 457, 431, 3, 2, 2, 2, 457, 441, 3, 2, 2, 2,
+	457, 451, 3, 2, 2, 2, 458, 37, 3, 2, 2, 2, 459, 460, 7, 58, 2, 2, 460,
+	462, 7, 135, 2, 2, 461, 459, 3, 2, 2, 2, 461, 462, 3, 2, 2, 2, 462, 463,
+	3, 2, 2, 2, 463, 465, 7, 59, 2, 2, 464, 466, 7, 135, 2, 2, 465, 464, 3,
+	2, 2, 2, 465, 466, 3, 2, 2, 2, 466, 467, 3, 2, 2, 2, 467, 478, 5, 110,
+	56, 2, 468, 470, 7, 135, 2, 2, 469, 468, 3, 2, 2, 2, 469, 470, 3, 2, 2,
+	2, 470, 471, 3, 2, 2, 2, 471, 473, 7, 4, 2, 2, 472, 474, 7, 135, 2, 2,
+	473, 472, 3, 2, 2, 2, 473, 474, 3, 2, 2, 2, 474, 475, 3, 2, 2, 2, 475,
+	477, 5, 110, 56, 2, 476, 469, 3, 2, 2, 2, 477, 480, 3, 2, 2, 2, 478, 476,
+	3, 2, 2, 2, 478, 479, 3, 2, 2, 2, 479, 39, 3, 2, 2, 2, 480, 478, 3, 2,
+	2, 2, 481, 482, 7, 60, 2, 2, 482, 483, 7, 135, 2, 2, 483, 494, 5, 42, 22,
+	2, 484, 486, 7, 135, 2, 2, 485, 484, 3, 2, 2, 2, 485, 486, 3, 2, 2, 2,
+	486, 487, 3, 2, 2, 2, 487, 489, 7, 4, 2, 2, 488, 490, 7, 135, 2, 2, 489,
+	488, 3, 2, 2, 2, 489, 490, 3, 2, 2, 2, 490, 491, 3, 2, 2, 2, 491, 493,
+	5, 42, 22, 2, 492, 485, 3, 2, 2, 2, 493, 496, 3, 2, 2, 2, 494, 492, 3,
+	2, 2, 2, 494, 495, 3, 2, 2, 2, 495, 41, 3, 2, 2, 2, 496, 494, 3, 2, 2,
+	2, 497, 498, 5, 188, 95, 2, 498, 499, 5, 96, 49, 2, 499, 502, 3, 2, 2,
+	2, 500, 502, 5, 196, 99, 2, 501, 497, 3, 2, 2, 2, 501, 500, 3, 2, 2, 2,
+	502, 43, 3, 2, 2, 2, 503, 504, 7, 61, 2, 2, 504, 505, 7, 135, 2, 2, 505,
+	512, 5, 168, 85, 2, 506, 508, 7, 135, 2, 2, 507, 506, 3, 2, 2, 2, 507,
+	508, 3, 2, 2, 2, 508, 509, 3, 2, 2, 2, 509, 510, 7, 62, 2, 2, 510, 511,
+	7, 135, 2, 2, 511, 513, 5, 52, 27, 2, 512, 507, 3, 2, 2, 2, 512, 513, 3,
+	2, 2, 2, 513, 45, 3, 2, 2, 2, 514, 516, 7, 61, 2, 2, 515, 517, 7, 135,
+	2, 2, 516, 515, 3, 2, 2, 2, 516, 517, 3, 2, 2, 2, 517, 518, 3, 2, 2, 2,
+	518, 520, 7, 7, 2, 2, 519, 521, 7, 135, 2, 2, 520, 519, 3, 2, 2, 2, 520,
+	521, 3, 2, 2, 2, 521, 522, 3, 2, 2, 2, 522, 524, 5, 8, 5, 2, 523, 525,
+	7, 135, 2, 2, 524, 523, 3, 2, 2, 2, 524, 525, 3, 2, 2, 2, 525, 526, 3,
+	2, 2, 2, 526, 531, 7, 8, 2, 2, 527, 529, 7, 135, 2, 2, 528, 527, 3, 2,
+	2, 2, 528, 529, 3, 2, 2, 2, 529, 530, 3, 2, 2, 2, 530, 532, 5, 48, 25,
+	2, 531, 528, 3, 2, 2, 2, 531, 532, 3, 2, 2, 2, 532, 47, 3, 2, 2, 2, 533,
+	534, 7, 87, 2, 2, 534, 540, 7, 135, 2, 2, 535, 536, 5, 110, 56, 2, 536,
+	537, 7, 135, 2, 2, 537, 538, 7, 63, 2, 2, 538, 539, 7, 135, 2, 2, 539,
+	541, 3, 2, 2, 2, 540, 535, 3, 2, 2, 2, 540, 541, 3, 2, 2, 2, 541, 542,
+	3, 2, 2, 2, 542, 550, 7, 64, 2, 2, 543, 544, 7, 135, 2, 2, 544, 545, 7,
+	126, 2, 2, 545, 546, 7, 135, 2, 2, 546, 547, 5, 110, 56, 2, 547, 548, 7,
+	135, 2, 2, 548, 549, 9, 2, 2, 2, 549, 551, 3, 2, 2, 2, 550, 543, 3, 2,
+	2, 2, 550, 551, 3, 2, 2, 2, 551, 558, 3, 2, 2, 2, 552, 553, 7, 135, 2,
+	2, 553, 554, 7, 55, 2, 2, 554, 555, 7, 135, 2, 2, 555, 556, 7, 67, 2, 2,
+	556, 557, 7, 135, 2, 2, 557, 559, 9, 3, 2, 2, 558, 552, 3, 2, 2, 2, 558,
+	559, 3, 2, 2, 2, 559, 49, 3, 2, 2, 2, 560, 561, 7, 61, 2, 2, 561, 564,
+	7, 135, 2, 2, 562, 565, 5, 168, 85, 2, 563, 565, 5, 170, 86, 2, 564, 562,
+	3, 2, 2, 2, 564, 563, 3, 2, 2, 2, 565, 570, 3, 2, 2, 2, 566, 567, 7, 135,
+	2, 2, 567, 568, 7, 62, 2, 2, 568, 569, 7, 135, 2, 2, 569, 571, 5, 52, 27,
+	2, 570, 566, 3, 2, 2, 2, 570, 571, 3, 2, 2, 2, 571, 51, 3, 2, 2, 2, 572,
+	588, 7, 9, 2, 2, 573, 584, 5, 54, 28, 2, 574, 576, 7, 135, 2, 2, 575, 574,
+	3, 2, 2, 2, 575, 576, 3, 2, 2, 2, 576, 577, 3, 2, 2, 2, 577, 579, 7, 4,
+	2, 2, 578, 580, 7, 135, 2, 2, 579, 578, 3, 2, 2, 2, 579, 580, 3, 2, 2,
+	2, 580, 581, 3, 2, 2, 2, 581, 583, 5, 54, 28, 2, 582, 575, 3, 2, 2, 2,
+	583, 586, 3, 2, 2, 2, 584, 582, 3, 2, 2, 2, 584, 585, 3, 2, 2, 2, 585,
+	588, 3, 2, 2, 2, 586, 584, 3, 2, 2, 2, 587, 572, 3, 2, 2, 2, 587, 573,
+	3, 2, 2, 2, 588, 593, 3, 2, 2, 2, 589, 591, 7, 135, 2, 2, 590, 589, 3,
+	2, 2, 2, 590, 591, 3, 2, 2, 2, 591, 592, 3, 2, 2, 2, 592, 594, 5, 74, 38,
+	2, 593, 590, 3, 2, 2, 2, 593, 594, 3, 2, 2, 2, 594, 53, 3, 2, 2, 2, 595,
+	596, 5, 172, 87, 2, 596, 597, 7, 135, 2, 2, 597, 598, 7, 53, 2, 2, 598,
+	599, 7, 135, 2, 2, 599, 601, 3, 2, 2, 2, 600, 595, 3, 2, 2, 2, 600, 601,
+	3, 2, 2, 2, 601, 602, 3, 2, 2, 2, 602, 603, 5, 188, 95, 2, 603, 55, 3,
+	2, 2, 2, 604, 609, 7, 71, 2, 2, 605, 607, 7, 135, 2, 2, 606, 605, 3, 2,
+	2, 2, 606, 607, 3, 2, 2, 2, 607, 608, 3, 2, 2, 2, 608, 610, 7, 72, 2, 2,
+	609, 606, 3, 2, 2, 2, 609, 610, 3, 2, 2, 2, 610, 611, 3, 2, 2, 2, 611,
+	612, 7, 135, 2, 2, 612, 617, 5, 60, 31, 2, 613, 615, 7, 135, 2, 2, 614,
+	613, 3, 2, 2, 2, 614, 615, 3, 2, 2, 2, 615, 616, 3, 2, 2, 2, 616, 618,
+	5, 74, 38, 2, 617, 614, 3, 2, 2, 2, 617, 618, 3, 2, 2, 2, 618, 57, 3, 2,
+	2, 2, 619, 624, 7, 73, 2, 2, 620, 622, 7, 135, 2, 2, 621, 620, 3, 2, 2,
+	2, 621, 622, 3, 2, 2, 2, 622, 623, 3, 2, 2, 2, 623, 625, 7, 72, 2, 2, 624,
+	621, 3, 2, 2, 2, 624, 625, 3, 2, 2, 2, 625, 626, 3, 2, 2, 2, 626, 627,
+	7, 135, 2, 2, 627, 628, 5, 60, 31, 2, 628, 59, 3, 2, 2, 2, 629, 632, 5,
+	62, 32, 2, 630, 631, 7, 135, 2, 2, 631, 633, 5, 66, 34, 2, 632, 630, 3,
+	2, 2, 2, 632, 633, 3, 2, 2, 2, 633, 636, 3, 2, 2, 2, 634, 635, 7, 135,
+	2, 2, 635, 637, 5, 68, 35, 2, 636, 634, 3, 2, 2, 2, 636, 637, 3, 2, 2,
+	2, 637, 640, 3, 2, 2, 2, 638, 639, 7, 135, 2, 2, 639, 641, 5, 70, 36, 2,
+	640, 638, 3, 2, 2, 2, 640, 641, 3, 2, 2, 2, 641, 61, 3, 2, 2, 2, 642, 653,
+	7, 9, 2, 2, 643, 645, 7, 135, 2, 2, 644, 643, 3, 2, 2, 2, 644, 645, 3,
+	2, 2, 2, 645, 646, 3, 2, 2, 2, 646, 648, 7, 4, 2, 2, 647, 649, 7, 135,
+	2, 2, 648, 647, 3, 2, 2, 2, 648, 649, 3, 2, 2, 2, 649, 650, 3, 2, 2, 2,
+	650, 652, 5, 64, 33, 2, 651, 644, 3, 2, 2, 2, 652, 655, 3, 2, 2, 2, 653,
+	651, 3, 2, 2, 2, 653, 654, 3, 2, 2, 2, 654, 671, 3, 2, 2, 2, 655, 653,
+	3, 2, 2, 2, 656, 667, 5, 64, 33, 2, 657, 659, 7, 135, 2, 2, 658, 657, 3,
+	2, 2, 2, 658, 659, 3, 2, 2, 2, 659, 660, 3, 2, 2, 2, 660, 662, 7, 4, 2,
+	2, 661, 663, 7, 135, 2, 2, 662, 661, 3, 2, 2, 2, 662, 663, 3, 2, 2, 2,
+	663, 664, 3, 2, 2, 2, 664, 666, 5, 64, 33, 2, 665, 658, 3, 2, 2, 2, 666,
+	669, 3, 2, 2, 2, 667, 665, 3, 2, 2, 2, 667, 668, 3, 2, 2, 2, 668, 671,
+	3, 2, 2, 2, 669, 667, 3, 2, 2, 2, 670, 642, 3, 2, 2, 2, 670, 656, 3, 2,
+	2, 2, 671, 63, 3, 2, 2, 2, 672, 673, 5, 110, 56, 2, 673, 674, 7, 135, 2,
+	2, 674, 675, 7, 53, 2, 2, 675, 676, 7, 135, 2, 2, 676, 677, 5, 188, 95,
+	2, 677, 680, 3, 2, 2, 2, 678, 680, 5, 110, 56, 2, 679, 672, 3, 2, 2, 2,
+	679, 678, 3, 2, 2, 2, 680, 65, 3, 2, 2, 2, 681, 682, 7, 74, 2, 2, 682,
+	683, 7, 135, 2, 2, 683, 684, 7, 75, 2, 2, 684, 685, 7, 135, 2, 2, 685,
+	693, 5, 72, 37, 2, 686, 688, 7, 4, 2, 2, 687, 689, 7, 135, 2, 2, 688, 687,
+	3, 2, 2, 2, 688, 689, 3, 2, 2, 2, 689, 690, 3, 2, 2, 2, 690, 692, 5, 72,
+	37, 2, 691, 686, 3, 2, 2, 2, 692, 695, 3, 2, 2, 2, 693, 691, 3, 2, 2, 2,
+	693, 694, 3, 2, 2, 2, 694, 67, 3, 2, 2, 2, 695, 693, 3, 2, 2, 2, 696, 697,
+	7, 76, 2, 2, 697, 698, 7, 135, 2, 2, 698, 699, 5, 110, 56, 2, 699, 69,
+	3, 2, 2, 2, 700, 701, 7, 77, 2, 2, 701, 702, 7, 135, 2, 2, 702, 703, 5,
+	110, 56, 2, 703, 71, 3, 2, 2, 2, 704, 709, 5, 110, 56, 2, 705, 707, 7,
+	135, 2, 2, 706, 705, 3, 2, 2, 2, 706, 707, 3, 2, 2, 2, 707, 708, 3, 2,
+	2, 2, 708, 710, 9, 4, 2, 2, 709, 706, 3, 2, 2, 2, 709, 710, 3, 2, 2, 2,
+	710, 73, 3, 2, 2, 2, 711, 712, 7, 82, 2, 2, 712, 713, 7, 135, 2, 2, 713,
+	714, 5, 110, 56, 2, 714, 75, 3, 2, 2, 2, 715, 726, 5, 78, 40, 2, 716, 718,
+	7, 135, 2, 2, 717, 716, 3, 2, 2, 2, 717, 718, 3, 2, 2, 2, 718, 719, 3,
+	2, 2, 2, 719, 721, 7, 4, 2, 2, 720, 722, 7, 135, 2, 2, 721, 720, 3, 2,
+	2, 2, 721, 722, 3, 2, 2, 2, 722, 723, 3, 2, 2, 2, 723, 725, 5, 78, 40,
+	2, 724, 717, 3, 2, 2, 2, 725, 728, 3, 2, 2, 2, 726, 724, 3, 2, 2, 2, 726,
+	727, 3, 2, 2, 2, 727, 77, 3, 2, 2, 2, 728, 726, 3, 2, 2, 2, 729, 731, 5,
+	188, 95, 2, 730, 732, 7, 135, 2, 2, 731, 730, 3, 2, 2, 2, 731, 732, 3,
+	2, 2, 2, 732, 733, 3, 2, 2, 2, 733, 735, 7, 5, 2, 2, 734, 736, 7, 135,
+	2, 2, 735, 734, 3, 2, 2, 2, 735, 736, 3, 2, 2, 2, 736, 737, 3, 2, 2, 2,
+	737, 738, 5, 80, 41, 2, 738, 741, 3, 2, 2, 2, 739, 741, 5, 80, 41, 2, 740,
+	729, 3, 2, 2, 2, 740, 739, 3, 2, 2, 2, 741, 79, 3, 2, 2, 2, 742, 743, 5,
+	82, 42, 2, 743, 81, 3, 2, 2, 2, 744, 751, 5, 84, 43, 2, 745, 747, 7, 135,
+	2, 2, 746, 745, 3, 2, 2, 2, 746, 747, 3, 2, 2, 2, 747, 748, 3, 2, 2, 2,
+	748, 750, 5, 86, 44, 2, 749, 746, 3, 2, 2, 2, 750, 753, 3, 2, 2, 2, 751,
+	749, 3, 2, 2, 2, 751, 752, 3, 2, 2, 2, 752, 759, 3, 2, 2, 2, 753, 751,
+	3, 2, 2, 2, 754, 755, 7, 10, 2, 2, 755, 756, 5, 82, 42, 2, 756, 757, 7,
+	11, 2, 2, 757, 759, 3, 2, 2, 2, 758, 744, 3, 2, 2, 2, 758, 754, 3, 2, 2,
+	2, 759, 83, 3, 2, 2, 2, 760, 762, 7, 10, 2, 2, 761, 763, 7, 135, 2, 2,
+	762, 761, 3, 2, 2, 2, 762, 763, 3, 2, 2, 2, 763, 768, 3, 2, 2, 2, 764,
+	766, 5, 188, 95, 2, 765, 767, 7, 135, 2, 2, 766, 765, 3, 2, 2, 2, 766,
+	767, 3, 2, 2, 2, 767, 769, 3, 2, 2, 2, 768, 764, 3, 2, 2, 2, 768, 769,
+	3, 2, 2, 2, 769, 774, 3, 2, 2, 2, 770, 772, 5, 96, 49, 2, 771, 773, 7,
+	135, 2, 2, 772, 771, 3, 2, 2, 2, 772, 773, 3, 2, 2, 2, 773, 775, 3, 2,
+	2, 2, 774, 770, 3, 2, 2, 2, 774, 775, 3, 2, 2, 2, 775, 780, 3, 2, 2, 2,
+	776, 778, 5, 92, 47, 2, 777, 779, 7, 135, 2, 2, 778, 777, 3, 2, 2, 2, 778,
+	779, 3, 2, 2, 2, 779, 781, 3, 2, 2, 2, 780, 776, 3, 2, 2, 2, 780, 781,
+	3, 2, 2, 2, 781, 782, 3, 2, 2, 2, 782, 783, 7, 11, 2, 2, 783, 85, 3, 2,
+	2, 2, 784, 786, 5, 88, 45, 2, 785, 787, 7, 135, 2, 2, 786, 785, 3, 2, 2,
+	2, 786, 787, 3, 2, 2, 2, 787, 788, 3, 2, 2, 2, 788, 789, 5, 84, 43, 2,
+	789, 87, 3, 2, 2, 2, 790, 792, 5, 210, 106, 2, 791, 793, 7, 135, 2, 2,
+	792, 791, 3, 2, 2, 2, 792, 793, 3, 2, 2, 2, 793, 794, 3, 2, 2, 2, 794,
+	796, 5, 214, 108, 2, 795, 797, 7, 135, 2, 2, 796, 795, 3, 2, 2, 2, 796,
+	797, 3, 2, 2, 2, 797, 799, 3, 2, 2, 2, 798, 800, 5, 90, 46, 2, 799, 798,
+	3, 2, 2, 2, 799, 800, 3, 2, 2, 2, 800, 802, 3, 2, 2, 2, 801, 803, 7, 135,
+	2, 2, 802, 801, 3, 2, 2, 2, 802, 803, 3, 2, 2, 2, 803, 804, 3, 2, 2, 2,
+	804, 806, 5, 214, 108, 2, 805, 807, 7, 135, 2, 2, 806, 805, 3, 2, 2, 2,
+	806, 807, 3, 2, 2, 2, 807, 808, 3, 2, 2, 2, 808, 809, 5, 212, 107, 2, 809,
+	855, 3, 2, 2, 2, 810, 812, 5, 210, 106, 2, 811, 813, 7, 135, 2, 2, 812,
+	811, 3, 2, 2, 2, 812, 813, 3, 2, 2, 2, 813, 814, 3, 2, 2, 2, 814, 816,
+	5, 214, 108, 2, 815, 817, 7, 135, 2, 2, 816, 815, 3, 2, 2, 2, 816, 817,
+	3, 2, 2, 2, 817, 819, 3, 2, 2, 2, 818, 820, 5, 90, 46, 2, 819, 818, 3,
+	2, 2, 2, 819, 820, 3, 2, 2, 2, 820, 822, 3, 2, 2, 2, 821, 823, 7, 135,
 	2, 2, 822, 821, 3, 2, 2, 2, 822, 823, 3, 2, 2, 2, 823, 824, 3, 2, 2, 2,
-	824, 838, 5, 104, 53, 2, 825, 827, 7, 127, 2, 2, 826, 825, 3, 2, 2, 2,
-	826, 827, 3, 2, 2, 2, 827, 828, 3, 2, 2, 2, 828, 830, 7, 13, 2, 2, 829,
-	831, 7, 12, 2, 2, 830, 829, 3, 2, 2, 2, 830, 831, 3, 2, 2, 2, 831, 833,
-	3, 2, 2, 2, 832, 834, 7, 127, 2, 2, 833, 832, 3, 2, 2, 2, 833, 834, 3,
-	2, 2, 2, 834, 835, 3, 2, 2, 2, 835, 837, 5, 104, 53, 2, 836, 826, 3, 2,
-	2, 2, 837, 840, 3, 2, 2, 2, 838, 836, 3, 2, 2, 2, 838, 839, 3, 2, 2, 2,
-	839, 91, 3, 2, 2, 2, 840, 838, 3, 2, 2, 2, 841, 848, 5, 94, 48, 2, 842,
-	844, 7, 127, 2, 2, 843, 842, 3, 2, 2, 2, 843, 844, 3, 2, 2, 2, 844, 845,
-	3, 2, 2, 2, 845, 847, 5, 94, 48, 2, 846, 843, 3, 2, 2, 2, 847, 850, 3,
-	2, 2, 2, 848, 846, 3, 2, 2, 2, 848, 849, 3, 2, 2, 2, 849, 93, 3, 2, 2,
-	2, 850, 848, 3, 2, 2, 2, 851, 853, 7, 12, 2, 2, 852, 854, 7, 127, 2, 2,
-	853, 852, 3, 2, 2, 2, 853, 854, 3, 2, 2, 2, 854, 855, 3, 2, 2, 2, 855,
-	856, 5, 102, 52, 2, 856, 95, 3, 2, 2, 2, 857, 859, 7, 7, 2, 2, 858, 860,
-	7, 127, 2, 2, 859, 858, 3, 2, 2, 2, 859, 860, 3, 2, 2, 2, 860, 865, 3,
-	2, 2, 2, 861, 863, 5, 98, 50, 2, 862, 864, 7, 127, 2, 2, 863, 862, 3, 2,
-	2, 2, 863, 864, 3, 2, 2, 2, 864, 866, 3, 2, 2, 2, 865, 861, 3, 2, 2, 2,
-	865, 866, 3, 2, 2, 2, 866, 877, 3, 2, 2, 2, 867, 869, 7, 14, 2, 2, 868,
-	870, 7, 127, 2, 2, 869, 868, 3, 2, 2, 2, 869, 870, 3, 2, 2, 2, 870, 875,
-	3, 2, 2, 2, 871, 873, 5, 100, 51, 2, 872, 874, 7, 127, 2, 2, 873, 872,
-	3, 2, 2, 2, 873, 874, 3, 2, 2, 2, 874, 876, 3, 2, 2, 2, 875, 871, 3, 2,
-	2, 2, 875, 876, 3, 2, 2, 2, 876, 878, 3, 2, 2, 2, 877, 867, 3, 2, 2, 2,
-	877, 878, 3, 2, 2, 2, 878, 97, 3, 2, 2, 2, 879, 880, 5, 190, 96, 2, 880,
-	99, 3, 2, 2, 2, 881, 882, 5, 190, 96, 2, 882, 101, 3, 2, 2, 2, 883, 884,
-	5, 194, 98, 2, 884, 103, 3, 2, 2, 2, 885, 886, 5, 194, 98, 2, 886, 105,
-	3, 2, 2, 2, 887, 888, 5, 108, 55, 2, 888, 107, 3, 2, 2, 2, 889, 896, 5,
-	110, 56, 2, 890, 891, 7, 127, 2, 2, 891, 892, 7, 75, 2, 2, 892, 893, 7,
-	127, 2, 2, 893, 895, 5, 110, 56, 2, 894, 890, 3, 2, 2, 2, 895, 898, 3,
-	2, 2, 2, 896, 894, 3, 2, 2, 2, 896, 897, 3, 2, 2, 2, 897, 109, 3, 2, 2,
-	2, 898, 896, 3, 2, 2, 2, 899, 906, 5, 112, 57, 2, 900, 901, 7, 127, 2,
-	2, 901, 902, 7, 76, 2, 2, 902, 903, 7, 127, 2, 2, 903, 905, 5, 112, 57,
-	2, 904, 900, 3, 2, 2, 2, 905, 908, 3, 2, 2, 2, 906, 904, 3, 2, 2, 2, 906,
-	907, 3, 2, 2, 2, 907, 111, 3, 2, 2, 2, 908, 906, 3, 2, 2, 2, 909, 916,
-	5, 114, 58, 2, 910, 911, 7, 127, 2, 2, 911, 912, 7, 77, 2, 2, 912, 913,
-	7, 127, 2, 2, 913, 915, 5, 114, 58, 2, 914, 910, 3, 2, 2, 2, 915, 918,
-	3, 2, 2, 2, 916, 914, 3, 2, 2, 2, 916, 917, 3, 2, 2, 2, 917, 113, 3, 2,
-	2, 2, 918, 916, 3, 2, 2, 2, 919, 921, 7, 78, 2, 2, 920, 922, 7, 127, 2,
-	2, 921, 920, 3, 2, 2, 2, 921, 922, 3, 2, 2, 2, 922, 924, 3, 2, 2, 2, 923,
-	919, 3, 2, 2, 2, 924, 927, 3, 2, 2, 2, 925, 923, 3, 2, 2, 2, 925, 926,
-	3, 2, 2, 2, 926, 928, 3, 2, 2, 2, 927, 925, 3, 2, 2, 2, 928, 929, 5, 116,
-	59, 2, 929, 115, 3, 2, 2, 2, 930, 937, 5, 118, 60, 2, 931, 933, 7, 127,
-	2, 2, 932, 931, 3, 2, 2, 2, 932, 933, 3, 2, 2, 2, 933, 934, 3, 2, 2, 2,
-	934, 936, 5, 144, 73, 2, 935, 932, 3, 2, 2, 2, 936, 939, 3, 2, 2, 2, 937,
-	935, 3, 2, 2, 2, 937, 938, 3, 2, 2, 2, 938, 117, 3, 2, 2, 2, 939, 937,
-	3, 2, 2, 2, 940, 959, 5, 120, 61, 2, 941, 943, 7, 127, 2, 2, 942, 941,
-	3, 2, 2, 2, 942, 943, 3, 2, 2, 2, 943, 944, 3, 2, 2, 2, 944, 946, 7, 15,
-	2, 2, 945, 947, 7, 127, 2, 2, 946, 945, 3, 2, 2, 2, 946, 947, 3, 2, 2,
-	2, 947, 948, 3, 2, 2, 2, 948, 958, 5, 120, 61, 2, 949, 951, 7, 127, 2,
-	2, 950, 949, 3, 2, 2, 2, 950, 951, 3, 2, 2, 2, 951, 952, 3, 2, 2, 2, 952,
-	954, 7, 16, 2, 2, 953, 955, 7, 127, 2, 2, 954, 953, 3, 2, 2, 2, 954, 955,
-	3, 2, 2, 2, 955, 956, 3, 2, 2, 2, 956, 958, 5, 120, 61, 2, 957, 942, 3,
-	2, 2, 2, 957, 950, 3, 2, 2, 2, 958, 961, 3, 2, 2, 2, 959, 957, 3, 2, 2,
-	2, 959, 960, 3, 2, 2, 2, 960, 119, 3, 2, 2, 2, 961, 959, 3, 2, 2, 2, 962,
-	989, 5, 122, 62, 2, 963, 965, 7, 127, 2, 2, 964, 963, 3, 2, 2, 2, 964,
-	965, 3, 2, 2, 2, 965, 966, 3, 2, 2, 2, 966, 968, 7, 7, 2, 2, 967, 969,
-	7, 127, 2, 2, 968, 967, 3, 2, 2, 2, 968, 969, 3, 2, 2, 2, 969, 970, 3,
-	2, 2, 2, 970, 988, 5, 122, 62, 2, 971, 973, 7, 127, 2, 2, 972, 971, 3,
-	2, 2, 2, 972, 973, 3, 2, 2, 2, 973, 974, 3, 2, 2, 2, 974, 976, 7, 17, 2,
-	2, 975, 977, 7, 127, 2, 2, 976, 975, 3, 2, 2, 2, 976, 977, 3, 2, 2, 2,
-	977, 978, 3, 2, 2, 2, 978, 988, 5, 122, 62, 2, 979, 981, 7, 127, 2, 2,
-	980, 979, 3, 2, 2, 2, 980, 981, 3, 2, 2, 2, 981, 982, 3, 2, 2, 2, 982,
-	984, 7, 18, 2, 2, 983, 985, 7, 127, 2, 2, 984, 983, 3, 2, 2, 2, 984, 985,
-	3, 2, 2, 2, 985, 986, 3, 2, 2, 2, 986, 988, 5, 122, 62, 2, 987, 964, 3,
-	2, 2, 2, 987, 972, 3, 2, 2, 2, 987, 980, 3, 2, 2, 2, 988, 991, 3, 2, 2,
-	2, 989, 987, 3, 2, 2, 2, 989, 990, 3, 2, 2, 2, 990, 121, 3, 2, 2, 2, 991,
-	989, 3, 2, 2, 2, 992, 1003, 5, 124, 63, 2, 993, 995, 7, 127, 2, 2, 994,
-	993, 3, 2, 2, 2, 994, 995, 3, 2, 2, 2, 995, 996, 3, 2, 2, 2, 996, 998,
-	7, 19, 2, 2, 997, 999, 7, 127, 2, 2, 998, 997, 3, 2, 2, 2, 998, 999, 3,
-	2, 2, 2, 999, 1000, 3, 2, 2, 2, 1000, 1002, 5, 124, 63, 2, 1001, 994, 3,
-	2, 2, 2, 1002, 1005, 3, 2, 2, 2, 1003, 1001, 3, 2, 2, 2, 1003, 1004, 3,
-	2, 2, 2, 1004, 123, 3, 2, 2, 2, 1005, 1003, 3, 2, 2, 2, 1006, 1008, 9,
-	3, 2, 2, 1007, 1009, 7, 127, 2, 2, 1008, 1007, 3, 2, 2, 2, 1008, 1009,
-	3, 2, 2, 2, 1009, 1011, 3, 2, 2, 2, 1010, 1006, 3, 2, 2, 2, 1011, 1014,
-	3, 2, 2, 2, 1012, 1010, 3, 2, 2, 2, 1012, 1013, 3, 2, 2, 2, 1013, 1015,
-	3, 2, 2, 2, 1014, 1012, 3, 2, 2, 2, 1015, 1016, 5, 126, 64, 2, 1016, 125,
-	3, 2, 2, 2, 1017, 1023, 5, 134, 68, 2, 1018, 1022, 5, 130, 66, 2, 1019,
-	1022, 5, 128, 65, 2, 1020, 1022, 5, 132, 67, 2, 1021, 1018, 3, 2, 2, 2,
-	1021, 1019, 3, 2, 2, 2, 1021, 1020, 3, 2, 2, 2, 1022, 1025, 3, 2, 2, 2,
-	1023, 1021, 3, 2, 2, 2, 1023, 1024, 3, 2, 2, 2, 1024, 127, 3, 2, 2, 2,
-	1025, 1023, 3, 2, 2, 2, 1026, 1027, 7, 127, 2, 2, 1027, 1029, 7, 79, 2,
-	2, 1028, 1030, 7, 127, 2, 2, 1029, 1028, 3, 2, 2, 2, 1029, 1030, 3, 2,
-	2, 2, 1030, 1031, 3, 2, 2, 2, 1031, 1052, 5, 134, 68, 2, 1032, 1034, 7,
-	127, 2, 2, 1033, 1032, 3, 2, 2, 2, 1033, 1034, 3, 2, 2, 2, 1034, 1035,
-	3, 2, 2, 2, 1035, 1036, 7, 10, 2, 2, 1036, 1037, 5, 106, 54, 2, 1037, 1038,
-	7, 11, 2, 2, 1038, 1052, 3, 2, 2, 2, 1039, 1041, 7, 127, 2, 2, 1040, 1039,
-	3, 2, 2, 2, 1040, 1041, 3, 2, 2, 2, 1041, 1042, 3, 2, 2, 2, 1042, 1044,
-	7, 10, 2, 2, 1043, 1045, 5, 106, 54, 2, 1044, 1043, 3, 2, 2, 2, 1044, 1045,
-	3, 2, 2, 2, 1045, 1046, 3, 2, 2, 2, 1046, 1048, 7, 14, 2, 2, 1047, 1049,
-	5, 106, 54, 2, 1048, 1047, 3, 2, 2, 2, 1048, 1049, 3, 2, 2, 2, 1049, 1050,
-	3, 2, 2, 2, 1050, 1052, 7, 11, 2, 2, 1051, 1026, 3, 2, 2, 2, 1051, 1033,
-	3, 2, 2, 2, 1051, 1040, 3, 2, 2, 2, 1052, 129, 3, 2, 2, 2, 1053, 1054,
-	7, 127, 2, 2, 1054, 1055, 7, 80, 2, 2, 1055, 1056, 7, 127, 2, 2, 1056,
-	1064, 7, 63, 2, 2, 1057, 1058, 7, 127, 2, 2, 1058, 1059, 7, 81, 2, 2, 1059,
-	1060, 7, 127, 2, 2, 1060, 1064, 7, 63, 2, 2, 1061, 1062, 7, 127, 2, 2,
-	1062, 1064, 7, 82, 2, 2, 1063, 1053, 3, 2, 2, 2, 1063, 1057, 3, 2, 2, 2,
-	1063, 1061, 3, 2, 2, 2, 1064, 1066, 3, 2, 2, 2, 1065, 1067, 7, 127, 2,
-	2, 1066, 1065, 3, 2, 2, 2, 1066, 1067, 3, 2, 2, 2, 1067, 1068, 3, 2, 2,
-	2, 1068, 1069, 5, 134, 68, 2, 1069, 131, 3, 2, 2, 2, 1070, 1071, 7, 127,
-	2, 2, 1071, 1072, 7, 83, 2, 2, 1072, 1073, 7, 127, 2, 2, 1073, 1081, 7,
-	84, 2, 2, 1074, 1075, 7, 127, 2, 2, 1075, 1076, 7, 83, 2, 2, 1076, 1077,
-	7, 127, 2, 2, 1077, 1078, 7, 78, 2, 2, 1078, 1079, 7, 127, 2, 2, 1079,
-	1081, 7, 84, 2, 2, 1080, 1070, 3, 2, 2, 2, 1080, 1074, 3, 2, 2, 2, 1081,
-	133, 3, 2, 2, 2, 1082, 1089, 5, 136, 69, 2, 1083, 1085, 7, 127, 2, 2, 1084,
-	1083, 3, 2, 2, 2, 1084, 1085, 3, 2, 2, 2, 1085, 1086, 3, 2, 2, 2, 1086,
-	1088, 5, 172, 87, 2, 1087, 1084, 3, 2, 2, 2, 1088, 1091, 3, 2, 2, 2, 1089,
-	1087, 3, 2, 2, 2, 1089, 1090, 3, 2, 2, 2, 1090, 1096, 3, 2, 2, 2, 1091,
-	1089, 3, 2, 2, 2, 1092, 1094, 7, 127, 2, 2, 1093, 1092, 3, 2, 2, 2, 1093,
-	1094, 3, 2, 2, 2, 1094, 1095, 3, 2, 2, 2, 1095, 1097, 5, 92, 47, 2, 1096,
-	1093, 3, 2, 2, 2, 1096, 1097, 3, 2, 2, 2, 1097, 135, 3, 2, 2, 2, 1098,
-	1177, 5, 138, 70, 2, 1099, 1177, 5, 184, 93, 2, 1100, 1177, 5, 174, 88,
-	2, 1101, 1103, 7, 85, 2, 2, 1102, 1104, 7, 127, 2, 2, 1103, 1102, 3, 2,
-	2, 2, 1103, 1104, 3, 2, 2, 2, 1104, 1105, 3, 2, 2, 2, 1105, 1107, 7, 8,
-	2, 2, 1106, 1108, 7, 127, 2, 2, 1107, 1106, 3, 2, 2, 2, 1107, 1108, 3,
-	2, 2, 2, 1108, 1109, 3, 2, 2, 2, 1109, 1111, 7, 7, 2, 2, 1110, 1112, 7,
-	127, 2, 2, 1111, 1110, 3, 2, 2, 2, 1111, 1112, 3, 2, 2, 2, 1112, 1113,
-	3, 2, 2, 2, 1113, 1177, 7, 9, 2, 2, 1114, 1177, 5, 168, 85, 2, 1115, 1177,
-	5, 170, 86, 2, 1116, 1118, 7, 49, 2, 2, 1117, 1119, 7, 127, 2, 2, 1118,
-	1117, 3, 2, 2, 2, 1118, 1119, 3, 2, 2, 2, 1119, 1120, 3, 2, 2, 2, 1120,
-	1122, 7, 8, 2, 2, 1121, 1123, 7, 127, 2, 2, 1122, 1121, 3, 2, 2, 2, 1122,
-	1123, 3, 2, 2, 2, 1123, 1124, 3, 2, 2, 2, 1124, 1126, 5, 150, 76, 2, 1125,
-	1127, 7, 127, 2, 2, 1126, 1125, 3, 2, 2, 2, 1126, 1127, 3, 2, 2, 2, 1127,
-	1128, 3, 2, 2, 2, 1128, 1129, 7, 9, 2, 2, 1129, 1177, 3, 2, 2, 2, 1130,
-	1132, 7, 86, 2, 2, 1131, 1133, 7, 127, 2, 2, 1132, 1131, 3, 2, 2, 2, 1132,
-	1133, 3, 2, 2, 2, 1133, 1134, 3, 2, 2, 2, 1134, 1136, 7, 8, 2, 2, 1135,
-	1137, 7, 127, 2, 2, 1136, 1135, 3, 2, 2, 2, 1136, 1137, 3, 2, 2, 2, 1137,
-	1138, 3, 2, 2, 2, 1138, 1140, 5, 150, 76, 2, 1139, 1141, 7, 127, 2, 2,
-	1140, 1139, 3, 2, 2, 2, 1140, 1141, 3, 2, 2, 2, 1141, 1142, 3, 2, 2, 2,
-	1142, 1143, 7, 9, 2, 2, 1143, 1177, 3, 2, 2, 2, 1144, 1146, 7, 87, 2, 2,
-	1145, 1147, 7, 127, 2, 2, 1146, 1145, 3, 2, 2, 2, 1146, 1147, 3, 2, 2,
-	2, 1147, 1148, 3, 2, 2, 2, 1148, 1150, 7, 8, 2, 2, 1149, 1151, 7, 127,
-	2, 2, 1150, 1149, 3, 2, 2, 2, 1150, 1151, 3, 2, 2, 2, 1151, 1152, 3, 2,
-	2, 2, 1152, 1154, 5, 150, 76, 2, 1153, 1155, 7, 127, 2, 2, 1154, 1153,
-	3, 2, 2, 2, 1154, 1155, 3, 2, 2, 2, 1155, 1156, 3, 2, 2, 2, 1156, 1157,
-	7, 9, 2, 2, 1157, 1177, 3, 2, 2, 2, 1158, 1160, 7, 88, 2, 2, 1159, 1161,
-	7, 127, 2, 2, 1160, 1159, 3, 2, 2, 2, 1160, 1161, 3, 2, 2, 2, 1161, 1162,
-	3, 2, 2, 2, 1162, 1164, 7, 8, 2, 2, 1163, 1165, 7, 127, 2, 2, 1164, 1163,
-	3, 2, 2, 2, 1164, 1165, 3, 2, 2, 2, 1165, 1166, 3, 2, 2, 2, 1166, 1168,
-	5, 150, 76, 2, 1167, 1169, 7, 127, 2, 2, 1168, 1167, 3, 2, 2, 2, 1168,
-	1169, 3, 2, 2, 2, 1169, 1170, 3, 2, 2, 2, 1170, 1171, 7, 9, 2, 2, 1171,
-	1177, 3, 2, 2, 2, 1172, 1177, 5, 148, 75, 2, 1173, 1177, 5, 146, 74, 2,
-	1174, 1177, 5, 154, 78, 2, 1175, 1177, 5, 178, 90, 2, 1176, 1098, 3, 2,
-	2, 2, 1176, 1099, 3, 2, 2, 2, 1176, 1100, 3, 2, 2, 2, 1176, 1101, 3, 2,
-	2, 2, 1176, 1114, 3, 2, 2, 2, 1176, 1115, 3, 2, 2, 2, 1176, 1116, 3, 2,
-	2, 2, 1176, 1130, 3, 2, 2, 2, 1176, 1144, 3, 2, 2, 2, 1176, 1158, 3, 2,
-	2, 2, 1176, 1172, 3, 2, 2, 2, 1176, 1173, 3, 2, 2, 2, 1176, 1174, 3, 2,
-	2, 2, 1176, 1175, 3, 2, 2, 2, 1177, 137, 3, 2, 2, 2, 1178, 1185, 5, 180,
-	91, 2, 1179, 1185, 7, 97, 2, 2, 1180, 1185, 5, 140, 71, 2, 1181, 1185,
-	7, 84, 2, 2, 1182, 1185, 5, 182, 92, 2, 1183, 1185, 5, 142, 72, 2, 1184,
-	1178, 3, 2, 2, 2, 1184, 1179, 3, 2, 2, 2, 1184, 1180, 3, 2, 2, 2, 1184,
-	1181, 3, 2, 2, 2, 1184, 1182, 3, 2, 2, 2, 1184, 1183, 3, 2, 2, 2, 1185,
-	139, 3, 2, 2, 2, 1186, 1187, 9, 4, 2, 2, 1187, 141, 3, 2, 2, 2, 1188, 1190,
-	7, 10, 2, 2, 1189, 1191, 7, 127, 2, 2, 1190, 1189, 3, 2, 2, 2, 1190, 1191,
-	3, 2, 2, 2, 1191, 1209, 3, 2, 2, 2, 1192, 1194, 5, 106, 54, 2, 1193, 1195,
-	7, 127, 2, 2, 1194, 1193, 3, 2, 2, 2, 1194, 1195, 3, 2, 2, 2, 1195, 1206,
-	3, 2, 2, 2, 1196, 1198, 7, 4, 2, 2, 1197, 1199, 7, 127, 2, 2, 1198, 1197,
-	3, 2, 2, 2, 1198, 1199, 3, 2, 2, 2, 1199, 1200, 3, 2, 2, 2, 1200, 1202,
-	5, 106, 54, 2, 1201, 1203, 7, 127, 2, 2, 1202, 1201, 3, 2, 2, 2, 1202,
-	1203, 3, 2, 2, 2, 1203, 1205, 3, 2, 2, 2, 1204, 1196, 3, 2, 2, 2, 1205,
-	1208, 3, 2, 2, 2, 1206, 1204, 3, 2, 2, 2, 1206, 1207, 3, 2, 2, 2, 1207,
-	1210, 3, 2, 2, 2, 1208, 1206, 3, 2, 2, 2, 1209, 1192, 3, 2, 2, 2, 1209,
-	1210, 3, 2, 2, 2, 1210, 1211, 3, 2, 2, 2, 1211, 1212, 7, 11, 2, 2, 1212,
-	143, 3, 2, 2, 2, 1213, 1215, 7, 5, 2, 2, 1214, 1216, 7, 127, 2, 2, 1215,
-	1214, 3, 2, 2, 2, 1215, 1216, 3, 2, 2, 2, 1216, 1217, 3, 2, 2, 2, 1217,
-	1244, 5, 118, 60, 2, 1218, 1220, 7, 20, 2, 2, 1219, 1221, 7, 127, 2, 2,
-	1220, 1219, 3, 2, 2, 2, 1220, 1221, 3, 2, 2, 2, 1221, 1222, 3, 2, 2, 2,
-	1222, 1244, 5, 118, 60, 2, 1223, 1225, 7, 21, 2, 2, 1224, 1226, 7, 127,
-	2, 2, 1225, 1224, 3, 2, 2, 2, 1225, 1226, 3, 2, 2, 2, 1226, 1227, 3, 2,
-	2, 2, 1227, 1244, 5, 118, 60, 2, 1228, 1230, 7, 22, 2, 2, 1229, 1231, 7,
-	127, 2, 2, 1230, 1229, 3, 2, 2, 2, 1230, 1231, 3, 2, 2, 2, 1231, 1232,
-	3, 2, 2, 2, 1232, 1244, 5, 118, 60, 2, 1233, 1235, 7, 23, 2, 2, 1234, 1236,
-	7, 127, 2, 2, 1235, 1234, 3, 2, 2, 2, 1235, 1236, 3, 2, 2, 2, 1236, 1237,
-	3, 2, 2, 2, 1237, 1244, 5, 118, 60, 2, 1238, 1240, 7, 24, 2, 2, 1239, 1241,
-	7, 127, 2, 2, 1240, 1239, 3, 2, 2, 2, 1240, 1241, 3, 2, 2, 2, 1241, 1242,
-	3, 2, 2, 2, 1242, 1244, 5, 118, 60, 2, 1243, 1213, 3, 2, 2, 2, 1243, 1218,
-	3, 2, 2, 2, 1243, 1223, 3, 2, 2, 2, 1243, 1228, 3, 2, 2, 2, 1243, 1233,
-	3, 2, 2, 2, 1243, 1238, 3, 2, 2, 2, 1244, 145, 3, 2, 2, 2, 1245, 1247,
-	7, 8, 2, 2, 1246, 1248, 7, 127, 2, 2, 1247, 1246, 3, 2, 2, 2, 1247, 1248,
-	3, 2, 2, 2, 1248, 1249, 3, 2, 2, 2, 1249, 1251, 5, 106, 54, 2, 1250, 1252,
-	7, 127, 2, 2, 1251, 1250, 3, 2, 2, 2, 1251, 1252, 3, 2, 2, 2, 1252, 1253,
-	3, 2, 2, 2, 1253, 1254, 7, 9, 2, 2, 1254, 147, 3, 2, 2, 2, 1255, 1260,
-	5, 80, 41, 2, 1256, 1258, 7, 127, 2, 2, 1257, 1256, 3, 2, 2, 2, 1257, 1258,
-	3, 2, 2, 2, 1258, 1259, 3, 2, 2, 2, 1259, 1261, 5, 82, 42, 2, 1260, 1257,
-	3, 2, 2, 2, 1261, 1262, 3, 2, 2, 2, 1262, 1260, 3, 2, 2, 2, 1262, 1263,
-	3, 2, 2, 2, 1263, 149, 3, 2, 2, 2, 1264, 1269, 5, 152, 77, 2, 1265, 1267,
-	7, 127, 2, 2, 1266, 1265, 3, 2, 2, 2, 1266, 1267, 3, 2, 2, 2, 1267, 1268,
-	3, 2, 2, 2, 1268, 1270, 5, 70, 36, 2, 1269, 1266, 3, 2, 2, 2, 1269, 1270,
-	3, 2, 2, 2, 1270, 151, 3, 2, 2, 2, 1271, 1272, 5, 178, 90, 2, 1272, 1273,
-	7, 127, 2, 2, 1273, 1274, 7, 79, 2, 2, 1274, 1275, 7, 127, 2, 2, 1275,
-	1276, 5, 106, 54, 2, 1276, 153, 3, 2, 2, 2, 1277, 1279, 5, 156, 79, 2,
-	1278, 1280, 7, 127, 2, 2, 1279, 1278, 3, 2, 2, 2, 1279, 1280, 3, 2, 2,
-	2, 1280, 1281, 3, 2, 2, 2, 1281, 1283, 7, 8, 2, 2, 1282, 1284, 7, 127,
-	2, 2, 1283, 1282, 3, 2, 2, 2, 1283, 1284, 3, 2, 2, 2, 1284, 1289, 3, 2,
-	2, 2, 1285, 1287, 7, 64, 2, 2, 1286, 1288, 7, 127, 2, 2, 1287, 1286, 3,
-	2, 2, 2, 1287, 1288, 3, 2, 2, 2, 1288, 1290, 3, 2, 2, 2, 1289, 1285, 3,
-	2, 2, 2, 1289, 1290, 3, 2, 2, 2, 1290, 1308, 3, 2, 2, 2, 1291, 1293, 5,
-	106, 54, 2, 1292, 1294, 7, 127, 2, 2, 1293, 1292, 3, 2, 2, 2, 1293, 1294,
-	3, 2, 2, 2, 1294, 1305, 3, 2, 2, 2, 1295, 1297, 7, 4, 2, 2, 1296, 1298,
-	7, 127, 2, 2, 1297, 1296, 3, 2, 2, 2, 1297, 1298, 3, 2, 2, 2, 1298, 1299,
-	3, 2, 2, 2, 1299, 1301, 5, 106, 54, 2, 1300, 1302, 7, 127, 2, 2, 1301,
-	1300, 3, 2, 2, 2, 1301, 1302, 3, 2, 2, 2, 1302, 1304, 3, 2, 2, 2, 1303,
-	1295, 3, 2, 2, 2, 1304, 1307, 3, 2, 2, 2, 1305, 1303, 3, 2, 2, 2, 1305,
-	1306, 3, 2, 2, 2, 1306, 1309, 3, 2, 2, 2, 1307, 1305, 3, 2, 2, 2, 1308,
-	1291, 3, 2, 2, 2, 1308, 1309, 3, 2, 2, 2, 1309, 1310, 3, 2, 2, 2, 1310,
-	1311, 7, 9, 2, 2, 1311, 155, 3, 2, 2, 2, 1312, 1313, 5, 166, 84, 2, 1313,
-	1314, 5, 198, 100, 2, 1314, 1317, 3, 2, 2, 2, 1315, 1317, 7, 91, 2, 2,
-	1316, 1312, 3, 2, 2, 2, 1316, 1315, 3, 2, 2, 2, 1317, 157, 3, 2, 2, 2,
-	1318, 1320, 5, 164, 83, 2, 1319, 1321, 7, 127, 2, 2, 1320, 1319, 3, 2,
-	2, 2, 1320, 1321, 3, 2, 2, 2, 1321, 1322, 3, 2, 2, 2, 1322, 1324, 7, 8,
-	2, 2, 1323, 1325, 7, 127, 2, 2, 1324, 1323, 3, 2, 2, 2, 1324, 1325, 3,
-	2, 2, 2, 1325, 1343, 3, 2, 2, 2, 1326, 1328, 5, 106, 54, 2, 1327, 1329,
-	7, 127, 2, 2, 1328, 1327, 3, 2, 2, 2, 1328, 1329, 3, 2, 2, 2, 1329, 1340,
-	3, 2, 2, 2, 1330, 1332, 7, 4, 2, 2, 1331, 1333, 7, 127, 2, 2, 1332, 1331,
-	3, 2, 2, 2, 1332, 1333, 3, 2, 2, 2, 1333, 1334, 3, 2, 2, 2, 1334, 1336,
-	5, 106, 54, 2, 1335, 1337, 7, 127, 2, 2, 1336, 1335, 3, 2, 2, 2, 1336,
-	1337, 3, 2, 2, 2, 1337, 1339, 3, 2, 2, 2, 1338, 1330, 3, 2, 2, 2, 1339,
-	1342, 3, 2, 2, 2, 1340, 1338, 3, 2, 2, 2, 1340, 1341, 3, 2, 2, 2, 1341,
-	1344, 3, 2, 2, 2, 1342, 1340, 3, 2, 2, 2, 1343, 1326, 3, 2, 2, 2, 1343,
-	1344, 3, 2, 2, 2, 1344, 1345, 3, 2, 2, 2, 1345, 1346, 7, 9, 2, 2, 1346,
-	159, 3, 2, 2, 2, 1347, 1348, 5, 164, 83, 2, 1348, 161, 3, 2, 2, 2, 1349,
-	1350, 5, 198, 100, 2, 1350, 163, 3, 2, 2, 2, 1351, 1352, 5, 166, 84, 2,
-	1352, 1353, 5, 198, 100, 2, 1353, 165, 3, 2, 2, 2, 1354, 1355, 5, 198,
-	100, 2, 1355, 1356, 7, 25, 2, 2, 1356, 1358, 3, 2, 2, 2, 1357, 1354, 3,
-	2, 2, 2, 1358, 1361, 3, 2, 2, 2, 1359, 1357, 3, 2, 2, 2, 1359, 1360, 3,
-	2, 2, 2, 1360, 167, 3, 2, 2, 2, 1361, 1359, 3, 2, 2, 2, 1362, 1364, 7,
-	10, 2, 2, 1363, 1365, 7, 127, 2, 2, 1364, 1363, 3, 2, 2, 2, 1364, 1365,
-	3, 2, 2, 2, 1365, 1366, 3, 2, 2, 2, 1366, 1375, 5, 150, 76, 2, 1367, 1369,
-	7, 127, 2, 2, 1368, 1367, 3, 2, 2, 2, 1368, 1369, 3, 2, 2, 2, 1369, 1370,
-	3, 2, 2, 2, 1370, 1372, 7, 13, 2, 2, 1371, 1373, 7, 127, 2, 2, 1372, 1371,
-	3, 2, 2, 2, 1372, 1373, 3, 2, 2, 2, 1373, 1374, 3, 2, 2, 2, 1374, 1376,
-	5, 106, 54, 2, 1375, 1368, 3, 2, 2, 2, 1375, 1376, 3, 2, 2, 2, 1376, 1378,
-	3, 2, 2, 2, 1377, 1379, 7, 127, 2, 2, 1378, 1377, 3, 2, 2, 2, 1378, 1379,
-	3, 2, 2, 2, 1379, 1380, 3, 2, 2, 2, 1380, 1381, 7, 11, 2, 2, 1381, 169,
-	3, 2, 2, 2, 1382, 1384, 7, 10, 2, 2, 1383, 1385, 7, 127, 2, 2, 1384, 1383,
-	3, 2, 2, 2, 1384, 1385, 3, 2, 2, 2, 1385, 1394, 3, 2, 2, 2, 1386, 1388,
-	5, 178, 90, 2, 1387, 1389, 7, 127, 2, 2, 1388, 1387, 3, 2, 2, 2, 1388,
-	1389, 3, 2, 2, 2, 1389, 1390, 3, 2, 2, 2, 1390, 1392, 7, 5, 2, 2, 1391,
-	1393, 7, 127, 2, 2, 1392, 1391, 3, 2, 2, 2, 1392, 1393, 3, 2, 2, 2, 1393,
-	1395, 3, 2, 2, 2, 1394, 1386, 3, 2, 2, 2, 1394, 1395, 3, 2, 2, 2, 1395,
-	1396, 3, 2, 2, 2, 1396, 1398, 5, 148, 75, 2, 1397, 1399, 7, 127, 2, 2,
-	1398, 1397, 3, 2, 2, 2, 1398, 1399, 3, 2, 2, 2, 1399, 1408, 3, 2, 2, 2,
-	1400, 1402, 7, 74, 2, 2, 1401, 1403, 7, 127, 2, 2, 1402, 1401, 3, 2, 2,
-	2, 1402, 1403, 3, 2, 2, 2, 1403, 1404, 3, 2, 2, 2, 1404, 1406, 5, 106,
-	54, 2, 1405, 1407, 7, 127, 2, 2, 1406, 1405, 3, 2, 2, 2, 1406, 1407, 3,
-	2, 2, 2, 1407, 1409, 3, 2, 2, 2, 1408, 1400, 3, 2, 2, 2, 1408, 1409, 3,
-	2, 2, 2, 1409, 1410, 3, 2, 2, 2, 1410, 1412, 7, 13, 2, 2, 1411, 1413, 7,
-	127, 2, 2, 1412, 1411, 3, 2, 2, 2, 1412, 1413, 3, 2, 2, 2, 1413, 1414,
-	3, 2, 2, 2, 1414, 1416, 5, 106, 54, 2, 1415, 1417, 7, 127, 2, 2, 1416,
-	1415, 3, 2, 2, 2, 1416, 1417, 3, 2, 2, 2, 1417, 1418, 3, 2, 2, 2, 1418,
-	1419, 7, 11, 2, 2, 1419, 171, 3, 2, 2, 2, 1420, 1422, 7, 25, 2, 2, 1421,
-	1423, 7, 127, 2, 2, 1422, 1421, 3, 2, 2, 2, 1422, 1423, 3, 2, 2, 2, 1423,
-	1424, 3, 2, 2, 2, 1424, 1425, 5, 188, 95, 2, 1425, 173, 3, 2, 2, 2, 1426,
-	1431, 7, 92, 2, 2, 1427, 1429, 7, 127, 2, 2, 1428, 1427, 3, 2, 2, 2, 1428,
-	1429, 3, 2, 2, 2, 1429, 1430, 3, 2, 2, 2, 1430, 1432, 5, 176, 89, 2, 1431,
-	1428, 3, 2, 2, 2, 1432, 1433, 3, 2, 2, 2, 1433, 1431, 3, 2, 2, 2, 1433,
-	1434, 3, 2, 2, 2, 1434, 1449, 3, 2, 2, 2, 1435, 1437, 7, 92, 2, 2, 1436,
-	1438, 7, 127, 2, 2, 1437, 1436, 3, 2, 2, 2, 1437, 1438, 3, 2, 2, 2, 1438,
-	1439, 3, 2, 2, 2, 1439, 1444, 5, 106, 54, 2, 1440, 1442, 7, 127, 2, 2,
-	1441, 1440, 3, 2, 2, 2, 1441, 1442, 3, 2, 2, 2, 1442, 1443, 3, 2, 2, 2,
-	1443, 1445, 5, 176, 89, 2, 1444, 1441, 3, 2, 2, 2, 1445, 1446, 3, 2, 2,
-	2, 1446, 1444, 3, 2, 2, 2, 1446, 1447, 3, 2, 2, 2, 1447, 1449, 3, 2, 2,
-	2, 1448, 1426, 3, 2, 2, 2, 1448, 1435, 3, 2, 2, 2, 1449, 1458, 3, 2, 2,
-	2, 1450, 1452, 7, 127, 2, 2, 1451, 1450, 3, 2, 2, 2, 1451, 1452, 3, 2,
-	2, 2, 1452, 1453, 3, 2, 2, 2, 1453, 1455, 7, 93, 2, 2, 1454, 1456, 7, 127,
-	2, 2, 1455, 1454, 3, 2, 2, 2, 1455, 1456, 3, 2, 2, 2, 1456, 1457, 3, 2,
-	2, 2, 1457, 1459, 5, 106, 54, 2, 1458, 1451, 3, 2, 2, 2, 1458, 1459, 3,
-	2, 2, 2, 1459, 1461, 3, 2, 2, 2, 1460, 1462, 7, 127, 2, 2, 1461, 1460,
-	3, 2, 2, 2, 1461, 1462, 3, 2, 2, 2, 1462, 1463, 3, 2, 2, 2, 1463, 1464,
-	7, 94, 2, 2, 1464, 175, 3, 2, 2, 2, 1465, 1467, 7, 95, 2, 2, 1466, 1468,
-	7, 127, 2, 2, 1467, 1466, 3, 2, 2, 2, 1467, 1468, 3, 2, 2, 2, 1468, 1469,
-	3, 2, 2, 2, 1469, 1471, 5, 106, 54, 2, 1470, 1472, 7, 127, 2, 2, 1471,
-	1470, 3, 2, 2, 2, 1471, 1472, 3, 2, 2, 2, 1472, 1473, 3, 2, 2, 2, 1473,
-	1475, 7, 96, 2, 2, 1474, 1476, 7, 127, 2, 2, 1475, 1474, 3, 2, 2, 2, 1475,
-	1476, 3, 2, 2, 2, 1476, 1477, 3, 2, 2, 2, 1477, 1478, 5, 106, 54, 2, 1478,
-	177, 3, 2, 2, 2, 1479, 1480, 5, 198, 100, 2, 1480, 179, 3, 2, 2, 2, 1481,
-	1484, 5, 192, 97, 2, 1482, 1484, 5, 190, 96, 2, 1483, 1481, 3, 2, 2, 2,
-	1483, 1482, 3, 2, 2, 2, 1484, 181, 3, 2, 2, 2, 1485, 1487, 7, 26, 2, 2,
-	1486, 1488, 7, 127, 2, 2, 1487, 1486, 3, 2, 2, 2, 1487, 1488, 3, 2, 2,
-	2, 1488, 1522, 3, 2, 2, 2, 1489, 1491, 5, 188, 95, 2, 1490, 1492, 7, 127,
-	2, 2, 1491, 1490, 3, 2, 2, 2, 1491, 1492, 3, 2, 2, 2, 1492, 1493, 3, 2,
-	2, 2, 1493, 1495, 7, 12, 2, 2, 1494, 1496, 7, 127, 2, 2, 1495, 1494, 3,
-	2, 2, 2, 1495, 1496, 3, 2, 2, 2, 1496, 1497, 3, 2, 2, 2, 1497, 1499, 5,
-	106, 54, 2, 1498, 1500, 7, 127, 2, 2, 1499, 1498, 3, 2, 2, 2, 1499, 1500,
-	3, 2, 2, 2, 1500, 1519, 3, 2, 2, 2, 1501, 1503, 7, 4, 2, 2, 1502, 1504,
-	7, 127, 2, 2, 1503, 1502, 3, 2, 2, 2, 1503, 1504, 3, 2, 2, 2, 1504, 1505,
-	3, 2, 2, 2, 1505, 1507, 5, 188, 95, 2, 1506, 1508, 7, 127, 2, 2, 1507,
-	1506, 3, 2, 2, 2, 1507, 1508, 3, 2, 2, 2, 1508, 1509, 3, 2, 2, 2, 1509,
-	1511, 7, 12, 2, 2, 1510, 1512, 7, 127, 2, 2, 1511, 1510, 3, 2, 2, 2, 1511,
-	1512, 3, 2, 2, 2, 1512, 1513, 3, 2, 2, 2, 1513, 1515, 5, 106, 54, 2, 1514,
-	1516, 7, 127, 2, 2, 1515, 1514, 3, 2, 2, 2, 1515, 1516, 3, 2, 2, 2, 1516,
-	1518, 3, 2, 2, 2, 1517, 1501, 3, 2, 2, 2, 1518, 1521, 3, 2, 2, 2, 1519,
-	1517, 3, 2, 2, 2, 1519, 1520, 3, 2, 2, 2, 1520, 1523, 3, 2, 2, 2, 1521,
-	1519, 3, 2, 2, 2, 1522, 1489, 3, 2, 2, 2, 1522, 1523, 3, 2, 2, 2, 1523,
-	1524, 3, 2, 2, 2, 1524, 1525, 7, 27, 2, 2, 1525, 183, 3, 2, 2, 2, 1526,
-	1529, 7, 28, 2, 2, 1527, 1530, 5, 198, 100, 2, 1528, 1530, 7, 100, 2, 2,
-	1529, 1527, 3, 2, 2, 2, 1529, 1528, 3, 2, 2, 2, 1530, 185, 3, 2, 2, 2,
-	1531, 1536, 5, 136, 69, 2, 1532, 1534, 7, 127, 2, 2, 1533, 1532, 3, 2,
-	2, 2, 1533, 1534, 3, 2, 2, 2, 1534, 1535, 3, 2, 2, 2, 1535, 1537, 5, 172,
-	87, 2, 1536, 1533, 3, 2, 2, 2, 1537, 1538, 3, 2, 2, 2, 1538, 1536, 3, 2,
-	2, 2, 1538, 1539, 3, 2, 2, 2, 1539, 187, 3, 2, 2, 2, 1540, 1541, 5, 194,
-	98, 2, 1541, 189, 3, 2, 2, 2, 1542, 1543, 9, 5, 2, 2, 1543, 191, 3, 2,
-	2, 2, 1544, 1545, 9, 6, 2, 2, 1545, 193, 3, 2, 2, 2, 1546, 1549, 5, 198,
-	100, 2, 1547, 1549, 5, 196, 99, 2, 1548, 1546, 3, 2, 2, 2, 1548, 1547,
-	3, 2, 2, 2, 1549, 195, 3, 2, 2, 2, 1550, 1551, 9, 7, 2, 2, 1551, 197, 3,
-	2, 2, 2, 1552, 1553, 9, 8, 2, 2, 1553, 199, 3, 2, 2, 2, 1554, 1555, 9,
-	9, 2, 2, 1555, 201, 3, 2, 2, 2, 1556, 1557, 9, 10, 2, 2, 1557, 203, 3,
-	2, 2, 2, 1558, 1559, 9, 11, 2, 2, 1559, 205, 3, 2, 2, 2, 287, 207, 211,
-	214, 217, 225, 229, 234, 241, 246, 249, 253, 257, 261, 267, 271, 276, 281,
-	285, 288, 290, 295, 301, 305, 310, 314, 319, 326, 331, 335, 339, 343, 346,
-	350, 360, 367, 380, 384, 390, 397, 402, 406, 412, 416, 422, 426, 432, 436,
-	440, 444, 448, 452, 457, 464, 468, 473, 480, 486, 491, 497, 503, 508, 512,
-	517, 520, 523, 526, 533, 539, 542, 547, 550, 554, 557, 565, 569, 573, 577,
-	581, 586, 591, 595, 600, 603, 612, 621, 626, 639, 642, 650, 654, 659, 664,
-	668, 673, 679, 684, 691, 695, 699, 701, 705, 707, 711, 713, 719, 725, 729,
-	732, 735, 739, 745, 749, 752, 755, 761, 764, 767, 771, 777, 780, 783, 787,
-	791, 795, 797, 801, 803, 806, 810, 812, 818, 822, 826, 830, 833, 838, 843,
-	848, 853, 859, 863, 865, 869, 873, 875, 877, 896, 906, 916, 921, 925, 932,
-	937, 942, 946, 950, 954, 957, 959, 964, 968, 972, 976, 980, 984, 987, 989,
-	994, 998, 1003, 1008, 1012, 1021, 1023, 1029, 1033, 1040, 1044, 1048, 1051,
-	1063, 1066, 1080, 1084, 1089, 1093, 1096, 1103, 1107, 1111, 1118, 1122,
-	1126, 1132, 1136, 1140, 1146, 1150, 1154, 1160, 1164, 1168, 1176, 1184,
-	1190, 1194, 1198, 1202, 1206, 1209, 1215, 1220, 1225, 1230, 1235, 1240,
-	1243, 1247, 1251, 1257, 1262, 1266, 1269, 1279, 1283, 1287, 1289, 1293,
-	1297, 1301, 1305, 1308, 1316, 1320, 1324, 1328, 1332, 1336, 1340, 1343,
-	1359, 1364, 1368, 1372, 1375, 1378, 1384, 1388, 1392, 1394, 1398, 1402,
-	1406, 1408, 1412, 1416, 1422, 1428, 1433, 1437, 1441, 1446, 1448, 1451,
-	1455, 1458, 1461, 1467, 1471, 1475, 1483, 1487, 1491, 1495, 1499, 1503,
-	1507, 1511, 1515, 1519, 1522, 1529, 1533, 1538, 1548,
+	824, 825, 5, 214, 108, 2, 825, 855, 3, 2, 2, 2, 826, 828, 5, 214, 108,
+	2, 827, 829, 7, 135, 2, 2, 828, 827, 3, 2, 2, 2, 828, 829, 3, 2, 2, 2,
+	829, 831, 3, 2, 2, 2, 830, 832, 5, 90, 46, 2, 831, 830, 3, 2, 2, 2, 831,
+	832, 3, 2, 2, 2, 832, 834, 3, 2, 2, 2, 833, 835, 7, 135, 2, 2, 834, 833,
+	3, 2, 2, 2, 834, 835, 3, 2, 2, 2, 835, 836, 3, 2, 2, 2, 836, 838, 5, 214,
+	108, 2, 837, 839, 7, 135, 2, 2, 838, 837, 3, 2, 2, 2, 838, 839, 3, 2, 2,
+	2, 839, 840, 3, 2, 2, 2, 840, 841, 5, 212, 107, 2, 841, 855, 3, 2, 2, 2,
+	842, 844, 5, 214, 108, 2, 843, 845, 7, 135, 2, 2, 844, 843, 3, 2, 2, 2,
+	844, 845, 3, 2, 2, 2, 845, 847, 3, 2, 2, 2, 846, 848, 5, 90, 46, 2, 847,
+	846, 3, 2, 2, 2, 847, 848, 3, 2, 2, 2, 848, 850, 3, 2, 2, 2, 849, 851,
+	7, 135, 2, 2, 850, 849, 3, 2, 2, 2, 850, 851, 3, 2, 2, 2, 851, 852, 3,
+	2, 2, 2, 852, 853, 5, 214, 108, 2, 853, 855, 3, 2, 2, 2, 854, 790, 3, 2,
+	2, 2, 854, 810, 3, 2, 2, 2, 854, 826, 3, 2, 2, 2, 854, 842, 3, 2, 2, 2,
+	855, 89, 3, 2, 2, 2, 856, 858, 7, 12, 2, 2, 857, 859, 7, 135, 2, 2, 858,
+	857, 3, 2, 2, 2, 858, 859, 3, 2, 2, 2, 859, 864, 3, 2, 2, 2, 860, 862,
+	5, 188, 95, 2, 861, 863, 7, 135, 2, 2, 862, 861, 3, 2, 2, 2, 862, 863,
+	3, 2, 2, 2, 863, 865, 3, 2, 2, 2, 864, 860, 3, 2, 2, 2, 864, 865, 3, 2,
+	2, 2, 865, 870, 3, 2, 2, 2, 866, 868, 5, 94, 48, 2, 867, 869, 7, 135, 2,
+	2, 868, 867, 3, 2, 2, 2, 868, 869, 3, 2, 2, 2, 869, 871, 3, 2, 2, 2, 870,
+	866, 3, 2, 2, 2, 870, 871, 3, 2, 2, 2, 871, 873, 3, 2, 2, 2, 872, 874,
+	5, 100, 51, 2, 873, 872, 3, 2, 2, 2, 873, 874, 3, 2, 2, 2, 874, 879, 3,
+	2, 2, 2, 875, 877, 5, 92, 47, 2, 876, 878, 7, 135, 2, 2, 877, 876, 3, 2,
+	2, 2, 877, 878, 3, 2, 2, 2, 878, 880, 3, 2, 2, 2, 879, 875, 3, 2, 2, 2,
+	879, 880, 3, 2, 2, 2, 880, 881, 3, 2, 2, 2, 881, 882, 7, 13, 2, 2, 882,
+	91, 3, 2, 2, 2, 883, 886, 5, 192, 97, 2, 884, 886, 5, 194, 98, 2, 885,
+	883, 3, 2, 2, 2, 885, 884, 3, 2, 2, 2, 886, 93, 3, 2, 2, 2, 887, 889, 7,
+	14, 2, 2, 888, 890, 7, 135, 2, 2, 889, 888, 3, 2, 2, 2, 889, 890, 3, 2,
+	2, 2, 890, 891, 3, 2, 2, 2, 891, 905, 5, 108, 55, 2, 892, 894, 7, 135,
+	2, 2, 893, 892, 3, 2, 2, 2, 893, 894, 3, 2, 2, 2, 894, 895, 3, 2, 2, 2,
+	895, 897, 7, 15, 2, 2, 896, 898, 7, 14, 2, 2, 897, 896, 3, 2, 2, 2, 897,
+	898, 3, 2, 2, 2, 898, 900, 3, 2, 2, 2, 899, 901, 7, 135, 2, 2, 900, 899,
+	3, 2, 2, 2, 900, 901, 3, 2, 2, 2, 901, 902, 3, 2, 2, 2, 902, 904, 5, 108,
+	55, 2, 903, 893, 3, 2, 2, 2, 904, 907, 3, 2, 2, 2, 905, 903, 3, 2, 2, 2,
+	905, 906, 3, 2, 2, 2, 906, 95, 3, 2, 2, 2, 907, 905, 3, 2, 2, 2, 908, 915,
+	5, 98, 50, 2, 909, 911, 7, 135, 2, 2, 910, 909, 3, 2, 2, 2, 910, 911, 3,
+	2, 2, 2, 911, 912, 3, 2, 2, 2, 912, 914, 5, 98, 50, 2, 913, 910, 3, 2,
+	2, 2, 914, 917, 3, 2, 2, 2, 915, 913, 3, 2, 2, 2, 915, 916, 3, 2, 2, 2,
+	916, 97, 3, 2, 2, 2, 917, 915, 3, 2, 2, 2, 918, 920, 7, 14, 2, 2, 919,
+	921, 7, 135, 2, 2, 920, 919, 3, 2, 2, 2, 920, 921, 3, 2, 2, 2, 921, 922,
+	3, 2, 2, 2, 922, 923, 5, 106, 54, 2, 923, 99, 3, 2, 2, 2, 924, 926, 7,
+	9, 2, 2, 925, 927, 7, 135, 2, 2, 926, 925, 3, 2, 2, 2, 926, 927, 3, 2,
+	2, 2, 927, 932, 3, 2, 2, 2, 928, 930, 5, 102, 52, 2, 929, 931, 7, 135,
+	2, 2, 930, 929, 3, 2, 2, 2, 930, 931, 3, 2, 2, 2, 931, 933, 3, 2, 2, 2,
+	932, 928, 3, 2, 2, 2, 932, 933, 3, 2, 2, 2, 933, 944, 3, 2, 2, 2, 934,
+	936, 7, 16, 2, 2, 935, 937, 7, 135, 2, 2, 936, 935, 3, 2, 2, 2, 936, 937,
+	3, 2, 2, 2, 937, 942, 3, 2, 2, 2, 938, 940, 5, 104, 53, 2, 939, 941, 7,
+	135, 2, 2, 940, 939, 3, 2, 2, 2, 940, 941, 3, 2, 2, 2, 941, 943, 3, 2,
+	2, 2, 942, 938, 3, 2, 2, 2, 942, 943, 3, 2, 2, 2, 943, 945, 3, 2, 2, 2,
+	944, 934, 3, 2, 2, 2, 944, 945, 3, 2, 2, 2, 945, 101, 3, 2, 2, 2, 946,
+	947, 5, 200, 101, 2, 947, 103, 3, 2, 2, 2, 948, 949, 5, 200, 101, 2, 949,
+	105, 3, 2, 2, 2, 950, 951, 5, 204, 103, 2, 951, 107, 3, 2, 2, 2, 952, 953,
+	5, 204, 103, 2, 953, 109, 3, 2, 2, 2, 954, 955, 5, 112, 57, 2, 955, 111,
+	3, 2, 2, 2, 956, 963, 5, 114, 58, 2, 957, 958, 7, 135, 2, 2, 958, 959,
+	7, 83, 2, 2, 959, 960, 7, 135, 2, 2, 960, 962, 5, 114, 58, 2, 961, 957,
+	3, 2, 2, 2, 962, 965, 3, 2, 2, 2, 963, 961, 3, 2, 2, 2, 963, 964, 3, 2,
+	2, 2, 964, 113, 3, 2, 2, 2, 965, 963, 3, 2, 2, 2, 966, 973, 5, 116, 59,
+	2, 967, 968, 7, 135, 2, 2, 968, 969, 7, 84, 2, 2, 969, 970, 7, 135, 2,
+	2, 970, 972, 5, 116, 59, 2, 971, 967, 3, 2, 2, 2, 972, 975, 3, 2, 2, 2,
+	973, 971, 3, 2, 2, 2, 973, 974, 3, 2, 2, 2, 974, 115, 3, 2, 2, 2, 975,
+	973, 3, 2, 2, 2, 976, 983, 5, 118, 60, 2, 977, 978, 7, 135, 2, 2, 978,
+	979, 7, 85, 2, 2, 979, 980, 7, 135, 2, 2, 980, 982, 5, 118, 60, 2, 981,
+	977, 3, 2, 2, 2, 982, 985, 3, 2, 2, 2, 983, 981, 3, 2, 2, 2, 983, 984,
+	3, 2, 2, 2, 984, 117, 3, 2, 2, 2, 985, 983, 3, 2, 2, 2, 986, 988, 7, 86,
+	2, 2, 987, 989, 7, 135, 2, 2, 988, 987, 3, 2, 2, 2, 988, 989, 3, 2, 2,
+	2, 989, 991, 3, 2, 2, 2, 990, 986, 3, 2, 2, 2, 991, 994, 3, 2, 2, 2, 992,
+	990, 3, 2, 2, 2, 992, 993, 3, 2, 2, 2, 993, 995, 3, 2, 2, 2, 994, 992,
+	3, 2, 2, 2, 995, 996, 5, 120, 61, 2, 996, 119, 3, 2, 2, 2, 997, 1004, 5,
+	122, 62, 2, 998, 1000, 7, 135, 2, 2, 999, 998, 3, 2, 2, 2, 999, 1000, 3,
+	2, 2, 2, 1000, 1001, 3, 2, 2, 2, 1001, 1003, 5, 154, 78, 2, 1002, 999,
+	3, 2, 2, 2, 1003, 1006, 3, 2, 2, 2, 1004, 1002, 3, 2, 2, 2, 1004, 1005,
+	3, 2, 2, 2, 1005, 121, 3, 2, 2, 2, 1006, 1004, 3, 2, 2, 2, 1007, 1026,
+	5, 124, 63, 2, 1008, 1010, 7, 135, 2, 2, 1009, 1008, 3, 2, 2, 2, 1009,
+	1010, 3, 2, 2, 2, 1010, 1011, 3, 2, 2, 2, 1011, 1013, 7, 17, 2, 2, 1012,
+	1014, 7, 135, 2, 2, 1013, 1012, 3, 2, 2, 2, 1013, 1014, 3, 2, 2, 2, 1014,
+	1015, 3, 2, 2, 2, 1015, 1025, 5, 124, 63, 2, 1016, 1018, 7, 135, 2, 2,
+	1017, 1016, 3, 2, 2, 2, 1017, 1018, 3, 2, 2, 2, 1018, 1019, 3, 2, 2, 2,
+	1019, 1021, 7, 18, 2, 2, 1020, 1022, 7, 135, 2, 2, 1021, 1020, 3, 2, 2,
+	2, 1021, 1022, 3, 2, 2, 2, 1022, 1023, 3, 2, 2, 2, 1023, 1025, 5, 124,
+	63, 2, 1024, 1009, 3, 2, 2, 2, 1024, 1017, 3, 2, 2, 2, 1025, 1028, 3, 2,
+	2, 2, 1026, 1024, 3, 2, 2, 2, 1026, 1027, 3, 2, 2, 2, 1027, 123, 3, 2,
+	2, 2, 1028, 1026, 3, 2, 2, 2, 1029, 1056, 5, 126, 64, 2, 1030, 1032, 7,
+	135, 2, 2, 1031, 1030, 3, 2, 2, 2, 1031, 1032, 3, 2, 2, 2, 1032, 1033,
+	3, 2, 2, 2, 1033, 1035, 7, 9, 2, 2, 1034, 1036, 7, 135, 2, 2, 1035, 1034,
+	3, 2, 2, 2, 1035, 1036, 3, 2, 2, 2, 1036, 1037, 3, 2, 2, 2, 1037, 1055,
+	5, 126, 64, 2, 1038, 1040, 7, 135, 2, 2, 1039, 1038, 3, 2, 2, 2, 1039,
+	1040, 3, 2, 2, 2, 1040, 1041, 3, 2, 2, 2, 1041, 1043, 7, 19, 2, 2, 1042,
+	1044, 7, 135, 2, 2, 1043, 1042, 3, 2, 2, 2, 1043, 1044, 3, 2, 2, 2, 1044,
+	1045, 3, 2, 2, 2, 1045, 1055, 5, 126, 64, 2, 1046, 1048, 7, 135, 2, 2,
+	1047, 1046, 3, 2, 2, 2, 1047, 1048, 3, 2, 2, 2, 1048, 1049, 3, 2, 2, 2,
+	1049, 1051, 7, 20, 2, 2, 1050, 1052, 7, 135, 2, 2, 1051, 1050, 3, 2, 2,
+	2, 1051, 1052, 3, 2, 2, 2, 1052, 1053, 3, 2, 2, 2, 1053, 1055, 5, 126,
+	64, 2, 1054, 1031, 3, 2, 2, 2, 1054, 1039, 3, 2, 2, 2, 1054, 1047, 3, 2,
+	2, 2, 1055, 1058, 3, 2, 2, 2, 1056, 1054, 3, 2, 2, 2, 1056, 1057, 3, 2,
+	2, 2, 1057, 125, 3, 2, 2, 2, 1058, 1056, 3, 2, 2, 2, 1059, 1070, 5, 128,
+	65, 2, 1060, 1062, 7, 135, 2, 2, 1061, 1060, 3, 2, 2, 2, 1061, 1062, 3,
+	2, 2, 2, 1062, 1063, 3, 2, 2, 2, 1063, 1065, 7, 21, 2, 2, 1064, 1066, 7,
+	135, 2, 2, 1065, 1064, 3, 2, 2, 2, 1065, 1066, 3, 2, 2, 2, 1066, 1067,
+	3, 2, 2, 2, 1067, 1069, 5, 128, 65, 2, 1068, 1061, 3, 2, 2, 2, 1069, 1072,
+	3, 2, 2, 2, 1070, 1068, 3, 2, 2, 2, 1070, 1071, 3, 2, 2, 2, 1071, 127,
+	3, 2, 2, 2, 1072, 1070, 3, 2, 2, 2, 1073, 1075, 9, 5, 2, 2, 1074, 1076,
+	7, 135, 2, 2, 1075, 1074, 3, 2, 2, 2, 1075, 1076, 3, 2, 2, 2, 1076, 1078,
+	3, 2, 2, 2, 1077, 1073, 3, 2, 2, 2, 1078, 1081, 3, 2, 2, 2, 1079, 1077,
+	3, 2, 2, 2, 1079, 1080, 3, 2, 2, 2, 1080, 1082, 3, 2, 2, 2, 1081, 1079,
+	3, 2, 2, 2, 1082, 1083, 5, 130, 66, 2, 1083, 129, 3, 2, 2, 2, 1084, 1090,
+	5, 138, 70, 2, 1085, 1089, 5, 134, 68, 2, 1086, 1089, 5, 132, 67, 2, 1087,
+	1089, 5, 136, 69, 2, 1088, 1085, 3, 2, 2, 2, 1088, 1086, 3, 2, 2, 2, 1088,
+	1087, 3, 2, 2, 2, 1089, 1092, 3, 2, 2, 2, 1090, 1088, 3, 2, 2, 2, 1090,
+	1091, 3, 2, 2, 2, 1091, 131, 3, 2, 2, 2, 1092, 1090, 3, 2, 2, 2, 1093,
+	1094, 7, 135, 2, 2, 1094, 1096, 7, 87, 2, 2, 1095, 1097, 7, 135, 2, 2,
+	1096, 1095, 3, 2, 2, 2, 1096, 1097, 3, 2, 2, 2, 1097, 1098, 3, 2, 2, 2,
+	1098, 1119, 5, 138, 70, 2, 1099, 1101, 7, 135, 2, 2, 1100, 1099, 3, 2,
+	2, 2, 1100, 1101, 3, 2, 2, 2, 1101, 1102, 3, 2, 2, 2, 1102, 1103, 7, 12,
+	2, 2, 1103, 1104, 5, 110, 56, 2, 1104, 1105, 7, 13, 2, 2, 1105, 1119, 3,
+	2, 2, 2, 1106, 1108, 7, 135, 2, 2, 1107, 1106, 3, 2, 2, 2, 1107, 1108,
+	3, 2, 2, 2, 1108, 1109, 3, 2, 2, 2, 1109, 1111, 7, 12, 2, 2, 1110, 1112,
+	5, 110, 56, 2, 1111, 1110, 3, 2, 2, 2, 1111, 1112, 3, 2, 2, 2, 1112, 1113,
+	3, 2, 2, 2, 1113, 1115, 7, 16, 2, 2, 1114, 1116, 5, 110, 56, 2, 1115, 1114,
+	3, 2, 2, 2, 1115, 1116, 3, 2, 2, 2, 1116, 1117, 3, 2, 2, 2, 1117, 1119,
+	7, 13, 2, 2, 1118, 1093, 3, 2, 2, 2, 1118, 1100, 3, 2, 2, 2, 1118, 1107,
+	3, 2, 2, 2, 1119, 133, 3, 2, 2, 2, 1120, 1121, 7, 135, 2, 2, 1121, 1122,
+	7, 88, 2, 2, 1122, 1123, 7, 135, 2, 2, 1123, 1131, 7, 71, 2, 2, 1124, 1125,
+	7, 135, 2, 2, 1125, 1126, 7, 89, 2, 2, 1126, 1127, 7, 135, 2, 2, 1127,
+	1131, 7, 71, 2, 2, 1128, 1129, 7, 135, 2, 2, 1129, 1131, 7, 90, 2, 2, 1130,
+	1120, 3, 2, 2, 2, 1130, 1124, 3, 2, 2, 2, 1130, 1128, 3, 2, 2, 2, 1131,
+	1133, 3, 2, 2, 2, 1132, 1134, 7, 135, 2, 2, 1133, 1132, 3, 2, 2, 2, 1133,
+	1134, 3, 2, 2, 2, 1134, 1135, 3, 2, 2, 2, 1135, 1136, 5, 138, 70, 2, 1136,
+	135, 3, 2, 2, 2, 1137, 1138, 7, 135, 2, 2, 1138, 1139, 7, 91, 2, 2, 1139,
+	1140, 7, 135, 2, 2, 1140, 1148, 7, 92, 2, 2, 1141, 1142, 7, 135, 2, 2,
+	1142, 1143, 7, 91, 2, 2, 1143, 1144, 7, 135, 2, 2, 1144, 1145, 7, 86, 2,
+	2, 1145, 1146, 7, 135, 2, 2, 1146, 1148, 7, 92, 2, 2, 1147, 1137, 3, 2,
+	2, 2, 1147, 1141, 3, 2, 2, 2, 1148, 137, 3, 2, 2, 2, 1149, 1156, 5, 140,
+	71, 2, 1150, 1152, 7, 135, 2, 2, 1151, 1150, 3, 2, 2, 2, 1151, 1152, 3,
+	2, 2, 2, 1152, 1153, 3, 2, 2, 2, 1153, 1155, 5, 182, 92, 2, 1154, 1151,
+	3, 2, 2, 2, 1155, 1158, 3, 2, 2, 2, 1156, 1154, 3, 2, 2, 2, 1156, 1157,
+	3, 2, 2, 2, 1157, 1163, 3, 2, 2, 2, 1158, 1156, 3, 2, 2, 2, 1159, 1161,
+	7, 135, 2, 2, 1160, 1159, 3, 2, 2, 2, 1160, 1161, 3, 2, 2, 2, 1161, 1162,
+	3, 2, 2, 2, 1162, 1164, 5, 96, 49, 2, 1163, 1160, 3, 2, 2, 2, 1163, 1164,
+	3, 2, 2, 2, 1164, 139, 3, 2, 2, 2, 1165, 1246, 5, 148, 75, 2, 1166, 1246,
+	5, 194, 98, 2, 1167, 1246, 5, 184, 93, 2, 1168, 1170, 7, 93, 2, 2, 1169,
+	1171, 7, 135, 2, 2, 1170, 1169, 3, 2, 2, 2, 1170, 1171, 3, 2, 2, 2, 1171,
+	1172, 3, 2, 2, 2, 1172, 1174, 7, 10, 2, 2, 1173, 1175, 7, 135, 2, 2, 1174,
+	1173, 3, 2, 2, 2, 1174, 1175, 3, 2, 2, 2, 1175, 1176, 3, 2, 2, 2, 1176,
+	1178, 7, 9, 2, 2, 1177, 1179, 7, 135, 2, 2, 1178, 1177, 3, 2, 2, 2, 1178,
+	1179, 3, 2, 2, 2, 1179, 1180, 3, 2, 2, 2, 1180, 1246, 7, 11, 2, 2, 1181,
+	1246, 5, 142, 72, 2, 1182, 1246, 5, 144, 73, 2, 1183, 1246, 5, 178, 90,
+	2, 1184, 1246, 5, 180, 91, 2, 1185, 1187, 7, 49, 2, 2, 1186, 1188, 7, 135,
+	2, 2, 1187, 1186, 3, 2, 2, 2, 1187, 1188, 3, 2, 2, 2, 1188, 1189, 3, 2,
+	2, 2, 1189, 1191, 7, 10, 2, 2, 1190, 1192, 7, 135, 2, 2, 1191, 1190, 3,
+	2, 2, 2, 1191, 1192, 3, 2, 2, 2, 1192, 1193, 3, 2, 2, 2, 1193, 1195, 5,
+	160, 81, 2, 1194, 1196, 7, 135, 2, 2, 1195, 1194, 3, 2, 2, 2, 1195, 1196,
+	3, 2, 2, 2, 1196, 1197, 3, 2, 2, 2, 1197, 1198, 7, 11, 2, 2, 1198, 1246,
+	3, 2, 2, 2, 1199, 1201, 7, 94, 2, 2, 1200, 1202, 7, 135, 2, 2, 1201, 1200,
+	3, 2, 2, 2, 1201, 1202, 3, 2, 2, 2, 1202, 1203, 3, 2, 2, 2, 1203, 1205,
+	7, 10, 2, 2, 1204, 1206, 7, 135, 2, 2, 1205, 1204, 3, 2, 2, 2, 1205, 1206,
+	3, 2, 2, 2, 1206, 1207, 3, 2, 2, 2, 1207, 1209, 5, 160, 81, 2, 1208, 1210,
+	7, 135, 2, 2, 1209, 1208, 3, 2, 2, 2, 1209, 1210, 3, 2, 2, 2, 1210, 1211,
+	3, 2, 2, 2, 1211, 1212, 7, 11, 2, 2, 1212, 1246, 3, 2, 2, 2, 1213, 1215,
+	7, 95, 2, 2, 1214, 1216, 7, 135, 2, 2, 1215, 1214, 3, 2, 2, 2, 1215, 1216,
+	3, 2, 2, 2, 1216, 1217, 3, 2, 2, 2, 1217, 1219, 7, 10, 2, 2, 1218, 1220,
+	7, 135, 2, 2, 1219, 1218, 3, 2, 2, 2, 1219, 1220, 3, 2, 2, 2, 1220, 1221,
+	3, 2, 2, 2, 1221, 1223, 5, 160, 81, 2, 1222, 1224, 7, 135, 2, 2, 1223,
+	1222, 3, 2, 2, 2, 1223, 1224, 3, 2, 2, 2, 1224, 1225, 3, 2, 2, 2, 1225,
+	1226, 7, 11, 2, 2, 1226, 1246, 3, 2, 2, 2, 1227, 1229, 7, 96, 2, 2, 1228,
+	1230, 7, 135, 2, 2, 1229, 1228, 3, 2, 2, 2, 1229, 1230, 3, 2, 2, 2, 1230,
+	1231, 3, 2, 2, 2, 1231, 1233, 7, 10, 2, 2, 1232, 1234, 7, 135, 2, 2, 1233,
+	1232, 3, 2, 2, 2, 1233, 1234, 3, 2, 2, 2, 1234, 1235, 3, 2, 2, 2, 1235,
+	1237, 5, 160, 81, 2, 1236, 1238, 7, 135, 2, 2, 1237, 1236, 3, 2, 2, 2,
+	1237, 1238, 3, 2, 2, 2, 1238, 1239, 3, 2, 2, 2, 1239, 1240, 7, 11, 2, 2,
+	1240, 1246, 3, 2, 2, 2, 1241, 1246, 5, 158, 80, 2, 1242, 1246, 5, 156,
+	79, 2, 1243, 1246, 5, 164, 83, 2, 1244, 1246, 5, 188, 95, 2, 1245, 1165,
+	3, 2, 2, 2, 1245, 1166, 3, 2, 2, 2, 1245, 1167, 3, 2, 2, 2, 1245, 1168,
+	3, 2, 2, 2, 1245, 1181, 3, 2, 2, 2, 1245, 1182, 3, 2, 2, 2, 1245, 1183,
+	3, 2, 2, 2, 1245, 1184, 3, 2, 2, 2, 1245, 1185, 3, 2, 2, 2, 1245, 1199,
+	3, 2, 2, 2, 1245, 1213, 3, 2, 2, 2, 1245, 1227, 3, 2, 2, 2, 1245, 1241,
+	3, 2, 2, 2, 1245, 1242, 3, 2, 2, 2, 1245, 1243, 3, 2, 2, 2, 1245, 1244,
+	3, 2, 2, 2, 1246, 141, 3, 2, 2, 2, 1247, 1249, 7, 99, 2, 2, 1248, 1250,
+	7, 135, 2, 2, 1249, 1248, 3, 2, 2, 2, 1249, 1250, 3, 2, 2, 2, 1250, 1251,
+	3, 2, 2, 2, 1251, 1253, 7, 7, 2, 2, 1252, 1254, 7, 135, 2, 2, 1253, 1252,
+	3, 2, 2, 2, 1253, 1254, 3, 2, 2, 2, 1254, 1255, 3, 2, 2, 2, 1255, 1257,
+	5, 146, 74, 2, 1256, 1258, 7, 135, 2, 2, 1257, 1256, 3, 2, 2, 2, 1257,
+	1258, 3, 2, 2, 2, 1258, 1259, 3, 2, 2, 2, 1259, 1260, 7, 8, 2, 2, 1260,
+	143, 3, 2, 2, 2, 1261, 1263, 7, 93, 2, 2, 1262, 1264, 7, 135, 2, 2, 1263,
+	1262, 3, 2, 2, 2, 1263, 1264, 3, 2, 2, 2, 1264, 1265, 3, 2, 2, 2, 1265,
+	1267, 7, 7, 2, 2, 1266, 1268, 7, 135, 2, 2, 1267, 1266, 3, 2, 2, 2, 1267,
+	1268, 3, 2, 2, 2, 1268, 1269, 3, 2, 2, 2, 1269, 1271, 5, 146, 74, 2, 1270,
+	1272, 7, 135, 2, 2, 1271, 1270, 3, 2, 2, 2, 1271, 1272, 3, 2, 2, 2, 1272,
+	1273, 3, 2, 2, 2, 1273, 1274, 7, 8, 2, 2, 1274, 145, 3, 2, 2, 2, 1275,
+	1294, 5, 8, 5, 2, 1276, 1283, 5, 22, 12, 2, 1277, 1279, 7, 135, 2, 2, 1278,
+	1277, 3, 2, 2, 2, 1278, 1279, 3, 2, 2, 2, 1279, 1280, 3, 2, 2, 2, 1280,
+	1282, 5, 22, 12, 2, 1281, 1278, 3, 2, 2, 2, 1282, 1285, 3, 2, 2, 2, 1283,
+	1281, 3, 2, 2, 2, 1283, 1284, 3, 2, 2, 2, 1284, 1294, 3, 2, 2, 2, 1285,
+	1283, 3, 2, 2, 2, 1286, 1291, 5, 76, 39, 2, 1287, 1289, 7, 135, 2, 2, 1288,
+	1287, 3, 2, 2, 2, 1288, 1289, 3, 2, 2, 2, 1289, 1290, 3, 2, 2, 2, 1290,
+	1292, 5, 74, 38, 2, 1291, 1288, 3, 2, 2, 2, 1291, 1292, 3, 2, 2, 2, 1292,
+	1294, 3, 2, 2, 2, 1293, 1275, 3, 2, 2, 2, 1293, 1276, 3, 2, 2, 2, 1293,
+	1286, 3, 2, 2, 2, 1294, 147, 3, 2, 2, 2, 1295, 1302, 5, 190, 96, 2, 1296,
+	1302, 7, 105, 2, 2, 1297, 1302, 5, 150, 76, 2, 1298, 1302, 7, 92, 2, 2,
+	1299, 1302, 5, 192, 97, 2, 1300, 1302, 5, 152, 77, 2, 1301, 1295, 3, 2,
+	2, 2, 1301, 1296, 3, 2, 2, 2, 1301, 1297, 3, 2, 2, 2, 1301, 1298, 3, 2,
+	2, 2, 1301, 1299, 3, 2, 2, 2, 1301, 1300, 3, 2, 2, 2, 1302, 149, 3, 2,
+	2, 2, 1303, 1304, 9, 6, 2, 2, 1304, 151, 3, 2, 2, 2, 1305, 1307, 7, 12,
+	2, 2, 1306, 1308, 7, 135, 2, 2, 1307, 1306, 3, 2, 2, 2, 1307, 1308, 3,
+	2, 2, 2, 1308, 1326, 3, 2, 2, 2, 1309, 1311, 5, 110, 56, 2, 1310, 1312,
+	7, 135, 2, 2, 1311, 1310, 3, 2, 2, 2, 1311, 1312, 3, 2, 2, 2, 1312, 1323,
+	3, 2, 2, 2, 1313, 1315, 7, 4, 2, 2, 1314, 1316, 7, 135, 2, 2, 1315, 1314,
+	3, 2, 2, 2, 1315, 1316, 3, 2, 2, 2, 1316, 1317, 3, 2, 2, 2, 1317, 1319,
+	5, 110, 56, 2, 1318, 1320, 7, 135, 2, 2, 1319, 1318, 3, 2, 2, 2, 1319,
+	1320, 3, 2, 2, 2, 1320, 1322, 3, 2, 2, 2, 1321, 1313, 3, 2, 2, 2, 1322,
+	1325, 3, 2, 2, 2, 1323, 1321, 3, 2, 2, 2, 1323, 1324, 3, 2, 2, 2, 1324,
+	1327, 3, 2, 2, 2, 1325, 1323, 3, 2, 2, 2, 1326, 1309, 3, 2, 2, 2, 1326,
+	1327, 3, 2, 2, 2, 1327, 1328, 3, 2, 2, 2, 1328, 1329, 7, 13, 2, 2, 1329,
+	153, 3, 2, 2, 2, 1330, 1332, 7, 5, 2, 2, 1331, 1333, 7, 135, 2, 2, 1332,
+	1331, 3, 2, 2, 2, 1332, 1333, 3, 2, 2, 2, 1333, 1334, 3, 2, 2, 2, 1334,
+	1361, 5, 122, 62, 2, 1335, 1337, 7, 22, 2, 2, 1336, 1338, 7, 135, 2, 2,
+	1337, 1336, 3, 2, 2, 2, 1337, 1338, 3, 2, 2, 2, 1338, 1339, 3, 2, 2, 2,
+	1339, 1361, 5, 122, 62, 2, 1340, 1342, 7, 23, 2, 2, 1341, 1343, 7, 135,
+	2, 2, 1342, 1341, 3, 2, 2, 2, 1342, 1343, 3, 2, 2, 2, 1343, 1344, 3, 2,
+	2, 2, 1344, 1361, 5, 122, 62, 2, 1345, 1347, 7, 24, 2, 2, 1346, 1348, 7,
+	135, 2, 2, 1347, 1346, 3, 2, 2, 2, 1347, 1348, 3, 2, 2, 2, 1348, 1349,
+	3, 2, 2, 2, 1349, 1361, 5, 122, 62, 2, 1350, 1352, 7, 25, 2, 2, 1351, 1353,
+	7, 135, 2, 2, 1352, 1351, 3, 2, 2, 2, 1352, 1353, 3, 2, 2, 2, 1353, 1354,
+	3, 2, 2, 2, 1354, 1361, 5, 122, 62, 2, 1355, 1357, 7, 26, 2, 2, 1356, 1358,
+	7, 135, 2, 2, 1357, 1356, 3, 2, 2, 2, 1357, 1358, 3, 2, 2, 2, 1358, 1359,
+	3, 2, 2, 2, 1359, 1361, 5, 122, 62, 2, 1360, 1330, 3, 2, 2, 2, 1360, 1335,
+	3, 2, 2, 2, 1360, 1340, 3, 2, 2, 2, 1360, 1345, 3, 2, 2, 2, 1360, 1350,
+	3, 2, 2, 2, 1360, 1355, 3, 2, 2, 2, 1361, 155, 3, 2, 2, 2, 1362, 1364,
+	7, 10, 2, 2, 1363, 1365, 7, 135, 2, 2, 1364, 1363, 3, 2, 2, 2, 1364, 1365,
+	3, 2, 2, 2, 1365, 1366, 3, 2, 2, 2, 1366, 1368, 5, 110, 56, 2, 1367, 1369,
+	7, 135, 2, 2, 1368, 1367, 3, 2, 2, 2, 1368, 1369, 3, 2, 2, 2, 1369, 1370,
+	3, 2, 2, 2, 1370, 1371, 7, 11, 2, 2, 1371, 157, 3, 2, 2, 2, 1372, 1377,
+	5, 84, 43, 2, 1373, 1375, 7, 135, 2, 2, 1374, 1373, 3, 2, 2, 2, 1374, 1375,
+	3, 2, 2, 2, 1375, 1376, 3, 2, 2, 2, 1376, 1378, 5, 86, 44, 2, 1377, 1374,
+	3, 2, 2, 2, 1378, 1379, 3, 2, 2, 2, 1379, 1377, 3, 2, 2, 2, 1379, 1380,
+	3, 2, 2, 2, 1380, 159, 3, 2, 2, 2, 1381, 1386, 5, 162, 82, 2, 1382, 1384,
+	7, 135, 2, 2, 1383, 1382, 3, 2, 2, 2, 1383, 1384, 3, 2, 2, 2, 1384, 1385,
+	3, 2, 2, 2, 1385, 1387, 5, 74, 38, 2, 1386, 1383, 3, 2, 2, 2, 1386, 1387,
+	3, 2, 2, 2, 1387, 161, 3, 2, 2, 2, 1388, 1389, 5, 188, 95, 2, 1389, 1390,
+	7, 135, 2, 2, 1390, 1391, 7, 87, 2, 2, 1391, 1392, 7, 135, 2, 2, 1392,
+	1393, 5, 110, 56, 2, 1393, 163, 3, 2, 2, 2, 1394, 1396, 5, 166, 84, 2,
+	1395, 1397, 7, 135, 2, 2, 1396, 1395, 3, 2, 2, 2, 1396, 1397, 3, 2, 2,
+	2, 1397, 1398, 3, 2, 2, 2, 1398, 1400, 7, 10, 2, 2, 1399, 1401, 7, 135,
+	2, 2, 1400, 1399, 3, 2, 2, 2, 1400, 1401, 3, 2, 2, 2, 1401, 1406, 3, 2,
+	2, 2, 1402, 1404, 7, 72, 2, 2, 1403, 1405, 7, 135, 2, 2, 1404, 1403, 3,
+	2, 2, 2, 1404, 1405, 3, 2, 2, 2, 1405, 1407, 3, 2, 2, 2, 1406, 1402, 3,
+	2, 2, 2, 1406, 1407, 3, 2, 2, 2, 1407, 1425, 3, 2, 2, 2, 1408, 1410, 5,
+	110, 56, 2, 1409, 1411, 7, 135, 2, 2, 1410, 1409, 3, 2, 2, 2, 1410, 1411,
+	3, 2, 2, 2, 1411, 1422, 3, 2, 2, 2, 1412, 1414, 7, 4, 2, 2, 1413, 1415,
+	7, 135, 2, 2, 1414, 1413, 3, 2, 2, 2, 1414, 1415, 3, 2, 2, 2, 1415, 1416,
+	3, 2, 2, 2, 1416, 1418, 5, 110, 56, 2, 1417, 1419, 7, 135, 2, 2, 1418,
+	1417, 3, 2, 2, 2, 1418, 1419, 3, 2, 2, 2, 1419, 1421, 3, 2, 2, 2, 1420,
+	1412, 3, 2, 2, 2, 1421, 1424, 3, 2, 2, 2, 1422, 1420, 3, 2, 2, 2, 1422,
+	1423, 3, 2, 2, 2, 1423, 1426, 3, 2, 2, 2, 1424, 1422, 3, 2, 2, 2, 1425,
+	1408, 3, 2, 2, 2, 1425, 1426, 3, 2, 2, 2, 1426, 1427, 3, 2, 2, 2, 1427,
+	1428, 7, 11, 2, 2, 1428, 165, 3, 2, 2, 2, 1429, 1430, 5, 176, 89, 2, 1430,
+	1431, 5, 208, 105, 2, 1431, 1434, 3, 2, 2, 2, 1432, 1434, 7, 99, 2, 2,
+	1433, 1429, 3, 2, 2, 2, 1433, 1432, 3, 2, 2, 2, 1434, 167, 3, 2, 2, 2,
+	1435, 1437, 5, 174, 88, 2, 1436, 1438, 7, 135, 2, 2, 1437, 1436, 3, 2,
+	2, 2, 1437, 1438, 3, 2, 2, 2, 1438, 1439, 3, 2, 2, 2, 1439, 1441, 7, 10,
+	2, 2, 1440, 1442, 7, 135, 2, 2, 1441, 1440, 3, 2, 2, 2, 1441, 1442, 3,
+	2, 2, 2, 1442, 1460, 3, 2, 2, 2, 1443, 1445, 5, 110, 56, 2, 1444, 1446,
+	7, 135, 2, 2, 1445, 1444, 3, 2, 2, 2, 1445, 1446, 3, 2, 2, 2, 1446, 1457,
+	3, 2, 2, 2, 1447, 1449, 7, 4, 2, 2, 1448, 1450, 7, 135, 2, 2, 1449, 1448,
+	3, 2, 2, 2, 1449, 1450, 3, 2, 2, 2, 1450, 1451, 3, 2, 2, 2, 1451, 1453,
+	5, 110, 56, 2, 1452, 1454, 7, 135, 2, 2, 1453, 1452, 3, 2, 2, 2, 1453,
+	1454, 3, 2, 2, 2, 1454, 1456, 3, 2, 2, 2, 1455, 1447, 3, 2, 2, 2, 1456,
+	1459, 3, 2, 2, 2, 1457, 1455, 3, 2, 2, 2, 1457, 1458, 3, 2, 2, 2, 1458,
+	1461, 3, 2, 2, 2, 1459, 1457, 3, 2, 2, 2, 1460, 1443, 3, 2, 2, 2, 1460,
+	1461, 3, 2, 2, 2, 1461, 1462, 3, 2, 2, 2, 1462, 1463, 7, 11, 2, 2, 1463,
+	169, 3, 2, 2, 2, 1464, 1465, 5, 174, 88, 2, 1465, 171, 3, 2, 2, 2, 1466,
+	1467, 5, 208, 105, 2, 1467, 173, 3, 2, 2, 2, 1468, 1469, 5, 176, 89, 2,
+	1469, 1470, 5, 208, 105, 2, 1470, 175, 3, 2, 2, 2, 1471, 1472, 5, 208,
+	105, 2, 1472, 1473, 7, 27, 2, 2, 1473, 1475, 3, 2, 2, 2, 1474, 1471, 3,
+	2, 2, 2, 1475, 1478, 3, 2, 2, 2, 1476, 1474, 3, 2, 2, 2, 1476, 1477, 3,
+	2, 2, 2, 1477, 177, 3, 2, 2, 2, 1478, 1476, 3, 2, 2, 2, 1479, 1481, 7,
+	12, 2, 2, 1480, 1482, 7, 135, 2, 2, 1481, 1480, 3, 2, 2, 2, 1481, 1482,
+	3, 2, 2, 2, 1482, 1483, 3, 2, 2, 2, 1483, 1492, 5, 160, 81, 2, 1484, 1486,
+	7, 135, 2, 2, 1485, 1484, 3, 2, 2, 2, 1485, 1486, 3, 2, 2, 2, 1486, 1487,
+	3, 2, 2, 2, 1487, 1489, 7, 15, 2, 2, 1488, 1490, 7, 135, 2, 2, 1489, 1488,
+	3, 2, 2, 2, 1489, 1490, 3, 2, 2, 2, 1490, 1491, 3, 2, 2, 2, 1491, 1493,
+	5, 110, 56, 2, 1492, 1485, 3, 2, 2, 2, 1492, 1493, 3, 2, 2, 2, 1493, 1495,
+	3, 2, 2, 2, 1494, 1496, 7, 135, 2, 2, 1495, 1494, 3, 2, 2, 2, 1495, 1496,
+	3, 2, 2, 2, 1496, 1497, 3, 2, 2, 2, 1497, 1498, 7, 13, 2, 2, 1498, 179,
+	3, 2, 2, 2, 1499, 1501, 7, 12, 2, 2, 1500, 1502, 7, 135, 2, 2, 1501, 1500,
+	3, 2, 2, 2, 1501, 1502, 3, 2, 2, 2, 1502, 1511, 3, 2, 2, 2, 1503, 1505,
+	5, 188, 95, 2, 1504, 1506, 7, 135, 2, 2, 1505, 1504, 3, 2, 2, 2, 1505,
+	1506, 3, 2, 2, 2, 1506, 1507, 3, 2, 2, 2, 1507, 1509, 7, 5, 2, 2, 1508,
+	1510, 7, 135, 2, 2, 1509, 1508, 3, 2, 2, 2, 1509, 1510, 3, 2, 2, 2, 1510,
+	1512, 3, 2, 2, 2, 1511, 1503, 3, 2, 2, 2, 1511, 1512, 3, 2, 2, 2, 1512,
+	1513, 3, 2, 2, 2, 1513, 1515, 5, 158, 80, 2, 1514, 1516, 7, 135, 2, 2,
+	1515, 1514, 3, 2, 2, 2, 1515, 1516, 3, 2, 2, 2, 1516, 1525, 3, 2, 2, 2,
+	1517, 1519, 7, 82, 2, 2, 1518, 1520, 7, 135, 2, 2, 1519, 1518, 3, 2, 2,
+	2, 1519, 1520, 3, 2, 2, 2, 1520, 1521, 3, 2, 2, 2, 1521, 1523, 5, 110,
+	56, 2, 1522, 1524, 7, 135, 2, 2, 1523, 1522, 3, 2, 2, 2, 1523, 1524, 3,
+	2, 2, 2, 1524, 1526, 3, 2, 2, 2, 1525, 1517, 3, 2, 2, 2, 1525, 1526, 3,
+	2, 2, 2, 1526, 1527, 3, 2, 2, 2, 1527, 1529, 7, 15, 2, 2, 1528, 1530, 7,
+	135, 2, 2, 1529, 1528, 3, 2, 2, 2, 1529, 1530, 3, 2, 2, 2, 1530, 1531,
+	3, 2, 2, 2, 1531, 1533, 5, 110, 56, 2, 1532, 1534, 7, 135, 2, 2, 1533,
+	1532, 3, 2, 2, 2, 1533, 1534, 3, 2, 2, 2, 1534, 1535, 3, 2, 2, 2, 1535,
+	1536, 7, 13, 2, 2, 1536, 181, 3, 2, 2, 2, 1537, 1539, 7, 27, 2, 2, 1538,
+	1540, 7, 135, 2, 2, 1539, 1538, 3, 2, 2, 2, 1539, 1540, 3, 2, 2, 2, 1540,
+	1541, 3, 2, 2, 2, 1541, 1542, 5, 198, 100, 2, 1542, 183, 3, 2, 2, 2, 1543,
+	1548, 7, 100, 2, 2, 1544, 1546, 7, 135, 2, 2, 1545, 1544, 3, 2, 2, 2, 1545,
+	1546, 3, 2, 2, 2, 1546, 1547, 3, 2, 2, 2, 1547, 1549, 5, 186, 94, 2, 1548,
+	1545, 3, 2, 2, 2, 1549, 1550, 3, 2, 2, 2, 1550, 1548, 3, 2, 2, 2, 1550,
+	1551, 3, 2, 2, 2, 1551, 1566, 3, 2, 2, 2, 1552, 1554, 7, 100, 2, 2, 1553,
+	1555, 7, 135, 2, 2, 1554, 1553, 3, 2, 2, 2, 1554, 1555, 3, 2, 2, 2, 1555,
+	1556, 3, 2, 2, 2, 1556, 1561, 5, 110, 56, 2, 1557, 1559, 7, 135, 2, 2,
+	1558, 1557, 3, 2, 2, 2, 1558, 1559, 3, 2, 2, 2, 1559, 1560, 3, 2, 2, 2,
+	1560, 1562, 5, 186, 94, 2, 1561, 1558, 3, 2, 2, 2, 1562, 1563, 3, 2, 2,
+	2, 1563, 1561, 3, 2, 2, 2, 1563, 1564, 3, 2, 2, 2, 1564, 1566, 3, 2, 2,
+	2, 1565, 1543, 3, 2, 2, 2, 1565, 1552, 3, 2, 2, 2, 1566, 1575, 3, 2, 2,
+	2, 1567, 1569, 7, 135, 2, 2, 1568, 1567, 3, 2, 2, 2, 1568, 1569, 3, 2,
+	2, 2, 1569, 1570, 3, 2, 2, 2, 1570, 1572, 7, 101, 2, 2, 1571, 1573, 7,
+	135, 2, 2, 1572, 1571, 3, 2, 2, 2, 1572, 1573, 3, 2, 2, 2, 1573, 1574,
+	3, 2, 2, 2, 1574, 1576, 5, 110, 56, 2, 1575, 1568, 3, 2, 2, 2, 1575, 1576,
+	3, 2, 2, 2, 1576, 1578, 3, 2, 2, 2, 1577, 1579, 7, 135, 2, 2, 1578, 1577,
+	3, 2, 2, 2, 1578, 1579, 3, 2, 2, 2, 1579, 1580, 3, 2, 2, 2, 1580, 1581,
+	7, 102, 2, 2, 1581, 185, 3, 2, 2, 2, 1582, 1584, 7, 103, 2, 2, 1583, 1585,
+	7, 135, 2, 2, 1584, 1583, 3, 2, 2, 2, 1584, 1585, 3, 2, 2, 2, 1585, 1586,
+	3, 2, 2, 2, 1586, 1588, 5, 110, 56, 2, 1587, 1589, 7, 135, 2, 2, 1588,
+	1587, 3, 2, 2, 2, 1588, 1589, 3, 2, 2, 2, 1589, 1590, 3, 2, 2, 2, 1590,
+	1592, 7, 104, 2, 2, 1591, 1593, 7, 135, 2, 2, 1592, 1591, 3, 2, 2, 2, 1592,
+	1593, 3, 2, 2, 2, 1593, 1594, 3, 2, 2, 2, 1594, 1595, 5, 110, 56, 2, 1595,
+	187, 3, 2, 2, 2, 1596, 1597, 5, 208, 105, 2, 1597, 189, 3, 2, 2, 2, 1598,
+	1601, 5, 202, 102, 2, 1599, 1601, 5, 200, 101, 2, 1600, 1598, 3, 2, 2,
+	2, 1600, 1599, 3, 2, 2, 2, 1601, 191, 3, 2, 2, 2, 1602, 1604, 7, 7, 2,
+	2, 1603, 1605, 7, 135, 2, 2, 1604, 1603, 3, 2, 2, 2, 1604, 1605, 3, 2,
+	2, 2, 1605, 1639, 3, 2, 2, 2, 1606, 1608, 5, 198, 100, 2, 1607, 1609, 7,
+	135, 2, 2, 1608, 1607, 3, 2, 2, 2, 1608, 1609, 3, 2, 2, 2, 1609, 1610,
+	3, 2, 2, 2, 1610, 1612, 7, 14, 2, 2, 1611, 1613, 7, 135, 2, 2, 1612, 1611,
+	3, 2, 2, 2, 1612, 1613, 3, 2, 2, 2, 1613, 1614, 3, 2, 2, 2, 1614, 1616,
+	5, 110, 56, 2, 1615, 1617, 7, 135, 2, 2, 1616, 1615, 3, 2, 2, 2, 1616,
+	1617, 3, 2, 2, 2, 1617, 1636, 3, 2, 2, 2, 1618, 1620, 7, 4, 2, 2, 1619,
+	1621, 7, 135, 2, 2, 1620, 1619, 3, 2, 2, 2, 1620, 1621, 3, 2, 2, 2, 1621,
+	1622, 3, 2, 2, 2, 1622, 1624, 5, 198, 100, 2, 1623, 1625, 7, 135, 2, 2,
+	1624, 1623, 3, 2, 2, 2, 1624, 1625, 3, 2, 2, 2, 1625, 1626, 3, 2, 2, 2,
+	1626, 1628, 7, 14, 2, 2, 1627, 1629, 7, 135, 2, 2, 1628, 1627, 3, 2, 2,
+	2, 1628, 1629, 3, 2, 2, 2, 1629, 1630, 3, 2, 2, 2, 1630, 1632, 5, 110,
+	56, 2, 1631, 1633, 7, 135, 2, 2, 1632, 1631, 3, 2, 2, 2, 1632, 1633, 3,
+	2, 2, 2, 1633, 1635, 3, 2, 2, 2, 1634, 1618, 3, 2, 2, 2, 1635, 1638, 3,
+	2, 2, 2, 1636, 1634, 3, 2, 2, 2, 1636, 1637, 3, 2, 2, 2, 1637, 1640, 3,
+	2, 2, 2, 1638, 1636, 3, 2, 2, 2, 1639, 1606, 3, 2, 2, 2, 1639, 1640, 3,
+	2, 2, 2, 1640, 1641, 3, 2, 2, 2, 1641, 1642, 7, 8, 2, 2, 1642, 193, 3,
+	2, 2, 2, 1643, 1646, 7, 28, 2, 2, 1644, 1647, 5, 208, 105, 2, 1645, 1647,
+	7, 108, 2, 2, 1646, 1644, 3, 2, 2, 2, 1646, 1645, 3, 2, 2, 2, 1647, 195,
+	3, 2, 2, 2, 1648, 1653, 5, 140, 71, 2, 1649, 1651, 7, 135, 2, 2, 1650,
+	1649, 3, 2, 2, 2, 1650, 1651, 3, 2, 2, 2, 1651, 1652, 3, 2, 2, 2, 1652,
+	1654, 5, 182, 92, 2, 1653, 1650, 3, 2, 2, 2, 1654, 1655, 3, 2, 2, 2, 1655,
+	1653, 3, 2, 2, 2, 1655, 1656, 3, 2, 2, 2, 1656, 197, 3, 2, 2, 2, 1657,
+	1658, 5, 204, 103, 2, 1658, 199, 3, 2, 2, 2, 1659, 1660, 9, 7, 2, 2, 1660,
+	201, 3, 2, 2, 2, 1661, 1662, 9, 8, 2, 2, 1662, 203, 3, 2, 2, 2, 1663, 1666,
+	5, 208, 105, 2, 1664, 1666, 5, 206, 104, 2, 1665, 1663, 3, 2, 2, 2, 1665,
+	1664, 3, 2, 2, 2, 1666, 205, 3, 2, 2, 2, 1667, 1668, 9, 9, 2, 2, 1668,
+	207, 3, 2, 2, 2, 1669, 1670, 9, 10, 2, 2, 1670, 209, 3, 2, 2, 2, 1671,
+	1672, 9, 11, 2, 2, 1672, 211, 3, 2, 2, 2, 1673, 1674, 9, 12, 2, 2, 1674,
+	213, 3, 2, 2, 2, 1675, 1676, 9, 13, 2, 2, 1676, 215, 3, 2, 2, 2, 308, 217,
+	221, 224, 227, 235, 239, 244, 251, 256, 259, 263, 267, 271, 277, 281, 286,
+	291, 295, 298, 302, 306, 310, 315, 321, 325, 330, 334, 339, 346, 352, 356,
+	360, 364, 367, 371, 381, 388, 401, 405, 411, 418, 423, 427, 433, 437, 443,
+	447, 453, 457, 461, 465, 469, 473, 478, 485, 489, 494, 501, 507, 512, 516,
+	520, 524, 528, 531, 540, 550, 558, 564, 570, 575, 579, 584, 587, 590, 593,
+	600, 606, 609, 614, 617, 621, 624, 632, 636, 640, 644, 648, 653, 658, 662,
+	667, 670, 679, 688, 693, 706, 709, 717, 721, 726, 731, 735, 740, 746, 751,
+	758, 762, 766, 768, 772, 774, 778, 780, 786, 792, 796, 799, 802, 806, 812,
+	816, 819, 822, 828, 831, 834, 838, 844, 847, 850, 854, 858, 862, 864, 868,
+	870, 873, 877, 879, 885, 889, 893, 897, 900, 905, 910, 915, 920, 926, 930,
+	932, 936, 940, 942, 944, 963, 973, 983, 988, 992, 999, 1004, 1009, 1013,
+	1017, 1021, 1024, 1026, 1031, 1035, 1039, 1043, 1047, 1051, 1054, 1056,
+	1061, 1065, 1070, 1075, 1079, 1088, 1090, 1096, 1100, 1107, 1111, 1115,
+	1118, 1130, 1133, 1147, 1151, 1156, 1160, 1163, 1170, 1174, 1178, 1187,
+	1191, 1195, 1201, 1205, 1209, 1215, 1219, 1223, 1229, 1233, 1237, 1245,
+	1249, 1253, 1257, 1263, 1267, 1271, 1278, 1283, 1288, 1291, 1293, 1301,
+	1307, 1311, 1315, 1319, 1323, 1326, 1332, 1337, 1342, 1347, 1352, 1357,
+	1360, 1364, 1368, 1374, 1379, 1383, 1386, 1396, 1400, 1404, 1406, 1410,
+	1414, 1418, 1422, 1425, 1433, 1437, 1441, 1445, 1449, 1453, 1457, 1460,
+	1476, 1481, 1485, 1489, 1492, 1495, 1501, 1505, 1509, 1511, 1515, 1519,
+	1523, 1525, 1529, 1533, 1539, 1545, 1550, 1554, 1558, 1563, 1565, 1568,
+	1572, 1575, 1578, 1584, 1588, 1592, 1600, 1604, 1608, 1612, 1616, 1620,
+	1624, 1628, 1632, 1636, 1639, 1646, 1650, 1655, 1665,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 
 var literalNames = []string{
-	"", "';'", "','", "'='", "'+='", "'*'", "'('", "')'", "'['", "']'", "':'",
-	"'|'", "'..'", "'+'", "'-'", "'/'", "'%'", "'^'", "'<>'", "'<'", "'>'",
-	"'<='", "'>='", "'.'", "'{'", "'}'", "'$'", "'\u27E8'", "'\u3008'", "'\uFE64'",
+	"", "';'", "','", "'='", "'+='", "'{'", "'}'", "'*'", "'('", "')'", "'['",
+	"']'", "':'", "'|'", "'..'", "'+'", "'-'", "'/'", "'%'", "'^'", "'<>'",
+	"'<'", "'>'", "'<='", "'>='", "'.'", "'$'", "'\u27E8'", "'\u3008'", "'\uFE64'",
 	"'\uFF1C'", "'\u27E9'", "'\u3009'", "'\uFE65'", "'\uFF1E'", "'\u00AD'",
 	"'\u2010'", "'\u2011'", "'\u2012'", "'\u2013'", "'\u2014'", "'\u2015'",
 	"'\u2212'", "'\uFE58'", "'\uFE63'", "'\uFF0D'", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"'0'",
+	"", "", "", "", "", "", "", "", "'0'",
 }
 var symbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "UNION", "ALL", "OPTIONAL", "MATCH",
 	"UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE", "REMOVE",
-	"CALL", "YIELD", "WITH", "DISTINCT", "RETURN", "ORDER", "BY", "L_SKIP",
-	"LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR", "XOR",
-	"AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL", "COUNT",
-	"ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE", "END",
-	"WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
+	"CALL", "YIELD", "CONCURRENT", "TRANSACTIONS", "ROW", "ROWS", "ERROR",
+	"CONTINUE", "BREAK", "FAIL", "WITH", "DISTINCT", "RETURN", "ORDER", "BY",
+	"L_SKIP", "LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR",
+	"XOR", "AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL",
+	"COUNT", "ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE",
+	"END", "WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
 	"OctalInteger", "HexLetter", "HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit",
 	"OctDigit", "ZeroDigit", "ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT",
 	"DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP",
@@ -836,24 +901,25 @@ var ruleNames = []string{
 	"singlePartQuery", "multiPartQuery", "multiPartQueryPartial", "updatingClause",
 	"readingClause", "matchClause", "unwindClause", "mergeClause", "mergeAction",
 	"createClause", "setClause", "setItem", "deleteClause", "removeClause",
-	"removeItem", "inQueryCall", "standaloneCall", "yieldItems", "yieldItem",
-	"withClause", "returnClause", "returnBody", "returnItems", "returnItem",
-	"orderClause", "skipClause", "limitClause", "sortItem", "whereClause",
-	"pattern", "patternPart", "anonymousPatternPart", "patternElement", "nodePattern",
-	"patternElementChain", "relationshipPattern", "relationshipDetail", "properties",
-	"relationshipTypes", "nodeLabels", "nodeLabel", "rangeLiteral", "minHops",
-	"maxHops", "labelName", "relTypeName", "expr", "orExpr", "xorExpr", "andExpr",
-	"notExpr", "comparisonExpr", "addOrSubtractExpr", "multiplyDivideModuloExpr",
-	"powerOfExpr", "unaryAddOrSubtractExpr", "stringListNullOperatorExpr",
+	"removeItem", "inQueryCall", "subqueryClause", "inTransactions", "standaloneCall",
+	"yieldItems", "yieldItem", "withClause", "returnClause", "returnBody",
+	"returnItems", "returnItem", "orderClause", "skipClause", "limitClause",
+	"sortItem", "whereClause", "pattern", "patternPart", "anonymousPatternPart",
+	"patternElement", "nodePattern", "patternElementChain", "relationshipPattern",
+	"relationshipDetail", "properties", "relationshipTypes", "nodeLabels",
+	"nodeLabel", "rangeLiteral", "minHops", "maxHops", "labelName", "relTypeName",
+	"expr", "orExpr", "xorExpr", "andExpr", "notExpr", "comparisonExpr", "addOrSubtractExpr",
+	"multiplyDivideModuloExpr", "powerOfExpr", "unaryAddOrSubtractExpr", "stringListNullOperatorExpr",
 	"listOperatorExpr", "stringOperatorExpr", "nullOperatorExpr", "propertyOrLabelsExpr",
-	"atom", "literal", "booleanLiteral", "listLiteral", "partialComparisonExpr",
-	"parenthesizedExpr", "relationshipsPattern", "filterExpr", "idInColl",
-	"functionInvocation", "functionName", "explicitProcedureInvocation", "implicitProcedureInvocation",
-	"procedureResultField", "procedureName", "namespace", "listComprehension",
-	"patternComprehension", "propertyLookup", "caseExpr", "caseAlternatives",
-	"variable", "numberLiteral", "mapLiteral", "parameter", "propertyExpr",
-	"propertyKeyName", "integerLiteral", "doubleLiteral", "schemaName", "reservedWord",
-	"symbolicName", "leftArrowHead", "rightArrowHead", "dash",
+	"atom", "existsSubquery", "countSubquery", "subqueryBody", "literal", "booleanLiteral",
+	"listLiteral", "partialComparisonExpr", "parenthesizedExpr", "relationshipsPattern",
+	"filterExpr", "idInColl", "functionInvocation", "functionName", "explicitProcedureInvocation",
+	"implicitProcedureInvocation", "procedureResultField", "procedureName",
+	"namespace", "listComprehension", "patternComprehension", "propertyLookup",
+	"caseExpr", "caseAlternatives", "variable", "numberLiteral", "mapLiteral",
+	"parameter", "propertyExpr", "propertyKeyName", "integerLiteral", "doubleLiteral",
+	"schemaName", "reservedWord", "symbolicName", "leftArrowHead", "rightArrowHead",
+	"dash",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -944,73 +1010,81 @@ const (
 	CypherParserREMOVE                = 58
 	CypherParserCALL                  = 59
 	CypherParserYIELD                 = 60
-	CypherParserWITH                  = 61
-	CypherParserDISTINCT              = 62
-	CypherParserRETURN                = 63
-	CypherParserORDER                 = 64
-	CypherParserBY                    = 65
-	CypherParserL_SKIP                = 66
-	CypherParserLIMIT                 = 67
-	CypherParserASCENDING             = 68
-	CypherParserASC                   = 69
-	CypherParserDESCENDING            = 70
-	CypherParserDESC                  = 71
-	CypherParserWHERE                 = 72
-	CypherParserOR                    = 73
-	CypherParserXOR                   = 74
-	CypherParserAND                   = 75
-	CypherParserNOT                   = 76
-	CypherParserIN                    = 77
-	CypherParserSTARTS                = 78
-	CypherParserENDS                  = 79
-	CypherParserCONTAINS              = 80
-	CypherParserIS                    = 81
-	CypherParserNULL                  = 82
-	CypherParserCOUNT                 = 83
-	CypherParserANY                   = 84
-	CypherParserNONE                  = 85
-	CypherParserSINGLE                = 86
-	CypherParserTRUE                  = 87
-	CypherParserFALSE                 = 88
-	CypherParserEXISTS                = 89
-	CypherParserCASE                  = 90
-	CypherParserELSE                  = 91
-	CypherParserEND                   = 92
-	CypherParserWHEN                  = 93
-	CypherParserTHEN                  = 94
-	CypherParserStringLiteral         = 95
-	CypherParserEscapedChar           = 96
-	CypherParserHexInteger            = 97
-	CypherParserDecimalInteger        = 98
-	CypherParserOctalInteger          = 99
-	CypherParserHexLetter             = 100
-	CypherParserHexDigit              = 101
-	CypherParserDigit                 = 102
-	CypherParserNonZeroDigit          = 103
-	CypherParserNonZeroOctDigit       = 104
-	CypherParserOctDigit              = 105
-	CypherParserZeroDigit             = 106
-	CypherParserExponentDecimalReal   = 107
-	CypherParserRegularDecimalReal    = 108
-	CypherParserCONSTRAINT            = 109
-	CypherParserDO                    = 110
-	CypherParserFOR                   = 111
-	CypherParserREQUIRE               = 112
-	CypherParserUNIQUE                = 113
-	CypherParserMANDATORY             = 114
-	CypherParserSCALAR                = 115
-	CypherParserOF                    = 116
-	CypherParserADD                   = 117
-	CypherParserDROP                  = 118
-	CypherParserFILTER                = 119
-	CypherParserEXTRACT               = 120
-	CypherParserUnescapedSymbolicName = 121
-	CypherParserIdentifierStart       = 122
-	CypherParserIdentifierPart        = 123
-	CypherParserEscapedSymbolicName   = 124
-	CypherParserSP                    = 125
-	CypherParserWHITESPACE            = 126
-	CypherParserComment               = 127
+	CypherParserCONCURRENT            = 61
+	CypherParserTRANSACTIONS          = 62
+	CypherParserROW                   = 63
+	CypherParserROWS                  = 64
+	CypherParserERROR                 = 65
+	CypherParserCONTINUE              = 66
+	CypherParserBREAK                 = 67
+	CypherParserFAIL                  = 68
+	CypherParserWITH                  = 69
+	CypherParserDISTINCT              = 70
+	CypherParserRETURN                = 71
+	CypherParserORDER                 = 72
+	CypherParserBY                    = 73
+	CypherParserL_SKIP                = 74
+	CypherParserLIMIT                 = 75
+	CypherParserASCENDING             = 76
+	CypherParserASC                   = 77
+	CypherParserDESCENDING            = 78
+	CypherParserDESC                  = 79
+	CypherParserWHERE                 = 80
+	CypherParserOR                    = 81
+	CypherParserXOR                   = 82
+	CypherParserAND                   = 83
+	CypherParserNOT                   = 84
+	CypherParserIN                    = 85
+	CypherParserSTARTS                = 86
+	CypherParserENDS                  = 87
+	CypherParserCONTAINS              = 88
+	CypherParserIS                    = 89
+	CypherParserNULL                  = 90
+	CypherParserCOUNT                 = 91
+	CypherParserANY                   = 92
+	CypherParserNONE                  = 93
+	CypherParserSINGLE                = 94
+	CypherParserTRUE                  = 95
+	CypherParserFALSE                 = 96
+	CypherParserEXISTS                = 97
+	CypherParserCASE                  = 98
+	CypherParserELSE                  = 99
+	CypherParserEND                   = 100
+	CypherParserWHEN                  = 101
+	CypherParserTHEN                  = 102
+	CypherParserStringLiteral         = 103
+	CypherParserEscapedChar           = 104
+	CypherParserHexInteger            = 105
+	CypherParserDecimalInteger        = 106
+	CypherParserOctalInteger          = 107
+	CypherParserHexLetter             = 108
+	CypherParserHexDigit              = 109
+	CypherParserDigit                 = 110
+	CypherParserNonZeroDigit          = 111
+	CypherParserNonZeroOctDigit       = 112
+	CypherParserOctDigit              = 113
+	CypherParserZeroDigit             = 114
+	CypherParserExponentDecimalReal   = 115
+	CypherParserRegularDecimalReal    = 116
+	CypherParserCONSTRAINT            = 117
+	CypherParserDO                    = 118
+	CypherParserFOR                   = 119
+	CypherParserREQUIRE               = 120
+	CypherParserUNIQUE                = 121
+	CypherParserMANDATORY             = 122
+	CypherParserSCALAR                = 123
+	CypherParserOF                    = 124
+	CypherParserADD                   = 125
+	CypherParserDROP                  = 126
+	CypherParserFILTER                = 127
+	CypherParserEXTRACT               = 128
+	CypherParserUnescapedSymbolicName = 129
+	CypherParserIdentifierStart       = 130
+	CypherParserIdentifierPart        = 131
+	CypherParserEscapedSymbolicName   = 132
+	CypherParserSP                    = 133
+	CypherParserWHITESPACE            = 134
+	CypherParserComment               = 135
 )
 
 // CypherParser rules.
@@ -1037,86 +1111,91 @@ const (
 	CypherParserRULE_removeClause                = 19
 	CypherParserRULE_removeItem                  = 20
 	CypherParserRULE_inQueryCall                 = 21
-	CypherParserRULE_standaloneCall              = 22
-	CypherParserRULE_yieldItems                  = 23
-	CypherParserRULE_yieldItem                   = 24
-	CypherParserRULE_withClause                  = 25
-	CypherParserRULE_returnClause                = 26
-	CypherParserRULE_returnBody                  = 27
-	CypherParserRULE_returnItems                 = 28
-	CypherParserRULE_returnItem                  = 29
-	CypherParserRULE_orderClause                 = 30
-	CypherParserRULE_skipClause                  = 31
-	CypherParserRULE_limitClause                 = 32
-	CypherParserRULE_sortItem                    = 33
-	CypherParserRULE_whereClause                 = 34
-	CypherParserRULE_pattern                     = 35
-	CypherParserRULE_patternPart                 = 36
-	CypherParserRULE_anonymousPatternPart        = 37
-	CypherParserRULE_patternElement              = 38
-	CypherParserRULE_nodePattern                 = 39
-	CypherParserRULE_patternElementChain         = 40
-	CypherParserRULE_relationshipPattern         = 41
-	CypherParserRULE_relationshipDetail          = 42
-	CypherParserRULE_properties                  = 43
-	CypherParserRULE_relationshipTypes           = 44
-	CypherParserRULE_nodeLabels                  = 45
-	CypherParserRULE_nodeLabel                   = 46
-	CypherParserRULE_rangeLiteral                = 47
-	CypherParserRULE_minHops                     = 48
-	CypherParserRULE_maxHops                     = 49
-	CypherParserRULE_labelName                   = 50
-	CypherParserRULE_relTypeName                 = 51
-	CypherParserRULE_expr                        = 52
-	CypherParserRULE_orExpr                      = 53
-	CypherParserRULE_xorExpr                     = 54
-	CypherParserRULE_andExpr                     = 55
-	CypherParserRULE_notExpr                     = 56
-	CypherParserRULE_comparisonExpr              = 57
-	CypherParserRULE_addOrSubtractExpr           = 58
-	CypherParserRULE_multiplyDivideModuloExpr    = 59
-	CypherParserRULE_powerOfExpr                 = 60
-	CypherParserRULE_unaryAddOrSubtractExpr      = 61
-	CypherParserRULE_stringListNullOperatorExpr  = 62
-	CypherParserRULE_listOperatorExpr            = 63
-	CypherParserRULE_stringOperatorExpr          = 64
-	CypherParserRULE_nullOperatorExpr            = 65
-	CypherParserRULE_propertyOrLabelsExpr        = 66
-	CypherParserRULE_atom                        = 67
-	CypherParserRULE_literal                     = 68
-	CypherParserRULE_booleanLiteral              = 69
-	CypherParserRULE_listLiteral                 = 70
-	CypherParserRULE_partialComparisonExpr       = 71
-	CypherParserRULE_parenthesizedExpr           = 72
-	CypherParserRULE_relationshipsPattern        = 73
-	CypherParserRULE_filterExpr                  = 74
-	CypherParserRULE_idInColl                    = 75
-	CypherParserRULE_functionInvocation          = 76
-	CypherParserRULE_functionName                = 77
-	CypherParserRULE_explicitProcedureInvocation = 78
-	CypherParserRULE_implicitProcedureInvocation = 79
-	CypherParserRULE_procedureResultField        = 80
-	CypherParserRULE_procedureName               = 81
-	CypherParserRULE_namespace                   = 82
-	CypherParserRULE_listComprehension           = 83
-	CypherParserRULE_patternComprehension        = 84
-	CypherParserRULE_propertyLookup              = 85
-	CypherParserRULE_caseExpr                    = 86
-	CypherParserRULE_caseAlternatives            = 87
-	CypherParserRULE_variable                    = 88
-	CypherParserRULE_numberLiteral               = 89
-	CypherParserRULE_mapLiteral                  = 90
-	CypherParserRULE_parameter                   = 91
-	CypherParserRULE_propertyExpr                = 92
-	CypherParserRULE_propertyKeyName             = 93
-	CypherParserRULE_integerLiteral              = 94
-	CypherParserRULE_doubleLiteral               = 95
-	CypherParserRULE_schemaName                  = 96
-	CypherParserRULE_reservedWord                = 97
-	CypherParserRULE_symbolicName                = 98
-	CypherParserRULE_leftArrowHead               = 99
-	CypherParserRULE_rightArrowHead              = 100
-	CypherParserRULE_dash                        = 101
+	CypherParserRULE_subqueryClause              = 22
+	CypherParserRULE_inTransactions              = 23
+	CypherParserRULE_standaloneCall              = 24
+	CypherParserRULE_yieldItems                  = 25
+	CypherParserRULE_yieldItem                   = 26
+	CypherParserRULE_withClause                  = 27
+	CypherParserRULE_returnClause                = 28
+	CypherParserRULE_returnBody                  = 29
+	CypherParserRULE_returnItems                 = 30
+	CypherParserRULE_returnItem                  = 31
+	CypherParserRULE_orderClause                 = 32
+	CypherParserRULE_skipClause                  = 33
+	CypherParserRULE_limitClause                 = 34
+	CypherParserRULE_sortItem                    = 35
+	CypherParserRULE_whereClause                 = 36
+	CypherParserRULE_pattern                     = 37
+	CypherParserRULE_patternPart                 = 38
+	CypherParserRULE_anonymousPatternPart        = 39
+	CypherParserRULE_patternElement              = 40
+	CypherParserRULE_nodePattern                 = 41
+	CypherParserRULE_patternElementChain         = 42
+	CypherParserRULE_relationshipPattern         = 43
+	CypherParserRULE_relationshipDetail          = 44
+	CypherParserRULE_properties                  = 45
+	CypherParserRULE_relationshipTypes           = 46
+	CypherParserRULE_nodeLabels                  = 47
+	CypherParserRULE_nodeLabel                   = 48
+	CypherParserRULE_rangeLiteral                = 49
+	CypherParserRULE_minHops                     = 50
+	CypherParserRULE_maxHops                     = 51
+	CypherParserRULE_labelName                   = 52
+	CypherParserRULE_relTypeName                 = 53
+	CypherParserRULE_expr                        = 54
+	CypherParserRULE_orExpr                      = 55
+	CypherParserRULE_xorExpr                     = 56
+	CypherParserRULE_andExpr                     = 57
+	CypherParserRULE_notExpr                     = 58
+	CypherParserRULE_comparisonExpr              = 59
+	CypherParserRULE_addOrSubtractExpr           = 60
+	CypherParserRULE_multiplyDivideModuloExpr    = 61
+	CypherParserRULE_powerOfExpr                 = 62
+	CypherParserRULE_unaryAddOrSubtractExpr      = 63
+	CypherParserRULE_stringListNullOperatorExpr  = 64
+	CypherParserRULE_listOperatorExpr            = 65
+	CypherParserRULE_stringOperatorExpr          = 66
+	CypherParserRULE_nullOperatorExpr            = 67
+	CypherParserRULE_propertyOrLabelsExpr        = 68
+	CypherParserRULE_atom                        = 69
+	CypherParserRULE_existsSubquery              = 70
+	CypherParserRULE_countSubquery               = 71
+	CypherParserRULE_subqueryBody                = 72
+	CypherParserRULE_literal                     = 73
+	CypherParserRULE_booleanLiteral              = 74
+	CypherParserRULE_listLiteral                 = 75
+	CypherParserRULE_partialComparisonExpr       = 76
+	CypherParserRULE_parenthesizedExpr           = 77
+	CypherParserRULE_relationshipsPattern        = 78
+	CypherParserRULE_filterExpr                  = 79
+	CypherParserRULE_idInColl                    = 80
+	CypherParserRULE_functionInvocation          = 81
+	CypherParserRULE_functionName                = 82
+	CypherParserRULE_explicitProcedureInvocation = 83
+	CypherParserRULE_implicitProcedureInvocation = 84
+	CypherParserRULE_procedureResultField        = 85
+	CypherParserRULE_procedureName               = 86
+	CypherParserRULE_namespace                   = 87
+	CypherParserRULE_listComprehension           = 88
+	CypherParserRULE_patternComprehension        = 89
+	CypherParserRULE_propertyLookup              = 90
+	CypherParserRULE_caseExpr                    = 91
+	CypherParserRULE_caseAlternatives            = 92
+	CypherParserRULE_variable                    = 93
+	CypherParserRULE_numberLiteral               = 94
+	CypherParserRULE_mapLiteral                  = 95
+	CypherParserRULE_parameter                   = 96
+	CypherParserRULE_propertyExpr                = 97
+	CypherParserRULE_propertyKeyName             = 98
+	CypherParserRULE_integerLiteral              = 99
+	CypherParserRULE_doubleLiteral               = 100
+	CypherParserRULE_schemaName                  = 101
+	CypherParserRULE_reservedWord                = 102
+	CypherParserRULE_symbolicName                = 103
+	CypherParserRULE_leftArrowHead               = 104
+	CypherParserRULE_rightArrowHead              = 105
+	CypherParserRULE_dash                        = 106
 )
 
 // ICypherContext is an interface to support dynamic dispatch.
@@ -1219,55 +1298,55 @@ func (p *CypherParser) Cypher() (localctx ICypherContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(205)
+	p.SetState(215)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(204)
+			p.SetState(214)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(207)
+		p.SetState(217)
 		p.Stmt()
 	}
-	p.SetState(212)
+	p.SetState(222)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) == 1 {
-		p.SetState(209)
+		p.SetState(219)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(208)
+				p.SetState(218)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(211)
+			p.SetState(221)
 			p.Match(CypherParserT__0)
 		}
 
 	}
-	p.SetState(215)
+	p.SetState(225)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(214)
+			p.SetState(224)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(217)
+		p.SetState(227)
 		p.Match(CypherParserEOF)
 	}
 
@@ -1362,7 +1441,7 @@ func (p *CypherParser) Stmt() (localctx IStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(219)
+		p.SetState(229)
 		p.Query()
 	}
 
@@ -1465,20 +1544,20 @@ func (p *CypherParser) Query() (localctx IQueryContext) {
 		}
 	}()
 
-	p.SetState(223)
+	p.SetState(233)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(221)
+			p.SetState(231)
 			p.RegularQuery()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(222)
+			p.SetState(232)
 			p.StandaloneCall()
 		}
 
@@ -1609,33 +1688,33 @@ func (p *CypherParser) RegularQuery() (localctx IRegularQueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(225)
+		p.SetState(235)
 		p.SingleQuery()
 	}
-	p.SetState(232)
+	p.SetState(242)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(227)
+			p.SetState(237)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(226)
+					p.SetState(236)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(229)
+				p.SetState(239)
 				p.UnionClause()
 			}
 
 		}
-		p.SetState(234)
+		p.SetState(244)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 	}
@@ -1746,58 +1825,58 @@ func (p *CypherParser) UnionClause() (localctx IUnionClauseContext) {
 		}
 	}()
 
-	p.SetState(247)
+	p.SetState(257)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(235)
+			p.SetState(245)
 			p.Match(CypherParserUNION)
 		}
 		{
-			p.SetState(236)
+			p.SetState(246)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(237)
+			p.SetState(247)
 			p.Match(CypherParserALL)
 		}
-		p.SetState(239)
+		p.SetState(249)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(238)
+				p.SetState(248)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(241)
+			p.SetState(251)
 			p.SingleQuery()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(242)
+			p.SetState(252)
 			p.Match(CypherParserUNION)
 		}
-		p.SetState(244)
+		p.SetState(254)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(243)
+				p.SetState(253)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(246)
+			p.SetState(256)
 			p.SingleQuery()
 		}
 
@@ -1902,20 +1981,20 @@ func (p *CypherParser) SingleQuery() (localctx ISingleQueryContext) {
 		}
 	}()
 
-	p.SetState(251)
+	p.SetState(261)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(249)
+			p.SetState(259)
 			p.SinglePartQuery()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(250)
+			p.SetState(260)
 			p.MultiPartQuery()
 		}
 
@@ -2026,6 +2105,16 @@ func (s *SinglePartQueryContext) UpdatingClause(i int) IUpdatingClauseContext {
 	return t.(IUpdatingClauseContext)
 }
 
+func (s *SinglePartQueryContext) SubqueryClause() ISubqueryClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISubqueryClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubqueryClauseContext)
+}
+
 func (s *SinglePartQueryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -2067,121 +2156,155 @@ func (p *CypherParser) SinglePartQuery() (localctx ISinglePartQueryContext) {
 
 	var _alt int
 
-	p.SetState(288)
+	p.SetState(308)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(259)
+		p.SetState(269)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for ((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserOPTIONAL-48))|(1<<(CypherParserMATCH-48))|(1<<(CypherParserUNWIND-48))|(1<<(CypherParserCALL-48)))) != 0 {
 			{
-				p.SetState(253)
+				p.SetState(263)
 				p.ReadingClause()
 			}
-			p.SetState(255)
+			p.SetState(265)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(254)
+					p.SetState(264)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(261)
+			p.SetState(271)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(262)
+			p.SetState(272)
 			p.ReturnClause()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(269)
+		p.SetState(279)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for ((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserOPTIONAL-48))|(1<<(CypherParserMATCH-48))|(1<<(CypherParserUNWIND-48))|(1<<(CypherParserCALL-48)))) != 0 {
 			{
-				p.SetState(263)
+				p.SetState(273)
 				p.ReadingClause()
 			}
-			p.SetState(265)
+			p.SetState(275)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(264)
+					p.SetState(274)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(271)
+			p.SetState(281)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(272)
+			p.SetState(282)
 			p.UpdatingClause()
 		}
-		p.SetState(279)
+		p.SetState(289)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(274)
+				p.SetState(284)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(273)
+						p.SetState(283)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(276)
+					p.SetState(286)
 					p.UpdatingClause()
 				}
 
 			}
-			p.SetState(281)
+			p.SetState(291)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext())
 		}
-		p.SetState(286)
+		p.SetState(296)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext()) == 1 {
-			p.SetState(283)
+			p.SetState(293)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(282)
+					p.SetState(292)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(285)
+				p.SetState(295)
 				p.ReturnClause()
 			}
 
 		}
 
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		p.SetState(304)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(298)
+					p.ReadingClause()
+				}
+				p.SetState(300)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == CypherParserSP {
+					{
+						p.SetState(299)
+						p.Match(CypherParserSP)
+					}
+
+				}
+
+			}
+			p.SetState(306)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext())
+		}
+		{
+			p.SetState(307)
+			p.SubqueryClause()
+		}
+
 	}
 
 	return localctx
@@ -2299,14 +2422,14 @@ func (p *CypherParser) MultiPartQuery() (localctx IMultiPartQueryContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(291)
+	p.SetState(311)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
 			{
-				p.SetState(290)
+				p.SetState(310)
 				p.MultiPartQueryPartial()
 			}
 
@@ -2314,12 +2437,12 @@ func (p *CypherParser) MultiPartQuery() (localctx IMultiPartQueryContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(293)
+		p.SetState(313)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext())
 	}
 	{
-		p.SetState(295)
+		p.SetState(315)
 		p.SinglePartQuery()
 	}
 
@@ -2468,67 +2591,67 @@ func (p *CypherParser) MultiPartQueryPartial() (localctx IMultiPartQueryPartialC
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(303)
+	p.SetState(323)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserOPTIONAL-48))|(1<<(CypherParserMATCH-48))|(1<<(CypherParserUNWIND-48))|(1<<(CypherParserCALL-48)))) != 0 {
 		{
-			p.SetState(297)
+			p.SetState(317)
 			p.ReadingClause()
 		}
-		p.SetState(299)
+		p.SetState(319)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(298)
+				p.SetState(318)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
-		p.SetState(305)
+		p.SetState(325)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(312)
+	p.SetState(332)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ((_la-52)&-(0x1f+1)) == 0 && ((1<<uint((_la-52)))&((1<<(CypherParserMERGE-52))|(1<<(CypherParserCREATE-52))|(1<<(CypherParserSET-52))|(1<<(CypherParserDETACH-52))|(1<<(CypherParserDELETE-52))|(1<<(CypherParserREMOVE-52)))) != 0 {
 		{
-			p.SetState(306)
+			p.SetState(326)
 			p.UpdatingClause()
 		}
-		p.SetState(308)
+		p.SetState(328)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(307)
+				p.SetState(327)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
-		p.SetState(314)
+		p.SetState(334)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(315)
+		p.SetState(335)
 		p.WithClause()
 	}
-	p.SetState(317)
+	p.SetState(337)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(316)
+			p.SetState(336)
 			p.Match(CypherParserSP)
 		}
 
@@ -2663,42 +2786,42 @@ func (p *CypherParser) UpdatingClause() (localctx IUpdatingClauseContext) {
 		}
 	}()
 
-	p.SetState(324)
+	p.SetState(344)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserCREATE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(319)
+			p.SetState(339)
 			p.CreateClause()
 		}
 
 	case CypherParserMERGE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(320)
+			p.SetState(340)
 			p.MergeClause()
 		}
 
 	case CypherParserDETACH, CypherParserDELETE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(321)
+			p.SetState(341)
 			p.DeleteClause()
 		}
 
 	case CypherParserSET:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(322)
+			p.SetState(342)
 			p.SetClause()
 		}
 
 	case CypherParserREMOVE:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(323)
+			p.SetState(343)
 			p.RemoveClause()
 		}
 
@@ -2777,6 +2900,16 @@ func (s *ReadingClauseContext) InQueryCall() IInQueryCallContext {
 	return t.(IInQueryCallContext)
 }
 
+func (s *ReadingClauseContext) SubqueryClause() ISubqueryClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISubqueryClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubqueryClauseContext)
+}
+
 func (s *ReadingClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -2815,33 +2948,37 @@ func (p *CypherParser) ReadingClause() (localctx IReadingClauseContext) {
 		}
 	}()
 
-	p.SetState(329)
+	p.SetState(350)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case CypherParserOPTIONAL, CypherParserMATCH:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(326)
+			p.SetState(346)
 			p.MatchClause()
 		}
 
-	case CypherParserUNWIND:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(327)
+			p.SetState(347)
 			p.UnwindClause()
 		}
 
-	case CypherParserCALL:
+	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(328)
+			p.SetState(348)
 			p.InQueryCall()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(349)
+			p.SubqueryClause()
+		}
+
 	}
 
 	return localctx
@@ -2961,57 +3098,57 @@ func (p *CypherParser) MatchClause() (localctx IMatchClauseContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(333)
+	p.SetState(354)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserOPTIONAL {
 		{
-			p.SetState(331)
+			p.SetState(352)
 			p.Match(CypherParserOPTIONAL)
 		}
 		{
-			p.SetState(332)
+			p.SetState(353)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(335)
+		p.SetState(356)
 		p.Match(CypherParserMATCH)
 	}
-	p.SetState(337)
+	p.SetState(358)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(336)
+			p.SetState(357)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(339)
+		p.SetState(360)
 		p.Pattern()
 	}
-	p.SetState(344)
+	p.SetState(365)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) == 1 {
-		p.SetState(341)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext()) == 1 {
+		p.SetState(362)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(340)
+				p.SetState(361)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(343)
+			p.SetState(364)
 			p.WhereClause()
 		}
 
@@ -3135,38 +3272,38 @@ func (p *CypherParser) UnwindClause() (localctx IUnwindClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(346)
+		p.SetState(367)
 		p.Match(CypherParserUNWIND)
 	}
-	p.SetState(348)
+	p.SetState(369)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(347)
+			p.SetState(368)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(350)
+		p.SetState(371)
 		p.Expr()
 	}
 	{
-		p.SetState(351)
+		p.SetState(372)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(352)
+		p.SetState(373)
 		p.Match(CypherParserAS)
 	}
 	{
-		p.SetState(353)
+		p.SetState(374)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(354)
+		p.SetState(375)
 		p.Variable()
 	}
 
@@ -3299,43 +3436,43 @@ func (p *CypherParser) MergeClause() (localctx IMergeClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(356)
+		p.SetState(377)
 		p.Match(CypherParserMERGE)
 	}
-	p.SetState(358)
+	p.SetState(379)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(357)
+			p.SetState(378)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(360)
+		p.SetState(381)
 		p.PatternPart()
 	}
-	p.SetState(365)
+	p.SetState(386)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(361)
+				p.SetState(382)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(362)
+				p.SetState(383)
 				p.MergeAction()
 			}
 
 		}
-		p.SetState(367)
+		p.SetState(388)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -3447,52 +3584,52 @@ func (p *CypherParser) MergeAction() (localctx IMergeActionContext) {
 		}
 	}()
 
-	p.SetState(378)
+	p.SetState(399)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(368)
+			p.SetState(389)
 			p.Match(CypherParserON)
 		}
 		{
-			p.SetState(369)
+			p.SetState(390)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(370)
+			p.SetState(391)
 			p.Match(CypherParserMATCH)
 		}
 		{
-			p.SetState(371)
+			p.SetState(392)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(372)
+			p.SetState(393)
 			p.SetClause()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(373)
+			p.SetState(394)
 			p.Match(CypherParserON)
 		}
 		{
-			p.SetState(374)
+			p.SetState(395)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(375)
+			p.SetState(396)
 			p.Match(CypherParserCREATE)
 		}
 		{
-			p.SetState(376)
+			p.SetState(397)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(377)
+			p.SetState(398)
 			p.SetClause()
 		}
 
@@ -3598,22 +3735,22 @@ func (p *CypherParser) CreateClause() (localctx ICreateClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(380)
+		p.SetState(401)
 		p.Match(CypherParserCREATE)
 	}
-	p.SetState(382)
+	p.SetState(403)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(381)
+			p.SetState(402)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(384)
+		p.SetState(405)
 		p.Pattern()
 	}
 
@@ -3730,39 +3867,39 @@ func (p *CypherParser) SetClause() (localctx ISetClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(386)
+		p.SetState(407)
 		p.Match(CypherParserSET)
 	}
-	p.SetState(388)
+	p.SetState(409)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(387)
+			p.SetState(408)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(390)
+		p.SetState(411)
 		p.SetItem()
 	}
-	p.SetState(395)
+	p.SetState(416)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CypherParserT__1 {
 		{
-			p.SetState(391)
+			p.SetState(412)
 			p.Match(CypherParserT__1)
 		}
 		{
-			p.SetState(392)
+			p.SetState(413)
 			p.SetItem()
 		}
 
-		p.SetState(397)
+		p.SetState(418)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -3895,139 +4032,139 @@ func (p *CypherParser) SetItem() (localctx ISetItemContext) {
 		}
 	}()
 
-	p.SetState(434)
+	p.SetState(455)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(398)
+			p.SetState(419)
 			p.PropertyExpr()
 		}
-		p.SetState(400)
+		p.SetState(421)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(399)
+				p.SetState(420)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(402)
+			p.SetState(423)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(404)
+		p.SetState(425)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(403)
+				p.SetState(424)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(406)
+			p.SetState(427)
 			p.Expr()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(408)
+			p.SetState(429)
 			p.Variable()
 		}
-		p.SetState(410)
+		p.SetState(431)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(409)
+				p.SetState(430)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(412)
+			p.SetState(433)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(414)
+		p.SetState(435)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(413)
+				p.SetState(434)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(416)
+			p.SetState(437)
 			p.Expr()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(418)
+			p.SetState(439)
 			p.Variable()
 		}
-		p.SetState(420)
+		p.SetState(441)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(419)
+				p.SetState(440)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(422)
+			p.SetState(443)
 			p.Match(CypherParserT__3)
 		}
-		p.SetState(424)
+		p.SetState(445)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(423)
+				p.SetState(444)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(426)
+			p.SetState(447)
 			p.Expr()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(428)
+			p.SetState(449)
 			p.Variable()
 		}
-		p.SetState(430)
+		p.SetState(451)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(429)
+				p.SetState(450)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(432)
+			p.SetState(453)
 			p.NodeLabels()
 		}
 
@@ -4155,81 +4292,81 @@ func (p *CypherParser) DeleteClause() (localctx IDeleteClauseContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(438)
+	p.SetState(459)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserDETACH {
 		{
-			p.SetState(436)
+			p.SetState(457)
 			p.Match(CypherParserDETACH)
 		}
 		{
-			p.SetState(437)
+			p.SetState(458)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(440)
+		p.SetState(461)
 		p.Match(CypherParserDELETE)
 	}
-	p.SetState(442)
+	p.SetState(463)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(441)
+			p.SetState(462)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(444)
+		p.SetState(465)
 		p.Expr()
 	}
-	p.SetState(455)
+	p.SetState(476)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(446)
+			p.SetState(467)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(445)
+					p.SetState(466)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(448)
+				p.SetState(469)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(450)
+			p.SetState(471)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(449)
+					p.SetState(470)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(452)
+				p.SetState(473)
 				p.Expr()
 			}
 
 		}
-		p.SetState(457)
+		p.SetState(478)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -4351,58 +4488,58 @@ func (p *CypherParser) RemoveClause() (localctx IRemoveClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(458)
+		p.SetState(479)
 		p.Match(CypherParserREMOVE)
 	}
 	{
-		p.SetState(459)
+		p.SetState(480)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(460)
+		p.SetState(481)
 		p.RemoveItem()
 	}
-	p.SetState(471)
+	p.SetState(492)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(462)
+			p.SetState(483)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(461)
+					p.SetState(482)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(464)
+				p.SetState(485)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(466)
+			p.SetState(487)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(465)
+					p.SetState(486)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(468)
+				p.SetState(489)
 				p.RemoveItem()
 			}
 
 		}
-		p.SetState(473)
+		p.SetState(494)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -4514,24 +4651,24 @@ func (p *CypherParser) RemoveItem() (localctx IRemoveItemContext) {
 		}
 	}()
 
-	p.SetState(478)
+	p.SetState(499)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(474)
+			p.SetState(495)
 			p.Variable()
 		}
 		{
-			p.SetState(475)
+			p.SetState(496)
 			p.NodeLabels()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(477)
+			p.SetState(498)
 			p.PropertyExpr()
 		}
 
@@ -4655,42 +4792,42 @@ func (p *CypherParser) InQueryCall() (localctx IInQueryCallContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(480)
+		p.SetState(501)
 		p.Match(CypherParserCALL)
 	}
 	{
-		p.SetState(481)
+		p.SetState(502)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(482)
+		p.SetState(503)
 		p.ExplicitProcedureInvocation()
 	}
-	p.SetState(489)
+	p.SetState(510)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) == 1 {
-		p.SetState(484)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext()) == 1 {
+		p.SetState(505)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(483)
+				p.SetState(504)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(486)
+			p.SetState(507)
 			p.Match(CypherParserYIELD)
 		}
 		{
-			p.SetState(487)
+			p.SetState(508)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(488)
+			p.SetState(509)
 			p.YieldItems()
 		}
 
@@ -4699,111 +4836,98 @@ func (p *CypherParser) InQueryCall() (localctx IInQueryCallContext) {
 	return localctx
 }
 
-// IStandaloneCallContext is an interface to support dynamic dispatch.
-type IStandaloneCallContext interface {
+// ISubqueryClauseContext is an interface to support dynamic dispatch.
+type ISubqueryClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsStandaloneCallContext differentiates from other interfaces.
-	IsStandaloneCallContext()
+	// IsSubqueryClauseContext differentiates from other interfaces.
+	IsSubqueryClauseContext()
 }
 
-type StandaloneCallContext struct {
+type SubqueryClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStandaloneCallContext() *StandaloneCallContext {
-	var p = new(StandaloneCallContext)
+func NewEmptySubqueryClauseContext() *SubqueryClauseContext {
+	var p = new(SubqueryClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_standaloneCall
+	p.RuleIndex = CypherParserRULE_subqueryClause
 	return p
 }
 
-func (*StandaloneCallContext) IsStandaloneCallContext() {}
+func (*SubqueryClauseContext) IsSubqueryClauseContext() {}
 
-func NewStandaloneCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StandaloneCallContext {
-	var p = new(StandaloneCallContext)
+func NewSubqueryClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubqueryClauseContext {
+	var p = new(SubqueryClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_standaloneCall
+	p.RuleIndex = CypherParserRULE_subqueryClause
 
 	return p
 }
 
-func (s *StandaloneCallContext) GetParser() antlr.Parser { return s.parser }
+func (s *SubqueryClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StandaloneCallContext) CALL() antlr.TerminalNode {
+func (s *SubqueryClauseContext) CALL() antlr.TerminalNode {
 	return s.GetToken(CypherParserCALL, 0)
 }
 
-func (s *StandaloneCallContext) AllSP() []antlr.TerminalNode {
-	return s.GetTokens(CypherParserSP)
-}
-
-func (s *StandaloneCallContext) SP(i int) antlr.TerminalNode {
-	return s.GetToken(CypherParserSP, i)
-}
-
-func (s *StandaloneCallContext) ExplicitProcedureInvocation() IExplicitProcedureInvocationContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExplicitProcedureInvocationContext)(nil)).Elem(), 0)
+func (s *SubqueryClauseContext) RegularQuery() IRegularQueryContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRegularQueryContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExplicitProcedureInvocationContext)
+	return t.(IRegularQueryContext)
 }
 
-func (s *StandaloneCallContext) ImplicitProcedureInvocation() IImplicitProcedureInvocationContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IImplicitProcedureInvocationContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IImplicitProcedureInvocationContext)
+func (s *SubqueryClauseContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
 }
 
-func (s *StandaloneCallContext) YIELD() antlr.TerminalNode {
-	return s.GetToken(CypherParserYIELD, 0)
+func (s *SubqueryClauseContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
 }
 
-func (s *StandaloneCallContext) YieldItems() IYieldItemsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IYieldItemsContext)(nil)).Elem(), 0)
+func (s *SubqueryClauseContext) InTransactions() IInTransactionsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IInTransactionsContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IYieldItemsContext)
+	return t.(IInTransactionsContext)
 }
 
-func (s *StandaloneCallContext) GetRuleContext() antlr.RuleContext {
+func (s *SubqueryClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StandaloneCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SubqueryClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StandaloneCallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SubqueryClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitStandaloneCall(s)
+		return t.VisitSubqueryClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) StandaloneCall() (localctx IStandaloneCallContext) {
-	localctx = NewStandaloneCallContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, CypherParserRULE_standaloneCall)
+func (p *CypherParser) SubqueryClause() (localctx ISubqueryClauseContext) {
+	localctx = NewSubqueryClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, CypherParserRULE_subqueryClause)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4823,48 +4947,72 @@ func (p *CypherParser) StandaloneCall() (localctx IStandaloneCallContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(491)
+		p.SetState(512)
 		p.Match(CypherParserCALL)
 	}
-	{
-		p.SetState(492)
-		p.Match(CypherParserSP)
-	}
-	p.SetState(495)
+	p.SetState(514)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(493)
-			p.ExplicitProcedureInvocation()
-		}
+	_la = p.GetTokenStream().LA(1)
 
-	case 2:
+	if _la == CypherParserSP {
 		{
-			p.SetState(494)
-			p.ImplicitProcedureInvocation()
+			p.SetState(513)
+			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(501)
+	{
+		p.SetState(516)
+		p.Match(CypherParserT__4)
+	}
+	p.SetState(518)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext()) == 1 {
+	if _la == CypherParserSP {
 		{
-			p.SetState(497)
+			p.SetState(517)
 			p.Match(CypherParserSP)
 		}
+
+	}
+	{
+		p.SetState(520)
+		p.RegularQuery()
+	}
+	p.SetState(522)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
 		{
-			p.SetState(498)
-			p.Match(CypherParserYIELD)
-		}
-		{
-			p.SetState(499)
+			p.SetState(521)
 			p.Match(CypherParserSP)
 		}
+
+	}
+	{
+		p.SetState(524)
+		p.Match(CypherParserT__5)
+	}
+	p.SetState(529)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext()) == 1 {
+		p.SetState(526)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(525)
+				p.Match(CypherParserSP)
+			}
+
+		}
 		{
-			p.SetState(500)
-			p.YieldItems()
+			p.SetState(528)
+			p.InTransactions()
 		}
 
 	}
@@ -4872,106 +5020,140 @@ func (p *CypherParser) StandaloneCall() (localctx IStandaloneCallContext) {
 	return localctx
 }
 
-// IYieldItemsContext is an interface to support dynamic dispatch.
-type IYieldItemsContext interface {
+// IInTransactionsContext is an interface to support dynamic dispatch.
+type IInTransactionsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsYieldItemsContext differentiates from other interfaces.
-	IsYieldItemsContext()
+	// IsInTransactionsContext differentiates from other interfaces.
+	IsInTransactionsContext()
 }
 
-type YieldItemsContext struct {
+type InTransactionsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyYieldItemsContext() *YieldItemsContext {
-	var p = new(YieldItemsContext)
+func NewEmptyInTransactionsContext() *InTransactionsContext {
+	var p = new(InTransactionsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_yieldItems
+	p.RuleIndex = CypherParserRULE_inTransactions
 	return p
 }
 
-func (*YieldItemsContext) IsYieldItemsContext() {}
+func (*InTransactionsContext) IsInTransactionsContext() {}
 
-func NewYieldItemsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *YieldItemsContext {
-	var p = new(YieldItemsContext)
+func NewInTransactionsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InTransactionsContext {
+	var p = new(InTransactionsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_yieldItems
+	p.RuleIndex = CypherParserRULE_inTransactions
 
 	return p
 }
 
-func (s *YieldItemsContext) GetParser() antlr.Parser { return s.parser }
+func (s *InTransactionsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *YieldItemsContext) WhereClause() IWhereClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhereClauseContext)(nil)).Elem(), 0)
+func (s *InTransactionsContext) IN() antlr.TerminalNode {
+	return s.GetToken(CypherParserIN, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *InTransactionsContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
 
-	return t.(IWhereClauseContext)
+func (s *InTransactionsContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
 }
 
-func (s *YieldItemsContext) AllYieldItem() []IYieldItemContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IYieldItemContext)(nil)).Elem())
-	var tst = make([]IYieldItemContext, len(ts))
+func (s *InTransactionsContext) TRANSACTIONS() antlr.TerminalNode {
+	return s.GetToken(CypherParserTRANSACTIONS, 0)
+}
+
+func (s *InTransactionsContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IYieldItemContext)
+			tst[i] = t.(IExprContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *YieldItemsContext) YieldItem(i int) IYieldItemContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IYieldItemContext)(nil)).Elem(), i)
+func (s *InTransactionsContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IYieldItemContext)
+	return t.(IExprContext)
 }
 
-func (s *YieldItemsContext) AllSP() []antlr.TerminalNode {
-	return s.GetTokens(CypherParserSP)
+func (s *InTransactionsContext) CONCURRENT() antlr.TerminalNode {
+	return s.GetToken(CypherParserCONCURRENT, 0)
 }
 
-func (s *YieldItemsContext) SP(i int) antlr.TerminalNode {
-	return s.GetToken(CypherParserSP, i)
+func (s *InTransactionsContext) OF() antlr.TerminalNode {
+	return s.GetToken(CypherParserOF, 0)
 }
 
-func (s *YieldItemsContext) GetRuleContext() antlr.RuleContext {
+func (s *InTransactionsContext) ON() antlr.TerminalNode {
+	return s.GetToken(CypherParserON, 0)
+}
+
+func (s *InTransactionsContext) ERROR() antlr.TerminalNode {
+	return s.GetToken(CypherParserERROR, 0)
+}
+
+func (s *InTransactionsContext) ROW() antlr.TerminalNode {
+	return s.GetToken(CypherParserROW, 0)
+}
+
+func (s *InTransactionsContext) ROWS() antlr.TerminalNode {
+	return s.GetToken(CypherParserROWS, 0)
+}
+
+func (s *InTransactionsContext) CONTINUE() antlr.TerminalNode {
+	return s.GetToken(CypherParserCONTINUE, 0)
+}
+
+func (s *InTransactionsContext) BREAK() antlr.TerminalNode {
+	return s.GetToken(CypherParserBREAK, 0)
+}
+
+func (s *InTransactionsContext) FAIL() antlr.TerminalNode {
+	return s.GetToken(CypherParserFAIL, 0)
+}
+
+func (s *InTransactionsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *YieldItemsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *InTransactionsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *YieldItemsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *InTransactionsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitYieldItems(s)
+		return t.VisitInTransactions(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) YieldItems() (localctx IYieldItemsContext) {
-	localctx = NewYieldItemsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, CypherParserRULE_yieldItems)
+func (p *CypherParser) InTransactions() (localctx IInTransactionsContext) {
+	localctx = NewInTransactionsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, CypherParserRULE_inTransactions)
 	var _la int
 
 	defer func() {
@@ -4990,88 +5172,108 @@ func (p *CypherParser) YieldItems() (localctx IYieldItemsContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(518)
+	{
+		p.SetState(531)
+		p.Match(CypherParserIN)
+	}
+	{
+		p.SetState(532)
+		p.Match(CypherParserSP)
+	}
+	p.SetState(538)
 	p.GetErrorHandler().Sync(p)
 
-	switch p.GetTokenStream().LA(1) {
-	case CypherParserT__4:
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(503)
-			p.Match(CypherParserT__4)
+			p.SetState(533)
+			p.Expr()
 		}
-
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		{
-			p.SetState(504)
-			p.YieldItem()
+			p.SetState(534)
+			p.Match(CypherParserSP)
 		}
-		p.SetState(515)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext())
-
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				p.SetState(506)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
-
-				if _la == CypherParserSP {
-					{
-						p.SetState(505)
-						p.Match(CypherParserSP)
-					}
-
-				}
-				{
-					p.SetState(508)
-					p.Match(CypherParserT__1)
-				}
-				p.SetState(510)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
-
-				if _la == CypherParserSP {
-					{
-						p.SetState(509)
-						p.Match(CypherParserSP)
-					}
-
-				}
-				{
-					p.SetState(512)
-					p.YieldItem()
-				}
-
-			}
-			p.SetState(517)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext())
+		{
+			p.SetState(535)
+			p.Match(CypherParserCONCURRENT)
+		}
+		{
+			p.SetState(536)
+			p.Match(CypherParserSP)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(524)
+	{
+		p.SetState(540)
+		p.Match(CypherParserTRANSACTIONS)
+	}
+	p.SetState(548)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) == 1 {
-		p.SetState(521)
-		p.GetErrorHandler().Sync(p)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(541)
+			p.Match(CypherParserSP)
+		}
+		{
+			p.SetState(542)
+			p.Match(CypherParserOF)
+		}
+		{
+			p.SetState(543)
+			p.Match(CypherParserSP)
+		}
+		{
+			p.SetState(544)
+			p.Expr()
+		}
+		{
+			p.SetState(545)
+			p.Match(CypherParserSP)
+		}
+		p.SetState(546)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(520)
-				p.Match(CypherParserSP)
-			}
+		if !(_la == CypherParserROW || _la == CypherParserROWS) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 
+	}
+	p.SetState(556)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(550)
+			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(523)
-			p.WhereClause()
+			p.SetState(551)
+			p.Match(CypherParserON)
+		}
+		{
+			p.SetState(552)
+			p.Match(CypherParserSP)
+		}
+		{
+			p.SetState(553)
+			p.Match(CypherParserERROR)
+		}
+		{
+			p.SetState(554)
+			p.Match(CypherParserSP)
+		}
+		p.SetState(555)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(CypherParserCONTINUE-66))|(1<<(CypherParserBREAK-66))|(1<<(CypherParserFAIL-66)))) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 
 	}
@@ -5079,97 +5281,111 @@ func (p *CypherParser) YieldItems() (localctx IYieldItemsContext) {
 	return localctx
 }
 
-// IYieldItemContext is an interface to support dynamic dispatch.
-type IYieldItemContext interface {
+// IStandaloneCallContext is an interface to support dynamic dispatch.
+type IStandaloneCallContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsYieldItemContext differentiates from other interfaces.
-	IsYieldItemContext()
+	// IsStandaloneCallContext differentiates from other interfaces.
+	IsStandaloneCallContext()
 }
 
-type YieldItemContext struct {
+type StandaloneCallContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyYieldItemContext() *YieldItemContext {
-	var p = new(YieldItemContext)
+func NewEmptyStandaloneCallContext() *StandaloneCallContext {
+	var p = new(StandaloneCallContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_yieldItem
+	p.RuleIndex = CypherParserRULE_standaloneCall
 	return p
 }
 
-func (*YieldItemContext) IsYieldItemContext() {}
+func (*StandaloneCallContext) IsStandaloneCallContext() {}
 
-func NewYieldItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *YieldItemContext {
-	var p = new(YieldItemContext)
+func NewStandaloneCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StandaloneCallContext {
+	var p = new(StandaloneCallContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_yieldItem
+	p.RuleIndex = CypherParserRULE_standaloneCall
 
 	return p
 }
 
-func (s *YieldItemContext) GetParser() antlr.Parser { return s.parser }
+func (s *StandaloneCallContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *YieldItemContext) Variable() IVariableContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
+func (s *StandaloneCallContext) CALL() antlr.TerminalNode {
+	return s.GetToken(CypherParserCALL, 0)
+}
+
+func (s *StandaloneCallContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
+
+func (s *StandaloneCallContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *StandaloneCallContext) ExplicitProcedureInvocation() IExplicitProcedureInvocationContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExplicitProcedureInvocationContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IVariableContext)
+	return t.(IExplicitProcedureInvocationContext)
 }
 
-func (s *YieldItemContext) ProcedureResultField() IProcedureResultFieldContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IProcedureResultFieldContext)(nil)).Elem(), 0)
+func (s *StandaloneCallContext) ImplicitProcedureInvocation() IImplicitProcedureInvocationContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IImplicitProcedureInvocationContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IProcedureResultFieldContext)
+	return t.(IImplicitProcedureInvocationContext)
 }
 
-func (s *YieldItemContext) AllSP() []antlr.TerminalNode {
-	return s.GetTokens(CypherParserSP)
+func (s *StandaloneCallContext) YIELD() antlr.TerminalNode {
+	return s.GetToken(CypherParserYIELD, 0)
 }
 
-func (s *YieldItemContext) SP(i int) antlr.TerminalNode {
-	return s.GetToken(CypherParserSP, i)
-}
+func (s *StandaloneCallContext) YieldItems() IYieldItemsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IYieldItemsContext)(nil)).Elem(), 0)
 
-func (s *YieldItemContext) AS() antlr.TerminalNode {
-	return s.GetToken(CypherParserAS, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IYieldItemsContext)
 }
 
-func (s *YieldItemContext) GetRuleContext() antlr.RuleContext {
+func (s *StandaloneCallContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *YieldItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StandaloneCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *YieldItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StandaloneCallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitYieldItem(s)
+		return t.VisitStandaloneCall(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) YieldItem() (localctx IYieldItemContext) {
-	localctx = NewYieldItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, CypherParserRULE_yieldItem)
+func (p *CypherParser) StandaloneCall() (localctx IStandaloneCallContext) {
+	localctx = NewStandaloneCallContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, CypherParserRULE_standaloneCall)
 
 	defer func() {
 		p.ExitRule()
@@ -5188,131 +5404,156 @@ func (p *CypherParser) YieldItem() (localctx IYieldItemContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(531)
+	{
+		p.SetState(558)
+		p.Match(CypherParserCALL)
+	}
+	{
+		p.SetState(559)
+		p.Match(CypherParserSP)
+	}
+	p.SetState(562)
 	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) == 1 {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) {
+	case 1:
 		{
-			p.SetState(526)
-			p.ProcedureResultField()
+			p.SetState(560)
+			p.ExplicitProcedureInvocation()
 		}
+
+	case 2:
 		{
-			p.SetState(527)
-			p.Match(CypherParserSP)
+			p.SetState(561)
+			p.ImplicitProcedureInvocation()
 		}
-		{
-			p.SetState(528)
-			p.Match(CypherParserAS)
+
+	}
+	p.SetState(568)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 69, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(564)
+			p.Match(CypherParserSP)
+		}
+		{
+			p.SetState(565)
+			p.Match(CypherParserYIELD)
 		}
 		{
-			p.SetState(529)
+			p.SetState(566)
 			p.Match(CypherParserSP)
 		}
+		{
+			p.SetState(567)
+			p.YieldItems()
+		}
 
 	}
-	{
-		p.SetState(533)
-		p.Variable()
-	}
 
 	return localctx
 }
 
-// IWithClauseContext is an interface to support dynamic dispatch.
-type IWithClauseContext interface {
+// IYieldItemsContext is an interface to support dynamic dispatch.
+type IYieldItemsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsWithClauseContext differentiates from other interfaces.
-	IsWithClauseContext()
+	// IsYieldItemsContext differentiates from other interfaces.
+	IsYieldItemsContext()
 }
 
-type WithClauseContext struct {
+type YieldItemsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyWithClauseContext() *WithClauseContext {
-	var p = new(WithClauseContext)
+func NewEmptyYieldItemsContext() *YieldItemsContext {
+	var p = new(YieldItemsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_withClause
+	p.RuleIndex = CypherParserRULE_yieldItems
 	return p
 }
 
-func (*WithClauseContext) IsWithClauseContext() {}
+func (*YieldItemsContext) IsYieldItemsContext() {}
 
-func NewWithClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithClauseContext {
-	var p = new(WithClauseContext)
+func NewYieldItemsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *YieldItemsContext {
+	var p = new(YieldItemsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_withClause
+	p.RuleIndex = CypherParserRULE_yieldItems
 
 	return p
 }
 
-func (s *WithClauseContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *WithClauseContext) WITH() antlr.TerminalNode {
-	return s.GetToken(CypherParserWITH, 0)
-}
-
-func (s *WithClauseContext) AllSP() []antlr.TerminalNode {
-	return s.GetTokens(CypherParserSP)
-}
-
-func (s *WithClauseContext) SP(i int) antlr.TerminalNode {
-	return s.GetToken(CypherParserSP, i)
-}
+func (s *YieldItemsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WithClauseContext) ReturnBody() IReturnBodyContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturnBodyContext)(nil)).Elem(), 0)
+func (s *YieldItemsContext) WhereClause() IWhereClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhereClauseContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IReturnBodyContext)
+	return t.(IWhereClauseContext)
 }
 
-func (s *WithClauseContext) DISTINCT() antlr.TerminalNode {
-	return s.GetToken(CypherParserDISTINCT, 0)
+func (s *YieldItemsContext) AllYieldItem() []IYieldItemContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IYieldItemContext)(nil)).Elem())
+	var tst = make([]IYieldItemContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IYieldItemContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *WithClauseContext) WhereClause() IWhereClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhereClauseContext)(nil)).Elem(), 0)
+func (s *YieldItemsContext) YieldItem(i int) IYieldItemContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IYieldItemContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IWhereClauseContext)
+	return t.(IYieldItemContext)
 }
 
-func (s *WithClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *YieldItemsContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
+
+func (s *YieldItemsContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *YieldItemsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *WithClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *YieldItemsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *WithClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *YieldItemsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitWithClause(s)
+		return t.VisitYieldItems(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) WithClause() (localctx IWithClauseContext) {
-	localctx = NewWithClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, CypherParserRULE_withClause)
+func (p *CypherParser) YieldItems() (localctx IYieldItemsContext) {
+	localctx = NewYieldItemsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, CypherParserRULE_yieldItems)
 	var _la int
 
 	defer func() {
@@ -5331,57 +5572,87 @@ func (p *CypherParser) WithClause() (localctx IWithClauseContext) {
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(535)
-		p.Match(CypherParserWITH)
-	}
-	p.SetState(540)
+	p.SetState(585)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) == 1 {
-		p.SetState(537)
+	switch p.GetTokenStream().LA(1) {
+	case CypherParserT__6:
+		{
+			p.SetState(570)
+			p.Match(CypherParserT__6)
+		}
+
+	case CypherParserCONCURRENT, CypherParserTRANSACTIONS, CypherParserROW, CypherParserROWS, CypherParserERROR, CypherParserCONTINUE, CypherParserBREAK, CypherParserFAIL, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+		{
+			p.SetState(571)
+			p.YieldItem()
+		}
+		p.SetState(582)
 		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext())
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(536)
-				p.Match(CypherParserSP)
-			}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				p.SetState(573)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
 
-		}
-		{
-			p.SetState(539)
-			p.Match(CypherParserDISTINCT)
+				if _la == CypherParserSP {
+					{
+						p.SetState(572)
+						p.Match(CypherParserSP)
+					}
+
+				}
+				{
+					p.SetState(575)
+					p.Match(CypherParserT__1)
+				}
+				p.SetState(577)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == CypherParserSP {
+					{
+						p.SetState(576)
+						p.Match(CypherParserSP)
+					}
+
+				}
+				{
+					p.SetState(579)
+					p.YieldItem()
+				}
+
+			}
+			p.SetState(584)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext())
 		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	{
-		p.SetState(542)
-		p.Match(CypherParserSP)
-	}
-	{
-		p.SetState(543)
-		p.ReturnBody()
-	}
-	p.SetState(548)
+	p.SetState(591)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) == 1 {
-		p.SetState(545)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext()) == 1 {
+		p.SetState(588)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(544)
+				p.SetState(587)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(547)
+			p.SetState(590)
 			p.WhereClause()
 		}
 
@@ -5390,92 +5661,97 @@ func (p *CypherParser) WithClause() (localctx IWithClauseContext) {
 	return localctx
 }
 
-// IReturnClauseContext is an interface to support dynamic dispatch.
-type IReturnClauseContext interface {
+// IYieldItemContext is an interface to support dynamic dispatch.
+type IYieldItemContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsReturnClauseContext differentiates from other interfaces.
-	IsReturnClauseContext()
+	// IsYieldItemContext differentiates from other interfaces.
+	IsYieldItemContext()
 }
 
-type ReturnClauseContext struct {
+type YieldItemContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyReturnClauseContext() *ReturnClauseContext {
-	var p = new(ReturnClauseContext)
+func NewEmptyYieldItemContext() *YieldItemContext {
+	var p = new(YieldItemContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_returnClause
+	p.RuleIndex = CypherParserRULE_yieldItem
 	return p
 }
 
-func (*ReturnClauseContext) IsReturnClauseContext() {}
+func (*YieldItemContext) IsYieldItemContext() {}
 
-func NewReturnClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnClauseContext {
-	var p = new(ReturnClauseContext)
+func NewYieldItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *YieldItemContext {
+	var p = new(YieldItemContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_returnClause
+	p.RuleIndex = CypherParserRULE_yieldItem
 
 	return p
 }
 
-func (s *ReturnClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *YieldItemContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ReturnClauseContext) RETURN() antlr.TerminalNode {
-	return s.GetToken(CypherParserRETURN, 0)
-}
+func (s *YieldItemContext) Variable() IVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
 
-func (s *ReturnClauseContext) AllSP() []antlr.TerminalNode {
-	return s.GetTokens(CypherParserSP)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *ReturnClauseContext) SP(i int) antlr.TerminalNode {
-	return s.GetToken(CypherParserSP, i)
+	return t.(IVariableContext)
 }
 
-func (s *ReturnClauseContext) ReturnBody() IReturnBodyContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturnBodyContext)(nil)).Elem(), 0)
+func (s *YieldItemContext) ProcedureResultField() IProcedureResultFieldContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IProcedureResultFieldContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IReturnBodyContext)
+	return t.(IProcedureResultFieldContext)
 }
 
-func (s *ReturnClauseContext) DISTINCT() antlr.TerminalNode {
-	return s.GetToken(CypherParserDISTINCT, 0)
+func (s *YieldItemContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
 }
 
-func (s *ReturnClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *YieldItemContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *YieldItemContext) AS() antlr.TerminalNode {
+	return s.GetToken(CypherParserAS, 0)
+}
+
+func (s *YieldItemContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ReturnClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *YieldItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ReturnClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *YieldItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitReturnClause(s)
+		return t.VisitYieldItem(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) ReturnClause() (localctx IReturnClauseContext) {
-	localctx = NewReturnClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, CypherParserRULE_returnClause)
-	var _la int
+func (p *CypherParser) YieldItem() (localctx IYieldItemContext) {
+	localctx = NewYieldItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, CypherParserRULE_yieldItem)
 
 	defer func() {
 		p.ExitRule()
@@ -5494,150 +5770,132 @@ func (p *CypherParser) ReturnClause() (localctx IReturnClauseContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(550)
-		p.Match(CypherParserRETURN)
-	}
-	p.SetState(555)
+	p.SetState(598)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) == 1 {
-		p.SetState(552)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == CypherParserSP {
-			{
-				p.SetState(551)
-				p.Match(CypherParserSP)
-			}
-
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(593)
+			p.ProcedureResultField()
 		}
 		{
-			p.SetState(554)
-			p.Match(CypherParserDISTINCT)
+			p.SetState(594)
+			p.Match(CypherParserSP)
+		}
+		{
+			p.SetState(595)
+			p.Match(CypherParserAS)
+		}
+		{
+			p.SetState(596)
+			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(557)
-		p.Match(CypherParserSP)
-	}
-	{
-		p.SetState(558)
-		p.ReturnBody()
+		p.SetState(600)
+		p.Variable()
 	}
 
 	return localctx
 }
 
-// IReturnBodyContext is an interface to support dynamic dispatch.
-type IReturnBodyContext interface {
+// IWithClauseContext is an interface to support dynamic dispatch.
+type IWithClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsReturnBodyContext differentiates from other interfaces.
-	IsReturnBodyContext()
+	// IsWithClauseContext differentiates from other interfaces.
+	IsWithClauseContext()
 }
 
-type ReturnBodyContext struct {
+type WithClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyReturnBodyContext() *ReturnBodyContext {
-	var p = new(ReturnBodyContext)
+func NewEmptyWithClauseContext() *WithClauseContext {
+	var p = new(WithClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_returnBody
+	p.RuleIndex = CypherParserRULE_withClause
 	return p
 }
 
-func (*ReturnBodyContext) IsReturnBodyContext() {}
+func (*WithClauseContext) IsWithClauseContext() {}
 
-func NewReturnBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnBodyContext {
-	var p = new(ReturnBodyContext)
+func NewWithClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithClauseContext {
+	var p = new(WithClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_returnBody
+	p.RuleIndex = CypherParserRULE_withClause
 
 	return p
 }
 
-func (s *ReturnBodyContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ReturnBodyContext) ReturnItems() IReturnItemsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturnItemsContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *WithClauseContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IReturnItemsContext)
+func (s *WithClauseContext) WITH() antlr.TerminalNode {
+	return s.GetToken(CypherParserWITH, 0)
 }
 
-func (s *ReturnBodyContext) AllSP() []antlr.TerminalNode {
+func (s *WithClauseContext) AllSP() []antlr.TerminalNode {
 	return s.GetTokens(CypherParserSP)
 }
 
-func (s *ReturnBodyContext) SP(i int) antlr.TerminalNode {
+func (s *WithClauseContext) SP(i int) antlr.TerminalNode {
 	return s.GetToken(CypherParserSP, i)
 }
 
-func (s *ReturnBodyContext) OrderClause() IOrderClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrderClauseContext)(nil)).Elem(), 0)
+func (s *WithClauseContext) ReturnBody() IReturnBodyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturnBodyContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IOrderClauseContext)
+	return t.(IReturnBodyContext)
 }
 
-func (s *ReturnBodyContext) SkipClause() ISkipClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISkipClauseContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISkipClauseContext)
+func (s *WithClauseContext) DISTINCT() antlr.TerminalNode {
+	return s.GetToken(CypherParserDISTINCT, 0)
 }
 
-func (s *ReturnBodyContext) LimitClause() ILimitClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILimitClauseContext)(nil)).Elem(), 0)
+func (s *WithClauseContext) WhereClause() IWhereClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhereClauseContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
+	return t.(IWhereClauseContext)
 }
 
-func (s *ReturnBodyContext) GetRuleContext() antlr.RuleContext {
+func (s *WithClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ReturnBodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *WithClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ReturnBodyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *WithClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitReturnBody(s)
+		return t.VisitWithClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) ReturnBody() (localctx IReturnBodyContext) {
-	localctx = NewReturnBodyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, CypherParserRULE_returnBody)
+func (p *CypherParser) WithClause() (localctx IWithClauseContext) {
+	localctx = NewWithClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, CypherParserRULE_withClause)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5657,48 +5915,56 @@ func (p *CypherParser) ReturnBody() (localctx IReturnBodyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(560)
-		p.ReturnItems()
+		p.SetState(602)
+		p.Match(CypherParserWITH)
 	}
-	p.SetState(563)
+	p.SetState(607)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(561)
-			p.Match(CypherParserSP)
-		}
-		{
-			p.SetState(562)
-			p.OrderClause()
-		}
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext()) == 1 {
+		p.SetState(604)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	}
-	p.SetState(567)
-	p.GetErrorHandler().Sync(p)
+		if _la == CypherParserSP {
+			{
+				p.SetState(603)
+				p.Match(CypherParserSP)
+			}
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(565)
-			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(566)
-			p.SkipClause()
+			p.SetState(606)
+			p.Match(CypherParserDISTINCT)
 		}
 
 	}
-	p.SetState(571)
+	{
+		p.SetState(609)
+		p.Match(CypherParserSP)
+	}
+	{
+		p.SetState(610)
+		p.ReturnBody()
+	}
+	p.SetState(615)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(569)
-			p.Match(CypherParserSP)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext()) == 1 {
+		p.SetState(612)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(611)
+				p.Match(CypherParserSP)
+			}
+
 		}
 		{
-			p.SetState(570)
-			p.LimitClause()
+			p.SetState(614)
+			p.WhereClause()
 		}
 
 	}
@@ -5706,96 +5972,91 @@ func (p *CypherParser) ReturnBody() (localctx IReturnBodyContext) {
 	return localctx
 }
 
-// IReturnItemsContext is an interface to support dynamic dispatch.
-type IReturnItemsContext interface {
+// IReturnClauseContext is an interface to support dynamic dispatch.
+type IReturnClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsReturnItemsContext differentiates from other interfaces.
-	IsReturnItemsContext()
+	// IsReturnClauseContext differentiates from other interfaces.
+	IsReturnClauseContext()
 }
 
-type ReturnItemsContext struct {
+type ReturnClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyReturnItemsContext() *ReturnItemsContext {
-	var p = new(ReturnItemsContext)
+func NewEmptyReturnClauseContext() *ReturnClauseContext {
+	var p = new(ReturnClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_returnItems
+	p.RuleIndex = CypherParserRULE_returnClause
 	return p
 }
 
-func (*ReturnItemsContext) IsReturnItemsContext() {}
+func (*ReturnClauseContext) IsReturnClauseContext() {}
 
-func NewReturnItemsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnItemsContext {
-	var p = new(ReturnItemsContext)
+func NewReturnClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnClauseContext {
+	var p = new(ReturnClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_returnItems
+	p.RuleIndex = CypherParserRULE_returnClause
 
 	return p
 }
 
-func (s *ReturnItemsContext) GetParser() antlr.Parser { return s.parser }
+func (s *ReturnClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ReturnItemsContext) AllReturnItem() []IReturnItemContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IReturnItemContext)(nil)).Elem())
-	var tst = make([]IReturnItemContext, len(ts))
+func (s *ReturnClauseContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(CypherParserRETURN, 0)
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IReturnItemContext)
-		}
-	}
+func (s *ReturnClauseContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
 
-	return tst
+func (s *ReturnClauseContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
 }
 
-func (s *ReturnItemsContext) ReturnItem(i int) IReturnItemContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturnItemContext)(nil)).Elem(), i)
+func (s *ReturnClauseContext) ReturnBody() IReturnBodyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturnBodyContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IReturnItemContext)
-}
-
-func (s *ReturnItemsContext) AllSP() []antlr.TerminalNode {
-	return s.GetTokens(CypherParserSP)
+	return t.(IReturnBodyContext)
 }
 
-func (s *ReturnItemsContext) SP(i int) antlr.TerminalNode {
-	return s.GetToken(CypherParserSP, i)
+func (s *ReturnClauseContext) DISTINCT() antlr.TerminalNode {
+	return s.GetToken(CypherParserDISTINCT, 0)
 }
 
-func (s *ReturnItemsContext) GetRuleContext() antlr.RuleContext {
+func (s *ReturnClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ReturnItemsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ReturnClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ReturnItemsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ReturnClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitReturnItems(s)
+		return t.VisitReturnClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) ReturnItems() (localctx IReturnItemsContext) {
-	localctx = NewReturnItemsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, CypherParserRULE_returnItems)
+func (p *CypherParser) ReturnClause() (localctx IReturnClauseContext) {
+	localctx = NewReturnClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, CypherParserRULE_returnClause)
 	var _la int
 
 	defer func() {
@@ -5814,208 +6075,151 @@ func (p *CypherParser) ReturnItems() (localctx IReturnItemsContext) {
 		}
 	}()
 
-	var _alt int
-
-	p.SetState(601)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(617)
+		p.Match(CypherParserRETURN)
+	}
+	p.SetState(622)
 	p.GetErrorHandler().Sync(p)
 
-	switch p.GetTokenStream().LA(1) {
-	case CypherParserT__4:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(573)
-			p.Match(CypherParserT__4)
-		}
-		p.SetState(584)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 82, p.GetParserRuleContext()) == 1 {
+		p.SetState(619)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext())
-
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				p.SetState(575)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
-
-				if _la == CypherParserSP {
-					{
-						p.SetState(574)
-						p.Match(CypherParserSP)
-					}
-
-				}
-				{
-					p.SetState(577)
-					p.Match(CypherParserT__1)
-				}
-				p.SetState(579)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
-
-				if _la == CypherParserSP {
-					{
-						p.SetState(578)
-						p.Match(CypherParserSP)
-					}
-
-				}
-				{
-					p.SetState(581)
-					p.ReturnItem()
-				}
+		_la = p.GetTokenStream().LA(1)
 
+		if _la == CypherParserSP {
+			{
+				p.SetState(618)
+				p.Match(CypherParserSP)
 			}
-			p.SetState(586)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext())
-		}
 
-	case CypherParserT__5, CypherParserT__7, CypherParserT__12, CypherParserT__13, CypherParserT__23, CypherParserT__25, CypherParserALL, CypherParserNOT, CypherParserNULL, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserTRUE, CypherParserFALSE, CypherParserEXISTS, CypherParserCASE, CypherParserStringLiteral, CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger, CypherParserHexLetter, CypherParserExponentDecimalReal, CypherParserRegularDecimalReal, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
-		p.EnterOuterAlt(localctx, 2)
+		}
 		{
-			p.SetState(587)
-			p.ReturnItem()
+			p.SetState(621)
+			p.Match(CypherParserDISTINCT)
 		}
-		p.SetState(598)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext())
 
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				p.SetState(589)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
-
-				if _la == CypherParserSP {
-					{
-						p.SetState(588)
-						p.Match(CypherParserSP)
-					}
-
-				}
-				{
-					p.SetState(591)
-					p.Match(CypherParserT__1)
-				}
-				p.SetState(593)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
-
-				if _la == CypherParserSP {
-					{
-						p.SetState(592)
-						p.Match(CypherParserSP)
-					}
-
-				}
-				{
-					p.SetState(595)
-					p.ReturnItem()
-				}
-
-			}
-			p.SetState(600)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext())
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-	}
+	}
+	{
+		p.SetState(624)
+		p.Match(CypherParserSP)
+	}
+	{
+		p.SetState(625)
+		p.ReturnBody()
+	}
 
 	return localctx
 }
 
-// IReturnItemContext is an interface to support dynamic dispatch.
-type IReturnItemContext interface {
+// IReturnBodyContext is an interface to support dynamic dispatch.
+type IReturnBodyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsReturnItemContext differentiates from other interfaces.
-	IsReturnItemContext()
+	// IsReturnBodyContext differentiates from other interfaces.
+	IsReturnBodyContext()
 }
 
-type ReturnItemContext struct {
+type ReturnBodyContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyReturnItemContext() *ReturnItemContext {
-	var p = new(ReturnItemContext)
+func NewEmptyReturnBodyContext() *ReturnBodyContext {
+	var p = new(ReturnBodyContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_returnItem
+	p.RuleIndex = CypherParserRULE_returnBody
 	return p
 }
 
-func (*ReturnItemContext) IsReturnItemContext() {}
+func (*ReturnBodyContext) IsReturnBodyContext() {}
 
-func NewReturnItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnItemContext {
-	var p = new(ReturnItemContext)
+func NewReturnBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnBodyContext {
+	var p = new(ReturnBodyContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_returnItem
+	p.RuleIndex = CypherParserRULE_returnBody
 
 	return p
 }
 
-func (s *ReturnItemContext) GetParser() antlr.Parser { return s.parser }
+func (s *ReturnBodyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ReturnItemContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *ReturnBodyContext) ReturnItems() IReturnItemsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturnItemsContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IReturnItemsContext)
 }
 
-func (s *ReturnItemContext) AllSP() []antlr.TerminalNode {
+func (s *ReturnBodyContext) AllSP() []antlr.TerminalNode {
 	return s.GetTokens(CypherParserSP)
 }
 
-func (s *ReturnItemContext) SP(i int) antlr.TerminalNode {
+func (s *ReturnBodyContext) SP(i int) antlr.TerminalNode {
 	return s.GetToken(CypherParserSP, i)
 }
 
-func (s *ReturnItemContext) AS() antlr.TerminalNode {
-	return s.GetToken(CypherParserAS, 0)
+func (s *ReturnBodyContext) OrderClause() IOrderClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrderClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrderClauseContext)
 }
 
-func (s *ReturnItemContext) Variable() IVariableContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
+func (s *ReturnBodyContext) SkipClause() ISkipClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISkipClauseContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IVariableContext)
+	return t.(ISkipClauseContext)
 }
 
-func (s *ReturnItemContext) GetRuleContext() antlr.RuleContext {
+func (s *ReturnBodyContext) LimitClause() ILimitClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILimitClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ReturnBodyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ReturnItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ReturnBodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ReturnItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ReturnBodyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitReturnItem(s)
+		return t.VisitReturnBody(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) ReturnItem() (localctx IReturnItemContext) {
-	localctx = NewReturnItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, CypherParserRULE_returnItem)
+func (p *CypherParser) ReturnBody() (localctx IReturnBodyContext) {
+	localctx = NewReturnBodyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, CypherParserRULE_returnBody)
 
 	defer func() {
 		p.ExitRule()
@@ -6033,37 +6237,50 @@ func (p *CypherParser) ReturnItem() (localctx IReturnItemContext) {
 		}
 	}()
 
-	p.SetState(610)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(627)
+		p.ReturnItems()
+	}
+	p.SetState(630)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 83, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(603)
-			p.Expr()
-		}
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 83, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(604)
+			p.SetState(628)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(605)
-			p.Match(CypherParserAS)
+			p.SetState(629)
+			p.OrderClause()
 		}
+
+	}
+	p.SetState(634)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 84, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(606)
+			p.SetState(632)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(607)
-			p.Variable()
+			p.SetState(633)
+			p.SkipClause()
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
+	}
+	p.SetState(638)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 85, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(609)
-			p.Expr()
+			p.SetState(636)
+			p.Match(CypherParserSP)
+		}
+		{
+			p.SetState(637)
+			p.LimitClause()
 		}
 
 	}
@@ -6071,104 +6288,96 @@ func (p *CypherParser) ReturnItem() (localctx IReturnItemContext) {
 	return localctx
 }
 
-// IOrderClauseContext is an interface to support dynamic dispatch.
-type IOrderClauseContext interface {
+// IReturnItemsContext is an interface to support dynamic dispatch.
+type IReturnItemsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsOrderClauseContext differentiates from other interfaces.
-	IsOrderClauseContext()
+	// IsReturnItemsContext differentiates from other interfaces.
+	IsReturnItemsContext()
 }
 
-type OrderClauseContext struct {
+type ReturnItemsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyOrderClauseContext() *OrderClauseContext {
-	var p = new(OrderClauseContext)
+func NewEmptyReturnItemsContext() *ReturnItemsContext {
+	var p = new(ReturnItemsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_orderClause
+	p.RuleIndex = CypherParserRULE_returnItems
 	return p
 }
 
-func (*OrderClauseContext) IsOrderClauseContext() {}
+func (*ReturnItemsContext) IsReturnItemsContext() {}
 
-func NewOrderClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrderClauseContext {
-	var p = new(OrderClauseContext)
+func NewReturnItemsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnItemsContext {
+	var p = new(ReturnItemsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_orderClause
+	p.RuleIndex = CypherParserRULE_returnItems
 
 	return p
 }
 
-func (s *OrderClauseContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *OrderClauseContext) ORDER() antlr.TerminalNode {
-	return s.GetToken(CypherParserORDER, 0)
-}
-
-func (s *OrderClauseContext) AllSP() []antlr.TerminalNode {
-	return s.GetTokens(CypherParserSP)
-}
-
-func (s *OrderClauseContext) SP(i int) antlr.TerminalNode {
-	return s.GetToken(CypherParserSP, i)
-}
-
-func (s *OrderClauseContext) BY() antlr.TerminalNode {
-	return s.GetToken(CypherParserBY, 0)
-}
+func (s *ReturnItemsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OrderClauseContext) AllSortItem() []ISortItemContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISortItemContext)(nil)).Elem())
-	var tst = make([]ISortItemContext, len(ts))
+func (s *ReturnItemsContext) AllReturnItem() []IReturnItemContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IReturnItemContext)(nil)).Elem())
+	var tst = make([]IReturnItemContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(ISortItemContext)
+			tst[i] = t.(IReturnItemContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *OrderClauseContext) SortItem(i int) ISortItemContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISortItemContext)(nil)).Elem(), i)
+func (s *ReturnItemsContext) ReturnItem(i int) IReturnItemContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturnItemContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISortItemContext)
+	return t.(IReturnItemContext)
 }
 
-func (s *OrderClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *ReturnItemsContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
+
+func (s *ReturnItemsContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *ReturnItemsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OrderClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ReturnItemsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OrderClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ReturnItemsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitOrderClause(s)
+		return t.VisitReturnItems(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) OrderClause() (localctx IOrderClauseContext) {
-	localctx = NewOrderClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, CypherParserRULE_orderClause)
+func (p *CypherParser) ReturnItems() (localctx IReturnItemsContext) {
+	localctx = NewReturnItemsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, CypherParserRULE_returnItems)
 	var _la int
 
 	defer func() {
@@ -6187,137 +6396,208 @@ func (p *CypherParser) OrderClause() (localctx IOrderClauseContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(612)
-		p.Match(CypherParserORDER)
-	}
-	{
-		p.SetState(613)
-		p.Match(CypherParserSP)
-	}
-	{
-		p.SetState(614)
-		p.Match(CypherParserBY)
-	}
-	{
-		p.SetState(615)
-		p.Match(CypherParserSP)
-	}
-	{
-		p.SetState(616)
-		p.SortItem()
-	}
-	p.SetState(624)
+	var _alt int
+
+	p.SetState(668)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == CypherParserT__1 {
+	switch p.GetTokenStream().LA(1) {
+	case CypherParserT__6:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(617)
-			p.Match(CypherParserT__1)
+			p.SetState(640)
+			p.Match(CypherParserT__6)
 		}
-		p.SetState(619)
+		p.SetState(651)
 		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext())
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(618)
-				p.Match(CypherParserSP)
-			}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				p.SetState(642)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
 
-		}
-		{
-			p.SetState(621)
-			p.SortItem()
-		}
+				if _la == CypherParserSP {
+					{
+						p.SetState(641)
+						p.Match(CypherParserSP)
+					}
 
-		p.SetState(626)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+				}
+				{
+					p.SetState(644)
+					p.Match(CypherParserT__1)
+				}
+				p.SetState(646)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == CypherParserSP {
+					{
+						p.SetState(645)
+						p.Match(CypherParserSP)
+					}
+
+				}
+				{
+					p.SetState(648)
+					p.ReturnItem()
+				}
+
+			}
+			p.SetState(653)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext())
+		}
+
+	case CypherParserT__4, CypherParserT__7, CypherParserT__9, CypherParserT__14, CypherParserT__15, CypherParserT__25, CypherParserALL, CypherParserCONCURRENT, CypherParserTRANSACTIONS, CypherParserROW, CypherParserROWS, CypherParserERROR, CypherParserCONTINUE, CypherParserBREAK, CypherParserFAIL, CypherParserNOT, CypherParserNULL, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserTRUE, CypherParserFALSE, CypherParserEXISTS, CypherParserCASE, CypherParserStringLiteral, CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger, CypherParserHexLetter, CypherParserExponentDecimalReal, CypherParserRegularDecimalReal, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(654)
+			p.ReturnItem()
+		}
+		p.SetState(665)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 91, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				p.SetState(656)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == CypherParserSP {
+					{
+						p.SetState(655)
+						p.Match(CypherParserSP)
+					}
+
+				}
+				{
+					p.SetState(658)
+					p.Match(CypherParserT__1)
+				}
+				p.SetState(660)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == CypherParserSP {
+					{
+						p.SetState(659)
+						p.Match(CypherParserSP)
+					}
+
+				}
+				{
+					p.SetState(662)
+					p.ReturnItem()
+				}
+
+			}
+			p.SetState(667)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 91, p.GetParserRuleContext())
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// ISkipClauseContext is an interface to support dynamic dispatch.
-type ISkipClauseContext interface {
+// IReturnItemContext is an interface to support dynamic dispatch.
+type IReturnItemContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSkipClauseContext differentiates from other interfaces.
-	IsSkipClauseContext()
+	// IsReturnItemContext differentiates from other interfaces.
+	IsReturnItemContext()
 }
 
-type SkipClauseContext struct {
+type ReturnItemContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySkipClauseContext() *SkipClauseContext {
-	var p = new(SkipClauseContext)
+func NewEmptyReturnItemContext() *ReturnItemContext {
+	var p = new(ReturnItemContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_skipClause
+	p.RuleIndex = CypherParserRULE_returnItem
 	return p
 }
 
-func (*SkipClauseContext) IsSkipClauseContext() {}
+func (*ReturnItemContext) IsReturnItemContext() {}
 
-func NewSkipClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SkipClauseContext {
-	var p = new(SkipClauseContext)
+func NewReturnItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnItemContext {
+	var p = new(ReturnItemContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_skipClause
+	p.RuleIndex = CypherParserRULE_returnItem
 
 	return p
 }
 
-func (s *SkipClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *ReturnItemContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SkipClauseContext) L_SKIP() antlr.TerminalNode {
-	return s.GetToken(CypherParserL_SKIP, 0)
+func (s *ReturnItemContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
 }
 
-func (s *SkipClauseContext) SP() antlr.TerminalNode {
-	return s.GetToken(CypherParserSP, 0)
+func (s *ReturnItemContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
 }
 
-func (s *SkipClauseContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *ReturnItemContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *ReturnItemContext) AS() antlr.TerminalNode {
+	return s.GetToken(CypherParserAS, 0)
+}
+
+func (s *ReturnItemContext) Variable() IVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IVariableContext)
 }
 
-func (s *SkipClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *ReturnItemContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SkipClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ReturnItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SkipClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ReturnItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitSkipClause(s)
+		return t.VisitReturnItem(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) SkipClause() (localctx ISkipClauseContext) {
-	localctx = NewSkipClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, CypherParserRULE_skipClause)
+func (p *CypherParser) ReturnItem() (localctx IReturnItemContext) {
+	localctx = NewReturnItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, CypherParserRULE_returnItem)
 
 	defer func() {
 		p.ExitRule()
@@ -6335,100 +6615,143 @@ func (p *CypherParser) SkipClause() (localctx ISkipClauseContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(627)
-		p.Match(CypherParserL_SKIP)
-	}
-	{
-		p.SetState(628)
-		p.Match(CypherParserSP)
-	}
-	{
-		p.SetState(629)
-		p.Expr()
+	p.SetState(677)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(670)
+			p.Expr()
+		}
+		{
+			p.SetState(671)
+			p.Match(CypherParserSP)
+		}
+		{
+			p.SetState(672)
+			p.Match(CypherParserAS)
+		}
+		{
+			p.SetState(673)
+			p.Match(CypherParserSP)
+		}
+		{
+			p.SetState(674)
+			p.Variable()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(676)
+			p.Expr()
+		}
+
 	}
 
 	return localctx
 }
 
-// ILimitClauseContext is an interface to support dynamic dispatch.
-type ILimitClauseContext interface {
+// IOrderClauseContext is an interface to support dynamic dispatch.
+type IOrderClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsLimitClauseContext differentiates from other interfaces.
-	IsLimitClauseContext()
+	// IsOrderClauseContext differentiates from other interfaces.
+	IsOrderClauseContext()
 }
 
-type LimitClauseContext struct {
+type OrderClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLimitClauseContext() *LimitClauseContext {
-	var p = new(LimitClauseContext)
+func NewEmptyOrderClauseContext() *OrderClauseContext {
+	var p = new(OrderClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CypherParserRULE_limitClause
+	p.RuleIndex = CypherParserRULE_orderClause
 	return p
 }
 
-func (*LimitClauseContext) IsLimitClauseContext() {}
+func (*OrderClauseContext) IsOrderClauseContext() {}
 
-func NewLimitClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LimitClauseContext {
-	var p = new(LimitClauseContext)
+func NewOrderClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrderClauseContext {
+	var p = new(OrderClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CypherParserRULE_limitClause
+	p.RuleIndex = CypherParserRULE_orderClause
 
 	return p
 }
 
-func (s *LimitClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *OrderClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LimitClauseContext) LIMIT() antlr.TerminalNode {
-	return s.GetToken(CypherParserLIMIT, 0)
+func (s *OrderClauseContext) ORDER() antlr.TerminalNode {
+	return s.GetToken(CypherParserORDER, 0)
 }
 
-func (s *LimitClauseContext) SP() antlr.TerminalNode {
-	return s.GetToken(CypherParserSP, 0)
+func (s *OrderClauseContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
 }
 
-func (s *LimitClauseContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *OrderClauseContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *OrderClauseContext) BY() antlr.TerminalNode {
+	return s.GetToken(CypherParserBY, 0)
+}
+
+func (s *OrderClauseContext) AllSortItem() []ISortItemContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISortItemContext)(nil)).Elem())
+	var tst = make([]ISortItemContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISortItemContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *OrderClauseContext) SortItem(i int) ISortItemContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISortItemContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(ISortItemContext)
 }
 
-func (s *LimitClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *OrderClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LimitClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OrderClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LimitClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OrderClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CypherVisitor:
-		return t.VisitLimitClause(s)
+		return t.VisitOrderClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CypherParser) LimitClause() (localctx ILimitClauseContext) {
-	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, CypherParserRULE_limitClause)
+func (p *CypherParser) OrderClause() (localctx IOrderClauseContext) {
+	localctx = NewOrderClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, CypherParserRULE_orderClause)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -6448,24 +6771,283 @@ func (p *CypherParser) LimitClause() (localctx ILimitClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(631)
-		p.Match(CypherParserLIMIT)
+		p.SetState(679)
+		p.Match(CypherParserORDER)
 	}
 	{
-		p.SetState(632)
+		p.SetState(680)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(633)
-		p.Expr()
+		p.SetState(681)
+		p.Match(CypherParserBY)
 	}
+	{
+		p.SetState(682)
+		p.Match(CypherParserSP)
+	}
+	{
+		p.SetState(683)
+		p.SortItem()
+	}
+	p.SetState(691)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	return localctx
-}
-
-// ISortItemContext is an interface to support dynamic dispatch.
-type ISortItemContext interface {
-	antlr.ParserRuleContext
+	for _la == CypherParserT__1 {
+		{
+			p.SetState(684)
+			p.Match(CypherParserT__1)
+		}
+		p.SetState(686)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(685)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(688)
+			p.SortItem()
+		}
+
+		p.SetState(693)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ISkipClauseContext is an interface to support dynamic dispatch.
+type ISkipClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSkipClauseContext differentiates from other interfaces.
+	IsSkipClauseContext()
+}
+
+type SkipClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySkipClauseContext() *SkipClauseContext {
+	var p = new(SkipClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CypherParserRULE_skipClause
+	return p
+}
+
+func (*SkipClauseContext) IsSkipClauseContext() {}
+
+func NewSkipClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SkipClauseContext {
+	var p = new(SkipClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CypherParserRULE_skipClause
+
+	return p
+}
+
+func (s *SkipClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SkipClauseContext) L_SKIP() antlr.TerminalNode {
+	return s.GetToken(CypherParserL_SKIP, 0)
+}
+
+func (s *SkipClauseContext) SP() antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, 0)
+}
+
+func (s *SkipClauseContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *SkipClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SkipClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SkipClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CypherVisitor:
+		return t.VisitSkipClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CypherParser) SkipClause() (localctx ISkipClauseContext) {
+	localctx = NewSkipClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, CypherParserRULE_skipClause)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(694)
+		p.Match(CypherParserL_SKIP)
+	}
+	{
+		p.SetState(695)
+		p.Match(CypherParserSP)
+	}
+	{
+		p.SetState(696)
+		p.Expr()
+	}
+
+	return localctx
+}
+
+// ILimitClauseContext is an interface to support dynamic dispatch.
+type ILimitClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsLimitClauseContext differentiates from other interfaces.
+	IsLimitClauseContext()
+}
+
+type LimitClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLimitClauseContext() *LimitClauseContext {
+	var p = new(LimitClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CypherParserRULE_limitClause
+	return p
+}
+
+func (*LimitClauseContext) IsLimitClauseContext() {}
+
+func NewLimitClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LimitClauseContext {
+	var p = new(LimitClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CypherParserRULE_limitClause
+
+	return p
+}
+
+func (s *LimitClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LimitClauseContext) LIMIT() antlr.TerminalNode {
+	return s.GetToken(CypherParserLIMIT, 0)
+}
+
+func (s *LimitClauseContext) SP() antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, 0)
+}
+
+func (s *LimitClauseContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *LimitClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LimitClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LimitClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CypherVisitor:
+		return t.VisitLimitClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CypherParser) LimitClause() (localctx ILimitClauseContext) {
+	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, CypherParserRULE_limitClause)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(698)
+		p.Match(CypherParserLIMIT)
+	}
+	{
+		p.SetState(699)
+		p.Match(CypherParserSP)
+	}
+	{
+		p.SetState(700)
+		p.Expr()
+	}
+
+	return localctx
+}
+
+// ISortItemContext is an interface to support dynamic dispatch.
+type ISortItemContext interface {
+	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
@@ -6551,7 +7133,7 @@ func (s *SortItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) SortItem() (localctx ISortItemContext) {
 	localctx = NewSortItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, CypherParserRULE_sortItem)
+	p.EnterRule(localctx, 70, CypherParserRULE_sortItem)
 	var _la int
 
 	defer func() {
@@ -6572,28 +7154,28 @@ func (p *CypherParser) SortItem() (localctx ISortItemContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(635)
+		p.SetState(702)
 		p.Expr()
 	}
-	p.SetState(640)
+	p.SetState(707)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 87, p.GetParserRuleContext()) == 1 {
-		p.SetState(637)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 97, p.GetParserRuleContext()) == 1 {
+		p.SetState(704)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(636)
+				p.SetState(703)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(639)
+		p.SetState(706)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CypherParserASCENDING-68))|(1<<(CypherParserASC-68))|(1<<(CypherParserDESCENDING-68))|(1<<(CypherParserDESC-68)))) != 0) {
+		if !(((_la-76)&-(0x1f+1)) == 0 && ((1<<uint((_la-76)))&((1<<(CypherParserASCENDING-76))|(1<<(CypherParserASC-76))|(1<<(CypherParserDESCENDING-76))|(1<<(CypherParserDESC-76)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -6681,7 +7263,7 @@ func (s *WhereClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) WhereClause() (localctx IWhereClauseContext) {
 	localctx = NewWhereClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, CypherParserRULE_whereClause)
+	p.EnterRule(localctx, 72, CypherParserRULE_whereClause)
 
 	defer func() {
 		p.ExitRule()
@@ -6701,15 +7283,15 @@ func (p *CypherParser) WhereClause() (localctx IWhereClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(642)
+		p.SetState(709)
 		p.Match(CypherParserWHERE)
 	}
 	{
-		p.SetState(643)
+		p.SetState(710)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(644)
+		p.SetState(711)
 		p.Expr()
 	}
 
@@ -6805,7 +7387,7 @@ func (s *PatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) Pattern() (localctx IPatternContext) {
 	localctx = NewPatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, CypherParserRULE_pattern)
+	p.EnterRule(localctx, 74, CypherParserRULE_pattern)
 	var _la int
 
 	defer func() {
@@ -6828,50 +7410,50 @@ func (p *CypherParser) Pattern() (localctx IPatternContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(646)
+		p.SetState(713)
 		p.PatternPart()
 	}
-	p.SetState(657)
+	p.SetState(724)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 90, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 100, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(648)
+			p.SetState(715)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(647)
+					p.SetState(714)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(650)
+				p.SetState(717)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(652)
+			p.SetState(719)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(651)
+					p.SetState(718)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(654)
+				p.SetState(721)
 				p.PatternPart()
 			}
 
 		}
-		p.SetState(659)
+		p.SetState(726)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 90, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 100, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -6963,7 +7545,7 @@ func (s *PatternPartContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) PatternPart() (localctx IPatternPartContext) {
 	localctx = NewPatternPartContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, CypherParserRULE_patternPart)
+	p.EnterRule(localctx, 76, CypherParserRULE_patternPart)
 	var _la int
 
 	defer func() {
@@ -6982,51 +7564,51 @@ func (p *CypherParser) PatternPart() (localctx IPatternPartContext) {
 		}
 	}()
 
-	p.SetState(671)
+	p.SetState(738)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserCONCURRENT, CypherParserTRANSACTIONS, CypherParserROW, CypherParserROWS, CypherParserERROR, CypherParserCONTINUE, CypherParserBREAK, CypherParserFAIL, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(660)
+			p.SetState(727)
 			p.Variable()
 		}
-		p.SetState(662)
+		p.SetState(729)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(661)
+				p.SetState(728)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(664)
+			p.SetState(731)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(666)
+		p.SetState(733)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(665)
+				p.SetState(732)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(668)
+			p.SetState(735)
 			p.AnonymousPatternPart()
 		}
 
-	case CypherParserT__5:
+	case CypherParserT__7:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(670)
+			p.SetState(737)
 			p.AnonymousPatternPart()
 		}
 
@@ -7105,7 +7687,7 @@ func (s *AnonymousPatternPartContext) Accept(visitor antlr.ParseTreeVisitor) int
 
 func (p *CypherParser) AnonymousPatternPart() (localctx IAnonymousPatternPartContext) {
 	localctx = NewAnonymousPatternPartContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, CypherParserRULE_anonymousPatternPart)
+	p.EnterRule(localctx, 78, CypherParserRULE_anonymousPatternPart)
 
 	defer func() {
 		p.ExitRule()
@@ -7125,7 +7707,7 @@ func (p *CypherParser) AnonymousPatternPart() (localctx IAnonymousPatternPartCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(673)
+		p.SetState(740)
 		p.PatternElement()
 	}
 
@@ -7241,7 +7823,7 @@ func (s *PatternElementContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *CypherParser) PatternElement() (localctx IPatternElementContext) {
 	localctx = NewPatternElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, CypherParserRULE_patternElement)
+	p.EnterRule(localctx, 80, CypherParserRULE_patternElement)
 	var _la int
 
 	defer func() {
@@ -7262,56 +7844,56 @@ func (p *CypherParser) PatternElement() (localctx IPatternElementContext) {
 
 	var _alt int
 
-	p.SetState(689)
+	p.SetState(756)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 96, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(675)
+			p.SetState(742)
 			p.NodePattern()
 		}
-		p.SetState(682)
+		p.SetState(749)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 95, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 105, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(677)
+				p.SetState(744)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(676)
+						p.SetState(743)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(679)
+					p.SetState(746)
 					p.PatternElementChain()
 				}
 
 			}
-			p.SetState(684)
+			p.SetState(751)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 95, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 105, p.GetParserRuleContext())
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(685)
-			p.Match(CypherParserT__5)
+			p.SetState(752)
+			p.Match(CypherParserT__7)
 		}
 		{
-			p.SetState(686)
+			p.SetState(753)
 			p.PatternElement()
 		}
 		{
-			p.SetState(687)
-			p.Match(CypherParserT__6)
+			p.SetState(754)
+			p.Match(CypherParserT__8)
 		}
 
 	}
@@ -7415,7 +7997,7 @@ func (s *NodePatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) NodePattern() (localctx INodePatternContext) {
 	localctx = NewNodePatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, CypherParserRULE_nodePattern)
+	p.EnterRule(localctx, 82, CypherParserRULE_nodePattern)
 	var _la int
 
 	defer func() {
@@ -7436,80 +8018,80 @@ func (p *CypherParser) NodePattern() (localctx INodePatternContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(691)
-		p.Match(CypherParserT__5)
+		p.SetState(758)
+		p.Match(CypherParserT__7)
 	}
-	p.SetState(693)
+	p.SetState(760)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(692)
+			p.SetState(759)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(699)
+	p.SetState(766)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la-61)&-(0x1f+1)) == 0 && ((1<<uint((_la-61)))&((1<<(CypherParserCONCURRENT-61))|(1<<(CypherParserTRANSACTIONS-61))|(1<<(CypherParserROW-61))|(1<<(CypherParserROWS-61))|(1<<(CypherParserERROR-61))|(1<<(CypherParserCONTINUE-61))|(1<<(CypherParserBREAK-61))|(1<<(CypherParserFAIL-61))|(1<<(CypherParserCOUNT-61))|(1<<(CypherParserANY-61)))) != 0) || (((_la-93)&-(0x1f+1)) == 0 && ((1<<uint((_la-93)))&((1<<(CypherParserNONE-93))|(1<<(CypherParserSINGLE-93))|(1<<(CypherParserHexLetter-93)))) != 0) || (((_la-127)&-(0x1f+1)) == 0 && ((1<<uint((_la-127)))&((1<<(CypherParserFILTER-127))|(1<<(CypherParserEXTRACT-127))|(1<<(CypherParserUnescapedSymbolicName-127))|(1<<(CypherParserEscapedSymbolicName-127)))) != 0) {
 		{
-			p.SetState(695)
+			p.SetState(762)
 			p.Variable()
 		}
-		p.SetState(697)
+		p.SetState(764)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(696)
+				p.SetState(763)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(705)
+	p.SetState(772)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CypherParserT__9 {
+	if _la == CypherParserT__11 {
 		{
-			p.SetState(701)
+			p.SetState(768)
 			p.NodeLabels()
 		}
-		p.SetState(703)
+		p.SetState(770)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(702)
+				p.SetState(769)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(711)
+	p.SetState(778)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CypherParserT__23 || _la == CypherParserT__25 {
+	if _la == CypherParserT__4 || _la == CypherParserT__25 {
 		{
-			p.SetState(707)
+			p.SetState(774)
 			p.Properties()
 		}
-		p.SetState(709)
+		p.SetState(776)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(708)
+				p.SetState(775)
 				p.Match(CypherParserSP)
 			}
 
@@ -7517,8 +8099,8 @@ func (p *CypherParser) NodePattern() (localctx INodePatternContext) {
 
 	}
 	{
-		p.SetState(713)
-		p.Match(CypherParserT__6)
+		p.SetState(780)
+		p.Match(CypherParserT__8)
 	}
 
 	return localctx
@@ -7606,7 +8188,7 @@ func (s *PatternElementChainContext) Accept(visitor antlr.ParseTreeVisitor) inte
 
 func (p *CypherParser) PatternElementChain() (localctx IPatternElementChainContext) {
 	localctx = NewPatternElementChainContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, CypherParserRULE_patternElementChain)
+	p.EnterRule(localctx, 84, CypherParserRULE_patternElementChain)
 	var _la int
 
 	defer func() {
@@ -7627,22 +8209,22 @@ func (p *CypherParser) PatternElementChain() (localctx IPatternElementChainConte
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(715)
+		p.SetState(782)
 		p.RelationshipPattern()
 	}
-	p.SetState(717)
+	p.SetState(784)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(716)
+			p.SetState(783)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(719)
+		p.SetState(786)
 		p.NodePattern()
 	}
 
@@ -7768,7 +8350,7 @@ func (s *RelationshipPatternContext) Accept(visitor antlr.ParseTreeVisitor) inte
 
 func (p *CypherParser) RelationshipPattern() (localctx IRelationshipPatternContext) {
 	localctx = NewRelationshipPatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, CypherParserRULE_relationshipPattern)
+	p.EnterRule(localctx, 86, CypherParserRULE_relationshipPattern)
 	var _la int
 
 	defer func() {
@@ -7787,238 +8369,238 @@ func (p *CypherParser) RelationshipPattern() (localctx IRelationshipPatternConte
 		}
 	}()
 
-	p.SetState(785)
+	p.SetState(852)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 121, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 131, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(721)
+			p.SetState(788)
 			p.LeftArrowHead()
 		}
-		p.SetState(723)
+		p.SetState(790)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(722)
+				p.SetState(789)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(725)
+			p.SetState(792)
 			p.Dash()
 		}
-		p.SetState(727)
+		p.SetState(794)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 116, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(726)
+				p.SetState(793)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(730)
+		p.SetState(797)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == CypherParserT__7 {
+		if _la == CypherParserT__9 {
 			{
-				p.SetState(729)
+				p.SetState(796)
 				p.RelationshipDetail()
 			}
 
 		}
-		p.SetState(733)
+		p.SetState(800)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(732)
+				p.SetState(799)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(735)
+			p.SetState(802)
 			p.Dash()
 		}
-		p.SetState(737)
+		p.SetState(804)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(736)
+				p.SetState(803)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(739)
+			p.SetState(806)
 			p.RightArrowHead()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(741)
+			p.SetState(808)
 			p.LeftArrowHead()
 		}
-		p.SetState(743)
+		p.SetState(810)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(742)
+				p.SetState(809)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(745)
+			p.SetState(812)
 			p.Dash()
 		}
-		p.SetState(747)
+		p.SetState(814)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 111, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 121, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(746)
+				p.SetState(813)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(750)
+		p.SetState(817)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == CypherParserT__7 {
+		if _la == CypherParserT__9 {
 			{
-				p.SetState(749)
+				p.SetState(816)
 				p.RelationshipDetail()
 			}
 
 		}
-		p.SetState(753)
+		p.SetState(820)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(752)
+				p.SetState(819)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(755)
+			p.SetState(822)
 			p.Dash()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(757)
+			p.SetState(824)
 			p.Dash()
 		}
-		p.SetState(759)
+		p.SetState(826)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 114, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 124, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(758)
+				p.SetState(825)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(762)
+		p.SetState(829)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == CypherParserT__7 {
+		if _la == CypherParserT__9 {
 			{
-				p.SetState(761)
+				p.SetState(828)
 				p.RelationshipDetail()
 			}
 
 		}
-		p.SetState(765)
+		p.SetState(832)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(764)
+				p.SetState(831)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(767)
+			p.SetState(834)
 			p.Dash()
 		}
-		p.SetState(769)
+		p.SetState(836)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(768)
+				p.SetState(835)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(771)
+			p.SetState(838)
 			p.RightArrowHead()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(773)
+			p.SetState(840)
 			p.Dash()
 		}
-		p.SetState(775)
+		p.SetState(842)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 118, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 128, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(774)
+				p.SetState(841)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(778)
+		p.SetState(845)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == CypherParserT__7 {
+		if _la == CypherParserT__9 {
 			{
-				p.SetState(777)
+				p.SetState(844)
 				p.RelationshipDetail()
 			}
 
 		}
-		p.SetState(781)
+		p.SetState(848)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(780)
+				p.SetState(847)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(783)
+			p.SetState(850)
 			p.Dash()
 		}
 
@@ -8133,7 +8715,7 @@ func (s *RelationshipDetailContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *CypherParser) RelationshipDetail() (localctx IRelationshipDetailContext) {
 	localctx = NewRelationshipDetailContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, CypherParserRULE_relationshipDetail)
+	p.EnterRule(localctx, 88, CypherParserRULE_relationshipDetail)
 	var _la int
 
 	defer func() {
@@ -8154,91 +8736,91 @@ func (p *CypherParser) RelationshipDetail() (localctx IRelationshipDetailContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(787)
-		p.Match(CypherParserT__7)
+		p.SetState(854)
+		p.Match(CypherParserT__9)
 	}
-	p.SetState(789)
+	p.SetState(856)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(788)
+			p.SetState(855)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(795)
+	p.SetState(862)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la-61)&-(0x1f+1)) == 0 && ((1<<uint((_la-61)))&((1<<(CypherParserCONCURRENT-61))|(1<<(CypherParserTRANSACTIONS-61))|(1<<(CypherParserROW-61))|(1<<(CypherParserROWS-61))|(1<<(CypherParserERROR-61))|(1<<(CypherParserCONTINUE-61))|(1<<(CypherParserBREAK-61))|(1<<(CypherParserFAIL-61))|(1<<(CypherParserCOUNT-61))|(1<<(CypherParserANY-61)))) != 0) || (((_la-93)&-(0x1f+1)) == 0 && ((1<<uint((_la-93)))&((1<<(CypherParserNONE-93))|(1<<(CypherParserSINGLE-93))|(1<<(CypherParserHexLetter-93)))) != 0) || (((_la-127)&-(0x1f+1)) == 0 && ((1<<uint((_la-127)))&((1<<(CypherParserFILTER-127))|(1<<(CypherParserEXTRACT-127))|(1<<(CypherParserUnescapedSymbolicName-127))|(1<<(CypherParserEscapedSymbolicName-127)))) != 0) {
 		{
-			p.SetState(791)
+			p.SetState(858)
 			p.Variable()
 		}
-		p.SetState(793)
+		p.SetState(860)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(792)
+				p.SetState(859)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(801)
+	p.SetState(868)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CypherParserT__9 {
+	if _la == CypherParserT__11 {
 		{
-			p.SetState(797)
+			p.SetState(864)
 			p.RelationshipTypes()
 		}
-		p.SetState(799)
+		p.SetState(866)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(798)
+				p.SetState(865)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(804)
+	p.SetState(871)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CypherParserT__4 {
+	if _la == CypherParserT__6 {
 		{
-			p.SetState(803)
+			p.SetState(870)
 			p.RangeLiteral()
 		}
 
 	}
-	p.SetState(810)
+	p.SetState(877)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CypherParserT__23 || _la == CypherParserT__25 {
+	if _la == CypherParserT__4 || _la == CypherParserT__25 {
 		{
-			p.SetState(806)
+			p.SetState(873)
 			p.Properties()
 		}
-		p.SetState(808)
+		p.SetState(875)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(807)
+				p.SetState(874)
 				p.Match(CypherParserSP)
 			}
 
@@ -8246,8 +8828,8 @@ func (p *CypherParser) RelationshipDetail() (localctx IRelationshipDetailContext
 
 	}
 	{
-		p.SetState(812)
-		p.Match(CypherParserT__8)
+		p.SetState(879)
+		p.Match(CypherParserT__10)
 	}
 
 	return localctx
@@ -8331,7 +8913,7 @@ func (s *PropertiesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) Properties() (localctx IPropertiesContext) {
 	localctx = NewPropertiesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, CypherParserRULE_properties)
+	p.EnterRule(localctx, 90, CypherParserRULE_properties)
 
 	defer func() {
 		p.ExitRule()
@@ -8349,21 +8931,21 @@ func (p *CypherParser) Properties() (localctx IPropertiesContext) {
 		}
 	}()
 
-	p.SetState(816)
+	p.SetState(883)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserT__23:
+	case CypherParserT__4:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(814)
+			p.SetState(881)
 			p.MapLiteral()
 		}
 
 	case CypherParserT__25:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(815)
+			p.SetState(882)
 			p.Parameter()
 		}
 
@@ -8463,7 +9045,7 @@ func (s *RelationshipTypesContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *CypherParser) RelationshipTypes() (localctx IRelationshipTypesContext) {
 	localctx = NewRelationshipTypesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, CypherParserRULE_relationshipTypes)
+	p.EnterRule(localctx, 92, CypherParserRULE_relationshipTypes)
 	var _la int
 
 	defer func() {
@@ -8486,76 +9068,76 @@ func (p *CypherParser) RelationshipTypes() (localctx IRelationshipTypesContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(818)
-		p.Match(CypherParserT__9)
+		p.SetState(885)
+		p.Match(CypherParserT__11)
 	}
-	p.SetState(820)
+	p.SetState(887)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(819)
+			p.SetState(886)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(822)
+		p.SetState(889)
 		p.RelTypeName()
 	}
-	p.SetState(836)
+	p.SetState(903)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 135, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 145, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(824)
+			p.SetState(891)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(823)
+					p.SetState(890)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(826)
-				p.Match(CypherParserT__10)
+				p.SetState(893)
+				p.Match(CypherParserT__12)
 			}
-			p.SetState(828)
+			p.SetState(895)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
-			if _la == CypherParserT__9 {
+			if _la == CypherParserT__11 {
 				{
-					p.SetState(827)
-					p.Match(CypherParserT__9)
+					p.SetState(894)
+					p.Match(CypherParserT__11)
 				}
 
 			}
-			p.SetState(831)
+			p.SetState(898)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(830)
+					p.SetState(897)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(833)
+				p.SetState(900)
 				p.RelTypeName()
 			}
 
 		}
-		p.SetState(838)
+		p.SetState(905)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 135, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 145, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -8650,7 +9232,7 @@ func (s *NodeLabelsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) NodeLabels() (localctx INodeLabelsContext) {
 	localctx = NewNodeLabelsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, CypherParserRULE_nodeLabels)
+	p.EnterRule(localctx, 94, CypherParserRULE_nodeLabels)
 	var _la int
 
 	defer func() {
@@ -8673,35 +9255,35 @@ func (p *CypherParser) NodeLabels() (localctx INodeLabelsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(839)
+		p.SetState(906)
 		p.NodeLabel()
 	}
-	p.SetState(846)
+	p.SetState(913)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 137, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 147, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(841)
+			p.SetState(908)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(840)
+					p.SetState(907)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(843)
+				p.SetState(910)
 				p.NodeLabel()
 			}
 
 		}
-		p.SetState(848)
+		p.SetState(915)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 137, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 147, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -8779,7 +9361,7 @@ func (s *NodeLabelContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) NodeLabel() (localctx INodeLabelContext) {
 	localctx = NewNodeLabelContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, CypherParserRULE_nodeLabel)
+	p.EnterRule(localctx, 96, CypherParserRULE_nodeLabel)
 	var _la int
 
 	defer func() {
@@ -8800,22 +9382,22 @@ func (p *CypherParser) NodeLabel() (localctx INodeLabelContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(849)
-		p.Match(CypherParserT__9)
+		p.SetState(916)
+		p.Match(CypherParserT__11)
 	}
-	p.SetState(851)
+	p.SetState(918)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(850)
+			p.SetState(917)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(853)
+		p.SetState(920)
 		p.LabelName()
 	}
 
@@ -8908,7 +9490,7 @@ func (s *RangeLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) RangeLiteral() (localctx IRangeLiteralContext) {
 	localctx = NewRangeLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, CypherParserRULE_rangeLiteral)
+	p.EnterRule(localctx, 98, CypherParserRULE_rangeLiteral)
 	var _la int
 
 	defer func() {
@@ -8929,78 +9511,78 @@ func (p *CypherParser) RangeLiteral() (localctx IRangeLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(855)
-		p.Match(CypherParserT__4)
+		p.SetState(922)
+		p.Match(CypherParserT__6)
 	}
-	p.SetState(857)
+	p.SetState(924)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(856)
+			p.SetState(923)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(863)
+	p.SetState(930)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((_la-97)&-(0x1f+1)) == 0 && ((1<<uint((_la-97)))&((1<<(CypherParserHexInteger-97))|(1<<(CypherParserDecimalInteger-97))|(1<<(CypherParserOctalInteger-97)))) != 0 {
+	if ((_la-105)&-(0x1f+1)) == 0 && ((1<<uint((_la-105)))&((1<<(CypherParserHexInteger-105))|(1<<(CypherParserDecimalInteger-105))|(1<<(CypherParserOctalInteger-105)))) != 0 {
 		{
-			p.SetState(859)
+			p.SetState(926)
 			p.MinHops()
 		}
-		p.SetState(861)
+		p.SetState(928)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(860)
+				p.SetState(927)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(875)
+	p.SetState(942)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CypherParserT__11 {
+	if _la == CypherParserT__13 {
 		{
-			p.SetState(865)
-			p.Match(CypherParserT__11)
+			p.SetState(932)
+			p.Match(CypherParserT__13)
 		}
-		p.SetState(867)
+		p.SetState(934)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(866)
+				p.SetState(933)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(873)
+		p.SetState(940)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if ((_la-97)&-(0x1f+1)) == 0 && ((1<<uint((_la-97)))&((1<<(CypherParserHexInteger-97))|(1<<(CypherParserDecimalInteger-97))|(1<<(CypherParserOctalInteger-97)))) != 0 {
+		if ((_la-105)&-(0x1f+1)) == 0 && ((1<<uint((_la-105)))&((1<<(CypherParserHexInteger-105))|(1<<(CypherParserDecimalInteger-105))|(1<<(CypherParserOctalInteger-105)))) != 0 {
 			{
-				p.SetState(869)
+				p.SetState(936)
 				p.MaxHops()
 			}
-			p.SetState(871)
+			p.SetState(938)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(870)
+					p.SetState(937)
 					p.Match(CypherParserSP)
 				}
 
@@ -9081,7 +9663,7 @@ func (s *MinHopsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) MinHops() (localctx IMinHopsContext) {
 	localctx = NewMinHopsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, CypherParserRULE_minHops)
+	p.EnterRule(localctx, 100, CypherParserRULE_minHops)
 
 	defer func() {
 		p.ExitRule()
@@ -9101,7 +9683,7 @@ func (p *CypherParser) MinHops() (localctx IMinHopsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(877)
+		p.SetState(944)
 		p.IntegerLiteral()
 	}
 
@@ -9176,7 +9758,7 @@ func (s *MaxHopsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) MaxHops() (localctx IMaxHopsContext) {
 	localctx = NewMaxHopsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, CypherParserRULE_maxHops)
+	p.EnterRule(localctx, 102, CypherParserRULE_maxHops)
 
 	defer func() {
 		p.ExitRule()
@@ -9196,7 +9778,7 @@ func (p *CypherParser) MaxHops() (localctx IMaxHopsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(879)
+		p.SetState(946)
 		p.IntegerLiteral()
 	}
 
@@ -9271,7 +9853,7 @@ func (s *LabelNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) LabelName() (localctx ILabelNameContext) {
 	localctx = NewLabelNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, CypherParserRULE_labelName)
+	p.EnterRule(localctx, 104, CypherParserRULE_labelName)
 
 	defer func() {
 		p.ExitRule()
@@ -9291,7 +9873,7 @@ func (p *CypherParser) LabelName() (localctx ILabelNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(881)
+		p.SetState(948)
 		p.SchemaName()
 	}
 
@@ -9366,7 +9948,7 @@ func (s *RelTypeNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) RelTypeName() (localctx IRelTypeNameContext) {
 	localctx = NewRelTypeNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, CypherParserRULE_relTypeName)
+	p.EnterRule(localctx, 106, CypherParserRULE_relTypeName)
 
 	defer func() {
 		p.ExitRule()
@@ -9386,7 +9968,7 @@ func (p *CypherParser) RelTypeName() (localctx IRelTypeNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(883)
+		p.SetState(950)
 		p.SchemaName()
 	}
 
@@ -9461,7 +10043,7 @@ func (s *ExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) Expr() (localctx IExprContext) {
 	localctx = NewExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, CypherParserRULE_expr)
+	p.EnterRule(localctx, 108, CypherParserRULE_expr)
 
 	defer func() {
 		p.ExitRule()
@@ -9481,7 +10063,7 @@ func (p *CypherParser) Expr() (localctx IExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(885)
+		p.SetState(952)
 		p.OrExpr()
 	}
 
@@ -9585,7 +10167,7 @@ func (s *OrExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) OrExpr() (localctx IOrExprContext) {
 	localctx = NewOrExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, CypherParserRULE_orExpr)
+	p.EnterRule(localctx, 110, CypherParserRULE_orExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -9607,36 +10189,36 @@ func (p *CypherParser) OrExpr() (localctx IOrExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(887)
+		p.SetState(954)
 		p.XorExpr()
 	}
-	p.SetState(894)
+	p.SetState(961)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 146, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 156, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(888)
+				p.SetState(955)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(889)
+				p.SetState(956)
 				p.Match(CypherParserOR)
 			}
 			{
-				p.SetState(890)
+				p.SetState(957)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(891)
+				p.SetState(958)
 				p.XorExpr()
 			}
 
 		}
-		p.SetState(896)
+		p.SetState(963)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 146, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 156, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9739,7 +10321,7 @@ func (s *XorExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) XorExpr() (localctx IXorExprContext) {
 	localctx = NewXorExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, CypherParserRULE_xorExpr)
+	p.EnterRule(localctx, 112, CypherParserRULE_xorExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -9761,36 +10343,36 @@ func (p *CypherParser) XorExpr() (localctx IXorExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(897)
+		p.SetState(964)
 		p.AndExpr()
 	}
-	p.SetState(904)
+	p.SetState(971)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 147, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 157, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(898)
+				p.SetState(965)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(899)
+				p.SetState(966)
 				p.Match(CypherParserXOR)
 			}
 			{
-				p.SetState(900)
+				p.SetState(967)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(901)
+				p.SetState(968)
 				p.AndExpr()
 			}
 
 		}
-		p.SetState(906)
+		p.SetState(973)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 147, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 157, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9893,7 +10475,7 @@ func (s *AndExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) AndExpr() (localctx IAndExprContext) {
 	localctx = NewAndExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, CypherParserRULE_andExpr)
+	p.EnterRule(localctx, 114, CypherParserRULE_andExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -9915,36 +10497,36 @@ func (p *CypherParser) AndExpr() (localctx IAndExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(907)
+		p.SetState(974)
 		p.NotExpr()
 	}
-	p.SetState(914)
+	p.SetState(981)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 158, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(908)
+				p.SetState(975)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(909)
+				p.SetState(976)
 				p.Match(CypherParserAND)
 			}
 			{
-				p.SetState(910)
+				p.SetState(977)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(911)
+				p.SetState(978)
 				p.NotExpr()
 			}
 
 		}
-		p.SetState(916)
+		p.SetState(983)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 158, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10034,7 +10616,7 @@ func (s *NotExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) NotExpr() (localctx INotExprContext) {
 	localctx = NewNotExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, CypherParserRULE_notExpr)
+	p.EnterRule(localctx, 116, CypherParserRULE_notExpr)
 	var _la int
 
 	defer func() {
@@ -10054,33 +10636,33 @@ func (p *CypherParser) NotExpr() (localctx INotExprContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(923)
+	p.SetState(990)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CypherParserNOT {
 		{
-			p.SetState(917)
+			p.SetState(984)
 			p.Match(CypherParserNOT)
 		}
-		p.SetState(919)
+		p.SetState(986)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(918)
+				p.SetState(985)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
-		p.SetState(925)
+		p.SetState(992)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(926)
+		p.SetState(993)
 		p.ComparisonExpr()
 	}
 
@@ -10186,7 +10768,7 @@ func (s *ComparisonExprContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *CypherParser) ComparisonExpr() (localctx IComparisonExprContext) {
 	localctx = NewComparisonExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, CypherParserRULE_comparisonExpr)
+	p.EnterRule(localctx, 118, CypherParserRULE_comparisonExpr)
 	var _la int
 
 	defer func() {
@@ -10209,35 +10791,35 @@ func (p *CypherParser) ComparisonExpr() (localctx IComparisonExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(928)
+		p.SetState(995)
 		p.AddOrSubtractExpr()
 	}
-	p.SetState(935)
+	p.SetState(1002)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 162, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(930)
+			p.SetState(997)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(929)
+					p.SetState(996)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(932)
+				p.SetState(999)
 				p.PartialComparisonExpr()
 			}
 
 		}
-		p.SetState(937)
+		p.SetState(1004)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 162, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10332,7 +10914,7 @@ func (s *AddOrSubtractExprContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *CypherParser) AddOrSubtractExpr() (localctx IAddOrSubtractExprContext) {
 	localctx = NewAddOrSubtractExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, CypherParserRULE_addOrSubtractExpr)
+	p.EnterRule(localctx, 120, CypherParserRULE_addOrSubtractExpr)
 	var _la int
 
 	defer func() {
@@ -10355,88 +10937,88 @@ func (p *CypherParser) AddOrSubtractExpr() (localctx IAddOrSubtractExprContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(938)
+		p.SetState(1005)
 		p.MultiplyDivideModuloExpr()
 	}
-	p.SetState(957)
+	p.SetState(1024)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 158, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 168, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(955)
+			p.SetState(1022)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 157, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 167, p.GetParserRuleContext()) {
 			case 1:
-				p.SetState(940)
+				p.SetState(1007)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(939)
+						p.SetState(1006)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(942)
-					p.Match(CypherParserT__12)
+					p.SetState(1009)
+					p.Match(CypherParserT__14)
 				}
-				p.SetState(944)
+				p.SetState(1011)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(943)
+						p.SetState(1010)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(946)
+					p.SetState(1013)
 					p.MultiplyDivideModuloExpr()
 				}
 
 			case 2:
-				p.SetState(948)
+				p.SetState(1015)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(947)
+						p.SetState(1014)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(950)
-					p.Match(CypherParserT__13)
+					p.SetState(1017)
+					p.Match(CypherParserT__15)
 				}
-				p.SetState(952)
+				p.SetState(1019)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(951)
+						p.SetState(1018)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(954)
+					p.SetState(1021)
 					p.MultiplyDivideModuloExpr()
 				}
 
 			}
 
 		}
-		p.SetState(959)
+		p.SetState(1026)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 158, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 168, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10531,7 +11113,7 @@ func (s *MultiplyDivideModuloExprContext) Accept(visitor antlr.ParseTreeVisitor)
 
 func (p *CypherParser) MultiplyDivideModuloExpr() (localctx IMultiplyDivideModuloExprContext) {
 	localctx = NewMultiplyDivideModuloExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, CypherParserRULE_multiplyDivideModuloExpr)
+	p.EnterRule(localctx, 122, CypherParserRULE_multiplyDivideModuloExpr)
 	var _la int
 
 	defer func() {
@@ -10554,120 +11136,120 @@ func (p *CypherParser) MultiplyDivideModuloExpr() (localctx IMultiplyDivideModul
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(960)
+		p.SetState(1027)
 		p.PowerOfExpr()
 	}
-	p.SetState(987)
+	p.SetState(1054)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 166, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 176, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(985)
+			p.SetState(1052)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 165, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 175, p.GetParserRuleContext()) {
 			case 1:
-				p.SetState(962)
+				p.SetState(1029)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(961)
+						p.SetState(1028)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(964)
-					p.Match(CypherParserT__4)
+					p.SetState(1031)
+					p.Match(CypherParserT__6)
 				}
-				p.SetState(966)
+				p.SetState(1033)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(965)
+						p.SetState(1032)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(968)
+					p.SetState(1035)
 					p.PowerOfExpr()
 				}
 
 			case 2:
-				p.SetState(970)
+				p.SetState(1037)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(969)
+						p.SetState(1036)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(972)
-					p.Match(CypherParserT__14)
+					p.SetState(1039)
+					p.Match(CypherParserT__16)
 				}
-				p.SetState(974)
+				p.SetState(1041)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(973)
+						p.SetState(1040)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(976)
+					p.SetState(1043)
 					p.PowerOfExpr()
 				}
 
 			case 3:
-				p.SetState(978)
+				p.SetState(1045)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(977)
+						p.SetState(1044)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(980)
-					p.Match(CypherParserT__15)
+					p.SetState(1047)
+					p.Match(CypherParserT__17)
 				}
-				p.SetState(982)
+				p.SetState(1049)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(981)
+						p.SetState(1048)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(984)
+					p.SetState(1051)
 					p.PowerOfExpr()
 				}
 
 			}
 
 		}
-		p.SetState(989)
+		p.SetState(1056)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 166, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 176, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10762,7 +11344,7 @@ func (s *PowerOfExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) PowerOfExpr() (localctx IPowerOfExprContext) {
 	localctx = NewPowerOfExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, CypherParserRULE_powerOfExpr)
+	p.EnterRule(localctx, 124, CypherParserRULE_powerOfExpr)
 	var _la int
 
 	defer func() {
@@ -10785,50 +11367,50 @@ func (p *CypherParser) PowerOfExpr() (localctx IPowerOfExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(990)
+		p.SetState(1057)
 		p.UnaryAddOrSubtractExpr()
 	}
-	p.SetState(1001)
+	p.SetState(1068)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 169, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 179, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(992)
+			p.SetState(1059)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(991)
+					p.SetState(1058)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(994)
-				p.Match(CypherParserT__16)
+				p.SetState(1061)
+				p.Match(CypherParserT__18)
 			}
-			p.SetState(996)
+			p.SetState(1063)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(995)
+					p.SetState(1062)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(998)
+				p.SetState(1065)
 				p.UnaryAddOrSubtractExpr()
 			}
 
 		}
-		p.SetState(1003)
+		p.SetState(1070)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 169, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 179, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10910,7 +11492,7 @@ func (s *UnaryAddOrSubtractExprContext) Accept(visitor antlr.ParseTreeVisitor) i
 
 func (p *CypherParser) UnaryAddOrSubtractExpr() (localctx IUnaryAddOrSubtractExprContext) {
 	localctx = NewUnaryAddOrSubtractExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, CypherParserRULE_unaryAddOrSubtractExpr)
+	p.EnterRule(localctx, 126, CypherParserRULE_unaryAddOrSubtractExpr)
 	var _la int
 
 	defer func() {
@@ -10930,38 +11512,38 @@ func (p *CypherParser) UnaryAddOrSubtractExpr() (localctx IUnaryAddOrSubtractExp
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1010)
+	p.SetState(1077)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == CypherParserT__12 || _la == CypherParserT__13 {
-		p.SetState(1004)
+	for _la == CypherParserT__14 || _la == CypherParserT__15 {
+		p.SetState(1071)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == CypherParserT__12 || _la == CypherParserT__13) {
+		if !(_la == CypherParserT__14 || _la == CypherParserT__15) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
 			p.Consume()
 		}
-		p.SetState(1006)
+		p.SetState(1073)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1005)
+				p.SetState(1072)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
-		p.SetState(1012)
+		p.SetState(1079)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(1013)
+		p.SetState(1080)
 		p.StringListNullOperatorExpr()
 	}
 
@@ -11105,7 +11687,7 @@ func (s *StringListNullOperatorExprContext) Accept(visitor antlr.ParseTreeVisito
 
 func (p *CypherParser) StringListNullOperatorExpr() (localctx IStringListNullOperatorExprContext) {
 	localctx = NewStringListNullOperatorExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, CypherParserRULE_stringListNullOperatorExpr)
+	p.EnterRule(localctx, 128, CypherParserRULE_stringListNullOperatorExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -11127,42 +11709,42 @@ func (p *CypherParser) StringListNullOperatorExpr() (localctx IStringListNullOpe
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1015)
+		p.SetState(1082)
 		p.PropertyOrLabelsExpr()
 	}
-	p.SetState(1021)
+	p.SetState(1088)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 173, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 183, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(1019)
+			p.SetState(1086)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 172, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 182, p.GetParserRuleContext()) {
 			case 1:
 				{
-					p.SetState(1016)
+					p.SetState(1083)
 					p.StringOperatorExpr()
 				}
 
 			case 2:
 				{
-					p.SetState(1017)
+					p.SetState(1084)
 					p.ListOperatorExpr()
 				}
 
 			case 3:
 				{
-					p.SetState(1018)
+					p.SetState(1085)
 					p.NullOperatorExpr()
 				}
 
 			}
 
 		}
-		p.SetState(1023)
+		p.SetState(1090)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 173, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 183, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -11271,7 +11853,7 @@ func (s *ListOperatorExprContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *CypherParser) ListOperatorExpr() (localctx IListOperatorExprContext) {
 	localctx = NewListOperatorExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, CypherParserRULE_listOperatorExpr)
+	p.EnterRule(localctx, 130, CypherParserRULE_listOperatorExpr)
 	var _la int
 
 	defer func() {
@@ -11290,107 +11872,107 @@ func (p *CypherParser) ListOperatorExpr() (localctx IListOperatorExprContext) {
 		}
 	}()
 
-	p.SetState(1049)
+	p.SetState(1116)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 179, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 189, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1024)
+			p.SetState(1091)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1025)
+			p.SetState(1092)
 			p.Match(CypherParserIN)
 		}
-		p.SetState(1027)
+		p.SetState(1094)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1026)
+				p.SetState(1093)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1029)
+			p.SetState(1096)
 			p.PropertyOrLabelsExpr()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(1031)
+		p.SetState(1098)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1030)
+				p.SetState(1097)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1033)
-			p.Match(CypherParserT__7)
+			p.SetState(1100)
+			p.Match(CypherParserT__9)
 		}
 		{
-			p.SetState(1034)
+			p.SetState(1101)
 			p.Expr()
 		}
 		{
-			p.SetState(1035)
-			p.Match(CypherParserT__8)
+			p.SetState(1102)
+			p.Match(CypherParserT__10)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
-		p.SetState(1038)
+		p.SetState(1105)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1037)
+				p.SetState(1104)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1040)
-			p.Match(CypherParserT__7)
+			p.SetState(1107)
+			p.Match(CypherParserT__9)
 		}
-		p.SetState(1042)
+		p.SetState(1109)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__4)|(1<<CypherParserT__7)|(1<<CypherParserT__9)|(1<<CypherParserT__14)|(1<<CypherParserT__15)|(1<<CypherParserT__25))) != 0) || (((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(CypherParserALL-47))|(1<<(CypherParserCONCURRENT-47))|(1<<(CypherParserTRANSACTIONS-47))|(1<<(CypherParserROW-47))|(1<<(CypherParserROWS-47))|(1<<(CypherParserERROR-47))|(1<<(CypherParserCONTINUE-47))|(1<<(CypherParserBREAK-47))|(1<<(CypherParserFAIL-47)))) != 0) || (((_la-84)&-(0x1f+1)) == 0 && ((1<<uint((_la-84)))&((1<<(CypherParserNOT-84))|(1<<(CypherParserNULL-84))|(1<<(CypherParserCOUNT-84))|(1<<(CypherParserANY-84))|(1<<(CypherParserNONE-84))|(1<<(CypherParserSINGLE-84))|(1<<(CypherParserTRUE-84))|(1<<(CypherParserFALSE-84))|(1<<(CypherParserEXISTS-84))|(1<<(CypherParserCASE-84))|(1<<(CypherParserStringLiteral-84))|(1<<(CypherParserHexInteger-84))|(1<<(CypherParserDecimalInteger-84))|(1<<(CypherParserOctalInteger-84))|(1<<(CypherParserHexLetter-84))|(1<<(CypherParserExponentDecimalReal-84)))) != 0) || (((_la-116)&-(0x1f+1)) == 0 && ((1<<uint((_la-116)))&((1<<(CypherParserRegularDecimalReal-116))|(1<<(CypherParserFILTER-116))|(1<<(CypherParserEXTRACT-116))|(1<<(CypherParserUnescapedSymbolicName-116))|(1<<(CypherParserEscapedSymbolicName-116)))) != 0) {
 			{
-				p.SetState(1041)
+				p.SetState(1108)
 				p.Expr()
 			}
 
 		}
 		{
-			p.SetState(1044)
-			p.Match(CypherParserT__11)
+			p.SetState(1111)
+			p.Match(CypherParserT__13)
 		}
-		p.SetState(1046)
+		p.SetState(1113)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__4)|(1<<CypherParserT__7)|(1<<CypherParserT__9)|(1<<CypherParserT__14)|(1<<CypherParserT__15)|(1<<CypherParserT__25))) != 0) || (((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(CypherParserALL-47))|(1<<(CypherParserCONCURRENT-47))|(1<<(CypherParserTRANSACTIONS-47))|(1<<(CypherParserROW-47))|(1<<(CypherParserROWS-47))|(1<<(CypherParserERROR-47))|(1<<(CypherParserCONTINUE-47))|(1<<(CypherParserBREAK-47))|(1<<(CypherParserFAIL-47)))) != 0) || (((_la-84)&-(0x1f+1)) == 0 && ((1<<uint((_la-84)))&((1<<(CypherParserNOT-84))|(1<<(CypherParserNULL-84))|(1<<(CypherParserCOUNT-84))|(1<<(CypherParserANY-84))|(1<<(CypherParserNONE-84))|(1<<(CypherParserSINGLE-84))|(1<<(CypherParserTRUE-84))|(1<<(CypherParserFALSE-84))|(1<<(CypherParserEXISTS-84))|(1<<(CypherParserCASE-84))|(1<<(CypherParserStringLiteral-84))|(1<<(CypherParserHexInteger-84))|(1<<(CypherParserDecimalInteger-84))|(1<<(CypherParserOctalInteger-84))|(1<<(CypherParserHexLetter-84))|(1<<(CypherParserExponentDecimalReal-84)))) != 0) || (((_la-116)&-(0x1f+1)) == 0 && ((1<<uint((_la-116)))&((1<<(CypherParserRegularDecimalReal-116))|(1<<(CypherParserFILTER-116))|(1<<(CypherParserEXTRACT-116))|(1<<(CypherParserUnescapedSymbolicName-116))|(1<<(CypherParserEscapedSymbolicName-116)))) != 0) {
 			{
-				p.SetState(1045)
+				p.SetState(1112)
 				p.Expr()
 			}
 
 		}
 		{
-			p.SetState(1048)
-			p.Match(CypherParserT__8)
+			p.SetState(1115)
+			p.Match(CypherParserT__10)
 		}
 
 	}
@@ -11490,7 +12072,7 @@ func (s *StringOperatorExprContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *CypherParser) StringOperatorExpr() (localctx IStringOperatorExprContext) {
 	localctx = NewStringOperatorExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, CypherParserRULE_stringOperatorExpr)
+	p.EnterRule(localctx, 132, CypherParserRULE_stringOperatorExpr)
 	var _la int
 
 	defer func() {
@@ -11510,69 +12092,69 @@ func (p *CypherParser) StringOperatorExpr() (localctx IStringOperatorExprContext
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1061)
+	p.SetState(1128)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 180, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 190, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(1051)
+			p.SetState(1118)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1052)
+			p.SetState(1119)
 			p.Match(CypherParserSTARTS)
 		}
 		{
-			p.SetState(1053)
+			p.SetState(1120)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1054)
+			p.SetState(1121)
 			p.Match(CypherParserWITH)
 		}
 
 	case 2:
 		{
-			p.SetState(1055)
+			p.SetState(1122)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1056)
+			p.SetState(1123)
 			p.Match(CypherParserENDS)
 		}
 		{
-			p.SetState(1057)
+			p.SetState(1124)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1058)
+			p.SetState(1125)
 			p.Match(CypherParserWITH)
 		}
 
 	case 3:
 		{
-			p.SetState(1059)
+			p.SetState(1126)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1060)
+			p.SetState(1127)
 			p.Match(CypherParserCONTAINS)
 		}
 
 	}
-	p.SetState(1064)
+	p.SetState(1131)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1063)
+			p.SetState(1130)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1066)
+		p.SetState(1133)
 		p.PropertyOrLabelsExpr()
 	}
 
@@ -11657,7 +12239,7 @@ func (s *NullOperatorExprContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *CypherParser) NullOperatorExpr() (localctx INullOperatorExprContext) {
 	localctx = NewNullOperatorExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, CypherParserRULE_nullOperatorExpr)
+	p.EnterRule(localctx, 134, CypherParserRULE_nullOperatorExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -11675,52 +12257,52 @@ func (p *CypherParser) NullOperatorExpr() (localctx INullOperatorExprContext) {
 		}
 	}()
 
-	p.SetState(1078)
+	p.SetState(1145)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 182, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 192, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1068)
+			p.SetState(1135)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1069)
+			p.SetState(1136)
 			p.Match(CypherParserIS)
 		}
 		{
-			p.SetState(1070)
+			p.SetState(1137)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1071)
+			p.SetState(1138)
 			p.Match(CypherParserNULL)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1072)
+			p.SetState(1139)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1073)
+			p.SetState(1140)
 			p.Match(CypherParserIS)
 		}
 		{
-			p.SetState(1074)
+			p.SetState(1141)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1075)
+			p.SetState(1142)
 			p.Match(CypherParserNOT)
 		}
 		{
-			p.SetState(1076)
+			p.SetState(1143)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1077)
+			p.SetState(1144)
 			p.Match(CypherParserNULL)
 		}
 
@@ -11838,7 +12420,7 @@ func (s *PropertyOrLabelsExprContext) Accept(visitor antlr.ParseTreeVisitor) int
 
 func (p *CypherParser) PropertyOrLabelsExpr() (localctx IPropertyOrLabelsExprContext) {
 	localctx = NewPropertyOrLabelsExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, CypherParserRULE_propertyOrLabelsExpr)
+	p.EnterRule(localctx, 136, CypherParserRULE_propertyOrLabelsExpr)
 	var _la int
 
 	defer func() {
@@ -11861,53 +12443,53 @@ func (p *CypherParser) PropertyOrLabelsExpr() (localctx IPropertyOrLabelsExprCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1080)
+		p.SetState(1147)
 		p.Atom()
 	}
-	p.SetState(1087)
+	p.SetState(1154)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 184, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 194, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(1082)
+			p.SetState(1149)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1081)
+					p.SetState(1148)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1084)
+				p.SetState(1151)
 				p.PropertyLookup()
 			}
 
 		}
-		p.SetState(1089)
+		p.SetState(1156)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 184, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 194, p.GetParserRuleContext())
 	}
-	p.SetState(1094)
+	p.SetState(1161)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 186, p.GetParserRuleContext()) == 1 {
-		p.SetState(1091)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 196, p.GetParserRuleContext()) == 1 {
+		p.SetState(1158)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1090)
+				p.SetState(1157)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1093)
+			p.SetState(1160)
 			p.NodeLabels()
 		}
 
@@ -11996,6 +12578,26 @@ func (s *AtomContext) SP(i int) antlr.TerminalNode {
 	return s.GetToken(CypherParserSP, i)
 }
 
+func (s *AtomContext) ExistsSubquery() IExistsSubqueryContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExistsSubqueryContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExistsSubqueryContext)
+}
+
+func (s *AtomContext) CountSubquery() ICountSubqueryContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICountSubqueryContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICountSubqueryContext)
+}
+
 func (s *AtomContext) ListComprehension() IListComprehensionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IListComprehensionContext)(nil)).Elem(), 0)
 
@@ -12102,7 +12704,7 @@ func (s *AtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) Atom() (localctx IAtomContext) {
 	localctx = NewAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, CypherParserRULE_atom)
+	p.EnterRule(localctx, 138, CypherParserRULE_atom)
 	var _la int
 
 	defer func() {
@@ -12121,331 +12723,868 @@ func (p *CypherParser) Atom() (localctx IAtomContext) {
 		}
 	}()
 
-	p.SetState(1174)
+	p.SetState(1243)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 202, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 212, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1096)
+			p.SetState(1163)
 			p.Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1097)
+			p.SetState(1164)
 			p.Parameter()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1098)
+			p.SetState(1165)
 			p.CaseExpr()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1099)
+			p.SetState(1166)
 			p.Match(CypherParserCOUNT)
 		}
-		p.SetState(1101)
+		p.SetState(1168)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1100)
+				p.SetState(1167)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1103)
-			p.Match(CypherParserT__5)
+			p.SetState(1170)
+			p.Match(CypherParserT__7)
 		}
-		p.SetState(1105)
+		p.SetState(1172)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1104)
+				p.SetState(1171)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1107)
-			p.Match(CypherParserT__4)
+			p.SetState(1174)
+			p.Match(CypherParserT__6)
 		}
-		p.SetState(1109)
+		p.SetState(1176)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1108)
+				p.SetState(1175)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1111)
-			p.Match(CypherParserT__6)
+			p.SetState(1178)
+			p.Match(CypherParserT__8)
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1112)
-			p.ListComprehension()
+			p.SetState(1179)
+			p.ExistsSubquery()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1113)
-			p.PatternComprehension()
+			p.SetState(1180)
+			p.CountSubquery()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(1114)
+			p.SetState(1181)
+			p.ListComprehension()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(1182)
+			p.PatternComprehension()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(1183)
 			p.Match(CypherParserALL)
 		}
-		p.SetState(1116)
+		p.SetState(1185)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1115)
+				p.SetState(1184)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1118)
-			p.Match(CypherParserT__5)
+			p.SetState(1187)
+			p.Match(CypherParserT__7)
 		}
-		p.SetState(1120)
+		p.SetState(1189)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1119)
+				p.SetState(1188)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1122)
+			p.SetState(1191)
 			p.FilterExpr()
 		}
-		p.SetState(1124)
+		p.SetState(1193)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1123)
+				p.SetState(1192)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1126)
-			p.Match(CypherParserT__6)
+			p.SetState(1195)
+			p.Match(CypherParserT__8)
 		}
 
-	case 8:
-		p.EnterOuterAlt(localctx, 8)
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(1128)
+			p.SetState(1197)
 			p.Match(CypherParserANY)
 		}
-		p.SetState(1130)
+		p.SetState(1199)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1129)
+				p.SetState(1198)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1132)
-			p.Match(CypherParserT__5)
+			p.SetState(1201)
+			p.Match(CypherParserT__7)
 		}
-		p.SetState(1134)
+		p.SetState(1203)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1133)
+				p.SetState(1202)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1136)
+			p.SetState(1205)
 			p.FilterExpr()
 		}
-		p.SetState(1138)
+		p.SetState(1207)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1137)
+				p.SetState(1206)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1140)
-			p.Match(CypherParserT__6)
+			p.SetState(1209)
+			p.Match(CypherParserT__8)
 		}
 
-	case 9:
-		p.EnterOuterAlt(localctx, 9)
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(1142)
+			p.SetState(1211)
 			p.Match(CypherParserNONE)
 		}
-		p.SetState(1144)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		p.SetState(1213)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1212)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1215)
+			p.Match(CypherParserT__7)
+		}
+		p.SetState(1217)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1216)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1219)
+			p.FilterExpr()
+		}
+		p.SetState(1221)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1220)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1223)
+			p.Match(CypherParserT__8)
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(1225)
+			p.Match(CypherParserSINGLE)
+		}
+		p.SetState(1227)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1226)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1229)
+			p.Match(CypherParserT__7)
+		}
+		p.SetState(1231)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1230)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1233)
+			p.FilterExpr()
+		}
+		p.SetState(1235)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1234)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1237)
+			p.Match(CypherParserT__8)
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(1239)
+			p.RelationshipsPattern()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(1240)
+			p.ParenthesizedExpr()
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(1241)
+			p.FunctionInvocation()
+		}
+
+	case 16:
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(1242)
+			p.Variable()
+		}
+
+	}
+
+	return localctx
+}
+
+// IExistsSubqueryContext is an interface to support dynamic dispatch.
+type IExistsSubqueryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExistsSubqueryContext differentiates from other interfaces.
+	IsExistsSubqueryContext()
+}
+
+type ExistsSubqueryContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExistsSubqueryContext() *ExistsSubqueryContext {
+	var p = new(ExistsSubqueryContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CypherParserRULE_existsSubquery
+	return p
+}
+
+func (*ExistsSubqueryContext) IsExistsSubqueryContext() {}
+
+func NewExistsSubqueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExistsSubqueryContext {
+	var p = new(ExistsSubqueryContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CypherParserRULE_existsSubquery
+
+	return p
+}
+
+func (s *ExistsSubqueryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExistsSubqueryContext) EXISTS() antlr.TerminalNode {
+	return s.GetToken(CypherParserEXISTS, 0)
+}
+
+func (s *ExistsSubqueryContext) SubqueryBody() ISubqueryBodyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISubqueryBodyContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubqueryBodyContext)
+}
+
+func (s *ExistsSubqueryContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
+
+func (s *ExistsSubqueryContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *ExistsSubqueryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExistsSubqueryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExistsSubqueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CypherVisitor:
+		return t.VisitExistsSubquery(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CypherParser) ExistsSubquery() (localctx IExistsSubqueryContext) {
+	localctx = NewExistsSubqueryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 140, CypherParserRULE_existsSubquery)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1245)
+		p.Match(CypherParserEXISTS)
+	}
+	p.SetState(1247)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1246)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1249)
+		p.Match(CypherParserT__4)
+	}
+	p.SetState(1251)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1250)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1253)
+		p.SubqueryBody()
+	}
+	p.SetState(1255)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1254)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1257)
+		p.Match(CypherParserT__5)
+	}
+
+	return localctx
+}
+
+// ICountSubqueryContext is an interface to support dynamic dispatch.
+type ICountSubqueryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCountSubqueryContext differentiates from other interfaces.
+	IsCountSubqueryContext()
+}
+
+type CountSubqueryContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCountSubqueryContext() *CountSubqueryContext {
+	var p = new(CountSubqueryContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CypherParserRULE_countSubquery
+	return p
+}
+
+func (*CountSubqueryContext) IsCountSubqueryContext() {}
+
+func NewCountSubqueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CountSubqueryContext {
+	var p = new(CountSubqueryContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CypherParserRULE_countSubquery
+
+	return p
+}
+
+func (s *CountSubqueryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CountSubqueryContext) COUNT() antlr.TerminalNode {
+	return s.GetToken(CypherParserCOUNT, 0)
+}
+
+func (s *CountSubqueryContext) SubqueryBody() ISubqueryBodyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISubqueryBodyContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubqueryBodyContext)
+}
+
+func (s *CountSubqueryContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
+
+func (s *CountSubqueryContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *CountSubqueryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CountSubqueryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CountSubqueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CypherVisitor:
+		return t.VisitCountSubquery(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CypherParser) CountSubquery() (localctx ICountSubqueryContext) {
+	localctx = NewCountSubqueryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 142, CypherParserRULE_countSubquery)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1259)
+		p.Match(CypherParserCOUNT)
+	}
+	p.SetState(1261)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1260)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1263)
+		p.Match(CypherParserT__4)
+	}
+	p.SetState(1265)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1264)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1267)
+		p.SubqueryBody()
+	}
+	p.SetState(1269)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1268)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1271)
+		p.Match(CypherParserT__5)
+	}
+
+	return localctx
+}
+
+// ISubqueryBodyContext is an interface to support dynamic dispatch.
+type ISubqueryBodyContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSubqueryBodyContext differentiates from other interfaces.
+	IsSubqueryBodyContext()
+}
+
+type SubqueryBodyContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySubqueryBodyContext() *SubqueryBodyContext {
+	var p = new(SubqueryBodyContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CypherParserRULE_subqueryBody
+	return p
+}
+
+func (*SubqueryBodyContext) IsSubqueryBodyContext() {}
+
+func NewSubqueryBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubqueryBodyContext {
+	var p = new(SubqueryBodyContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CypherParserRULE_subqueryBody
+
+	return p
+}
+
+func (s *SubqueryBodyContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SubqueryBodyContext) RegularQuery() IRegularQueryContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRegularQueryContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRegularQueryContext)
+}
+
+func (s *SubqueryBodyContext) AllReadingClause() []IReadingClauseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IReadingClauseContext)(nil)).Elem())
+	var tst = make([]IReadingClauseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IReadingClauseContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SubqueryBodyContext) ReadingClause(i int) IReadingClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReadingClauseContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IReadingClauseContext)
+}
+
+func (s *SubqueryBodyContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
+
+func (s *SubqueryBodyContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *SubqueryBodyContext) Pattern() IPatternContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPatternContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPatternContext)
+}
+
+func (s *SubqueryBodyContext) WhereClause() IWhereClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhereClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *SubqueryBodyContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SubqueryBodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SubqueryBodyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CypherVisitor:
+		return t.VisitSubqueryBody(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CypherParser) SubqueryBody() (localctx ISubqueryBodyContext) {
+	localctx = NewSubqueryBodyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 144, CypherParserRULE_subqueryBody)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(1143)
-				p.Match(CypherParserSP)
-			}
+	var _alt int
 
-		}
+	p.SetState(1291)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 223, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1146)
-			p.Match(CypherParserT__5)
+			p.SetState(1273)
+			p.RegularQuery()
 		}
-		p.SetState(1148)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == CypherParserSP {
-			{
-				p.SetState(1147)
-				p.Match(CypherParserSP)
-			}
 
-		}
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1150)
-			p.FilterExpr()
+			p.SetState(1274)
+			p.ReadingClause()
 		}
-		p.SetState(1152)
+		p.SetState(1281)
 		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 220, p.GetParserRuleContext())
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(1151)
-				p.Match(CypherParserSP)
-			}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				p.SetState(1276)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
 
-		}
-		{
-			p.SetState(1154)
-			p.Match(CypherParserT__6)
-		}
+				if _la == CypherParserSP {
+					{
+						p.SetState(1275)
+						p.Match(CypherParserSP)
+					}
 
-	case 10:
-		p.EnterOuterAlt(localctx, 10)
-		{
-			p.SetState(1156)
-			p.Match(CypherParserSINGLE)
-		}
-		p.SetState(1158)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+				}
+				{
+					p.SetState(1278)
+					p.ReadingClause()
+				}
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(1157)
-				p.Match(CypherParserSP)
 			}
-
+			p.SetState(1283)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 220, p.GetParserRuleContext())
 		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1160)
-			p.Match(CypherParserT__5)
+			p.SetState(1284)
+			p.Pattern()
 		}
-		p.SetState(1162)
+		p.SetState(1289)
 		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(1161)
-				p.Match(CypherParserSP)
-			}
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 222, p.GetParserRuleContext()) == 1 {
+			p.SetState(1286)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
 
-		}
-		{
-			p.SetState(1164)
-			p.FilterExpr()
-		}
-		p.SetState(1166)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+			if _la == CypherParserSP {
+				{
+					p.SetState(1285)
+					p.Match(CypherParserSP)
+				}
 
-		if _la == CypherParserSP {
+			}
 			{
-				p.SetState(1165)
-				p.Match(CypherParserSP)
+				p.SetState(1288)
+				p.WhereClause()
 			}
 
 		}
-		{
-			p.SetState(1168)
-			p.Match(CypherParserT__6)
-		}
-
-	case 11:
-		p.EnterOuterAlt(localctx, 11)
-		{
-			p.SetState(1170)
-			p.RelationshipsPattern()
-		}
-
-	case 12:
-		p.EnterOuterAlt(localctx, 12)
-		{
-			p.SetState(1171)
-			p.ParenthesizedExpr()
-		}
-
-	case 13:
-		p.EnterOuterAlt(localctx, 13)
-		{
-			p.SetState(1172)
-			p.FunctionInvocation()
-		}
-
-	case 14:
-		p.EnterOuterAlt(localctx, 14)
-		{
-			p.SetState(1173)
-			p.Variable()
-		}
 
 	}
 
@@ -12558,7 +13697,7 @@ func (s *LiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) Literal() (localctx ILiteralContext) {
 	localctx = NewLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, CypherParserRULE_literal)
+	p.EnterRule(localctx, 146, CypherParserRULE_literal)
 
 	defer func() {
 		p.ExitRule()
@@ -12576,49 +13715,49 @@ func (p *CypherParser) Literal() (localctx ILiteralContext) {
 		}
 	}()
 
-	p.SetState(1182)
+	p.SetState(1299)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger, CypherParserExponentDecimalReal, CypherParserRegularDecimalReal:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1176)
+			p.SetState(1293)
 			p.NumberLiteral()
 		}
 
 	case CypherParserStringLiteral:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1177)
+			p.SetState(1294)
 			p.Match(CypherParserStringLiteral)
 		}
 
 	case CypherParserTRUE, CypherParserFALSE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1178)
+			p.SetState(1295)
 			p.BooleanLiteral()
 		}
 
 	case CypherParserNULL:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1179)
+			p.SetState(1296)
 			p.Match(CypherParserNULL)
 		}
 
-	case CypherParserT__23:
+	case CypherParserT__4:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1180)
+			p.SetState(1297)
 			p.MapLiteral()
 		}
 
-	case CypherParserT__7:
+	case CypherParserT__9:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1181)
+			p.SetState(1298)
 			p.ListLiteral()
 		}
 
@@ -12695,7 +13834,7 @@ func (s *BooleanLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *CypherParser) BooleanLiteral() (localctx IBooleanLiteralContext) {
 	localctx = NewBooleanLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, CypherParserRULE_booleanLiteral)
+	p.EnterRule(localctx, 148, CypherParserRULE_booleanLiteral)
 	var _la int
 
 	defer func() {
@@ -12715,7 +13854,7 @@ func (p *CypherParser) BooleanLiteral() (localctx IBooleanLiteralContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1184)
+	p.SetState(1301)
 	_la = p.GetTokenStream().LA(1)
 
 	if !(_la == CypherParserTRUE || _la == CypherParserFALSE) {
@@ -12817,7 +13956,7 @@ func (s *ListLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) ListLiteral() (localctx IListLiteralContext) {
 	localctx = NewListLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, CypherParserRULE_listLiteral)
+	p.EnterRule(localctx, 150, CypherParserRULE_listLiteral)
 	var _la int
 
 	defer func() {
@@ -12838,85 +13977,85 @@ func (p *CypherParser) ListLiteral() (localctx IListLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1186)
-		p.Match(CypherParserT__7)
+		p.SetState(1303)
+		p.Match(CypherParserT__9)
 	}
-	p.SetState(1188)
+	p.SetState(1305)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1187)
+			p.SetState(1304)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1207)
+	p.SetState(1324)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__4)|(1<<CypherParserT__7)|(1<<CypherParserT__9)|(1<<CypherParserT__14)|(1<<CypherParserT__15)|(1<<CypherParserT__25))) != 0) || (((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(CypherParserALL-47))|(1<<(CypherParserCONCURRENT-47))|(1<<(CypherParserTRANSACTIONS-47))|(1<<(CypherParserROW-47))|(1<<(CypherParserROWS-47))|(1<<(CypherParserERROR-47))|(1<<(CypherParserCONTINUE-47))|(1<<(CypherParserBREAK-47))|(1<<(CypherParserFAIL-47)))) != 0) || (((_la-84)&-(0x1f+1)) == 0 && ((1<<uint((_la-84)))&((1<<(CypherParserNOT-84))|(1<<(CypherParserNULL-84))|(1<<(CypherParserCOUNT-84))|(1<<(CypherParserANY-84))|(1<<(CypherParserNONE-84))|(1<<(CypherParserSINGLE-84))|(1<<(CypherParserTRUE-84))|(1<<(CypherParserFALSE-84))|(1<<(CypherParserEXISTS-84))|(1<<(CypherParserCASE-84))|(1<<(CypherParserStringLiteral-84))|(1<<(CypherParserHexInteger-84))|(1<<(CypherParserDecimalInteger-84))|(1<<(CypherParserOctalInteger-84))|(1<<(CypherParserHexLetter-84))|(1<<(CypherParserExponentDecimalReal-84)))) != 0) || (((_la-116)&-(0x1f+1)) == 0 && ((1<<uint((_la-116)))&((1<<(CypherParserRegularDecimalReal-116))|(1<<(CypherParserFILTER-116))|(1<<(CypherParserEXTRACT-116))|(1<<(CypherParserUnescapedSymbolicName-116))|(1<<(CypherParserEscapedSymbolicName-116)))) != 0) {
 		{
-			p.SetState(1190)
+			p.SetState(1307)
 			p.Expr()
 		}
-		p.SetState(1192)
+		p.SetState(1309)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1191)
+				p.SetState(1308)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1204)
+		p.SetState(1321)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1194)
+				p.SetState(1311)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1196)
+			p.SetState(1313)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1195)
+					p.SetState(1312)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1198)
+				p.SetState(1315)
 				p.Expr()
 			}
-			p.SetState(1200)
+			p.SetState(1317)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1199)
+					p.SetState(1316)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1206)
+			p.SetState(1323)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1209)
-		p.Match(CypherParserT__8)
+		p.SetState(1326)
+		p.Match(CypherParserT__10)
 	}
 
 	return localctx
@@ -12994,7 +14133,7 @@ func (s *PartialComparisonExprContext) Accept(visitor antlr.ParseTreeVisitor) in
 
 func (p *CypherParser) PartialComparisonExpr() (localctx IPartialComparisonExprContext) {
 	localctx = NewPartialComparisonExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, CypherParserRULE_partialComparisonExpr)
+	p.EnterRule(localctx, 152, CypherParserRULE_partialComparisonExpr)
 	var _la int
 
 	defer func() {
@@ -13013,139 +14152,139 @@ func (p *CypherParser) PartialComparisonExpr() (localctx IPartialComparisonExprC
 		}
 	}()
 
-	p.SetState(1241)
+	p.SetState(1358)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserT__2:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1211)
+			p.SetState(1328)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(1213)
+		p.SetState(1330)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1212)
+				p.SetState(1329)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1215)
+			p.SetState(1332)
 			p.AddOrSubtractExpr()
 		}
 
-	case CypherParserT__17:
+	case CypherParserT__19:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1216)
-			p.Match(CypherParserT__17)
+			p.SetState(1333)
+			p.Match(CypherParserT__19)
 		}
-		p.SetState(1218)
+		p.SetState(1335)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1217)
+				p.SetState(1334)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1220)
+			p.SetState(1337)
 			p.AddOrSubtractExpr()
 		}
 
-	case CypherParserT__18:
+	case CypherParserT__20:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1221)
-			p.Match(CypherParserT__18)
+			p.SetState(1338)
+			p.Match(CypherParserT__20)
 		}
-		p.SetState(1223)
+		p.SetState(1340)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1222)
+				p.SetState(1339)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1225)
+			p.SetState(1342)
 			p.AddOrSubtractExpr()
 		}
 
-	case CypherParserT__19:
+	case CypherParserT__21:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1226)
-			p.Match(CypherParserT__19)
+			p.SetState(1343)
+			p.Match(CypherParserT__21)
 		}
-		p.SetState(1228)
+		p.SetState(1345)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1227)
+				p.SetState(1344)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1230)
+			p.SetState(1347)
 			p.AddOrSubtractExpr()
 		}
 
-	case CypherParserT__20:
+	case CypherParserT__22:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1231)
-			p.Match(CypherParserT__20)
+			p.SetState(1348)
+			p.Match(CypherParserT__22)
 		}
-		p.SetState(1233)
+		p.SetState(1350)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1232)
+				p.SetState(1349)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1235)
+			p.SetState(1352)
 			p.AddOrSubtractExpr()
 		}
 
-	case CypherParserT__21:
+	case CypherParserT__23:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1236)
-			p.Match(CypherParserT__21)
+			p.SetState(1353)
+			p.Match(CypherParserT__23)
 		}
-		p.SetState(1238)
+		p.SetState(1355)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1237)
+				p.SetState(1354)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1240)
+			p.SetState(1357)
 			p.AddOrSubtractExpr()
 		}
 
@@ -13232,7 +14371,7 @@ func (s *ParenthesizedExprContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *CypherParser) ParenthesizedExpr() (localctx IParenthesizedExprContext) {
 	localctx = NewParenthesizedExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, CypherParserRULE_parenthesizedExpr)
+	p.EnterRule(localctx, 154, CypherParserRULE_parenthesizedExpr)
 	var _la int
 
 	defer func() {
@@ -13253,38 +14392,38 @@ func (p *CypherParser) ParenthesizedExpr() (localctx IParenthesizedExprContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1243)
-		p.Match(CypherParserT__5)
+		p.SetState(1360)
+		p.Match(CypherParserT__7)
 	}
-	p.SetState(1245)
+	p.SetState(1362)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1244)
+			p.SetState(1361)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1247)
+		p.SetState(1364)
 		p.Expr()
 	}
-	p.SetState(1249)
+	p.SetState(1366)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1248)
+			p.SetState(1365)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1251)
-		p.Match(CypherParserT__6)
+		p.SetState(1368)
+		p.Match(CypherParserT__8)
 	}
 
 	return localctx
@@ -13389,7 +14528,7 @@ func (s *RelationshipsPatternContext) Accept(visitor antlr.ParseTreeVisitor) int
 
 func (p *CypherParser) RelationshipsPattern() (localctx IRelationshipsPatternContext) {
 	localctx = NewRelationshipsPatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, CypherParserRULE_relationshipsPattern)
+	p.EnterRule(localctx, 156, CypherParserRULE_relationshipsPattern)
 	var _la int
 
 	defer func() {
@@ -13412,28 +14551,28 @@ func (p *CypherParser) RelationshipsPattern() (localctx IRelationshipsPatternCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1253)
+		p.SetState(1370)
 		p.NodePattern()
 	}
-	p.SetState(1258)
+	p.SetState(1375)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
-			p.SetState(1255)
+			p.SetState(1372)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1254)
+					p.SetState(1371)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1257)
+				p.SetState(1374)
 				p.PatternElementChain()
 			}
 
@@ -13441,9 +14580,9 @@ func (p *CypherParser) RelationshipsPattern() (localctx IRelationshipsPatternCon
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(1260)
+		p.SetState(1377)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 220, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 241, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -13531,7 +14670,7 @@ func (s *FilterExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) FilterExpr() (localctx IFilterExprContext) {
 	localctx = NewFilterExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, CypherParserRULE_filterExpr)
+	p.EnterRule(localctx, 158, CypherParserRULE_filterExpr)
 	var _la int
 
 	defer func() {
@@ -13552,26 +14691,26 @@ func (p *CypherParser) FilterExpr() (localctx IFilterExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1262)
+		p.SetState(1379)
 		p.IdInColl()
 	}
-	p.SetState(1267)
+	p.SetState(1384)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 222, p.GetParserRuleContext()) == 1 {
-		p.SetState(1264)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 243, p.GetParserRuleContext()) == 1 {
+		p.SetState(1381)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1263)
+				p.SetState(1380)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1266)
+			p.SetState(1383)
 			p.WhereClause()
 		}
 
@@ -13670,7 +14809,7 @@ func (s *IdInCollContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) IdInColl() (localctx IIdInCollContext) {
 	localctx = NewIdInCollContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, CypherParserRULE_idInColl)
+	p.EnterRule(localctx, 160, CypherParserRULE_idInColl)
 
 	defer func() {
 		p.ExitRule()
@@ -13690,23 +14829,23 @@ func (p *CypherParser) IdInColl() (localctx IIdInCollContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1269)
+		p.SetState(1386)
 		p.Variable()
 	}
 	{
-		p.SetState(1270)
+		p.SetState(1387)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(1271)
+		p.SetState(1388)
 		p.Match(CypherParserIN)
 	}
 	{
-		p.SetState(1272)
+		p.SetState(1389)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(1273)
+		p.SetState(1390)
 		p.Expr()
 	}
 
@@ -13816,7 +14955,7 @@ func (s *FunctionInvocationContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *CypherParser) FunctionInvocation() (localctx IFunctionInvocationContext) {
 	localctx = NewFunctionInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, CypherParserRULE_functionInvocation)
+	p.EnterRule(localctx, 162, CypherParserRULE_functionInvocation)
 	var _la int
 
 	defer func() {
@@ -13837,122 +14976,122 @@ func (p *CypherParser) FunctionInvocation() (localctx IFunctionInvocationContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1275)
+		p.SetState(1392)
 		p.FunctionName()
 	}
-	p.SetState(1277)
+	p.SetState(1394)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1276)
+			p.SetState(1393)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1279)
-		p.Match(CypherParserT__5)
+		p.SetState(1396)
+		p.Match(CypherParserT__7)
 	}
-	p.SetState(1281)
+	p.SetState(1398)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1280)
+			p.SetState(1397)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1287)
+	p.SetState(1404)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserDISTINCT {
 		{
-			p.SetState(1283)
+			p.SetState(1400)
 			p.Match(CypherParserDISTINCT)
 		}
-		p.SetState(1285)
+		p.SetState(1402)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1284)
+				p.SetState(1401)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(1306)
+	p.SetState(1423)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__4)|(1<<CypherParserT__7)|(1<<CypherParserT__9)|(1<<CypherParserT__14)|(1<<CypherParserT__15)|(1<<CypherParserT__25))) != 0) || (((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(CypherParserALL-47))|(1<<(CypherParserCONCURRENT-47))|(1<<(CypherParserTRANSACTIONS-47))|(1<<(CypherParserROW-47))|(1<<(CypherParserROWS-47))|(1<<(CypherParserERROR-47))|(1<<(CypherParserCONTINUE-47))|(1<<(CypherParserBREAK-47))|(1<<(CypherParserFAIL-47)))) != 0) || (((_la-84)&-(0x1f+1)) == 0 && ((1<<uint((_la-84)))&((1<<(CypherParserNOT-84))|(1<<(CypherParserNULL-84))|(1<<(CypherParserCOUNT-84))|(1<<(CypherParserANY-84))|(1<<(CypherParserNONE-84))|(1<<(CypherParserSINGLE-84))|(1<<(CypherParserTRUE-84))|(1<<(CypherParserFALSE-84))|(1<<(CypherParserEXISTS-84))|(1<<(CypherParserCASE-84))|(1<<(CypherParserStringLiteral-84))|(1<<(CypherParserHexInteger-84))|(1<<(CypherParserDecimalInteger-84))|(1<<(CypherParserOctalInteger-84))|(1<<(CypherParserHexLetter-84))|(1<<(CypherParserExponentDecimalReal-84)))) != 0) || (((_la-116)&-(0x1f+1)) == 0 && ((1<<uint((_la-116)))&((1<<(CypherParserRegularDecimalReal-116))|(1<<(CypherParserFILTER-116))|(1<<(CypherParserEXTRACT-116))|(1<<(CypherParserUnescapedSymbolicName-116))|(1<<(CypherParserEscapedSymbolicName-116)))) != 0) {
 		{
-			p.SetState(1289)
+			p.SetState(1406)
 			p.Expr()
 		}
-		p.SetState(1291)
+		p.SetState(1408)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1290)
+				p.SetState(1407)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1303)
+		p.SetState(1420)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1293)
+				p.SetState(1410)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1295)
+			p.SetState(1412)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1294)
+					p.SetState(1411)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1297)
+				p.SetState(1414)
 				p.Expr()
 			}
-			p.SetState(1299)
+			p.SetState(1416)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1298)
+					p.SetState(1415)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1305)
+			p.SetState(1422)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1308)
-		p.Match(CypherParserT__6)
+		p.SetState(1425)
+		p.Match(CypherParserT__8)
 	}
 
 	return localctx
@@ -14040,7 +15179,7 @@ func (s *FunctionNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) FunctionName() (localctx IFunctionNameContext) {
 	localctx = NewFunctionNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, CypherParserRULE_functionName)
+	p.EnterRule(localctx, 164, CypherParserRULE_functionName)
 
 	defer func() {
 		p.ExitRule()
@@ -14058,25 +15197,25 @@ func (p *CypherParser) FunctionName() (localctx IFunctionNameContext) {
 		}
 	}()
 
-	p.SetState(1314)
+	p.SetState(1431)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserCONCURRENT, CypherParserTRANSACTIONS, CypherParserROW, CypherParserROWS, CypherParserERROR, CypherParserCONTINUE, CypherParserBREAK, CypherParserFAIL, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1310)
+			p.SetState(1427)
 			p.Namespace()
 		}
 		{
-			p.SetState(1311)
+			p.SetState(1428)
 			p.SymbolicName()
 		}
 
 	case CypherParserEXISTS:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1313)
+			p.SetState(1430)
 			p.Match(CypherParserEXISTS)
 		}
 
@@ -14186,7 +15325,7 @@ func (s *ExplicitProcedureInvocationContext) Accept(visitor antlr.ParseTreeVisit
 
 func (p *CypherParser) ExplicitProcedureInvocation() (localctx IExplicitProcedureInvocationContext) {
 	localctx = NewExplicitProcedureInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, CypherParserRULE_explicitProcedureInvocation)
+	p.EnterRule(localctx, 166, CypherParserRULE_explicitProcedureInvocation)
 	var _la int
 
 	defer func() {
@@ -14207,100 +15346,100 @@ func (p *CypherParser) ExplicitProcedureInvocation() (localctx IExplicitProcedur
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1316)
+		p.SetState(1433)
 		p.ProcedureName()
 	}
-	p.SetState(1318)
+	p.SetState(1435)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1317)
+			p.SetState(1434)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1320)
-		p.Match(CypherParserT__5)
+		p.SetState(1437)
+		p.Match(CypherParserT__7)
 	}
-	p.SetState(1322)
+	p.SetState(1439)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1321)
+			p.SetState(1438)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1341)
+	p.SetState(1458)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__4)|(1<<CypherParserT__7)|(1<<CypherParserT__9)|(1<<CypherParserT__14)|(1<<CypherParserT__15)|(1<<CypherParserT__25))) != 0) || (((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(CypherParserALL-47))|(1<<(CypherParserCONCURRENT-47))|(1<<(CypherParserTRANSACTIONS-47))|(1<<(CypherParserROW-47))|(1<<(CypherParserROWS-47))|(1<<(CypherParserERROR-47))|(1<<(CypherParserCONTINUE-47))|(1<<(CypherParserBREAK-47))|(1<<(CypherParserFAIL-47)))) != 0) || (((_la-84)&-(0x1f+1)) == 0 && ((1<<uint((_la-84)))&((1<<(CypherParserNOT-84))|(1<<(CypherParserNULL-84))|(1<<(CypherParserCOUNT-84))|(1<<(CypherParserANY-84))|(1<<(CypherParserNONE-84))|(1<<(CypherParserSINGLE-84))|(1<<(CypherParserTRUE-84))|(1<<(CypherParserFALSE-84))|(1<<(CypherParserEXISTS-84))|(1<<(CypherParserCASE-84))|(1<<(CypherParserStringLiteral-84))|(1<<(CypherParserHexInteger-84))|(1<<(CypherParserDecimalInteger-84))|(1<<(CypherParserOctalInteger-84))|(1<<(CypherParserHexLetter-84))|(1<<(CypherParserExponentDecimalReal-84)))) != 0) || (((_la-116)&-(0x1f+1)) == 0 && ((1<<uint((_la-116)))&((1<<(CypherParserRegularDecimalReal-116))|(1<<(CypherParserFILTER-116))|(1<<(CypherParserEXTRACT-116))|(1<<(CypherParserUnescapedSymbolicName-116))|(1<<(CypherParserEscapedSymbolicName-116)))) != 0) {
 		{
-			p.SetState(1324)
+			p.SetState(1441)
 			p.Expr()
 		}
-		p.SetState(1326)
+		p.SetState(1443)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1325)
+				p.SetState(1442)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1338)
+		p.SetState(1455)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1328)
+				p.SetState(1445)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1330)
+			p.SetState(1447)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1329)
+					p.SetState(1446)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1332)
+				p.SetState(1449)
 				p.Expr()
 			}
-			p.SetState(1334)
+			p.SetState(1451)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1333)
+					p.SetState(1450)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1340)
+			p.SetState(1457)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1343)
-		p.Match(CypherParserT__6)
+		p.SetState(1460)
+		p.Match(CypherParserT__8)
 	}
 
 	return localctx
@@ -14374,7 +15513,7 @@ func (s *ImplicitProcedureInvocationContext) Accept(visitor antlr.ParseTreeVisit
 
 func (p *CypherParser) ImplicitProcedureInvocation() (localctx IImplicitProcedureInvocationContext) {
 	localctx = NewImplicitProcedureInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, CypherParserRULE_implicitProcedureInvocation)
+	p.EnterRule(localctx, 168, CypherParserRULE_implicitProcedureInvocation)
 
 	defer func() {
 		p.ExitRule()
@@ -14394,7 +15533,7 @@ func (p *CypherParser) ImplicitProcedureInvocation() (localctx IImplicitProcedur
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1345)
+		p.SetState(1462)
 		p.ProcedureName()
 	}
 
@@ -14469,7 +15608,7 @@ func (s *ProcedureResultFieldContext) Accept(visitor antlr.ParseTreeVisitor) int
 
 func (p *CypherParser) ProcedureResultField() (localctx IProcedureResultFieldContext) {
 	localctx = NewProcedureResultFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, CypherParserRULE_procedureResultField)
+	p.EnterRule(localctx, 170, CypherParserRULE_procedureResultField)
 
 	defer func() {
 		p.ExitRule()
@@ -14489,7 +15628,7 @@ func (p *CypherParser) ProcedureResultField() (localctx IProcedureResultFieldCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1347)
+		p.SetState(1464)
 		p.SymbolicName()
 	}
 
@@ -14574,7 +15713,7 @@ func (s *ProcedureNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *CypherParser) ProcedureName() (localctx IProcedureNameContext) {
 	localctx = NewProcedureNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, CypherParserRULE_procedureName)
+	p.EnterRule(localctx, 172, CypherParserRULE_procedureName)
 
 	defer func() {
 		p.ExitRule()
@@ -14594,11 +15733,11 @@ func (p *CypherParser) ProcedureName() (localctx IProcedureNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1349)
+		p.SetState(1466)
 		p.Namespace()
 	}
 	{
-		p.SetState(1350)
+		p.SetState(1467)
 		p.SymbolicName()
 	}
 
@@ -14686,7 +15825,7 @@ func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) Namespace() (localctx INamespaceContext) {
 	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, CypherParserRULE_namespace)
+	p.EnterRule(localctx, 174, CypherParserRULE_namespace)
 
 	defer func() {
 		p.ExitRule()
@@ -14707,25 +15846,25 @@ func (p *CypherParser) Namespace() (localctx INamespaceContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1357)
+	p.SetState(1474)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 240, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 261, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(1352)
+				p.SetState(1469)
 				p.SymbolicName()
 			}
 			{
-				p.SetState(1353)
-				p.Match(CypherParserT__22)
+				p.SetState(1470)
+				p.Match(CypherParserT__24)
 			}
 
 		}
-		p.SetState(1359)
+		p.SetState(1476)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 240, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 261, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -14817,7 +15956,7 @@ func (s *ListComprehensionContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *CypherParser) ListComprehension() (localctx IListComprehensionContext) {
 	localctx = NewListComprehensionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, CypherParserRULE_listComprehension)
+	p.EnterRule(localctx, 176, CypherParserRULE_listComprehension)
 	var _la int
 
 	defer func() {
@@ -14838,74 +15977,74 @@ func (p *CypherParser) ListComprehension() (localctx IListComprehensionContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1360)
-		p.Match(CypherParserT__7)
+		p.SetState(1477)
+		p.Match(CypherParserT__9)
 	}
-	p.SetState(1362)
+	p.SetState(1479)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1361)
+			p.SetState(1478)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1364)
+		p.SetState(1481)
 		p.FilterExpr()
 	}
-	p.SetState(1373)
+	p.SetState(1490)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 244, p.GetParserRuleContext()) == 1 {
-		p.SetState(1366)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 265, p.GetParserRuleContext()) == 1 {
+		p.SetState(1483)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1365)
+				p.SetState(1482)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1368)
-			p.Match(CypherParserT__10)
+			p.SetState(1485)
+			p.Match(CypherParserT__12)
 		}
-		p.SetState(1370)
+		p.SetState(1487)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1369)
+				p.SetState(1486)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1372)
+			p.SetState(1489)
 			p.Expr()
 		}
 
 	}
-	p.SetState(1376)
+	p.SetState(1493)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1375)
+			p.SetState(1492)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1378)
-		p.Match(CypherParserT__8)
+		p.SetState(1495)
+		p.Match(CypherParserT__10)
 	}
 
 	return localctx
@@ -15024,7 +16163,7 @@ func (s *PatternComprehensionContext) Accept(visitor antlr.ParseTreeVisitor) int
 
 func (p *CypherParser) PatternComprehension() (localctx IPatternComprehensionContext) {
 	localctx = NewPatternComprehensionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, CypherParserRULE_patternComprehension)
+	p.EnterRule(localctx, 178, CypherParserRULE_patternComprehension)
 	var _la int
 
 	defer func() {
@@ -15045,51 +16184,51 @@ func (p *CypherParser) PatternComprehension() (localctx IPatternComprehensionCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1380)
-		p.Match(CypherParserT__7)
+		p.SetState(1497)
+		p.Match(CypherParserT__9)
 	}
-	p.SetState(1382)
+	p.SetState(1499)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1381)
+			p.SetState(1498)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1392)
+	p.SetState(1509)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la-61)&-(0x1f+1)) == 0 && ((1<<uint((_la-61)))&((1<<(CypherParserCONCURRENT-61))|(1<<(CypherParserTRANSACTIONS-61))|(1<<(CypherParserROW-61))|(1<<(CypherParserROWS-61))|(1<<(CypherParserERROR-61))|(1<<(CypherParserCONTINUE-61))|(1<<(CypherParserBREAK-61))|(1<<(CypherParserFAIL-61))|(1<<(CypherParserCOUNT-61))|(1<<(CypherParserANY-61)))) != 0) || (((_la-93)&-(0x1f+1)) == 0 && ((1<<uint((_la-93)))&((1<<(CypherParserNONE-93))|(1<<(CypherParserSINGLE-93))|(1<<(CypherParserHexLetter-93)))) != 0) || (((_la-127)&-(0x1f+1)) == 0 && ((1<<uint((_la-127)))&((1<<(CypherParserFILTER-127))|(1<<(CypherParserEXTRACT-127))|(1<<(CypherParserUnescapedSymbolicName-127))|(1<<(CypherParserEscapedSymbolicName-127)))) != 0) {
 		{
-			p.SetState(1384)
+			p.SetState(1501)
 			p.Variable()
 		}
-		p.SetState(1386)
+		p.SetState(1503)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1385)
+				p.SetState(1502)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1388)
+			p.SetState(1505)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(1390)
+		p.SetState(1507)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1389)
+				p.SetState(1506)
 				p.Match(CypherParserSP)
 			}
 
@@ -15097,51 +16236,51 @@ func (p *CypherParser) PatternComprehension() (localctx IPatternComprehensionCon
 
 	}
 	{
-		p.SetState(1394)
+		p.SetState(1511)
 		p.RelationshipsPattern()
 	}
-	p.SetState(1396)
+	p.SetState(1513)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1395)
+			p.SetState(1512)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1406)
+	p.SetState(1523)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserWHERE {
 		{
-			p.SetState(1398)
+			p.SetState(1515)
 			p.Match(CypherParserWHERE)
 		}
-		p.SetState(1400)
+		p.SetState(1517)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1399)
+				p.SetState(1516)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1402)
+			p.SetState(1519)
 			p.Expr()
 		}
-		p.SetState(1404)
+		p.SetState(1521)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1403)
+				p.SetState(1520)
 				p.Match(CypherParserSP)
 			}
 
@@ -15149,38 +16288,38 @@ func (p *CypherParser) PatternComprehension() (localctx IPatternComprehensionCon
 
 	}
 	{
-		p.SetState(1408)
-		p.Match(CypherParserT__10)
+		p.SetState(1525)
+		p.Match(CypherParserT__12)
 	}
-	p.SetState(1410)
+	p.SetState(1527)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1409)
+			p.SetState(1526)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1412)
+		p.SetState(1529)
 		p.Expr()
 	}
-	p.SetState(1414)
+	p.SetState(1531)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1413)
+			p.SetState(1530)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1416)
-		p.Match(CypherParserT__8)
+		p.SetState(1533)
+		p.Match(CypherParserT__10)
 	}
 
 	return localctx
@@ -15258,7 +16397,7 @@ func (s *PropertyLookupContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *CypherParser) PropertyLookup() (localctx IPropertyLookupContext) {
 	localctx = NewPropertyLookupContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, CypherParserRULE_propertyLookup)
+	p.EnterRule(localctx, 180, CypherParserRULE_propertyLookup)
 	var _la int
 
 	defer func() {
@@ -15279,23 +16418,23 @@ func (p *CypherParser) PropertyLookup() (localctx IPropertyLookupContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1418)
-		p.Match(CypherParserT__22)
+		p.SetState(1535)
+		p.Match(CypherParserT__24)
 	}
-	p.SetState(1420)
+	p.SetState(1537)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1419)
+			p.SetState(1536)
 			p.Match(CypherParserSP)
 		}
 
 	}
 
 	{
-		p.SetState(1422)
+		p.SetState(1539)
 		p.PropertyKeyName()
 	}
 
@@ -15426,7 +16565,7 @@ func (s *CaseExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) CaseExpr() (localctx ICaseExprContext) {
 	localctx = NewCaseExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, CypherParserRULE_caseExpr)
+	p.EnterRule(localctx, 182, CypherParserRULE_caseExpr)
 	var _la int
 
 	defer func() {
@@ -15448,33 +16587,33 @@ func (p *CypherParser) CaseExpr() (localctx ICaseExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1446)
+	p.SetState(1563)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 262, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 283, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(1424)
+			p.SetState(1541)
 			p.Match(CypherParserCASE)
 		}
-		p.SetState(1429)
+		p.SetState(1546)
 		p.GetErrorHandler().Sync(p)
 		_alt = 1
 		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			switch _alt {
 			case 1:
-				p.SetState(1426)
+				p.SetState(1543)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(1425)
+						p.SetState(1542)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(1428)
+					p.SetState(1545)
 					p.CaseAlternatives()
 				}
 
@@ -15482,50 +16621,50 @@ func (p *CypherParser) CaseExpr() (localctx ICaseExprContext) {
 				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			}
 
-			p.SetState(1431)
+			p.SetState(1548)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 258, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 279, p.GetParserRuleContext())
 		}
 
 	case 2:
 		{
-			p.SetState(1433)
+			p.SetState(1550)
 			p.Match(CypherParserCASE)
 		}
-		p.SetState(1435)
+		p.SetState(1552)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1434)
+				p.SetState(1551)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1437)
+			p.SetState(1554)
 			p.Expr()
 		}
-		p.SetState(1442)
+		p.SetState(1559)
 		p.GetErrorHandler().Sync(p)
 		_alt = 1
 		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			switch _alt {
 			case 1:
-				p.SetState(1439)
+				p.SetState(1556)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(1438)
+						p.SetState(1555)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(1441)
+					p.SetState(1558)
 					p.CaseAlternatives()
 				}
 
@@ -15533,61 +16672,61 @@ func (p *CypherParser) CaseExpr() (localctx ICaseExprContext) {
 				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			}
 
-			p.SetState(1444)
+			p.SetState(1561)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 261, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 282, p.GetParserRuleContext())
 		}
 
 	}
-	p.SetState(1456)
+	p.SetState(1573)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 265, p.GetParserRuleContext()) == 1 {
-		p.SetState(1449)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 286, p.GetParserRuleContext()) == 1 {
+		p.SetState(1566)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1448)
+				p.SetState(1565)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1451)
+			p.SetState(1568)
 			p.Match(CypherParserELSE)
 		}
-		p.SetState(1453)
+		p.SetState(1570)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1452)
+				p.SetState(1569)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1455)
+			p.SetState(1572)
 			p.Expr()
 		}
 
 	}
-	p.SetState(1459)
+	p.SetState(1576)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1458)
+			p.SetState(1575)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1461)
+		p.SetState(1578)
 		p.Match(CypherParserEND)
 	}
 
@@ -15691,7 +16830,7 @@ func (s *CaseAlternativesContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *CypherParser) CaseAlternatives() (localctx ICaseAlternativesContext) {
 	localctx = NewCaseAlternativesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, CypherParserRULE_caseAlternatives)
+	p.EnterRule(localctx, 184, CypherParserRULE_caseAlternatives)
 	var _la int
 
 	defer func() {
@@ -15712,52 +16851,52 @@ func (p *CypherParser) CaseAlternatives() (localctx ICaseAlternativesContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1463)
+		p.SetState(1580)
 		p.Match(CypherParserWHEN)
 	}
-	p.SetState(1465)
+	p.SetState(1582)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1464)
+			p.SetState(1581)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1467)
+		p.SetState(1584)
 		p.Expr()
 	}
-	p.SetState(1469)
+	p.SetState(1586)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1468)
+			p.SetState(1585)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1471)
+		p.SetState(1588)
 		p.Match(CypherParserTHEN)
 	}
-	p.SetState(1473)
+	p.SetState(1590)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1472)
+			p.SetState(1589)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1475)
+		p.SetState(1592)
 		p.Expr()
 	}
 
@@ -15832,7 +16971,7 @@ func (s *VariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) Variable() (localctx IVariableContext) {
 	localctx = NewVariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, CypherParserRULE_variable)
+	p.EnterRule(localctx, 186, CypherParserRULE_variable)
 
 	defer func() {
 		p.ExitRule()
@@ -15852,7 +16991,7 @@ func (p *CypherParser) Variable() (localctx IVariableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1477)
+		p.SetState(1594)
 		p.SymbolicName()
 	}
 
@@ -15937,7 +17076,7 @@ func (s *NumberLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *CypherParser) NumberLiteral() (localctx INumberLiteralContext) {
 	localctx = NewNumberLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, CypherParserRULE_numberLiteral)
+	p.EnterRule(localctx, 188, CypherParserRULE_numberLiteral)
 
 	defer func() {
 		p.ExitRule()
@@ -15955,21 +17094,21 @@ func (p *CypherParser) NumberLiteral() (localctx INumberLiteralContext) {
 		}
 	}()
 
-	p.SetState(1481)
+	p.SetState(1598)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserExponentDecimalReal, CypherParserRegularDecimalReal:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1479)
+			p.SetState(1596)
 			p.DoubleLiteral()
 		}
 
 	case CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1480)
+			p.SetState(1597)
 			p.IntegerLiteral()
 		}
 
@@ -16092,7 +17231,7 @@ func (s *MapLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) MapLiteral() (localctx IMapLiteralContext) {
 	localctx = NewMapLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, CypherParserRULE_mapLiteral)
+	p.EnterRule(localctx, 190, CypherParserRULE_mapLiteral)
 	var _la int
 
 	defer func() {
@@ -16113,145 +17252,145 @@ func (p *CypherParser) MapLiteral() (localctx IMapLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1483)
-		p.Match(CypherParserT__23)
+		p.SetState(1600)
+		p.Match(CypherParserT__4)
 	}
-	p.SetState(1485)
+	p.SetState(1602)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1484)
+			p.SetState(1601)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1520)
+	p.SetState(1637)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(CypherParserUNION-46))|(1<<(CypherParserALL-46))|(1<<(CypherParserOPTIONAL-46))|(1<<(CypherParserMATCH-46))|(1<<(CypherParserUNWIND-46))|(1<<(CypherParserAS-46))|(1<<(CypherParserMERGE-46))|(1<<(CypherParserON-46))|(1<<(CypherParserCREATE-46))|(1<<(CypherParserSET-46))|(1<<(CypherParserDETACH-46))|(1<<(CypherParserDELETE-46))|(1<<(CypherParserREMOVE-46))|(1<<(CypherParserWITH-46))|(1<<(CypherParserDISTINCT-46))|(1<<(CypherParserRETURN-46))|(1<<(CypherParserORDER-46))|(1<<(CypherParserBY-46))|(1<<(CypherParserL_SKIP-46))|(1<<(CypherParserLIMIT-46))|(1<<(CypherParserASCENDING-46))|(1<<(CypherParserASC-46))|(1<<(CypherParserDESCENDING-46))|(1<<(CypherParserDESC-46))|(1<<(CypherParserWHERE-46))|(1<<(CypherParserOR-46))|(1<<(CypherParserXOR-46))|(1<<(CypherParserAND-46))|(1<<(CypherParserNOT-46))|(1<<(CypherParserIN-46)))) != 0) || (((_la-78)&-(0x1f+1)) == 0 && ((1<<uint((_la-78)))&((1<<(CypherParserSTARTS-78))|(1<<(CypherParserENDS-78))|(1<<(CypherParserCONTAINS-78))|(1<<(CypherParserIS-78))|(1<<(CypherParserNULL-78))|(1<<(CypherParserCOUNT-78))|(1<<(CypherParserANY-78))|(1<<(CypherParserNONE-78))|(1<<(CypherParserSINGLE-78))|(1<<(CypherParserTRUE-78))|(1<<(CypherParserFALSE-78))|(1<<(CypherParserEXISTS-78))|(1<<(CypherParserCASE-78))|(1<<(CypherParserELSE-78))|(1<<(CypherParserEND-78))|(1<<(CypherParserWHEN-78))|(1<<(CypherParserTHEN-78))|(1<<(CypherParserHexLetter-78))|(1<<(CypherParserCONSTRAINT-78)))) != 0) || (((_la-110)&-(0x1f+1)) == 0 && ((1<<uint((_la-110)))&((1<<(CypherParserDO-110))|(1<<(CypherParserFOR-110))|(1<<(CypherParserREQUIRE-110))|(1<<(CypherParserUNIQUE-110))|(1<<(CypherParserMANDATORY-110))|(1<<(CypherParserSCALAR-110))|(1<<(CypherParserOF-110))|(1<<(CypherParserADD-110))|(1<<(CypherParserDROP-110))|(1<<(CypherParserFILTER-110))|(1<<(CypherParserEXTRACT-110))|(1<<(CypherParserUnescapedSymbolicName-110))|(1<<(CypherParserEscapedSymbolicName-110)))) != 0) {
+	if (((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(CypherParserUNION-46))|(1<<(CypherParserALL-46))|(1<<(CypherParserOPTIONAL-46))|(1<<(CypherParserMATCH-46))|(1<<(CypherParserUNWIND-46))|(1<<(CypherParserAS-46))|(1<<(CypherParserMERGE-46))|(1<<(CypherParserON-46))|(1<<(CypherParserCREATE-46))|(1<<(CypherParserSET-46))|(1<<(CypherParserDETACH-46))|(1<<(CypherParserDELETE-46))|(1<<(CypherParserREMOVE-46))|(1<<(CypherParserCONCURRENT-46))|(1<<(CypherParserTRANSACTIONS-46))|(1<<(CypherParserROW-46))|(1<<(CypherParserROWS-46))|(1<<(CypherParserERROR-46))|(1<<(CypherParserCONTINUE-46))|(1<<(CypherParserBREAK-46))|(1<<(CypherParserFAIL-46))|(1<<(CypherParserWITH-46))|(1<<(CypherParserDISTINCT-46))|(1<<(CypherParserRETURN-46))|(1<<(CypherParserORDER-46))|(1<<(CypherParserBY-46))|(1<<(CypherParserL_SKIP-46))|(1<<(CypherParserLIMIT-46))|(1<<(CypherParserASCENDING-46))|(1<<(CypherParserASC-46)))) != 0) || (((_la-78)&-(0x1f+1)) == 0 && ((1<<uint((_la-78)))&((1<<(CypherParserDESCENDING-78))|(1<<(CypherParserDESC-78))|(1<<(CypherParserWHERE-78))|(1<<(CypherParserOR-78))|(1<<(CypherParserXOR-78))|(1<<(CypherParserAND-78))|(1<<(CypherParserNOT-78))|(1<<(CypherParserIN-78))|(1<<(CypherParserSTARTS-78))|(1<<(CypherParserENDS-78))|(1<<(CypherParserCONTAINS-78))|(1<<(CypherParserIS-78))|(1<<(CypherParserNULL-78))|(1<<(CypherParserCOUNT-78))|(1<<(CypherParserANY-78))|(1<<(CypherParserNONE-78))|(1<<(CypherParserSINGLE-78))|(1<<(CypherParserTRUE-78))|(1<<(CypherParserFALSE-78))|(1<<(CypherParserEXISTS-78))|(1<<(CypherParserCASE-78))|(1<<(CypherParserELSE-78))|(1<<(CypherParserEND-78))|(1<<(CypherParserWHEN-78))|(1<<(CypherParserTHEN-78))|(1<<(CypherParserHexLetter-78)))) != 0) || (((_la-117)&-(0x1f+1)) == 0 && ((1<<uint((_la-117)))&((1<<(CypherParserCONSTRAINT-117))|(1<<(CypherParserDO-117))|(1<<(CypherParserFOR-117))|(1<<(CypherParserREQUIRE-117))|(1<<(CypherParserUNIQUE-117))|(1<<(CypherParserMANDATORY-117))|(1<<(CypherParserSCALAR-117))|(1<<(CypherParserOF-117))|(1<<(CypherParserADD-117))|(1<<(CypherParserDROP-117))|(1<<(CypherParserFILTER-117))|(1<<(CypherParserEXTRACT-117))|(1<<(CypherParserUnescapedSymbolicName-117))|(1<<(CypherParserEscapedSymbolicName-117)))) != 0) {
 		{
-			p.SetState(1487)
+			p.SetState(1604)
 			p.PropertyKeyName()
 		}
-		p.SetState(1489)
+		p.SetState(1606)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1488)
+				p.SetState(1605)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1491)
-			p.Match(CypherParserT__9)
+			p.SetState(1608)
+			p.Match(CypherParserT__11)
 		}
-		p.SetState(1493)
+		p.SetState(1610)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1492)
+				p.SetState(1609)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1495)
+			p.SetState(1612)
 			p.Expr()
 		}
-		p.SetState(1497)
+		p.SetState(1614)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1496)
+				p.SetState(1613)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1517)
+		p.SetState(1634)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1499)
+				p.SetState(1616)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1501)
+			p.SetState(1618)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1500)
+					p.SetState(1617)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1503)
+				p.SetState(1620)
 				p.PropertyKeyName()
 			}
-			p.SetState(1505)
+			p.SetState(1622)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1504)
+					p.SetState(1621)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1507)
-				p.Match(CypherParserT__9)
+				p.SetState(1624)
+				p.Match(CypherParserT__11)
 			}
-			p.SetState(1509)
+			p.SetState(1626)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1508)
+					p.SetState(1625)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1511)
+				p.SetState(1628)
 				p.Expr()
 			}
-			p.SetState(1513)
+			p.SetState(1630)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1512)
+					p.SetState(1629)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1519)
+			p.SetState(1636)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1522)
-		p.Match(CypherParserT__24)
+		p.SetState(1639)
+		p.Match(CypherParserT__5)
 	}
 
 	return localctx
@@ -16329,7 +17468,7 @@ func (s *ParameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) Parameter() (localctx IParameterContext) {
 	localctx = NewParameterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 182, CypherParserRULE_parameter)
+	p.EnterRule(localctx, 192, CypherParserRULE_parameter)
 
 	defer func() {
 		p.ExitRule()
@@ -16349,22 +17488,22 @@ func (p *CypherParser) Parameter() (localctx IParameterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1524)
+		p.SetState(1641)
 		p.Match(CypherParserT__25)
 	}
-	p.SetState(1527)
+	p.SetState(1644)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserCONCURRENT, CypherParserTRANSACTIONS, CypherParserROW, CypherParserROWS, CypherParserERROR, CypherParserCONTINUE, CypherParserBREAK, CypherParserFAIL, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		{
-			p.SetState(1525)
+			p.SetState(1642)
 			p.SymbolicName()
 		}
 
 	case CypherParserDecimalInteger:
 		{
-			p.SetState(1526)
+			p.SetState(1643)
 			p.Match(CypherParserDecimalInteger)
 		}
 
@@ -16474,7 +17613,7 @@ func (s *PropertyExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) PropertyExpr() (localctx IPropertyExprContext) {
 	localctx = NewPropertyExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 184, CypherParserRULE_propertyExpr)
+	p.EnterRule(localctx, 194, CypherParserRULE_propertyExpr)
 	var _la int
 
 	defer func() {
@@ -16497,28 +17636,28 @@ func (p *CypherParser) PropertyExpr() (localctx IPropertyExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1529)
+		p.SetState(1646)
 		p.Atom()
 	}
-	p.SetState(1534)
+	p.SetState(1651)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
-			p.SetState(1531)
+			p.SetState(1648)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1530)
+					p.SetState(1647)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1533)
+				p.SetState(1650)
 				p.PropertyLookup()
 			}
 
@@ -16526,9 +17665,9 @@ func (p *CypherParser) PropertyExpr() (localctx IPropertyExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(1536)
+		p.SetState(1653)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 283, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 304, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -16602,7 +17741,7 @@ func (s *PropertyKeyNameContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *CypherParser) PropertyKeyName() (localctx IPropertyKeyNameContext) {
 	localctx = NewPropertyKeyNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 186, CypherParserRULE_propertyKeyName)
+	p.EnterRule(localctx, 196, CypherParserRULE_propertyKeyName)
 
 	defer func() {
 		p.ExitRule()
@@ -16622,7 +17761,7 @@ func (p *CypherParser) PropertyKeyName() (localctx IPropertyKeyNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1538)
+		p.SetState(1655)
 		p.SchemaName()
 	}
 
@@ -16699,7 +17838,7 @@ func (s *IntegerLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *CypherParser) IntegerLiteral() (localctx IIntegerLiteralContext) {
 	localctx = NewIntegerLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 188, CypherParserRULE_integerLiteral)
+	p.EnterRule(localctx, 198, CypherParserRULE_integerLiteral)
 	var _la int
 
 	defer func() {
@@ -16719,10 +17858,10 @@ func (p *CypherParser) IntegerLiteral() (localctx IIntegerLiteralContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1540)
+	p.SetState(1657)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(((_la-97)&-(0x1f+1)) == 0 && ((1<<uint((_la-97)))&((1<<(CypherParserHexInteger-97))|(1<<(CypherParserDecimalInteger-97))|(1<<(CypherParserOctalInteger-97)))) != 0) {
+	if !(((_la-105)&-(0x1f+1)) == 0 && ((1<<uint((_la-105)))&((1<<(CypherParserHexInteger-105))|(1<<(CypherParserDecimalInteger-105))|(1<<(CypherParserOctalInteger-105)))) != 0) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
@@ -16798,7 +17937,7 @@ func (s *DoubleLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *CypherParser) DoubleLiteral() (localctx IDoubleLiteralContext) {
 	localctx = NewDoubleLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 190, CypherParserRULE_doubleLiteral)
+	p.EnterRule(localctx, 200, CypherParserRULE_doubleLiteral)
 	var _la int
 
 	defer func() {
@@ -16818,7 +17957,7 @@ func (p *CypherParser) DoubleLiteral() (localctx IDoubleLiteralContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1542)
+	p.SetState(1659)
 	_la = p.GetTokenStream().LA(1)
 
 	if !(_la == CypherParserExponentDecimalReal || _la == CypherParserRegularDecimalReal) {
@@ -16909,7 +18048,7 @@ func (s *SchemaNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) SchemaName() (localctx ISchemaNameContext) {
 	localctx = NewSchemaNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 192, CypherParserRULE_schemaName)
+	p.EnterRule(localctx, 202, CypherParserRULE_schemaName)
 
 	defer func() {
 		p.ExitRule()
@@ -16927,21 +18066,21 @@ func (p *CypherParser) SchemaName() (localctx ISchemaNameContext) {
 		}
 	}()
 
-	p.SetState(1546)
+	p.SetState(1663)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserCONCURRENT, CypherParserTRANSACTIONS, CypherParserROW, CypherParserROWS, CypherParserERROR, CypherParserCONTINUE, CypherParserBREAK, CypherParserFAIL, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1544)
+			p.SetState(1661)
 			p.SymbolicName()
 		}
 
 	case CypherParserUNION, CypherParserALL, CypherParserOPTIONAL, CypherParserMATCH, CypherParserUNWIND, CypherParserAS, CypherParserMERGE, CypherParserON, CypherParserCREATE, CypherParserSET, CypherParserDETACH, CypherParserDELETE, CypherParserREMOVE, CypherParserWITH, CypherParserDISTINCT, CypherParserRETURN, CypherParserORDER, CypherParserBY, CypherParserL_SKIP, CypherParserLIMIT, CypherParserASCENDING, CypherParserASC, CypherParserDESCENDING, CypherParserDESC, CypherParserWHERE, CypherParserOR, CypherParserXOR, CypherParserAND, CypherParserNOT, CypherParserIN, CypherParserSTARTS, CypherParserENDS, CypherParserCONTAINS, CypherParserIS, CypherParserNULL, CypherParserTRUE, CypherParserFALSE, CypherParserEXISTS, CypherParserCASE, CypherParserELSE, CypherParserEND, CypherParserWHEN, CypherParserTHEN, CypherParserCONSTRAINT, CypherParserDO, CypherParserFOR, CypherParserREQUIRE, CypherParserUNIQUE, CypherParserMANDATORY, CypherParserSCALAR, CypherParserOF, CypherParserADD, CypherParserDROP:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1545)
+			p.SetState(1662)
 			p.ReservedWord()
 		}
 
@@ -17222,7 +18361,7 @@ func (s *ReservedWordContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) ReservedWord() (localctx IReservedWordContext) {
 	localctx = NewReservedWordContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 194, CypherParserRULE_reservedWord)
+	p.EnterRule(localctx, 204, CypherParserRULE_reservedWord)
 	var _la int
 
 	defer func() {
@@ -17242,10 +18381,10 @@ func (p *CypherParser) ReservedWord() (localctx IReservedWordContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1548)
+	p.SetState(1665)
 	_la = p.GetTokenStream().LA(1)
 
-	if !((((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(CypherParserUNION-46))|(1<<(CypherParserALL-46))|(1<<(CypherParserOPTIONAL-46))|(1<<(CypherParserMATCH-46))|(1<<(CypherParserUNWIND-46))|(1<<(CypherParserAS-46))|(1<<(CypherParserMERGE-46))|(1<<(CypherParserON-46))|(1<<(CypherParserCREATE-46))|(1<<(CypherParserSET-46))|(1<<(CypherParserDETACH-46))|(1<<(CypherParserDELETE-46))|(1<<(CypherParserREMOVE-46))|(1<<(CypherParserWITH-46))|(1<<(CypherParserDISTINCT-46))|(1<<(CypherParserRETURN-46))|(1<<(CypherParserORDER-46))|(1<<(CypherParserBY-46))|(1<<(CypherParserL_SKIP-46))|(1<<(CypherParserLIMIT-46))|(1<<(CypherParserASCENDING-46))|(1<<(CypherParserASC-46))|(1<<(CypherParserDESCENDING-46))|(1<<(CypherParserDESC-46))|(1<<(CypherParserWHERE-46))|(1<<(CypherParserOR-46))|(1<<(CypherParserXOR-46))|(1<<(CypherParserAND-46))|(1<<(CypherParserNOT-46))|(1<<(CypherParserIN-46)))) != 0) || (((_la-78)&-(0x1f+1)) == 0 && ((1<<uint((_la-78)))&((1<<(CypherParserSTARTS-78))|(1<<(CypherParserENDS-78))|(1<<(CypherParserCONTAINS-78))|(1<<(CypherParserIS-78))|(1<<(CypherParserNULL-78))|(1<<(CypherParserTRUE-78))|(1<<(CypherParserFALSE-78))|(1<<(CypherParserEXISTS-78))|(1<<(CypherParserCASE-78))|(1<<(CypherParserELSE-78))|(1<<(CypherParserEND-78))|(1<<(CypherParserWHEN-78))|(1<<(CypherParserTHEN-78))|(1<<(CypherParserCONSTRAINT-78)))) != 0) || (((_la-110)&-(0x1f+1)) == 0 && ((1<<uint((_la-110)))&((1<<(CypherParserDO-110))|(1<<(CypherParserFOR-110))|(1<<(CypherParserREQUIRE-110))|(1<<(CypherParserUNIQUE-110))|(1<<(CypherParserMANDATORY-110))|(1<<(CypherParserSCALAR-110))|(1<<(CypherParserOF-110))|(1<<(CypherParserADD-110))|(1<<(CypherParserDROP-110)))) != 0)) {
+	if !((((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(CypherParserUNION-46))|(1<<(CypherParserALL-46))|(1<<(CypherParserOPTIONAL-46))|(1<<(CypherParserMATCH-46))|(1<<(CypherParserUNWIND-46))|(1<<(CypherParserAS-46))|(1<<(CypherParserMERGE-46))|(1<<(CypherParserON-46))|(1<<(CypherParserCREATE-46))|(1<<(CypherParserSET-46))|(1<<(CypherParserDETACH-46))|(1<<(CypherParserDELETE-46))|(1<<(CypherParserREMOVE-46))|(1<<(CypherParserWITH-46))|(1<<(CypherParserDISTINCT-46))|(1<<(CypherParserRETURN-46))|(1<<(CypherParserORDER-46))|(1<<(CypherParserBY-46))|(1<<(CypherParserL_SKIP-46))|(1<<(CypherParserLIMIT-46))|(1<<(CypherParserASCENDING-46))|(1<<(CypherParserASC-46)))) != 0) || (((_la-78)&-(0x1f+1)) == 0 && ((1<<uint((_la-78)))&((1<<(CypherParserDESCENDING-78))|(1<<(CypherParserDESC-78))|(1<<(CypherParserWHERE-78))|(1<<(CypherParserOR-78))|(1<<(CypherParserXOR-78))|(1<<(CypherParserAND-78))|(1<<(CypherParserNOT-78))|(1<<(CypherParserIN-78))|(1<<(CypherParserSTARTS-78))|(1<<(CypherParserENDS-78))|(1<<(CypherParserCONTAINS-78))|(1<<(CypherParserIS-78))|(1<<(CypherParserNULL-78))|(1<<(CypherParserTRUE-78))|(1<<(CypherParserFALSE-78))|(1<<(CypherParserEXISTS-78))|(1<<(CypherParserCASE-78))|(1<<(CypherParserELSE-78))|(1<<(CypherParserEND-78))|(1<<(CypherParserWHEN-78))|(1<<(CypherParserTHEN-78)))) != 0) || (((_la-117)&-(0x1f+1)) == 0 && ((1<<uint((_la-117)))&((1<<(CypherParserCONSTRAINT-117))|(1<<(CypherParserDO-117))|(1<<(CypherParserFOR-117))|(1<<(CypherParserREQUIRE-117))|(1<<(CypherParserUNIQUE-117))|(1<<(CypherParserMANDATORY-117))|(1<<(CypherParserSCALAR-117))|(1<<(CypherParserOF-117))|(1<<(CypherParserADD-117))|(1<<(CypherParserDROP-117)))) != 0)) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
@@ -17329,6 +18468,38 @@ func (s *SymbolicNameContext) SINGLE() antlr.TerminalNode {
 	return s.GetToken(CypherParserSINGLE, 0)
 }
 
+func (s *SymbolicNameContext) CONCURRENT() antlr.TerminalNode {
+	return s.GetToken(CypherParserCONCURRENT, 0)
+}
+
+func (s *SymbolicNameContext) TRANSACTIONS() antlr.TerminalNode {
+	return s.GetToken(CypherParserTRANSACTIONS, 0)
+}
+
+func (s *SymbolicNameContext) ROW() antlr.TerminalNode {
+	return s.GetToken(CypherParserROW, 0)
+}
+
+func (s *SymbolicNameContext) ROWS() antlr.TerminalNode {
+	return s.GetToken(CypherParserROWS, 0)
+}
+
+func (s *SymbolicNameContext) ERROR() antlr.TerminalNode {
+	return s.GetToken(CypherParserERROR, 0)
+}
+
+func (s *SymbolicNameContext) CONTINUE() antlr.TerminalNode {
+	return s.GetToken(CypherParserCONTINUE, 0)
+}
+
+func (s *SymbolicNameContext) BREAK() antlr.TerminalNode {
+	return s.GetToken(CypherParserBREAK, 0)
+}
+
+func (s *SymbolicNameContext) FAIL() antlr.TerminalNode {
+	return s.GetToken(CypherParserFAIL, 0)
+}
+
 func (s *SymbolicNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -17349,7 +18520,7 @@ func (s *SymbolicNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) SymbolicName() (localctx ISymbolicNameContext) {
 	localctx = NewSymbolicNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 196, CypherParserRULE_symbolicName)
+	p.EnterRule(localctx, 206, CypherParserRULE_symbolicName)
 	var _la int
 
 	defer func() {
@@ -17369,10 +18540,10 @@ func (p *CypherParser) SymbolicName() (localctx ISymbolicNameContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1550)
+	p.SetState(1667)
 	_la = p.GetTokenStream().LA(1)
 
-	if !((((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0)) {
+	if !((((_la-61)&-(0x1f+1)) == 0 && ((1<<uint((_la-61)))&((1<<(CypherParserCONCURRENT-61))|(1<<(CypherParserTRANSACTIONS-61))|(1<<(CypherParserROW-61))|(1<<(CypherParserROWS-61))|(1<<(CypherParserERROR-61))|(1<<(CypherParserCONTINUE-61))|(1<<(CypherParserBREAK-61))|(1<<(CypherParserFAIL-61))|(1<<(CypherParserCOUNT-61))|(1<<(CypherParserANY-61)))) != 0) || (((_la-93)&-(0x1f+1)) == 0 && ((1<<uint((_la-93)))&((1<<(CypherParserNONE-93))|(1<<(CypherParserSINGLE-93))|(1<<(CypherParserHexLetter-93)))) != 0) || (((_la-127)&-(0x1f+1)) == 0 && ((1<<uint((_la-127)))&((1<<(CypherParserFILTER-127))|(1<<(CypherParserEXTRACT-127))|(1<<(CypherParserUnescapedSymbolicName-127))|(1<<(CypherParserEscapedSymbolicName-127)))) != 0)) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
@@ -17439,7 +18610,7 @@ func (s *LeftArrowHeadContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *CypherParser) LeftArrowHead() (localctx ILeftArrowHeadContext) {
 	localctx = NewLeftArrowHeadContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 198, CypherParserRULE_leftArrowHead)
+	p.EnterRule(localctx, 208, CypherParserRULE_leftArrowHead)
 	var _la int
 
 	defer func() {
@@ -17459,10 +18630,10 @@ func (p *CypherParser) LeftArrowHead() (localctx ILeftArrowHeadContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1552)
+	p.SetState(1669)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__18)|(1<<CypherParserT__26)|(1<<CypherParserT__27)|(1<<CypherParserT__28)|(1<<CypherParserT__29))) != 0) {
+	if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__20)|(1<<CypherParserT__26)|(1<<CypherParserT__27)|(1<<CypherParserT__28)|(1<<CypherParserT__29))) != 0) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
@@ -17529,7 +18700,7 @@ func (s *RightArrowHeadContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *CypherParser) RightArrowHead() (localctx IRightArrowHeadContext) {
 	localctx = NewRightArrowHeadContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 200, CypherParserRULE_rightArrowHead)
+	p.EnterRule(localctx, 210, CypherParserRULE_rightArrowHead)
 	var _la int
 
 	defer func() {
@@ -17549,10 +18720,10 @@ func (p *CypherParser) RightArrowHead() (localctx IRightArrowHeadContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1554)
+	p.SetState(1671)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(((_la-20)&-(0x1f+1)) == 0 && ((1<<uint((_la-20)))&((1<<(CypherParserT__19-20))|(1<<(CypherParserT__30-20))|(1<<(CypherParserT__31-20))|(1<<(CypherParserT__32-20))|(1<<(CypherParserT__33-20)))) != 0) {
+	if !(((_la-22)&-(0x1f+1)) == 0 && ((1<<uint((_la-22)))&((1<<(CypherParserT__21-22))|(1<<(CypherParserT__30-22))|(1<<(CypherParserT__31-22))|(1<<(CypherParserT__32-22))|(1<<(CypherParserT__33-22)))) != 0) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
@@ -17619,7 +18790,7 @@ func (s *DashContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) Dash() (localctx IDashContext) {
 	localctx = NewDashContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 202, CypherParserRULE_dash)
+	p.EnterRule(localctx, 212, CypherParserRULE_dash)
 	var _la int
 
 	defer func() {
@@ -17639,10 +18810,10 @@ func (p *CypherParser) Dash() (localctx IDashContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1556)
+	p.SetState(1673)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(((_la-14)&-(0x1f+1)) == 0 && ((1<<uint((_la-14)))&((1<<(CypherParserT__13-14))|(1<<(CypherParserT__34-14))|(1<<(CypherParserT__35-14))|(1<<(CypherParserT__36-14))|(1<<(CypherParserT__37-14))|(1<<(CypherParserT__38-14))|(1<<(CypherParserT__39-14))|(1<<(CypherParserT__40-14))|(1<<(CypherParserT__41-14))|(1<<(CypherParserT__42-14))|(1<<(CypherParserT__43-14))|(1<<(CypherParserT__44-14)))) != 0) {
+	if !(((_la-16)&-(0x1f+1)) == 0 && ((1<<uint((_la-16)))&((1<<(CypherParserT__15-16))|(1<<(CypherParserT__34-16))|(1<<(CypherParserT__35-16))|(1<<(CypherParserT__36-16))|(1<<(CypherParserT__37-16))|(1<<(CypherParserT__38-16))|(1<<(CypherParserT__39-16))|(1<<(CypherParserT__40-16))|(1<<(CypherParserT__41-16))|(1<<(CypherParserT__42-16))|(1<<(CypherParserT__43-16))|(1<<(CypherParserT__44-16)))) != 0) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
@@ -74,6 +74,12 @@ type CypherVisitor interface {
 	// Visit a parse tree produced by CypherParser#inQueryCall.
 	VisitInQueryCall(ctx *InQueryCallContext) interface{}
 
+	// Visit a parse tree produced by CypherParser#subqueryClause.
+	VisitSubqueryClause(ctx *SubqueryClauseContext) interface{}
+
+	// Visit a parse tree produced by CypherParser#inTransactions.
+	VisitInTransactions(ctx *InTransactionsContext) interface{}
+
 	// Visit a parse tree produced by CypherParser#standaloneCall.
 	VisitStandaloneCall(ctx *StandaloneCallContext) interface{}
 
@@ -212,6 +218,15 @@ type CypherVisitor interface {
 	// Visit a parse tree produced by CypherParser#atom.
 	VisitAtom(ctx *AtomContext) interface{}
 
+	// Visit a parse tree produced by CypherParser#existsSubquery.
+	VisitExistsSubquery(ctx *ExistsSubqueryContext) interface{}
+
+	// Visit a parse tree produced by CypherParser#countSubquery.
+	VisitCountSubquery(ctx *CountSubqueryContext) interface{}
+
+	// Visit a parse tree produced by CypherParser#subqueryBody.
+	VisitSubqueryBody(ctx *SubqueryBodyContext) interface{}
+
 	// Visit a parse tree produced by CypherParser#literal.
 	VisitLiteral(ctx *LiteralContext) interface{}
 
@@ -0,0 +1,236 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Error represents an error raised while parsing cypher,
+// either a syntax error or a construct which is not supported yet.
+type Error struct {
+	Msg string
+	// Line starts from 1, Column starts from 0, same as ANTLR.
+	// Both of them are 0 if the position is unknown.
+	Line   int
+	Column int
+	// Token is the text of offending token.
+	Token string
+	// SyntaxErrors contains all the syntax errors reported by lexer and parser,
+	// the other fields are taken from the first one.
+	SyntaxErrors []*SyntaxError
+}
+
+// Error implements error interface
+func (e *Error) Error() string {
+	if e.Line == 0 {
+		return e.Msg
+	}
+	if e.Token == "" {
+		return fmt.Sprintf("line %d:%d %s", e.Line, e.Column, e.Msg)
+	}
+	return fmt.Sprintf("line %d:%d near %q: %s", e.Line, e.Column, e.Token, e.Msg)
+}
+
+func newError(token antlr.Token, format string, args ...interface{}) *Error {
+	err := &Error{
+		Msg: fmt.Sprintf(format, args...),
+	}
+	if token != nil {
+		err.Line = token.GetLine()
+		err.Column = token.GetColumn()
+		err.Token = token.GetText()
+	}
+	return err
+}
+
+// SyntaxError represents a syntax error reported by lexer or parser.
+type SyntaxError struct {
+	Msg    string
+	Line   int
+	Column int
+	// Start and Stop are byte offsets of the offending text in source,
+	// i.e. source[Start:Stop] is the offending text.
+	Start int
+	Stop  int
+	// Text is the offending text, it is "<EOF>" at the end of input.
+	Text string
+	// Expected is the set of tokens expected at the offending position.
+	// It is always empty for errors reported by lexer.
+	Expected []string
+}
+
+// Error implements error interface
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d:%d %s", e.Line, e.Column, e.Msg)
+}
+
+// ErrorListener collects all the syntax errors reported by lexer and parser.
+type ErrorListener struct {
+	*antlr.DefaultErrorListener
+
+	offsets offsetMap
+	Errors  []*SyntaxError
+}
+
+// NewErrorListener will create an ErrorListener for source.
+func NewErrorListener(source string) *ErrorListener {
+	return &ErrorListener{
+		DefaultErrorListener: antlr.NewDefaultErrorListener(),
+		offsets:              newOffsetMap(source),
+	}
+}
+
+// SyntaxError implements antlr.ErrorListener interface
+func (l *ErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	err := &SyntaxError{
+		Msg:    msg,
+		Line:   line,
+		Column: column,
+	}
+	switch r := recognizer.(type) {
+	case antlr.Parser:
+		token := offendingSymbol.(antlr.Token)
+		err.Start = l.offsets.byteOffset(token.GetStart())
+		err.Stop = l.offsets.byteOffset(token.GetStop() + 1)
+		if token.GetTokenType() == antlr.TokenEOF {
+			err.Stop = err.Start
+		}
+		err.Text = token.GetText()
+		err.Expected = expectedTokens(r)
+	case *antlr.BaseLexer:
+		start := r.TokenStartCharIndex
+		stop := r.GetInputStream().Index()
+		err.Start = l.offsets.byteOffset(start)
+		err.Stop = l.offsets.byteOffset(stop + 1)
+		err.Text = r.GetInputStream().GetText(start, stop)
+	}
+	l.Errors = append(l.Errors, err)
+}
+
+// Err returns an *Error built from the collected syntax errors,
+// or nil if there is no syntax error.
+func (l *ErrorListener) Err() *Error {
+	if len(l.Errors) == 0 {
+		return nil
+	}
+	first := l.Errors[0]
+	return &Error{
+		Msg:          first.Msg,
+		Line:         first.Line,
+		Column:       first.Column,
+		Token:        first.Text,
+		SyntaxErrors: l.Errors,
+	}
+}
+
+// BailError is raised by BailErrorStrategy when parsing is aborted.
+type BailError struct{}
+
+// Error implements error interface
+func (e *BailError) Error() string {
+	return "parsing is aborted on syntax error"
+}
+
+// BailErrorStrategy aborts parsing on the first syntax error by panicking with *BailError,
+// without reporting or recovering from it.
+// It is used by the SLL stage of parsing, which falls back to LL stage on failure.
+//
+// antlr.BailErrorStrategy isn't used since its Recover panics with a nil parent context.
+type BailErrorStrategy struct {
+	*antlr.DefaultErrorStrategy
+}
+
+// NewBailErrorStrategy will create a BailErrorStrategy
+func NewBailErrorStrategy() *BailErrorStrategy {
+	return &BailErrorStrategy{
+		DefaultErrorStrategy: antlr.NewDefaultErrorStrategy(),
+	}
+}
+
+// ReportError implements antlr.ErrorStrategy interface
+func (s *BailErrorStrategy) ReportError(recognizer antlr.Parser, e antlr.RecognitionException) {}
+
+// Recover implements antlr.ErrorStrategy interface
+func (s *BailErrorStrategy) Recover(recognizer antlr.Parser, e antlr.RecognitionException) {
+	panic(&BailError{})
+}
+
+// RecoverInline implements antlr.ErrorStrategy interface
+func (s *BailErrorStrategy) RecoverInline(recognizer antlr.Parser) antlr.Token {
+	panic(&BailError{})
+}
+
+// Sync implements antlr.ErrorStrategy interface
+func (s *BailErrorStrategy) Sync(recognizer antlr.Parser) {}
+
+// expectedTokens returns display names of the tokens which could follow the current state of p.
+func expectedTokens(p antlr.Parser) []string {
+	literalNames := p.GetLiteralNames()
+	symbolicNames := p.GetSymbolicNames()
+	// IntervalSet doesn't expose its elements, so stringify it with token types as names.
+	types := make([]string, len(symbolicNames))
+	for i := range types {
+		types[i] = strconv.Itoa(i)
+	}
+	set := p.GetExpectedTokens().StringVerbose(nil, types, false)
+	set = strings.TrimSuffix(strings.TrimPrefix(set, "{"), "}")
+	if set == "" {
+		return nil
+	}
+	var names []string
+	for _, t := range strings.Split(set, ", ") {
+		i, err := strconv.Atoi(t)
+		switch {
+		case err != nil:
+			// <EOF> or <EPSILON>
+			names = append(names, t)
+		case i < len(literalNames) && literalNames[i] != "":
+			names = append(names, literalNames[i])
+		default:
+			names = append(names, symbolicNames[i])
+		}
+	}
+	return names
+}
+
+// offsetMap maps character index of antlr.InputStream into byte offset of source.
+// It is nil if source only contains single-byte characters.
+type offsetMap []int
+
+func newOffsetMap(source string) offsetMap {
+	if utf8.RuneCountInString(source) == len(source) {
+		return nil
+	}
+	m := make(offsetMap, 0, len(source)+1)
+	for i := range source {
+		m = append(m, i)
+	}
+	return append(m, len(source))
+}
+
+func (m offsetMap) byteOffset(i int) int {
+	if m == nil {
+		return i
+	}
+	if i >= len(m) {
+		return m[len(m)-1]
+	}
+	return m[i]
+}
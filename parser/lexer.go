@@ -0,0 +1,78 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// CommentLexer is a CypherLexer which joins the spaces and comments between two tokens into one SP token.
+//
+// Comments are lexed as Comment tokens on the hidden channel by the grammar, but SP is required
+// between some tokens, and a comment can be the only separator, e.g. `WITH/* c */n`.
+// So the Comment tokens between two tokens are followed by an SP token spanning all the
+// spaces and comments there, which is what the parser sees.
+type CommentLexer struct {
+	*CypherLexer
+
+	pending []antlr.Token
+}
+
+// NewCommentLexer will create a CommentLexer reading tokens from lexer
+func NewCommentLexer(lexer *CypherLexer) *CommentLexer {
+	return &CommentLexer{CypherLexer: lexer}
+}
+
+// NextToken implements antlr.TokenSource interface
+func (l *CommentLexer) NextToken() antlr.Token {
+	if len(l.pending) == 0 {
+		l.pending = l.lex()
+	}
+	token := l.pending[0]
+	l.pending = l.pending[1:]
+	return token
+}
+
+// GetAllTokens returns all the tokens before EOF.
+func (l *CommentLexer) GetAllTokens() []antlr.Token {
+	var tokens []antlr.Token
+	for token := l.NextToken(); token.GetTokenType() != antlr.TokenEOF; token = l.NextToken() {
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// lex returns the next token, preceded by the comments and the SP token before it if there is any.
+func (l *CommentLexer) lex() []antlr.Token {
+	var spaces, comments []antlr.Token
+	for {
+		token := l.CypherLexer.NextToken()
+		switch token.GetTokenType() {
+		case CypherLexerSP:
+			spaces = append(spaces, token)
+			continue
+		case CypherLexerComment:
+			spaces = append(spaces, token)
+			comments = append(comments, token)
+			continue
+		}
+		if len(comments) == 0 {
+			return append(spaces, token)
+		}
+		first, last := spaces[0], spaces[len(spaces)-1]
+		sp := l.GetTokenFactory().Create(first.GetSource(), CypherLexerSP, "",
+			antlr.TokenDefaultChannel, first.GetStart(), last.GetStop(), first.GetLine(), first.GetColumn())
+		return append(append(comments, sp), token)
+	}
+}
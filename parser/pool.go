@@ -0,0 +1,98 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"sync"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Instance owns a copy of the ATN and DFA cache used by CypherLexer and CypherParser.
+//
+// The ANTLR runtime updates the DFA cache and some ATN states without locking,
+// so sharing them between goroutines is racy. An Instance must only be used
+// by one goroutine at a time, but its cache stays warm across parses.
+type Instance struct {
+	lexerATN    *antlr.ATN
+	lexerDFA    []*antlr.DFA
+	lexerCache  *antlr.PredictionContextCache
+	parserATN   *antlr.ATN
+	parserDFA   []*antlr.DFA
+	parserCache *antlr.PredictionContextCache
+}
+
+// NewInstance will create an Instance with a cold cache
+func NewInstance() *Instance {
+	i := &Instance{
+		lexerATN:    antlr.NewATNDeserializer(nil).DeserializeFromUInt16(serializedLexerAtn),
+		lexerCache:  antlr.NewPredictionContextCache(),
+		parserATN:   antlr.NewATNDeserializer(nil).DeserializeFromUInt16(parserATN),
+		parserCache: antlr.NewPredictionContextCache(),
+	}
+	i.lexerDFA = newDecisionToDFA(i.lexerATN)
+	i.parserDFA = newDecisionToDFA(i.parserATN)
+	return i
+}
+
+func newDecisionToDFA(atn *antlr.ATN) []*antlr.DFA {
+	dfa := make([]*antlr.DFA, len(atn.DecisionToState))
+	for i, ds := range atn.DecisionToState {
+		dfa[i] = antlr.NewDFA(ds, i)
+	}
+	return dfa
+}
+
+// NewLexer will create a CommentLexer using the cache of i
+func (i *Instance) NewLexer(input antlr.CharStream) *CommentLexer {
+	l := NewCypherLexer(input)
+	l.Interpreter = antlr.NewLexerATNSimulator(l, i.lexerATN, i.lexerDFA, i.lexerCache)
+	return NewCommentLexer(l)
+}
+
+// NewParser will create a CypherParser using the cache of i
+func (i *Instance) NewParser(input antlr.TokenStream) *CypherParser {
+	p := NewCypherParser(input)
+	p.Interpreter = antlr.NewParserATNSimulator(p, i.parserATN, i.parserDFA, i.parserCache)
+	return p
+}
+
+// Pool is a set of Instances which is safe for concurrent use.
+// The zero value is ready to use.
+//
+// Unlike sync.Pool, instances are never dropped, so their caches keep warm.
+// The number of instances is bounded by the peak number of concurrent users.
+type Pool struct {
+	mu   sync.Mutex
+	free []*Instance
+}
+
+// Get returns an idle Instance, or a new one if there is none
+func (p *Pool) Get() *Instance {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if n := len(p.free); n > 0 {
+		i := p.free[n-1]
+		p.free = p.free[:n-1]
+		return i
+	}
+	return NewInstance()
+}
+
+// Put gives i back to p, i must not be used after Put
+func (p *Pool) Put(i *Instance) {
+	p.mu.Lock()
+	p.free = append(p.free, i)
+	p.mu.Unlock()
+}
@@ -0,0 +1,101 @@
+// Copyright 2019 leiysky
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// SplitStatements lexes the whole script with lexer and splits the tokens
+// into statements by ';'. Since splitting is done on tokens, ';' inside strings,
+// comments and escaped names is not a separator.
+//
+// Each statement is returned as an antlr.Lexer replaying its tokens, with the trailing ';' included,
+// tokens keep their positions in script. Statements only containing
+// whitespaces and comments are dropped.
+func SplitStatements(lexer *CommentLexer) []antlr.Lexer {
+	var (
+		sources []antlr.Lexer
+		tokens  []antlr.Token
+		empty   = true
+	)
+	flush := func() {
+		if !empty {
+			sources = append(sources, newTokenSlice(lexer, tokens))
+		}
+		tokens, empty = nil, true
+	}
+	for _, token := range lexer.GetAllTokens() {
+		tokens = append(tokens, token)
+		switch token.GetTokenType() {
+		case CypherLexerSP, CypherLexerWHITESPACE, CypherLexerComment:
+		case CypherLexerT__0:
+			// ';'
+			flush()
+		default:
+			empty = false
+		}
+	}
+	flush()
+	return sources
+}
+
+// tokenSlice replays tokens of a statement followed by EOF,
+// the other methods are delegated to the lexer which produced the tokens.
+type tokenSlice struct {
+	antlr.Lexer
+
+	tokens []antlr.Token
+	i      int
+}
+
+func newTokenSlice(lexer antlr.Lexer, tokens []antlr.Token) *tokenSlice {
+	last := tokens[len(tokens)-1]
+	line, column := last.GetLine(), last.GetColumn()
+	text := last.GetText()
+	if i := strings.LastIndexByte(text, '\n'); i >= 0 {
+		line += strings.Count(text, "\n")
+		column = utf8.RuneCountInString(text[i+1:])
+	} else {
+		column += utf8.RuneCountInString(text)
+	}
+	eof := lexer.GetTokenFactory().Create(last.GetSource(), antlr.TokenEOF, "<EOF>",
+		antlr.TokenDefaultChannel, last.GetStop()+1, last.GetStop(), line, column)
+	return &tokenSlice{
+		Lexer:  lexer,
+		tokens: append(tokens, eof),
+	}
+}
+
+// NextToken implements antlr.TokenSource interface
+func (s *tokenSlice) NextToken() antlr.Token {
+	token := s.tokens[s.i]
+	if s.i < len(s.tokens)-1 {
+		s.i++
+	}
+	return token
+}
+
+// GetLine implements antlr.TokenSource interface
+func (s *tokenSlice) GetLine() int {
+	return s.tokens[s.i].GetLine()
+}
+
+// GetCharPositionInLine implements antlr.TokenSource interface
+func (s *tokenSlice) GetCharPositionInLine() int {
+	return s.tokens[s.i].GetColumn()
+}
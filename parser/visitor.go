@@ -14,7 +14,10 @@
 package parser
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/leiysky/parser/ast"
@@ -25,12 +28,20 @@ var (
 )
 
 type ConvertVisitor struct {
-	parser *CypherParser
+	parser  *CypherParser
+	source  string
+	offsets offsetMap
+	// nodes are all the converted nodes, in the order of their spans being set.
+	nodes []ast.Node
 }
 
 func NewConvertVisitor(parser *CypherParser) CypherVisitor {
+	input := parser.GetTokenStream().GetTokenSource().GetInputStream()
+	source := input.GetText(0, input.Size()-1)
 	return &ConvertVisitor{
-		parser: parser,
+		parser:  parser,
+		source:  source,
+		offsets: newOffsetMap(source),
 	}
 }
 
@@ -38,6 +49,65 @@ func (v *ConvertVisitor) Visit(tree antlr.ParseTree) interface{} {
 	return tree.Accept(v)
 }
 
+// startPos returns position of the first character of token.
+func (v *ConvertVisitor) startPos(token antlr.Token) ast.Pos {
+	return ast.Pos{
+		Offset: v.offsets.byteOffset(token.GetStart()),
+		Line:   token.GetLine(),
+		Column: token.GetColumn(),
+	}
+}
+
+// endPos returns position immediately after token.
+func (v *ConvertVisitor) endPos(token antlr.Token) ast.Pos {
+	return advancePos(v.startPos(token), token.GetText())
+}
+
+// advancePos returns position immediately after text which starts at pos.
+func advancePos(pos ast.Pos, text string) ast.Pos {
+	pos.Offset += len(text)
+	if i := strings.LastIndexByte(text, '\n'); i >= 0 {
+		pos.Line += strings.Count(text, "\n")
+		pos.Column = utf8.RuneCountInString(text[i+1:])
+	} else {
+		pos.Column += utf8.RuneCountInString(text)
+	}
+	return pos
+}
+
+// setSpan sets the source span of node, along with the original text in the span.
+func (v *ConvertVisitor) setSpan(node ast.Node, start, end ast.Pos) {
+	node.SetPos(start, end)
+	node.SetText(v.source[start.Offset:end.Offset])
+	v.nodes = append(v.nodes, node)
+}
+
+// setPos sets the source span of node from the start and stop token of ctx.
+func (v *ConvertVisitor) setPos(node ast.Node, ctx antlr.ParserRuleContext) {
+	start := v.startPos(ctx.GetStart())
+	end := start
+	// stop token is before start token if ctx is empty
+	if ctx.GetStop() != nil && ctx.GetStop().GetTokenIndex() >= ctx.GetStart().GetTokenIndex() {
+		end = v.endPos(ctx.GetStop())
+	}
+	v.setSpan(node, start, end)
+}
+
+// setTokenPos sets the source span of node from token.
+func (v *ConvertVisitor) setTokenPos(node ast.Node, token antlr.Token) {
+	v.setSpan(node, v.startPos(token), v.endPos(token))
+}
+
+// setRangePos sets the source span of node from the start of first to the end of last.
+func (v *ConvertVisitor) setRangePos(node, first, last ast.Node) {
+	v.setSpan(node, first.Start(), last.End())
+}
+
+// errorf aborts the conversion with an *Error located at the start of ctx.
+func (v *ConvertVisitor) errorf(ctx antlr.ParserRuleContext, format string, args ...interface{}) {
+	panic(newError(ctx.GetStart(), format, args...))
+}
+
 func (v *ConvertVisitor) VisitChildren(node antlr.RuleNode) interface{} {
 	return nil
 }
@@ -67,6 +137,9 @@ func (v *ConvertVisitor) VisitCypher(ctx *CypherContext) interface{} {
 		node.Type = ast.CypherStmtStandaloneCall
 		node.StandaloneCall = query.StandaloneCall().Accept(v).(*ast.StandaloneCall)
 	}
+	v.setPos(node, ctx.Stmt())
+	v.attachComments(node)
+	ast.SetParents(node)
 	return node
 }
 
@@ -85,6 +158,7 @@ func (v *ConvertVisitor) VisitRegularQuery(ctx *RegularQueryContext) interface{}
 		clauses = append(clauses, union.Accept(v).(*ast.UnionClause))
 	}
 	query.Clauses = clauses
+	v.setPos(query, ctx)
 	return query
 }
 
@@ -94,6 +168,7 @@ func (v *ConvertVisitor) VisitUnionClause(ctx *UnionClauseContext) interface{} {
 		unionClause.All = true
 	}
 	unionClause.Clauses = ctx.SingleQuery().Accept(v).([]ast.Stmt)
+	v.setPos(unionClause, ctx)
 	return unionClause
 }
 
@@ -117,6 +192,9 @@ func (v *ConvertVisitor) VisitSinglePartQuery(ctx *SinglePartQueryContext) inter
 	for _, c := range ctx.AllUpdatingClause() {
 		clauses = append(clauses, c.Accept(v).(ast.Stmt))
 	}
+	if ctx.SubqueryClause() != nil {
+		clauses = append(clauses, ctx.SubqueryClause().Accept(v).(ast.Stmt))
+	}
 	if ctx.ReturnClause() != nil {
 		clauses = append(clauses, ctx.ReturnClause().Accept(v).(ast.Stmt))
 	}
@@ -153,6 +231,7 @@ func (v *ConvertVisitor) VisitWithClause(ctx *WithClauseContext) interface{} {
 	if ctx.WhereClause() != nil {
 		withClause.Where = ctx.WhereClause().Accept(v).(ast.Expr)
 	}
+	v.setPos(withClause, ctx)
 	return withClause
 }
 
@@ -162,10 +241,78 @@ func (v *ConvertVisitor) VisitReadingClause(ctx *ReadingClauseContext) interface
 		n = ctx.MatchClause().Accept(v).(*ast.MatchClause)
 	} else if ctx.UnwindClause() != nil {
 		n = ctx.UnwindClause().Accept(v).(*ast.UnwindClause)
+	} else if ctx.InQueryCall() != nil {
+		n = ctx.InQueryCall().Accept(v).(*ast.InQueryCallClause)
+	} else if ctx.SubqueryClause() != nil {
+		n = ctx.SubqueryClause().Accept(v).(*ast.SubqueryClause)
 	}
 	return n
 }
 
+func (v *ConvertVisitor) VisitSubqueryClause(ctx *SubqueryClauseContext) interface{} {
+	subquery := &ast.SubqueryClause{}
+	query := ctx.RegularQuery().Accept(v).(*ast.QueryStmt)
+	// the importing WITH of a UNION is kept in each branch, see ast.SubqueryClause
+	if with, ok := query.Clauses[0].(*ast.WithClause); ok && importingWith(with) && !hasUnion(query) {
+		subquery.ImportingWith = with
+		query.Clauses = query.Clauses[1:]
+		v.setRangePos(query, query.Clauses[0], query.Clauses[len(query.Clauses)-1])
+	}
+	subquery.Query = query
+	if ctx.InTransactions() != nil {
+		tx := ctx.InTransactions().Accept(v).(*InTransactionsContext)
+		subquery.InTransactions = true
+		exprs := tx.AllExpr()
+		if tx.CONCURRENT() != nil {
+			subquery.Concurrency = exprs[0].Accept(v).(ast.Expr)
+			exprs = exprs[1:]
+		}
+		if tx.OF() != nil {
+			subquery.BatchSize = exprs[0].Accept(v).(ast.Expr)
+		}
+		switch {
+		case tx.CONTINUE() != nil:
+			subquery.OnError = ast.OnErrorContinue
+		case tx.BREAK() != nil:
+			subquery.OnError = ast.OnErrorBreak
+		case tx.FAIL() != nil:
+			subquery.OnError = ast.OnErrorFail
+		}
+	}
+	v.setPos(subquery, ctx)
+	return subquery
+}
+
+// importingWith returns true if with only imports variables into a subquery, e.g. `WITH a, b` or `WITH *`.
+func importingWith(with *ast.WithClause) bool {
+	body := with.ReturnBody
+	if with.Distinct || with.Where != nil || body.OrderBy != nil || body.Skip != nil || body.Limit != nil {
+		return false
+	}
+	for _, item := range body.ReturnItems {
+		if item.Wildcard {
+			continue
+		}
+		if _, ok := item.Expr.(*ast.VariableNode); !ok || item.As {
+			return false
+		}
+	}
+	return true
+}
+
+func hasUnion(query *ast.QueryStmt) bool {
+	for _, clause := range query.Clauses {
+		if _, ok := clause.(*ast.UnionClause); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *ConvertVisitor) VisitInTransactions(ctx *InTransactionsContext) interface{} {
+	return ctx
+}
+
 func (v *ConvertVisitor) VisitMatchClause(ctx *MatchClauseContext) interface{} {
 	match := &ast.MatchClause{}
 	if ctx.OPTIONAL() != nil {
@@ -175,13 +322,15 @@ func (v *ConvertVisitor) VisitMatchClause(ctx *MatchClauseContext) interface{} {
 		match.Where = ctx.WhereClause().Accept(v).(ast.Expr)
 	}
 	match.Pattern = ctx.Pattern().Accept(v).(*ast.Pattern)
+	v.setPos(match, ctx)
 	return match
 }
 
 func (v *ConvertVisitor) VisitUnwindClause(ctx *UnwindClauseContext) interface{} {
 	unwind := &ast.UnwindClause{}
 	unwind.Expr = ctx.Expr().Accept(v).(ast.Expr)
-	unwind.Variable = ctx.Variable().Accept(v).(*ast.SymbolicNameNode)
+	unwind.Variable = ctx.Variable().Accept(v).(*ast.VariableNode)
+	v.setPos(unwind, ctx)
 	return unwind
 }
 
@@ -205,6 +354,7 @@ func (v *ConvertVisitor) VisitUpdatingClause(ctx *UpdatingClauseContext) interfa
 func (v *ConvertVisitor) VisitCreateClause(ctx *CreateClauseContext) interface{} {
 	create := &ast.CreateClause{}
 	create.Pattern = ctx.Pattern().Accept(v).(*ast.Pattern)
+	v.setPos(create, ctx)
 	return create
 }
 
@@ -215,6 +365,7 @@ func (v *ConvertVisitor) VisitSetClause(ctx *SetClauseContext) interface{} {
 		items = append(items, item.Accept(v).(*ast.SetItem))
 	}
 	set.SetItems = items
+	v.setPos(set, ctx)
 	return set
 }
 
@@ -224,25 +375,23 @@ func (v *ConvertVisitor) VisitSetItem(ctx *SetItemContext) interface{} {
 	if ctx.PropertyExpr() != nil {
 		setItem.Type = ast.SetItemProperty
 		setItem.Property = ctx.PropertyExpr().Accept(v).(*ast.PropertyExpr)
-	} else if len(ctx.GetTokens(3)) > 0 {
-		// 3 presents '=' token, see Cypher.tokens
+		setItem.Expr = ctx.Expr().Accept(v).(ast.Expr)
+	} else if len(ctx.GetTokens(CypherParserT__2)) > 0 {
+		// T__2 presents '=' token, see Cypher.tokens
 		setItem.Type = ast.SetItemVariableAssignment
 		setItem.Variable = ctx.Variable().Accept(v).(*ast.VariableNode)
 		setItem.Expr = ctx.Expr().Accept(v).(ast.Expr)
-	} else if len(ctx.GetTokens(4)) > 0 {
-		// 4 presents '+=' token, see Cypher.tokens
+	} else if len(ctx.GetTokens(CypherParserT__3)) > 0 {
+		// T__3 presents '+=' token, see Cypher.tokens
 		setItem.Type = ast.SetItemVariableIncrement
 		setItem.Variable = ctx.Variable().Accept(v).(*ast.VariableNode)
 		setItem.Expr = ctx.Expr().Accept(v).(ast.Expr)
-	} else if allLabel := ctx.NodeLabels().Accept(v).(*NodeLabelsContext).AllNodeLabel(); len(allLabel) > 0 {
-		var labels []*ast.NodeLabelNode
-		for _, label := range allLabel {
-			labels = append(labels, label.Accept(v).(*ast.NodeLabelNode))
-		}
+	} else if ctx.NodeLabels() != nil {
 		setItem.Type = ast.SetItemVariableLabel
 		setItem.Variable = ctx.Variable().Accept(v).(*ast.VariableNode)
-		setItem.Labels = labels
+		setItem.Labels = ctx.NodeLabels().Accept(v).([]*ast.NodeLabelNode)
 	}
+	v.setPos(setItem, ctx)
 	return setItem
 }
 
@@ -256,6 +405,7 @@ func (v *ConvertVisitor) VisitDeleteClause(ctx *DeleteClauseContext) interface{}
 		exprs = append(exprs, expr.Accept(v).(ast.Expr))
 	}
 	deleteClause.Exprs = exprs
+	v.setPos(deleteClause, ctx)
 	return deleteClause
 }
 
@@ -266,6 +416,7 @@ func (v *ConvertVisitor) VisitRemoveClause(ctx *RemoveClauseContext) interface{}
 		items = append(items, item.Accept(v).(*ast.RemoveItem))
 	}
 	removeClause.RemoveItems = items
+	v.setPos(removeClause, ctx)
 	return removeClause
 }
 
@@ -274,15 +425,12 @@ func (v *ConvertVisitor) VisitRemoveItem(ctx *RemoveItemContext) interface{} {
 	if ctx.Variable() != nil {
 		removeItem.Type = ast.RemoveItemVariable
 		removeItem.Variable = ctx.Variable().Accept(v).(*ast.VariableNode)
-		var labels []*ast.NodeLabelNode
-		for _, label := range ctx.NodeLabels().Accept(v).(*NodeLabelsContext).AllNodeLabel() {
-			labels = append(labels, label.Accept(v).(*ast.NodeLabelNode))
-		}
-		removeItem.Labels = labels
+		removeItem.Labels = ctx.NodeLabels().Accept(v).([]*ast.NodeLabelNode)
 	} else if ctx.PropertyExpr() != nil {
 		removeItem.Type = ast.RemoveItemProperty
-		removeItem.Property = ctx.Variable().Accept(v).(*ast.PropertyExpr)
+		removeItem.Property = ctx.PropertyExpr().Accept(v).(*ast.PropertyExpr)
 	}
+	v.setPos(removeItem, ctx)
 	return removeItem
 }
 
@@ -294,6 +442,7 @@ func (v *ConvertVisitor) VisitMergeClause(ctx *MergeClauseContext) interface{} {
 		actions = append(actions, action.Accept(v).(*ast.MergeAction))
 	}
 	mergeClause.MergeActions = actions
+	v.setPos(mergeClause, ctx)
 	return mergeClause
 }
 
@@ -305,6 +454,7 @@ func (v *ConvertVisitor) VisitMergeAction(ctx *MergeActionContext) interface{} {
 		mergeAction.Type = ast.MergeActionMatch
 	}
 	mergeAction.Set = ctx.SetClause().Accept(v).(*ast.SetClause)
+	v.setPos(mergeAction, ctx)
 	return mergeAction
 }
 
@@ -316,6 +466,7 @@ func (v *ConvertVisitor) VisitWhereClause(ctx *WhereClauseContext) interface{} {
 func (v *ConvertVisitor) VisitVariable(ctx *VariableContext) interface{} {
 	variable := &ast.VariableNode{}
 	variable.SymbolicName = ctx.SymbolicName().Accept(v).(*ast.SymbolicNameNode)
+	v.setPos(variable, ctx)
 	return variable
 }
 
@@ -332,17 +483,24 @@ func (v *ConvertVisitor) VisitSymbolicName(ctx *SymbolicNameContext) interface{}
 	} else if i = ctx.ANY(); i != nil {
 	} else if i = ctx.NONE(); i != nil {
 	} else if i = ctx.SINGLE(); i != nil {
-	}
+	} else if i = ctx.CONCURRENT(); i != nil {
+	} else if i = ctx.TRANSACTIONS(); i != nil {
+	} else if i = ctx.ROW(); i != nil {
+	} else if i = ctx.ROWS(); i != nil {
+	} else if i = ctx.ERROR(); i != nil {
+	} else if i = ctx.CONTINUE(); i != nil {
+	} else if i = ctx.BREAK(); i != nil {
+	} else if i = ctx.FAIL(); i != nil {
+	}
+	symbolicName.Value = i.GetText()
 	switch i.GetSymbol().GetTokenType() {
 	case CypherLexerUnescapedSymbolicName:
 		symbolicName.Type = ast.SymbolicNameUnescaped
-		symbolicName.Value = i.GetText()
 	case CypherLexerEscapedSymbolicName:
 		symbolicName.Type = ast.SymbolicNameEscaped
-		symbolicName.Value = i.GetText()
+		symbolicName.Value = unquoteName(symbolicName.Value)
 	case CypherLexerHexLetter:
 		symbolicName.Type = ast.SymbolicNameHexLetter
-		symbolicName.Value = i.GetText()
 	case CypherLexerCOUNT:
 		symbolicName.Type = ast.SymbolicNameCount
 	case CypherLexerFILTER:
@@ -355,7 +513,12 @@ func (v *ConvertVisitor) VisitSymbolicName(ctx *SymbolicNameContext) interface{}
 		symbolicName.Type = ast.SymbolicNameNone
 	case CypherLexerSINGLE:
 		symbolicName.Type = ast.SymbolicNameSingle
+	case CypherLexerCONCURRENT, CypherLexerTRANSACTIONS, CypherLexerROW, CypherLexerROWS,
+		CypherLexerERROR, CypherLexerCONTINUE, CypherLexerBREAK, CypherLexerFAIL:
+		// keywords of IN TRANSACTIONS were names before, they are still unescaped names
+		symbolicName.Type = ast.SymbolicNameUnescaped
 	}
+	v.setPos(symbolicName, ctx)
 	return symbolicName
 }
 
@@ -370,6 +533,7 @@ func (v *ConvertVisitor) VisitNodeLabels(ctx *NodeLabelsContext) interface{} {
 func (v *ConvertVisitor) VisitNodeLabel(ctx *NodeLabelContext) interface{} {
 	nodeLabel := &ast.NodeLabelNode{}
 	nodeLabel.LabelName = ctx.LabelName().Accept(v).(*ast.SchemaNameNode)
+	v.setPos(nodeLabel, ctx)
 	return nodeLabel
 }
 
@@ -384,6 +548,7 @@ func (v *ConvertVisitor) VisitPattern(ctx *PatternContext) interface{} {
 		parts = append(parts, part.Accept(v).(*ast.PatternPart))
 	}
 	pattern.Parts = parts
+	v.setPos(pattern, ctx)
 	return pattern
 }
 
@@ -397,6 +562,7 @@ func (v *ConvertVisitor) VisitPatternPart(ctx *PatternPartContext) interface{} {
 		Accept(v).(*AnonymousPatternPartContext).
 		PatternElement().
 		Accept(v).(*ast.PatternElement)
+	v.setPos(patternPart, ctx)
 	return patternPart
 }
 
@@ -408,8 +574,7 @@ func (v *ConvertVisitor) VisitPatternElement(ctx *PatternElementContext) interfa
 	patternElement := &ast.PatternElement{}
 	// strip useless parenthesises recursively
 	if ctx.PatternElement() != nil {
-		ctx = ctx.PatternElement().Accept(v).(*PatternElementContext)
-		return ctx
+		return ctx.PatternElement().Accept(v)
 	}
 
 	var nodes []*ast.NodePattern
@@ -422,6 +587,7 @@ func (v *ConvertVisitor) VisitPatternElement(ctx *PatternElementContext) interfa
 	}
 	patternElement.Nodes = nodes
 	patternElement.Relationships = relationships
+	v.setPos(patternElement, ctx)
 	return patternElement
 }
 
@@ -436,6 +602,7 @@ func (v *ConvertVisitor) VisitNodePattern(ctx *NodePatternContext) interface{} {
 	if ctx.Properties() != nil {
 		nodePattern.Properties = ctx.Properties().Accept(v).(*ast.Properties)
 	}
+	v.setPos(nodePattern, ctx)
 	return nodePattern
 }
 
@@ -457,6 +624,7 @@ func (v *ConvertVisitor) VisitRelationshipPattern(ctx *RelationshipPatternContex
 	if ctx.RelationshipDetail() != nil {
 		relationshipPattern.Detail = ctx.RelationshipDetail().Accept(v).(*ast.RelationshipDetail)
 	}
+	v.setPos(relationshipPattern, ctx)
 	return relationshipPattern
 }
 
@@ -494,6 +662,9 @@ func (v *ConvertVisitor) VisitRelationshipDetail(ctx *RelationshipDetailContext)
 		}
 		if rangeLiteral.MaxHops() != nil {
 			relationshipDetail.MaxHops = rangeLiteral.MaxHops().Accept(v).(*MaxHopsContext).IntegerLiteral().Accept(v).(int)
+		} else if len(rangeLiteral.GetTokens(CypherParserT__13)) == 0 {
+			// `*2` means exactly 2 hops
+			relationshipDetail.MaxHops = relationshipDetail.MinHops
 		} else {
 			relationshipDetail.MaxHops = -1
 		}
@@ -505,6 +676,7 @@ func (v *ConvertVisitor) VisitRelationshipDetail(ctx *RelationshipDetailContext)
 	if ctx.Properties() != nil {
 		relationshipDetail.Properties = ctx.Properties().Accept(v).(*ast.Properties)
 	}
+	v.setPos(relationshipDetail, ctx)
 	return relationshipDetail
 }
 
@@ -538,6 +710,7 @@ func (v *ConvertVisitor) VisitSchemaName(ctx *SchemaNameContext) interface{} {
 		schemaName.Type = ast.SchemaNameReservedWord
 		schemaName.ReservedWord = i.Accept(v).(*ast.ReservedWordNode)
 	}
+	v.setPos(schemaName, ctx)
 	return schemaName
 }
 
@@ -547,6 +720,7 @@ func (v *ConvertVisitor) VisitReturnClause(ctx *ReturnClauseContext) interface{}
 		returnClause.Distinct = true
 	}
 	returnClause.ReturnBody = ctx.ReturnBody().Accept(v).(*ast.ReturnBody)
+	v.setPos(returnClause, ctx)
 	return returnClause
 }
 
@@ -563,18 +737,18 @@ func (v *ConvertVisitor) VisitReturnBody(ctx *ReturnBodyContext) interface{} {
 	if ctx.LimitClause() != nil {
 		returnBody.Limit = ctx.LimitClause().(*LimitClauseContext).Expr().Accept(v).(ast.Expr)
 	}
+	v.setPos(returnBody, ctx)
 	return returnBody
 }
 
 func (v *ConvertVisitor) VisitReturnItems(ctx *ReturnItemsContext) interface{} {
 	var returnItems []*ast.ReturnItem
-	if len(ctx.GetTokens(5)) > 0 {
-		returnItems = []*ast.ReturnItem{
-			&ast.ReturnItem{
-				Wildcard: true,
-			},
+	if len(ctx.GetTokens(CypherParserT__6)) > 0 {
+		wildcard := &ast.ReturnItem{
+			Wildcard: true,
 		}
-		return returnItems
+		v.setTokenPos(wildcard, ctx.GetToken(CypherParserT__6, 0).GetSymbol())
+		returnItems = []*ast.ReturnItem{wildcard}
 	}
 	for _, item := range ctx.AllReturnItem() {
 		returnItems = append(returnItems, item.Accept(v).(*ast.ReturnItem))
@@ -589,6 +763,7 @@ func (v *ConvertVisitor) VisitReturnItem(ctx *ReturnItemContext) interface{} {
 		returnItem.As = true
 		returnItem.Variable = ctx.Variable().Accept(v).(*ast.VariableNode)
 	}
+	v.setPos(returnItem, ctx)
 	return returnItem
 }
 
@@ -598,6 +773,8 @@ func (v *ConvertVisitor) VisitOrderClause(ctx *OrderClauseContext) interface{} {
 	for _, item := range ctx.AllSortItem() {
 		sortItems = append(sortItems, item.Accept(v).(*ast.SortItem))
 	}
+	orderClause.SortItems = sortItems
+	v.setPos(orderClause, ctx)
 	return orderClause
 }
 
@@ -617,6 +794,7 @@ func (v *ConvertVisitor) VisitSortItem(ctx *SortItemContext) interface{} {
 	} else if ctx.DESC() != nil || ctx.DESCENDING() != nil {
 		sortItem.Type = ast.SortDescending
 	}
+	v.setPos(sortItem, ctx)
 	return sortItem
 }
 
@@ -633,12 +811,14 @@ func (v *ConvertVisitor) VisitOrExpr(ctx *OrExprContext) interface{} {
 	for _, expr := range ctx.AllXorExpr() {
 		exprs = append(exprs, expr.Accept(v).(ast.Expr))
 	}
+	first := exprs[0]
 	binaryExpr := &ast.BinaryExpr{}
-	binaryExpr.L = exprs[0]
+	binaryExpr.L = first
 	exprs = exprs[1:]
 	for i, expr := range exprs {
 		binaryExpr.Op = ast.OpOr
 		binaryExpr.R = expr
+		v.setRangePos(binaryExpr, first, expr)
 		if i < len(exprs)-1 {
 			binaryExpr = &ast.BinaryExpr{
 				L: binaryExpr,
@@ -656,12 +836,14 @@ func (v *ConvertVisitor) VisitXorExpr(ctx *XorExprContext) interface{} {
 	for _, expr := range ctx.AllAndExpr() {
 		exprs = append(exprs, expr.Accept(v).(ast.Expr))
 	}
+	first := exprs[0]
 	binaryExpr := &ast.BinaryExpr{}
-	binaryExpr.L = exprs[0]
+	binaryExpr.L = first
 	exprs = exprs[1:]
 	for i, expr := range exprs {
 		binaryExpr.Op = ast.OpXor
 		binaryExpr.R = expr
+		v.setRangePos(binaryExpr, first, expr)
 		if i < len(exprs)-1 {
 			binaryExpr = &ast.BinaryExpr{
 				L: binaryExpr,
@@ -679,12 +861,14 @@ func (v *ConvertVisitor) VisitAndExpr(ctx *AndExprContext) interface{} {
 	for _, expr := range ctx.AllNotExpr() {
 		exprs = append(exprs, expr.Accept(v).(ast.Expr))
 	}
+	first := exprs[0]
 	binaryExpr := &ast.BinaryExpr{}
-	binaryExpr.L = exprs[0]
+	binaryExpr.L = first
 	exprs = exprs[1:]
 	for i, expr := range exprs {
 		binaryExpr.Op = ast.OpAnd
 		binaryExpr.R = expr
+		v.setRangePos(binaryExpr, first, expr)
 		if i < len(exprs)-1 {
 			binaryExpr = &ast.BinaryExpr{
 				L: binaryExpr,
@@ -700,9 +884,12 @@ func (v *ConvertVisitor) VisitNotExpr(ctx *NotExprContext) interface{} {
 	}
 	unaryExpr := &ast.UnaryExpr{}
 	unaryExpr.V = ctx.ComparisonExpr().Accept(v).(ast.Expr)
-	for i := range ctx.AllNOT() {
+	nots := ctx.AllNOT()
+	for i := range nots {
 		unaryExpr.Op = ast.OpNot
-		if i < len(ctx.AllNOT())-1 {
+		// the innermost expression belongs to the last NOT
+		v.setSpan(unaryExpr, v.startPos(nots[len(nots)-1-i].GetSymbol()), unaryExpr.V.End())
+		if i < len(nots)-1 {
 			unaryExpr = &ast.UnaryExpr{
 				V: unaryExpr,
 			}
@@ -732,6 +919,7 @@ func (v *ConvertVisitor) VisitComparisonExpr(ctx *ComparisonExprContext) interfa
 	for i, expr := range partialExprs {
 		binaryExpr.Op = expr.Type
 		binaryExpr.R = expr.Expr
+		v.setRangePos(binaryExpr, binaryExpr.L, expr.Expr)
 		if i < len(partialExprs)-1 {
 			binaryExpr = &ast.BinaryExpr{
 				L: binaryExpr,
@@ -782,12 +970,14 @@ func (v *ConvertVisitor) VisitAddOrSubtractExpr(ctx *AddOrSubtractExprContext) i
 		exprs = append(exprs, expr.Accept(v).(ast.Expr))
 	}
 
+	first := exprs[0]
 	binaryExpr := &ast.BinaryExpr{}
-	binaryExpr.L = exprs[0]
+	binaryExpr.L = first
 	exprs = exprs[1:]
 	for i, expr := range exprs {
 		binaryExpr.Op = ops[i]
 		binaryExpr.R = expr
+		v.setRangePos(binaryExpr, first, expr)
 		if i < len(exprs)-1 {
 			binaryExpr = &ast.BinaryExpr{
 				L: binaryExpr,
@@ -819,12 +1009,14 @@ func (v *ConvertVisitor) VisitMultiplyDivideModuloExpr(ctx *MultiplyDivideModulo
 		exprs = append(exprs, expr.Accept(v).(ast.Expr))
 	}
 
+	first := exprs[0]
 	binaryExpr := &ast.BinaryExpr{}
-	binaryExpr.L = exprs[0]
+	binaryExpr.L = first
 	exprs = exprs[1:]
 	for i, expr := range exprs {
 		binaryExpr.Op = ops[i]
 		binaryExpr.R = expr
+		v.setRangePos(binaryExpr, first, expr)
 		if i < len(exprs)-1 {
 			binaryExpr = &ast.BinaryExpr{
 				L: binaryExpr,
@@ -843,12 +1035,14 @@ func (v *ConvertVisitor) VisitPowerOfExpr(ctx *PowerOfExprContext) interface{} {
 		exprs = append(exprs, expr.Accept(v).(ast.Expr))
 	}
 
+	first := exprs[0]
 	binaryExpr := &ast.BinaryExpr{}
-	binaryExpr.L = exprs[0]
+	binaryExpr.L = first
 	exprs = exprs[1:]
 	for i, expr := range exprs {
 		binaryExpr.Op = ast.OpPow
 		binaryExpr.R = expr
+		v.setRangePos(binaryExpr, first, expr)
 		if i < len(exprs)-1 {
 			binaryExpr = &ast.BinaryExpr{
 				L: binaryExpr,
@@ -859,24 +1053,28 @@ func (v *ConvertVisitor) VisitPowerOfExpr(ctx *PowerOfExprContext) interface{} {
 }
 
 func (v *ConvertVisitor) VisitUnaryAddOrSubtractExpr(ctx *UnaryAddOrSubtractExprContext) interface{} {
-	if len(ctx.GetTokens(13))+len(ctx.GetTokens(14)) == 0 {
+	// T__14 and T__15 present '+' and '-' tokens, see Cypher.tokens
+	if len(ctx.GetTokens(CypherParserT__14))+len(ctx.GetTokens(CypherParserT__15)) == 0 {
 		return ctx.StringListNullOperatorExpr().Accept(v)
 	}
-	var ops []string
+	var ops []antlr.Token
 	for _, child := range ctx.GetChildren() {
 		if n, ok := child.GetPayload().(*antlr.CommonToken); ok && n.GetTokenType() != CypherLexerSP {
-			ops = append(ops, n.GetText())
+			ops = append(ops, n)
 		}
 	}
 
 	unaryExpr := &ast.UnaryExpr{}
 	unaryExpr.V = ctx.StringListNullOperatorExpr().Accept(v).(ast.Expr)
-	for i, op := range ops {
-		if op == "+" {
+	for i := range ops {
+		// the innermost expression belongs to the last operator
+		op := ops[len(ops)-1-i]
+		if op.GetText() == "+" {
 			unaryExpr.Op = ast.OpPlus
-		} else if op == "-" {
+		} else if op.GetText() == "-" {
 			unaryExpr.Op = ast.OpMinus
 		}
+		v.setSpan(unaryExpr, v.startPos(op), unaryExpr.V.End())
 		if i < len(ops)-1 {
 			unaryExpr = &ast.UnaryExpr{
 				V: unaryExpr,
@@ -891,44 +1089,47 @@ func (v *ConvertVisitor) VisitStringListNullOperatorExpr(ctx *StringListNullOper
 		return ctx.PropertyOrLabelsExpr().Accept(v)
 	}
 
-	predicationExpr := &ast.PredicationExpr{}
-	for i, child := range ctx.GetChildren() {
-		if i == 0 {
-			continue
-		}
-		switch expr := child.GetPayload().(type) {
-		case StringOperatorExprContext:
+	// the operations are applied from left to right, e.g. `a[0] IS NULL` is `(a[0]) IS NULL`
+	expr := ctx.PropertyOrLabelsExpr().Accept(v).(ast.Expr)
+	for _, child := range ctx.GetChildren()[1:] {
+		predicationExpr := &ast.PredicationExpr{Expr: expr}
+		switch op := child.(type) {
+		case *StringOperatorExprContext:
 			predicationExpr.Type = ast.PredicationStringOp
-			predicationExpr.Expr = expr.Accept(v).(ast.Expr)
-		case ListOperatorExprContext:
+			predicationExpr.Op = op.Accept(v).(ast.Expr)
+		case *ListOperatorExprContext:
 			predicationExpr.Type = ast.PredicationListOp
-			predicationExpr.Expr = expr.Accept(v).(ast.Expr)
-		case NullOperatorExprContext:
+			predicationExpr.Op = op.Accept(v).(ast.Expr)
+		case *NullOperatorExprContext:
 			predicationExpr.Type = ast.PredicationNullOp
-			predicationExpr.Expr = expr.Accept(v).(ast.Expr)
-		}
-
-		if i < len(ctx.GetChildren())-2 {
-			predicationExpr = &ast.PredicationExpr{
-				Expr: predicationExpr,
-			}
+			predicationExpr.Op = op.Accept(v).(ast.Expr)
+		default:
+			continue
 		}
+		v.setRangePos(predicationExpr, expr, predicationExpr.Op)
+		expr = predicationExpr
 	}
-
-	return predicationExpr
+	return expr
 }
 
 func (v *ConvertVisitor) VisitListOperatorExpr(ctx *ListOperatorExprContext) interface{} {
 	listOperatorExpr := &ast.ListOperationExpr{}
 	if ctx.PropertyOrLabelsExpr() != nil {
-		listOperatorExpr.InExpr = ctx.PropertyOrLabelsExpr().Accept(v).(*ast.PropertyOrLabelsExpr)
-	} else if len(ctx.GetTokens(12)) > 0 {
-		listOperatorExpr.LowerBound = ctx.Expr(0).Accept(v).(ast.Expr)
-		listOperatorExpr.UpperBound = ctx.Expr(1).Accept(v).(ast.Expr)
-	} else if len(ctx.GetTokens(12)) == 0 {
+		listOperatorExpr.InExpr = ctx.PropertyOrLabelsExpr().Accept(v).(ast.Expr)
+	} else if dots := ctx.GetToken(CypherParserT__13, 0); dots != nil {
+		// both bounds are optional, so they are told by the position of '..'
+		for _, expr := range ctx.AllExpr() {
+			if expr.GetStart().GetTokenIndex() < dots.GetSymbol().GetTokenIndex() {
+				listOperatorExpr.LowerBound = expr.Accept(v).(ast.Expr)
+			} else {
+				listOperatorExpr.UpperBound = expr.Accept(v).(ast.Expr)
+			}
+		}
+	} else {
 		expr := ctx.Expr(0).Accept(v).(ast.Expr)
 		listOperatorExpr.SingleExpr = expr
 	}
+	v.setPos(listOperatorExpr, ctx)
 	return listOperatorExpr
 }
 
@@ -941,7 +1142,8 @@ func (v *ConvertVisitor) VisitStringOperatorExpr(ctx *StringOperatorExprContext)
 	} else if ctx.CONTAINS() != nil {
 		stringOperatorExpr.Type = ast.StringOperationContains
 	}
-	stringOperatorExpr.Expr = ctx.PropertyOrLabelsExpr().Accept(v).(*ast.PropertyOrLabelsExpr)
+	stringOperatorExpr.Expr = ctx.PropertyOrLabelsExpr().Accept(v).(ast.Expr)
+	v.setPos(stringOperatorExpr, ctx)
 	return stringOperatorExpr
 }
 
@@ -950,6 +1152,7 @@ func (v *ConvertVisitor) VisitNullOperatorExpr(ctx *NullOperatorExprContext) int
 	if ctx.NOT() == nil {
 		nullOperatorExpr.IsIsNull = true
 	}
+	v.setPos(nullOperatorExpr, ctx)
 	return nullOperatorExpr
 }
 
@@ -971,12 +1174,14 @@ func (v *ConvertVisitor) VisitPropertyOrLabelsExpr(ctx *PropertyOrLabelsExprCont
 		lookups = append(lookups, lookup.Accept(v).(*ast.PropertyLookup))
 	}
 	propertyOrLabelsExpr.PropertyLookups = lookups
+	v.setPos(propertyOrLabelsExpr, ctx)
 	return propertyOrLabelsExpr
 }
 
 func (v *ConvertVisitor) VisitPropertyLookup(ctx *PropertyLookupContext) interface{} {
 	propertyLookup := &ast.PropertyLookup{}
 	propertyLookup.PropertyKey = ctx.PropertyKeyName().Accept(v).(*PropertyKeyNameContext).SchemaName().Accept(v).(*ast.SchemaNameNode)
+	v.setPos(propertyLookup, ctx)
 	return propertyLookup
 }
 
@@ -991,17 +1196,15 @@ func (v *ConvertVisitor) VisitCaseExpr(ctx *CaseExprContext) interface{} {
 		alts = append(alts, alt.Accept(v).(*ast.CaseAlt))
 	}
 	caseExpr.Alts = alts
-	if ctx.ELSE() != nil {
-		// means there are at least 1 Expr
-		if len(ctx.AllExpr()) > 1 {
-			caseExpr.Expr = ctx.Expr(0).Accept(v).(ast.Expr)
-			caseExpr.Else = ctx.Expr(1).Accept(v).(ast.Expr)
-		} else if len(ctx.AllExpr()) == 1 {
-			caseExpr.Expr = ctx.Expr(0).Accept(v).(ast.Expr)
+	// the expression before alternatives is the subject, and the one after ELSE is the default
+	for _, expr := range ctx.AllExpr() {
+		if expr.GetStart().GetTokenIndex() < ctx.CaseAlternatives(0).GetStart().GetTokenIndex() {
+			caseExpr.Expr = expr.Accept(v).(ast.Expr)
+		} else {
+			caseExpr.Else = expr.Accept(v).(ast.Expr)
 		}
-	} else if len(ctx.AllExpr()) > 0 {
-		caseExpr.Expr = ctx.Expr(0).Accept(v).(ast.Expr)
 	}
+	v.setPos(caseExpr, ctx)
 	return caseExpr
 }
 
@@ -1009,6 +1212,7 @@ func (v *ConvertVisitor) VisitCaseAlternatives(ctx *CaseAlternativesContext) int
 	caseAlt := &ast.CaseAlt{}
 	caseAlt.When = ctx.Expr(0).Accept(v).(ast.Expr)
 	caseAlt.Then = ctx.Expr(1).Accept(v).(ast.Expr)
+	v.setPos(caseAlt, ctx)
 	return caseAlt
 }
 
@@ -1020,8 +1224,14 @@ func (v *ConvertVisitor) VisitAtom(ctx *AtomContext) interface{} {
 		return ctx.Parameter().Accept(v)
 	case ctx.CaseExpr() != nil:
 		return ctx.CaseExpr().Accept(v)
+	case ctx.ExistsSubquery() != nil:
+		return ctx.ExistsSubquery().Accept(v)
+	case ctx.CountSubquery() != nil:
+		return ctx.CountSubquery().Accept(v)
 	case ctx.COUNT() != nil:
-		return &ast.CountAllExpr{}
+		countAll := &ast.CountAllExpr{}
+		v.setPos(countAll, ctx)
+		return countAll
 	case ctx.ListComprehension() != nil:
 		return ctx.ListComprehension().Accept(v)
 	case ctx.PatternComprehension() != nil:
@@ -1029,31 +1239,75 @@ func (v *ConvertVisitor) VisitAtom(ctx *AtomContext) interface{} {
 	case ctx.ALL() != nil:
 		filter := ctx.FilterExpr().Accept(v).(*ast.FilterExpr)
 		filter.Type = ast.FilterAll
+		v.setPos(filter, ctx)
 		return filter
 	case ctx.ANY() != nil:
 		filter := ctx.FilterExpr().Accept(v).(*ast.FilterExpr)
 		filter.Type = ast.FilterAny
+		v.setPos(filter, ctx)
 		return filter
 	case ctx.NONE() != nil:
 		filter := ctx.FilterExpr().Accept(v).(*ast.FilterExpr)
 		filter.Type = ast.FilterNone
+		v.setPos(filter, ctx)
 		return filter
 	case ctx.SINGLE() != nil:
 		filter := ctx.FilterExpr().Accept(v).(*ast.FilterExpr)
 		filter.Type = ast.FilterSingle
+		v.setPos(filter, ctx)
 		return filter
 	case ctx.RelationshipsPattern() != nil:
 		return ctx.RelationshipsPattern().Accept(v)
 	case ctx.ParenthesizedExpr() != nil:
 		return ctx.ParenthesizedExpr().Accept(v)
 	case ctx.FunctionInvocation() != nil:
-		// TODO
-		panic("FunctionInvocation not support now")
+		return ctx.FunctionInvocation().Accept(v)
 	case ctx.Variable() != nil:
 		return ctx.Variable().Accept(v)
 	default:
-		panic("")
+		v.errorf(ctx, "unknown atom")
+	}
+	return nil
+}
+
+func (v *ConvertVisitor) VisitExistsSubquery(ctx *ExistsSubqueryContext) interface{} {
+	exists := &ast.ExistsSubqueryExpr{}
+	exists.Query, exists.Pattern, exists.Where = v.subqueryBody(ctx.SubqueryBody())
+	v.setPos(exists, ctx)
+	return exists
+}
+
+func (v *ConvertVisitor) VisitCountSubquery(ctx *CountSubqueryContext) interface{} {
+	count := &ast.CountSubqueryExpr{}
+	count.Query, count.Pattern, count.Where = v.subqueryBody(ctx.SubqueryBody())
+	v.setPos(count, ctx)
+	return count
+}
+
+func (v *ConvertVisitor) VisitSubqueryBody(ctx *SubqueryBodyContext) interface{} {
+	return ctx
+}
+
+// subqueryBody returns either the query or the pattern and the where of a subquery expression,
+// the query can be reading clauses without RETURN.
+func (v *ConvertVisitor) subqueryBody(ctx ISubqueryBodyContext) (*ast.QueryStmt, *ast.Pattern, ast.Expr) {
+	body := ctx.Accept(v).(*SubqueryBodyContext)
+	if body.RegularQuery() != nil {
+		return body.RegularQuery().Accept(v).(*ast.QueryStmt), nil, nil
+	}
+	if len(body.AllReadingClause()) > 0 {
+		query := &ast.QueryStmt{}
+		for _, c := range body.AllReadingClause() {
+			query.Clauses = append(query.Clauses, c.Accept(v).(ast.Stmt))
+		}
+		v.setPos(query, body)
+		return query, nil, nil
 	}
+	var where ast.Expr
+	if body.WhereClause() != nil {
+		where = body.WhereClause().Accept(v).(ast.Expr)
+	}
+	return nil, body.Pattern().Accept(v).(*ast.Pattern), where
 }
 
 func (v *ConvertVisitor) VisitRelationshipsPattern(ctx *RelationshipsPatternContext) interface{} {
@@ -1069,12 +1323,14 @@ func (v *ConvertVisitor) VisitRelationshipsPattern(ctx *RelationshipsPatternCont
 	}
 	patternElement.Nodes = nodes
 	patternElement.Relationships = relationships
+	v.setPos(patternElement, ctx)
 	return patternElement
 }
 
 func (v *ConvertVisitor) VisitParenthesizedExpr(ctx *ParenthesizedExprContext) interface{} {
 	parenExpr := &ast.ParenExpr{}
 	parenExpr.Expr = ctx.Expr().Accept(v).(ast.Expr)
+	v.setPos(parenExpr, ctx)
 	return parenExpr
 }
 
@@ -1086,6 +1342,7 @@ func (v *ConvertVisitor) VisitFilterExpr(ctx *FilterExprContext) interface{} {
 	if ctx.WhereClause() != nil {
 		filterExpr.Where = ctx.WhereClause().Accept(v).(ast.Expr)
 	}
+	v.setPos(filterExpr, ctx)
 	return filterExpr
 }
 
@@ -1099,6 +1356,7 @@ func (v *ConvertVisitor) VisitListComprehension(ctx *ListComprehensionContext) i
 	if ctx.Expr() != nil {
 		listComprehension.Expr = ctx.Expr().Accept(v).(ast.Expr)
 	}
+	v.setPos(listComprehension, ctx)
 	return listComprehension
 }
 
@@ -1112,16 +1370,69 @@ func (v *ConvertVisitor) VisitPatternComprehension(ctx *PatternComprehensionCont
 		patternComprehension.Where = ctx.Expr(0).Accept(v).(ast.Expr)
 	}
 	patternComprehension.Expr = ctx.AllExpr()[len(ctx.AllExpr())-1].Accept(v).(ast.Expr)
+	v.setPos(patternComprehension, ctx)
 	return patternComprehension
 }
 
-func unquote(s string) string {
-	if s[0] == s[len(s)-1] &&
-		(s[0] == '\'' || s[0] == '"' || s[0] == '`') {
-		return s[1 : len(s)-1]
-	} else {
-		return s
+// unquoteName returns the name in backquotes, in which a doubled backquote is an escaped one.
+func unquoteName(s string) string {
+	return strings.Replace(s[1:len(s)-1], "``", "`", -1)
+}
+
+// unquoteString returns the value of a quoted string literal with the escaped characters unescaped.
+func unquoteString(s string) (string, error) {
+	s = s[1 : len(s)-1]
+	if !strings.Contains(s, "\\") {
+		return s, nil
 	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		if i == len(s) {
+			return "", fmt.Errorf("invalid escape at the end of %q", s)
+		}
+		switch s[i] {
+		case '\\', '\'', '"':
+			b.WriteByte(s[i])
+		case 'b', 'B':
+			b.WriteByte('\b')
+		case 'f', 'F':
+			b.WriteByte('\f')
+		case 'n', 'N':
+			b.WriteByte('\n')
+		case 'r', 'R':
+			b.WriteByte('\r')
+		case 't', 'T':
+			b.WriteByte('\t')
+		case 'u', 'U':
+			n := 4
+			if i+9 <= len(s) && isHex(s[i+1:i+9]) {
+				n = 8
+			}
+			if i+n+1 > len(s) || !isHex(s[i+1:i+n+1]) {
+				return "", fmt.Errorf("invalid unicode escape in %q", s)
+			}
+			r, _ := strconv.ParseUint(s[i+1:i+n+1], 16, 32)
+			b.WriteRune(rune(r))
+			i += n
+		default:
+			return "", fmt.Errorf("invalid escape \\%c in %q", s[i], s)
+		}
+	}
+	return b.String(), nil
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", rune(s[i])) {
+			return false
+		}
+	}
+	return true
 }
 
 func (v *ConvertVisitor) VisitLiteral(ctx *LiteralContext) interface{} {
@@ -1131,12 +1442,14 @@ func (v *ConvertVisitor) VisitLiteral(ctx *LiteralContext) interface{} {
 		literal.Number = ctx.NumberLiteral().Accept(v).(*ast.NumberLiteral)
 	} else if ctx.StringLiteral() != nil {
 		literal.Type = ast.LiteralString
-		literal.String = unquote(ctx.StringLiteral().GetText())
+		str, err := unquoteString(ctx.StringLiteral().GetText())
+		if err != nil {
+			v.errorf(ctx, "invalid string literal: %v", err)
+		}
+		literal.String = str
 	} else if ctx.BooleanLiteral() != nil {
 		literal.Type = ast.LiteralBoolean
-		if ctx.BooleanLiteral().GetText() == "TRUE" {
-			literal.Boolean = true
-		}
+		literal.Boolean = ctx.BooleanLiteral().(*BooleanLiteralContext).TRUE() != nil
 	} else if ctx.NULL() != nil {
 		literal.Type = ast.LiteralNull
 	} else if ctx.MapLiteral() != nil {
@@ -1146,6 +1459,7 @@ func (v *ConvertVisitor) VisitLiteral(ctx *LiteralContext) interface{} {
 		literal.Type = ast.LiteralList
 		literal.List = ctx.ListLiteral().Accept(v).(*ast.ListLiteral)
 	}
+	v.setPos(literal, ctx)
 	return literal
 }
 
@@ -1158,6 +1472,7 @@ func (v *ConvertVisitor) VisitNumberLiteral(ctx *NumberLiteralContext) interface
 		numberLiteral.Type = ast.NumberLiteralDouble
 		numberLiteral.Double = ctx.DoubleLiteral().Accept(v).(float64)
 	}
+	v.setPos(numberLiteral, ctx)
 	return numberLiteral
 }
 
@@ -1175,6 +1490,7 @@ func (v *ConvertVisitor) VisitMapLiteral(ctx *MapLiteralContext) interface{} {
 	}
 	mapLiteral.PropertyKeys = keys
 	mapLiteral.Exprs = exprs
+	v.setPos(mapLiteral, ctx)
 	return mapLiteral
 }
 
@@ -1185,6 +1501,7 @@ func (v *ConvertVisitor) VisitListLiteral(ctx *ListLiteralContext) interface{} {
 		exprs = append(exprs, expr.Accept(v).(ast.Expr))
 	}
 	listLiteral.Exprs = exprs
+	v.setPos(listLiteral, ctx)
 	return listLiteral
 }
 
@@ -1192,25 +1509,25 @@ func (v *ConvertVisitor) VisitIntegerLiteral(ctx *IntegerLiteralContext) interfa
 	var value int
 	if ctx.HexInteger() != nil {
 		hex := ctx.HexInteger().GetSymbol().GetText()
-		v, err := strconv.ParseInt(hex, 0, 64)
+		i, err := strconv.ParseInt(hex, 0, 64)
 		if err != nil {
-			panic(err)
+			v.errorf(ctx, "invalid integer literal: %v", err)
 		}
-		value = int(v)
+		value = int(i)
 	} else if ctx.OctalInteger() != nil {
 		oct := ctx.OctalInteger().GetSymbol().GetText()
-		v, err := strconv.ParseInt(oct, 0, 64)
+		i, err := strconv.ParseInt(oct, 0, 64)
 		if err != nil {
-			panic(err)
+			v.errorf(ctx, "invalid integer literal: %v", err)
 		}
-		value = int(v)
+		value = int(i)
 	} else if ctx.DecimalInteger() != nil {
 		dec := ctx.DecimalInteger().GetSymbol().GetText()
-		v, err := strconv.ParseInt(dec, 0, 64)
+		i, err := strconv.ParseInt(dec, 0, 64)
 		if err != nil {
-			panic(err)
+			v.errorf(ctx, "invalid integer literal: %v", err)
 		}
-		value = int(v)
+		value = int(i)
 	}
 
 	return value
@@ -1220,18 +1537,18 @@ func (v *ConvertVisitor) VisitDoubleLiteral(ctx *DoubleLiteralContext) interface
 	var value float64
 	if ctx.RegularDecimalReal() != nil {
 		regular := ctx.RegularDecimalReal().GetSymbol().GetText()
-		v, err := strconv.ParseFloat(regular, 64)
+		f, err := strconv.ParseFloat(regular, 64)
 		if err != nil {
-			panic(err)
+			v.errorf(ctx, "invalid double literal: %v", err)
 		}
-		value = v
+		value = f
 	} else if ctx.ExponentDecimalReal() != nil {
 		exponent := ctx.ExponentDecimalReal().GetSymbol().GetText()
-		v, err := strconv.ParseFloat(exponent, 64)
+		f, err := strconv.ParseFloat(exponent, 64)
 		if err != nil {
-			panic(err)
+			v.errorf(ctx, "invalid double literal: %v", err)
 		}
-		value = v
+		value = f
 	}
 	return value
 }
@@ -1243,12 +1560,13 @@ func (v *ConvertVisitor) VisitParameter(ctx *ParameterContext) interface{} {
 		parameter.SymbolicName = ctx.SymbolicName().Accept(v).(*ast.SymbolicNameNode)
 	} else if ctx.DecimalInteger() != nil {
 		parameter.Type = ast.ParameterDecimalInteger
-		v, err := strconv.Atoi(ctx.DecimalInteger().GetSymbol().GetText())
+		i, err := strconv.Atoi(ctx.DecimalInteger().GetSymbol().GetText())
 		if err != nil {
-			panic(err)
+			v.errorf(ctx, "invalid parameter: %v", err)
 		}
-		parameter.DecimalInteger = v
+		parameter.DecimalInteger = i
 	}
+	v.setPos(parameter, ctx)
 	return parameter
 }
 
@@ -1261,6 +1579,7 @@ func (v *ConvertVisitor) VisitProperties(ctx *PropertiesContext) interface{} {
 		properties.Type = ast.PropertiesParameter
 		properties.Parameter = ctx.Parameter().Accept(v).(*ast.ParameterNode)
 	}
+	v.setPos(properties, ctx)
 	return properties
 }
 
@@ -1272,64 +1591,134 @@ func (v *ConvertVisitor) VisitPropertyExpr(ctx *PropertyExprContext) interface{}
 		lookups = append(lookups, lookup.Accept(v).(*ast.PropertyLookup))
 	}
 	propertyExpr.Lookups = lookups
+	v.setPos(propertyExpr, ctx)
 	return propertyExpr
 }
 
 func (v *ConvertVisitor) VisitReservedWord(ctx *ReservedWordContext) interface{} {
 	reservedWord := &ast.ReservedWordNode{}
 	reservedWord.Content = ctx.GetText()
+	v.setPos(reservedWord, ctx)
 	return reservedWord
 }
 
 func (v *ConvertVisitor) VisitStandaloneCall(ctx *StandaloneCallContext) interface{} {
-	// TODO
-	panic("not implemented")
+	standaloneCall := &ast.StandaloneCall{}
+	if ctx.ExplicitProcedureInvocation() != nil {
+		standaloneCall.Procedure = ctx.ExplicitProcedureInvocation().Accept(v).(*ast.ProcedureInvocation)
+	} else if ctx.ImplicitProcedureInvocation() != nil {
+		standaloneCall.Procedure = ctx.ImplicitProcedureInvocation().Accept(v).(*ast.ProcedureInvocation)
+	}
+	if ctx.YieldItems() != nil {
+		standaloneCall.Yield = ctx.YieldItems().Accept(v).(*ast.YieldItems)
+	}
+	v.setPos(standaloneCall, ctx)
+	return standaloneCall
 }
 
 func (v *ConvertVisitor) VisitYieldItems(ctx *YieldItemsContext) interface{} {
-	return v.VisitChildren(ctx)
+	yieldItems := &ast.YieldItems{}
+	// T__6 presents '*' token, see Cypher.tokens
+	if len(ctx.GetTokens(CypherParserT__6)) > 0 {
+		yieldItems.Wildcard = true
+	}
+	var items []*ast.YieldItem
+	for _, item := range ctx.AllYieldItem() {
+		items = append(items, item.Accept(v).(*ast.YieldItem))
+	}
+	yieldItems.Items = items
+	if ctx.WhereClause() != nil {
+		yieldItems.Where = ctx.WhereClause().Accept(v).(ast.Expr)
+	}
+	v.setPos(yieldItems, ctx)
+	return yieldItems
 }
 
 func (v *ConvertVisitor) VisitYieldItem(ctx *YieldItemContext) interface{} {
-	return v.VisitChildren(ctx)
+	yieldItem := &ast.YieldItem{}
+	if ctx.ProcedureResultField() != nil {
+		yieldItem.Field = ctx.ProcedureResultField().Accept(v).(*ast.SymbolicNameNode)
+	}
+	yieldItem.Variable = ctx.Variable().Accept(v).(*ast.VariableNode)
+	v.setPos(yieldItem, ctx)
+	return yieldItem
 }
 
 func (v *ConvertVisitor) VisitFunctionInvocation(ctx *FunctionInvocationContext) interface{} {
-	// TODO
-	panic("not implemented")
+	functionInvocation := &ast.FunctionInvocation{}
+	functionName := ctx.FunctionName().Accept(v).(*FunctionNameContext)
+	if functionName.EXISTS() != nil {
+		name := &ast.SymbolicNameNode{}
+		name.Type = ast.SymbolicNameExists
+		name.Value = functionName.EXISTS().GetText()
+		v.setTokenPos(name, functionName.EXISTS().GetSymbol())
+		functionInvocation.Name = name
+	} else {
+		functionInvocation.Namespace = functionName.Namespace().Accept(v).([]*ast.SymbolicNameNode)
+		functionInvocation.Name = functionName.SymbolicName().Accept(v).(*ast.SymbolicNameNode)
+	}
+	if ctx.DISTINCT() != nil {
+		functionInvocation.Distinct = true
+	}
+	var args []ast.Expr
+	for _, expr := range ctx.AllExpr() {
+		args = append(args, expr.Accept(v).(ast.Expr))
+	}
+	functionInvocation.Args = args
+	v.setPos(functionInvocation, ctx)
+	return functionInvocation
 }
 
 func (v *ConvertVisitor) VisitFunctionName(ctx *FunctionNameContext) interface{} {
-	// TODO
-	panic("not implemented")
+	return ctx
 }
 
 func (v *ConvertVisitor) VisitExplicitProcedureInvocation(ctx *ExplicitProcedureInvocationContext) interface{} {
-	// TODO
-	panic("not implemented")
+	procedure := &ast.ProcedureInvocation{}
+	procedureName := ctx.ProcedureName().Accept(v).(*ProcedureNameContext)
+	procedure.Namespace = procedureName.Namespace().Accept(v).([]*ast.SymbolicNameNode)
+	procedure.Name = procedureName.SymbolicName().Accept(v).(*ast.SymbolicNameNode)
+	var args []ast.Expr
+	for _, expr := range ctx.AllExpr() {
+		args = append(args, expr.Accept(v).(ast.Expr))
+	}
+	procedure.Args = args
+	v.setPos(procedure, ctx)
+	return procedure
 }
 
 func (v *ConvertVisitor) VisitImplicitProcedureInvocation(ctx *ImplicitProcedureInvocationContext) interface{} {
-	// TODO
-	panic("not implemented")
+	procedure := &ast.ProcedureInvocation{}
+	procedureName := ctx.ProcedureName().Accept(v).(*ProcedureNameContext)
+	procedure.Namespace = procedureName.Namespace().Accept(v).([]*ast.SymbolicNameNode)
+	procedure.Name = procedureName.SymbolicName().Accept(v).(*ast.SymbolicNameNode)
+	procedure.Implicit = true
+	v.setPos(procedure, ctx)
+	return procedure
 }
 
 func (v *ConvertVisitor) VisitProcedureResultField(ctx *ProcedureResultFieldContext) interface{} {
-	// TODO
-	panic("not implemented")
+	return ctx.SymbolicName().Accept(v).(*ast.SymbolicNameNode)
 }
 
 func (v *ConvertVisitor) VisitProcedureName(ctx *ProcedureNameContext) interface{} {
-	// TODO
-	panic("not implemented")
+	return ctx
 }
 
 func (v *ConvertVisitor) VisitNamespace(ctx *NamespaceContext) interface{} {
-	// TODO
-	panic("not implemented")
+	var namespace []*ast.SymbolicNameNode
+	for _, name := range ctx.AllSymbolicName() {
+		namespace = append(namespace, name.Accept(v).(*ast.SymbolicNameNode))
+	}
+	return namespace
 }
 
 func (v *ConvertVisitor) VisitInQueryCall(ctx *InQueryCallContext) interface{} {
-	// TODO
-	panic("not implemented")
+	call := &ast.InQueryCallClause{}
+	call.Procedure = ctx.ExplicitProcedureInvocation().Accept(v).(*ast.ProcedureInvocation)
+	if ctx.YieldItems() != nil {
+		call.Yield = ctx.YieldItems().Accept(v).(*ast.YieldItems)
+	}
+	v.setPos(call, ctx)
+	return call
 }
@@ -15,11 +15,23 @@ package parser
 
 import (
 	"fmt"
+	"io"
+	"math"
+	"math/rand"
 	"os"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/leiysky/parser/ast"
+	"github.com/leiysky/parser/ast/astpb"
+	"github.com/leiysky/parser/build"
+	ps "github.com/leiysky/parser/parser"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
 type testCase struct {
@@ -31,11 +43,31 @@ type testCase struct {
 var cases = []testCase{
 	{"match (n) return n", true, "MATCH (`n`) RETURN `n`"},
 	{"match (n:Label)-[r:Type *1..2 {hello:'world'}]->() return *", true, "MATCH (`n`:Label)-[`r`:Type*1..2{hello: 'world'}]->() RETURN *"},
-	{"with n as n, a as a create (n)-[]-(a)", true, "WITH `n` AS `n`, `a` AS `a` CREATE (`n`)-[*1..1]-(`a`)"},
-	{"match (n) where n.name > 1 AND 'abc' = 2 OR 1.2 <> -2 return n", true, "MATCH (`n`) WHERE `n`.`name` > 1 AND 'abc' = 2 OR 1.200000 <> -2 RETURN `n`"},
+	{"with n as n, a as a create (n)-[]-(a)", true, "WITH `n` AS `n`, `a` AS `a` CREATE (`n`)-[]-(`a`)"},
+	{"match (n) where n.name > 1 AND 'abc' = 2 OR 1.2 <> -2 return n", true, "MATCH (`n`) WHERE `n`.`name` > 1 AND 'abc' = 2 OR 1.2 <> -2 RETURN `n`"},
 	{"match (n) return count(*)", true, "MATCH (`n`) RETURN COUNT(*)"},
 	{"match (n) return [n in list | n+1]", true, "MATCH (`n`) RETURN [`n` IN `list` | `n` + 1]"},
 	{"match (n) return any(n in list), all(n in list), single(n in list), none(n in list where TRUE)", true, "MATCH (`n`) RETURN ANY(`n` IN `list`), ALL(`n` IN `list`), SINGLE(`n` IN `list`), NONE(`n` IN `list` WHERE TRUE)"},
+	{"match (n) return count(n), toLower(n.name), exists(n.prop), apoc.coll.sum(n.list), count( DISTINCT n), rand()", true, "MATCH (`n`) RETURN count(`n`), toLower(`n`.`name`), exists(`n`.`prop`), apoc.coll.sum(`n`.`list`), count(DISTINCT `n`), rand()"},
+	{"CALL db.labels() YIELD label", true, "CALL db.labels() YIELD `label`"},
+	{"call db.labels", true, "CALL db.labels"},
+	{"CALL my.proc(1, 'a') YIELD *", true, "CALL my.proc(1, 'a') YIELD *"},
+	{"CALL dbms.procedures() YIELD name AS n, signature WHERE n = 'db.labels'", true, "CALL dbms.procedures() YIELD name AS `n`, `signature` WHERE `n` = 'db.labels'"},
+	{"MATCH (n) CALL my.proc(n) YIELD score WHERE score > 0.5 RETURN n, score", true, "MATCH (`n`) CALL my.proc(`n`) YIELD `score` WHERE `score` > 0.5 RETURN `n`, `score`"},
+	{"CALL db.labels() YIELD label RETURN count(label)", true, "CALL db.labels() YIELD `label` RETURN count(`label`)"},
+	{"// top\nMATCH (n) // after match\n/* before where */ WHERE n.x > 1 /* x */ AND n.y // line\nRETURN n, /* m */ m; // end", true, "// top\nMATCH (`n`) // after match\n/* before where */ WHERE `n`.`x` > 1 /* x */ AND `n`.`y` // line\nRETURN `n`, /* m */ `m` // end"},
+	{"MATCH (n)\n// c1\n// c2\nRETURN n", true, "MATCH (`n`)\n// c1\n// c2\nRETURN `n`"},
+	{"MATCH (n)\n  /* c */ RETURN n /* r */\n/* end */", true, "MATCH (`n`)\n/* c */ RETURN `n` /* r */\n/* end */"},
+	{"MATCH (/* in */ n /* n */ :Label) RETURN n.name /* name */ AS name // alias", true, "MATCH (/* in */ `n` /* n */:Label) RETURN `n`.`name` /* name */ AS `name` // alias"},
+	{"match (n) where exists { (n)-->(m) where m.x > 1 } return count {match (n)-->(m) return m}", true, "MATCH (`n`) WHERE EXISTS { (`n`)-->(`m`) WHERE `m`.`x` > 1 } RETURN COUNT { MATCH (`n`)-->(`m`) RETURN `m` }"},
+	{"match (n) return exists {}", false, ""},
+	{"unwind $rows as row call { with row create (:N {id: row.id}) } in transactions of 1000 rows", true, "UNWIND $rows AS `row` CALL { WITH `row` CREATE (:N{id: `row`.`id`}) } IN TRANSACTIONS OF 1000 ROWS"},
+	{"call { match (n) return n } in transactions of 10", false, ""},
+	{"call { } return 1", false, ""},
+	{"call { return 1 } in transactions on error retry", false, ""},
+	{"match (n) return count { where n.x }", false, ""},
+	{"match (n) return", false, ""},
+	{"match (n) return 99999999999999999999", false, ""},
 }
 
 func runTestCase(t *testing.T, cases []testCase) {
@@ -43,7 +75,16 @@ func runTestCase(t *testing.T, cases []testCase) {
 	var target strings.Builder
 	for _, c := range cases {
 		target.Reset()
-		originalAst := parser.Parse(c.original)
+		originalAst, err := parser.Parse(c.original)
+		if !c.pass {
+			if err == nil {
+				t.Fatalf("expected error: %s", c.original)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %s; %s", err, c.original)
+		}
 		ctx := ast.NewRestoreContext(&target)
 		originalAst.Restore(ctx)
 		if target.String() != c.target {
@@ -91,39 +132,2110 @@ func (v *testVisitor) VisitExpr(expr ast.Expr) {
 	}
 }
 
-func TestParser(t *testing.T) {
-	t.Skip()
+func TestConcurrentParse(t *testing.T) {
 	parser := New()
-	parser.Parse(`
-MATCH (n)-[:Label *0123]-()
-WHERE n.id = n +1
-SET n = {}
-RETURN n`)
+	var wg sync.WaitGroup
+	errs := make(chan error, 8*len(cases))
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var target strings.Builder
+			for _, c := range cases {
+				target.Reset()
+				stmt, err := parser.Parse(c.original)
+				if c.pass != (err == nil) {
+					errs <- fmt.Errorf("unexpected result: %v; %s", err, c.original)
+					continue
+				}
+				if err != nil {
+					continue
+				}
+				stmt.Restore(ast.NewRestoreContext(&target))
+				if target.String() != c.target {
+					errs <- fmt.Errorf("obtained: %s; expected: %s", target.String(), c.target)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
 }
 
-func TestMultiPartQuery(t *testing.T) {
-	t.Skip()
+func TestError(t *testing.T) {
 	parser := New()
-	parser.Parse(`
-MATCH (n)
-SET n.id = 1
-WITH n
-MATCH (n)
-RETURN n
-`)
+	_, err := parser.Parse("match (n)\nreturn n.name +")
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected *Error, obtained: %#v", err)
+	}
+	if e.Line != 2 || e.Column != 15 || e.Token != "<EOF>" {
+		t.Fatalf("obtained: %d:%d %q", e.Line, e.Column, e.Token)
+	}
+
+	_, err = parser.Parse("match (n) return 0x8000000000000000")
+	e, ok = err.(*Error)
+	if !ok {
+		t.Fatalf("expected *Error, obtained: %#v", err)
+	}
+	if e.Line != 1 || e.Column != 17 || e.Token != "0x8000000000000000" {
+		t.Fatalf("obtained: %d:%d %q", e.Line, e.Column, e.Token)
+	}
 }
 
-func TestExpression(t *testing.T) {
-	t.Skip()
+func TestSyntaxErrors(t *testing.T) {
 	parser := New()
-	stmt := parser.Parse(`
-	MATCH (n:Label1:Label2)-[r:Type1|Type2*1..2{name:'hello'}]->(n1)
-	WHERE n.a OR n AND n XOR NOT n < n > n = n <= n >= n <> n + n - n * n / n % -n
-	MATCH (n)
-	WHERE 1 = 1 < 1
-	RETURN n
-	`)
-	stmt.Accept(&testVisitor{})
-	rst := ast.NewRestoreContext(os.Stdout)
-	stmt.Restore(rst)
+	_, err := parser.Parse("match (n:Ĺabel) return n.name + ~ 1")
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected *Error, obtained: %#v", err)
+	}
+	if len(e.SyntaxErrors) < 2 {
+		t.Fatalf("expected more than one syntax error, obtained: %v", e.SyntaxErrors)
+	}
+	// reported by lexer
+	lexErr := e.SyntaxErrors[0]
+	if lexErr.Text != "~" || lexErr.Start != 33 || lexErr.Stop != 34 || lexErr.Column != 32 {
+		t.Fatalf("obtained: %#v", lexErr)
+	}
+	// reported by parser
+	parseErr := e.SyntaxErrors[1]
+	if parseErr.Text != " " || parseErr.Start != 34 || parseErr.Stop != 35 || len(parseErr.Expected) == 0 {
+		t.Fatalf("obtained: %#v", parseErr)
+	}
+}
+
+type collectVisitor struct {
+	nodes []ast.Node
+}
+
+func (v *collectVisitor) Enter(node ast.Node) (ast.Node, bool) {
+	v.nodes = append(v.nodes, node)
+	return node, false
+}
+
+func (v *collectVisitor) Leave(node ast.Node) (ast.Node, bool) {
+	return node, true
+}
+
+func TestPosition(t *testing.T) {
+	parser := New()
+	cypher := "MATCH (n:Person)-[r]->(m)\nWHERE n.age > 1 + 2\nRETURN  n.名字, m"
+	stmt, err := parser.Parse(cypher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &collectVisitor{}
+	stmt.Accept(v)
+	var binaryExprs []*ast.BinaryExpr
+	var lookups []*ast.PropertyLookup
+	for _, node := range v.nodes {
+		start, end := node.Start(), node.End()
+		if start.Line == 0 || start.Offset > end.Offset || end.Offset > len(cypher) {
+			t.Fatalf("invalid position of %T: %v, %v", node, start, end)
+		}
+		switch n := node.(type) {
+		case *ast.MatchClause:
+			if start != (ast.Pos{Offset: 0, Line: 1, Column: 0}) || end != (ast.Pos{Offset: 45, Line: 2, Column: 19}) {
+				t.Fatalf("obtained: %v, %v", start, end)
+			}
+		case *ast.BinaryExpr:
+			binaryExprs = append(binaryExprs, n)
+		case *ast.PropertyLookup:
+			lookups = append(lookups, n)
+		}
+	}
+	if len(binaryExprs) != 2 || len(lookups) != 2 {
+		t.Fatalf("obtained: %d, %d", len(binaryExprs), len(lookups))
+	}
+	// n.age > 1 + 2
+	if start, end := binaryExprs[0].Start(), binaryExprs[0].End(); start != (ast.Pos{Offset: 32, Line: 2, Column: 6}) || end.Offset != 45 {
+		t.Fatalf("obtained: %v, %v", start, end)
+	}
+	// 1 + 2
+	if start, end := binaryExprs[1].Start(), binaryExprs[1].End(); start.Offset != 40 || end.Offset != 45 {
+		t.Fatalf("obtained: %v, %v", start, end)
+	}
+	// .名字
+	if start, end := lookups[1].Start(), lookups[1].End(); start != (ast.Pos{Offset: 55, Line: 3, Column: 9}) || end != (ast.Pos{Offset: 62, Line: 3, Column: 12}) {
+		t.Fatalf("obtained: %v, %v", start, end)
+	}
+}
+
+func TestText(t *testing.T) {
+	parser := New()
+	cypher := "match (n:Person)  where n.Name   =  'leiysky'\nreturn NOT  n.名字 , -  n.age"
+	stmt, err := parser.Parse(cypher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stmt.Text() != cypher {
+		t.Fatalf("obtained: %q", stmt.Text())
+	}
+	v := &collectVisitor{}
+	stmt.Accept(v)
+	var texts []string
+	for _, node := range v.nodes {
+		if node.Text() != cypher[node.Start().Offset:node.End().Offset] {
+			t.Fatalf("text of %T mismatches its position: %q", node, node.Text())
+		}
+		switch node.(type) {
+		case *ast.MatchClause, *ast.NodePattern, *ast.BinaryExpr, *ast.UnaryExpr, *ast.ReturnItem:
+			texts = append(texts, node.Text())
+		}
+	}
+	expected := []string{
+		"match (n:Person)  where n.Name   =  'leiysky'",
+		"(n:Person)",
+		"n.Name   =  'leiysky'",
+		"NOT  n.名字",
+		"NOT  n.名字",
+		"-  n.age",
+		"-  n.age",
+	}
+	if strings.Join(texts, "|") != strings.Join(expected, "|") {
+		t.Fatalf("obtained: %q", texts)
+	}
+}
+
+func TestComments(t *testing.T) {
+	parser := New()
+	stmt, err := parser.Parse("MATCH (n) // 匹配\r\n/* where\n */ WHERE n.x RETURN n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &collectVisitor{}
+	stmt.Accept(v)
+	var comments []*ast.Comment
+	for _, n := range v.nodes {
+		comments = append(comments, n.Comments().Leading...)
+		comments = append(comments, n.Comments().Trailing...)
+	}
+	if len(comments) != 2 {
+		t.Fatalf("obtained %d comments", len(comments))
+	}
+	for _, c := range comments {
+		switch c.Text {
+		case "// 匹配":
+			if c.IsBlock() || c.OwnLine || c.Start != (ast.Pos{Offset: 10, Line: 1, Column: 10}) || c.End != (ast.Pos{Offset: 19, Line: 1, Column: 15}) {
+				t.Fatalf("obtained: %v %v", c.Start, c.End)
+			}
+		case "/* where\n */":
+			if !c.IsBlock() || !c.OwnLine || c.Start != (ast.Pos{Offset: 21, Line: 2, Column: 0}) || c.End != (ast.Pos{Offset: 33, Line: 3, Column: 3}) {
+				t.Fatalf("obtained: %v %v", c.Start, c.End)
+			}
+		default:
+			t.Fatalf("obtained: %q", c.Text)
+		}
+	}
+
+	// comments are hidden tokens, followed by an SP token for the parser
+	lexer := ps.NewInstance().NewLexer(antlr.NewInputStream("WITH/* c */n // d\nRETURN n"))
+	var tokens []string
+	for _, token := range lexer.GetAllTokens() {
+		tokens = append(tokens, fmt.Sprintf("%d:%d:%q", token.GetTokenType(), token.GetChannel(), token.GetText()))
+	}
+	expected := []string{
+		fmt.Sprintf("%d:0:%q", ps.CypherLexerWITH, "WITH"),
+		fmt.Sprintf("%d:1:%q", ps.CypherLexerComment, "/* c */"),
+		fmt.Sprintf("%d:0:%q", ps.CypherLexerSP, "/* c */"),
+		fmt.Sprintf("%d:0:%q", ps.CypherLexerUnescapedSymbolicName, "n"),
+		fmt.Sprintf("%d:1:%q", ps.CypherLexerComment, "// d\n"),
+		fmt.Sprintf("%d:0:%q", ps.CypherLexerSP, " // d\n"),
+		fmt.Sprintf("%d:0:%q", ps.CypherLexerRETURN, "RETURN"),
+		fmt.Sprintf("%d:0:%q", ps.CypherLexerSP, " "),
+		fmt.Sprintf("%d:0:%q", ps.CypherLexerUnescapedSymbolicName, "n"),
+	}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Fatalf("obtained: %v; expected: %v", tokens, expected)
+	}
+	if _, err := parser.Parse("WITH/* c */n RETURN n"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrettyRestore(t *testing.T) {
+	cases := []struct {
+		original string
+		target   string
+	}{
+		{"match (n) return n", "MATCH (`n`)\nRETURN `n`"},
+		{
+			"MATCH (a:Person {name: 'Alice', age: 30})-[:KNOWS]->(b:Person)<-[:KNOWS]-(c:Person) WHERE a.age > 18 RETURN a.name AS name, b.name AS friend, c.name AS foaf",
+			"MATCH (`a`:Person{name: 'Alice', age: 30})\n" +
+				"  -[:KNOWS]->(`b`:Person)\n" +
+				"  <-[:KNOWS]-(`c`:Person)\n" +
+				"WHERE `a`.`age` > 18\n" +
+				"RETURN\n" +
+				"  `a`.`name` AS `name`,\n" +
+				"  `b`.`name` AS `friend`,\n" +
+				"  `c`.`name` AS `foaf`",
+		},
+		{
+			"MATCH (n) WHERE n.name STARTS WITH 'A' AND n.age > 18 AND n.city = 'Berlin' XOR n.admin RETURN n",
+			"MATCH (`n`)\n" +
+				"WHERE `n`.`name` STARTS WITH 'A'\n" +
+				"  AND `n`.`age` > 18\n" +
+				"  AND `n`.`city` = 'Berlin'\n" +
+				"  XOR `n`.`admin`\n" +
+				"RETURN `n`",
+		},
+		{
+			"MATCH (n) RETURN CASE n.kind WHEN 'person' THEN n.name WHEN 'company' THEN n.title ELSE 'unknown' END",
+			"MATCH (`n`)\n" +
+				"RETURN\n" +
+				"  CASE `n`.`kind`\n" +
+				"    WHEN 'person' THEN `n`.`name`\n" +
+				"    WHEN 'company' THEN `n`.`title`\n" +
+				"    ELSE 'unknown'\n" +
+				"  END",
+		},
+		{
+			"MATCH (n) RETURN [x IN n.someVeryLongListPropertyName WHERE x.prop > 100 | x.prop * 2]",
+			"MATCH (`n`)\n" +
+				"RETURN\n" +
+				"  [`x` IN `n`.`someVeryLongListPropertyName`\n" +
+				"    WHERE `x`.`prop` > 100\n" +
+				"    | `x`.`prop` * 2]",
+		},
+		{
+			"CREATE (n {name: 'Alice', email: 'alice@example.com', city: 'Berlin'}) RETURN n UNION ALL MATCH (m) RETURN m // end",
+			"CREATE (`n`{\n" +
+				"  name: 'Alice',\n" +
+				"  email: 'alice@example.com',\n" +
+				"  city: 'Berlin'\n" +
+				"})\n" +
+				"RETURN `n`\n" +
+				"UNION ALL\n" +
+				"MATCH (`m`)\n" +
+				"RETURN `m` // end",
+		},
+		{
+			"MATCH (n) WHERE EXISTS { (n)-[:KNOWS]->(m) WHERE m.age > 18 } AND COUNT { MATCH (n)-->(m) RETURN m } > 1 RETURN n",
+			"MATCH (`n`)\n" +
+				"WHERE EXISTS {\n" +
+				"  (`n`)-[:KNOWS]->(`m`)\n" +
+				"  WHERE `m`.`age` > 18\n" +
+				"}\n" +
+				"  AND COUNT {\n" +
+				"    MATCH (`n`)-->(`m`)\n" +
+				"    RETURN `m`\n" +
+				"  } > 1\n" +
+				"RETURN `n`",
+		},
+		{
+			"MATCH (n) CALL { WITH n MATCH (n)-->(m) RETURN count(m) AS c } IN 4 CONCURRENT TRANSACTIONS OF 10 ROWS ON ERROR CONTINUE RETURN n, c",
+			"MATCH (`n`)\n" +
+				"CALL {\n" +
+				"  WITH `n`\n" +
+				"  MATCH (`n`)-->(`m`)\n" +
+				"  RETURN count(`m`) AS `c`\n" +
+				"} IN 4 CONCURRENT TRANSACTIONS OF 10 ROWS ON ERROR CONTINUE\n" +
+				"RETURN `n`, `c`",
+		},
+	}
+	parser := New()
+	var target strings.Builder
+	for _, c := range cases {
+		stmt, err := parser.Parse(c.original)
+		if err != nil {
+			t.Fatal(err)
+		}
+		target.Reset()
+		stmt.Restore(ast.NewPrettyRestoreContext(&target, "  ", 50))
+		if target.String() != c.target {
+			t.Fatalf("obtained:\n%s\nexpected:\n%s", target.String(), c.target)
+		}
+		if _, err := parser.Parse(target.String()); err != nil {
+			t.Fatalf("unexpected error: %s; %s", err, target.String())
+		}
+	}
+}
+
+func TestRestoreFlags(t *testing.T) {
+	cases := []struct {
+		original string
+		flags    ast.RestoreFlags
+		target   string
+	}{
+		{"match (n) where n.age > 1.5 return n", ast.DefaultRestoreFlags &^ ast.RestoreFloatShortest, "MATCH (`n`) WHERE `n`.`age` > 1.500000 RETURN `n`"},
+		{"MATCH (n) WHERE NOT n.a OR n.b RETURN n", ast.RestoreKeywordLowercase, "match (n) where not n.a or n.b return n"},
+		{"Match (n) optional MATCH (n)-[r]->(m) Where n.where = 'where' Return Distinct m As `return`", ast.RestoreKeywordAsWritten,
+			"Match (n) optional MATCH (n)-[r]->(m) Where n.`where` = 'where' Return Distinct m As `return`"},
+		{"match (`my var`), (`x`) return `my var`.`a``b`, x.name", 0, "MATCH (`my var`), (x) RETURN `my var`.`a``b`, x.name"},
+		{"match (n) return count(n), `count`", 0, "MATCH (n) RETURN count(n), `count`"},
+		{`return 'it\'s', "say \"hi\"", '\u00e9\t'`, ast.RestoreStringDoubleQuotes, `RETURN "it's", "say \"hi\"", "é\t"`},
+		{`return 'it\'s', "say \"hi\""`, ast.RestoreStringSingleQuotes, `RETURN 'it\'s', 'say "hi"'`},
+		{"return 1.2, 0.1, 3.0, 1e300, 1.5e-10", ast.RestoreFloatShortest, "RETURN 1.2, 0.1, 3.0, 1e300, 1.5e-10"},
+	}
+	parser := New()
+	var target strings.Builder
+	for _, c := range cases {
+		stmt, err := parser.Parse(c.original)
+		if err != nil {
+			t.Fatal(err)
+		}
+		target.Reset()
+		ctx := ast.NewRestoreContext(&target)
+		ctx.Flags = c.flags
+		stmt.Restore(ctx)
+		if target.String() != c.target {
+			t.Fatalf("obtained: %s; expected: %s", target.String(), c.target)
+		}
+		if _, err := parser.Parse(target.String()); err != nil {
+			t.Fatalf("unexpected error: %s; %s", err, target.String())
+		}
+	}
+
+	// doubles without literals are written as expressions
+	stmt, err := parser.Parse("RETURN 1.5, -2.5[$i], 0.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := []float64{math.Inf(1), math.Inf(-1), math.NaN()}
+	for i, n := range ast.FindAll[*ast.NumberLiteral](stmt) {
+		n.Double = values[i]
+	}
+	for _, flags := range []ast.RestoreFlags{ast.DefaultRestoreFlags, 0} {
+		target.Reset()
+		ctx := ast.NewRestoreContext(&target)
+		ctx.Flags = flags
+		stmt.Restore(ctx)
+		if expected := "RETURN (1.0 / 0.0), -(-1.0 / 0.0)[$i], (0.0 / 0.0)"; target.String() != expected {
+			t.Fatalf("obtained: %s; expected: %s", target.String(), expected)
+		}
+		if _, err := parser.Parse(target.String()); err != nil {
+			t.Fatalf("unexpected error: %s; %s", err, target.String())
+		}
+	}
+}
+
+// roundTripCorpus covers every construct of the grammar,
+// each of which must be parsed back into the same ast after Restore.
+var roundTripCorpus = []string{
+	// clauses
+	"MATCH (n) RETURN n",
+	"OPTIONAL MATCH (n:A:B {x: 1})-[r:T|:U|V *2..5 {y: 'z'}]->(m) WHERE n.x > 1 RETURN DISTINCT n, m AS o",
+	"MATCH p = (a)-->(b)<--(c)--(d)<-->(e), (f) RETURN p",
+	"MATCH (a)-[]-(b), (a)-[r]->(b), (a)<-[:T]-(b), (a)<-[*]->(b) RETURN *",
+	"MATCH (a)-[*2]-(b), (a)-[*1..]-(b), (a)-[*..3]-(b), (a)-[*..]-(b), (a)-[*1]-(b), (a)-[*0..0]-(b) RETURN a",
+	"MATCH ((a)-->(b)) RETURN a",
+	"MATCH (n $props)-[r $relProps]->(m) RETURN n, $0, $param",
+	"UNWIND [1, 2, 3] AS x RETURN x",
+	"UNWIND $list AS x WITH DISTINCT x WHERE x > 1 RETURN x ORDER BY x DESC, x ASC, x SKIP 1 LIMIT 10",
+	"MATCH (n) WITH n, count(*) AS c ORDER BY c DESCENDING SKIP $s LIMIT $l WHERE c > 1 RETURN n",
+	"MATCH (n) RETURN *, n.x AS x ORDER BY x ASCENDING LIMIT 1",
+	"CREATE (n:Person {name: 'a'}), (m) CREATE (n)-[:KNOWS {since: 2019}]->(m)",
+	"MERGE (n:Person {name: 'a'}) ON CREATE SET n.created = timestamp() ON MATCH SET n.seen = n.seen + 1,n.x = 2",
+	"MERGE p = (a)-[:T]->(b) RETURN p",
+	"MATCH (n) SET n.x = 1,n.y = 2,n = {a: 1},n += {b: 2},n:A:B RETURN n",
+	"MATCH (n) DETACH DELETE n, n.x DELETE n",
+	"MATCH (n) REMOVE n:A:B, n.x, n.y.z RETURN n",
+	"MATCH (n) RETURN n UNION MATCH (m) RETURN m UNION ALL RETURN 1 AS n",
+	"MATCH (n) SET n.x = 1 WITH n MATCH (n)-->(m) CREATE (m)-[:T]->(n) WITH m RETURN m",
+	"CALL db.labels",
+	"CALL db.labels() YIELD label AS l, count WHERE l <> 'a'",
+	"CALL a.b.c(1, $x) YIELD *",
+	"MATCH (n) CALL my.proc(n, 'x') YIELD a, b AS c WHERE a > c CALL other() RETURN n",
+	// expressions
+	"RETURN 1 + 2 - 3 * 4 / 5 % 6 ^ 7, -1, +1, - -1, --1, -(1), 1 - -1",
+	"RETURN a OR b XOR c AND NOT d, NOT NOT e, NOT (f OR g)",
+	"RETURN a = b, a <> b, a < b, a > b, a <= b, a >= b, a < b <= c",
+	"RETURN a STARTS WITH 'x', a ENDS WITH b.c, a CONTAINS 'y' AND b CONTAINS $p",
+	"RETURN a IN [1, 2], a IN b.list, a IS NULL, a IS NOT NULL, a.b IS NULL IS NOT NULL",
+	"RETURN a[0], a[1..2], a[..2], a[1..], a[..], a[0][1], a.b[0] IN c",
+	"RETURN n:A, n:A:B, n.x:C, (n):D",
+	"RETURN CASE WHEN a THEN 1 ELSE 2 END, CASE a WHEN 1 THEN 'x' WHEN 2 THEN 'y' END, CASE WHEN a THEN 1 END, CASE a WHEN 1 THEN 2 ELSE 3 END",
+	"RETURN [x IN list], [x IN list WHERE x > 1], [x IN list | x * 2], [x IN list WHERE x > 1 | x * 2]",
+	"RETURN all(x IN l WHERE x), any(x IN l WHERE x), none(x IN l WHERE x), single(x IN l WHERE x), any(x IN l)",
+	"RETURN [(a)-->(b) | b.name], [p = (a)-[:T]->(b) WHERE b.x > 1 | p], [(a)--(b)--(c) WHERE a <> c | c]",
+	"MATCH (a) WHERE (a)-->() AND NOT (a)-[:T]->(:B) RETURN a",
+	"RETURN count(*), count(n), count(DISTINCT n), exists(n.x), EXISTS(n.y), apoc.coll.sum([1]), rand(), f(1, 2, 3)",
+	"RETURN ((1 + 2)) * 3, (a)",
+	"MATCH (n) WHERE EXISTS { (n)-->(m) } AND exists{(n)-[:T]->(:A) WHERE n.x > 1} RETURN COUNT { (n)--() } AS c, count{p = (n)-->()}",
+	"RETURN EXISTS { MATCH (n) WITH n MATCH (n)-->(m) RETURN m UNION MATCH (m) RETURN m }, COUNT { MATCH (n) RETURN n } > 1",
+	"MATCH (n) WHERE EXISTS { MATCH (n)-->(m) WHERE m.x > 1 } AND NOT exists {MATCH (n)-->(m) UNWIND m.list AS x OPTIONAL MATCH (x)--()} RETURN COUNT {MATCH (n)--()}",
+	"UNWIND $rows AS row CALL { WITH row MATCH (n {id: row.id}) SET n += row } IN TRANSACTIONS OF $batch ROWS ON ERROR BREAK",
+	"MATCH (n) CALL {WITH * MATCH (n)-->(m) RETURN m UNION WITH n RETURN n AS m} CALL { CREATE (:Log) } IN 2 CONCURRENT TRANSACTIONS RETURN m",
+	"CALL { RETURN 1 AS row } IN TRANSACTIONS OF 1 ROW ON ERROR FAIL WITH row AS transactions CALL { WITH transactions AS t RETURN t } RETURN *",
+	"MATCH (count {a: 1}) WHERE (count {b: 2})-->() RETURN count, count(*), count(count), exists(count.x)",
+	// literals
+	"RETURN 0, 7, 1234567890, 0x1F, 0xff, 017, 0.5, .5, 1.0, 3.14159265358979, 1e10, 1.5e-7, 2E3, 0.1e1",
+	"RETURN 'single', \"double\", 'it\\'s', \"say \\\"hi\\\"\", 'tab\\there', 'new\\nline', '\\\\', '\\u00e9\\U0001F600', '', \"'\"",
+	"RETURN TRUE, false, True, NULL, null, [], [1, 'a', [2], {}], {a: 1, b: {c: [true]}, `d e`: null}",
+	"RETURN {match: 1, return: 2, count: 3, `x`: 4}, n.match, n.count, n.`a b`, n.`a``b`",
+	// names
+	"MATCH (`a b`:`C d`)-[`e`:`F|G`]->(`h``i`) RETURN `a b`, `h``i`.`j k`",
+	"MATCH (count)-->(filter)-->(extract)-->(any)-->(none)-->(single) RETURN count, `filter`, extract",
+	"MATCH (a:match:Return)-[:WHERE|create]->(b) RETURN a, b",
+	"MATCH (A)-->(b)-->(c)-->(D)-->(e)-->(f) RETURN A, b, c, D, e, f",
+	"MATCH (n) RETURN n.`ünï`, n.ünï, 名前 AS 名",
+	// comments and spaces
+	"// leading\nMATCH (n) /* block */ WHERE n.x // line\n= 1 RETURN n // trailing",
+	"  match(n)return  n  ;  ",
+	"MATCH (n)\n// c1\n// c2\nRETURN n",
+	"MATCH (n)\n  /* c1 */\nWITH n /* c2 */\n/* c3 */ RETURN n\n/* c4 */",
+}
+
+// roundTripContexts are the styles that Restore must be lossless with.
+var roundTripContexts = []struct {
+	name string
+	new  func(w io.Writer) *ast.RestoreContext
+}{
+	{"default", ast.NewRestoreContext},
+	{"pretty", func(w io.Writer) *ast.RestoreContext { return ast.NewPrettyRestoreContext(w, "  ", 40) }},
+	{"minimal", func(w io.Writer) *ast.RestoreContext {
+		ctx := ast.NewRestoreContext(w)
+		ctx.Flags = ast.RestoreKeywordLowercase | ast.RestoreStringDoubleQuotes | ast.RestoreFloatShortest
+		return ctx
+	}},
+	{"as written", func(w io.Writer) *ast.RestoreContext {
+		ctx := ast.NewRestoreContext(w)
+		ctx.Flags = ast.RestoreKeywordAsWritten | ast.RestoreNameBackquotes | ast.RestoreFloatShortest
+		return ctx
+	}},
+}
+
+// checkRoundTrip checks that Parse(Restore(Parse(cypher))) equals Parse(cypher) with every roundTripContexts,
+// and Restore is stable after the first round trip.
+func checkRoundTrip(t *testing.T, parser *Parser, cypher string) {
+	t.Helper()
+	stmt, err := parser.Parse(cypher)
+	if err != nil {
+		t.Fatalf("unexpected error: %s; %s", err, cypher)
+	}
+	for _, c := range roundTripContexts {
+		var restored strings.Builder
+		stmt.Restore(c.new(&restored))
+		restoredStmt, err := parser.Parse(restored.String())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s; %s\nrestored: %s", c.name, err, cypher, restored.String())
+		}
+		if !ast.EqualWithFlags(stmt, restoredStmt, ast.EqualIgnorePos|ast.EqualIgnoreText) {
+			t.Fatalf("%s: ast changed after round trip: %s\nrestored: %s", c.name, cypher, restored.String())
+		}
+		var again strings.Builder
+		restoredStmt.Restore(c.new(&again))
+		if again.String() != restored.String() {
+			t.Fatalf("%s: restore is not stable: %s\nfirst: %s\nsecond: %s", c.name, cypher, restored.String(), again.String())
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	parser := New()
+	for _, cypher := range roundTripCorpus {
+		checkRoundTrip(t, parser, cypher)
+	}
+	for _, c := range cases {
+		if c.pass {
+			checkRoundTrip(t, parser, c.original)
+		}
+	}
+}
+
+// queryGen generates random cypher from the grammar, with random keyword case and spaces.
+type queryGen struct {
+	r *rand.Rand
+}
+
+func (g *queryGen) pick(s ...string) string {
+	return s[g.r.Intn(len(s))]
+}
+
+func (g *queryGen) chance(n int) bool {
+	return g.r.Intn(n) == 0
+}
+
+// list joins n items generated by item with sep.
+func (g *queryGen) list(n int, sep string, item func() string) string {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = item()
+	}
+	return strings.Join(items, sep)
+}
+
+// kw returns keywords in s with random case.
+func (g *queryGen) kw(s string) string {
+	b := []byte(s)
+	for i := range b {
+		if g.chance(3) {
+			b[i] = strings.ToLower(string(b[i]))[0]
+		}
+	}
+	return string(b)
+}
+
+// sp returns a required space.
+func (g *queryGen) sp() string {
+	return g.pick(" ", " ", " ", "  ", "\n", " /* c */ ", " // c\n")
+}
+
+func (g *queryGen) name() string {
+	return g.pick("n", "m", "a", "b", "x", "node_1", "Ünï", "名前", "count", "filter", "any", "`a b`", "`x``y`", "`match`", "`0`", "`n`")
+}
+
+func (g *queryGen) schemaName() string {
+	return g.pick("Person", "KNOWS", "x", "e", "prop", "count", "match", "Return", "`a b`", "`c|d`", "`1`")
+}
+
+func (g *queryGen) str() string {
+	quote := g.pick("'", `"`)
+	var b strings.Builder
+	b.WriteString(quote)
+	for i := g.r.Intn(6); i > 0; i-- {
+		switch c := g.pick("a", " ", "é", "名", "'", `"`, "\\", "\t", "\n", "\x01", "😀"); {
+		case c == quote || c == "\\":
+			b.WriteString("\\" + c)
+		case c == "\t":
+			b.WriteString(g.pick(`\t`, `\T`, "\t"))
+		case c == "\n":
+			b.WriteString(g.pick(`\n`, `\N`))
+		case c == "😀":
+			b.WriteString(g.pick(`\U0001F600`, `\ud83d`))
+		case c == "é":
+			b.WriteString(g.pick("é", `é`))
+		default:
+			b.WriteString(c)
+		}
+	}
+	b.WriteString(quote)
+	return b.String()
+}
+
+func (g *queryGen) number() string {
+	switch g.r.Intn(4) {
+	case 0:
+		return fmt.Sprint(g.r.Intn(1000))
+	case 1:
+		return g.pick("0x1F", "0xff", "017", "0", "9223372036854775807")
+	case 2:
+		s := strconv.FormatFloat(g.r.Float64()*math.Pow(10, float64(g.r.Intn(40)-20)), 'f', -1, 64)
+		if !strings.Contains(s, ".") {
+			s += ".0"
+		}
+		return s
+	default:
+		return g.pick("1e10", "1.5e-7", ".5", "0.1", "2E3", "1e300", "5e-324", "123456789.123456789")
+	}
+}
+
+func (g *queryGen) param() string {
+	return "$" + g.pick("p", "0", "12", "param", "`a b`")
+}
+
+// atom returns an expression which is parsed as an atom.
+func (g *queryGen) atom(depth int) string {
+	if depth <= 0 {
+		switch g.r.Intn(7) {
+		case 0:
+			return g.number()
+		case 1:
+			return g.str()
+		case 2:
+			return g.kw(g.pick("TRUE", "FALSE", "NULL"))
+		case 3:
+			return g.param()
+		default:
+			return g.name()
+		}
+	}
+	switch g.r.Intn(14) {
+	case 0:
+		return "[" + g.list(g.r.Intn(3), ", ", func() string { return g.expr(depth - 1) }) + "]"
+	case 1:
+		return "{" + g.list(g.r.Intn(3), ", ", func() string { return g.schemaName() + ": " + g.expr(depth-1) }) + "}"
+	case 2:
+		s := g.kw("CASE")
+		if g.chance(2) {
+			s += " " + g.expr(depth-1)
+		}
+		for i := g.r.Intn(2) + 1; i > 0; i-- {
+			s += g.sp() + g.kw("WHEN") + " " + g.expr(depth-1) + " " + g.kw("THEN") + " " + g.expr(depth-1)
+		}
+		if g.chance(2) {
+			s += g.sp() + g.kw("ELSE") + " " + g.expr(depth-1)
+		}
+		return s + g.sp() + g.kw("END")
+	case 3:
+		return g.kw("COUNT") + "(*)"
+	case 4:
+		s := "[" + g.filter(depth-1)
+		if g.chance(2) {
+			s += " | " + g.expr(depth-1)
+		}
+		return s + "]"
+	case 5:
+		s := "["
+		if g.chance(2) {
+			s += g.name() + " = "
+		}
+		s += g.relationshipsPattern(depth - 1)
+		if g.chance(2) {
+			s += g.sp() + g.kw("WHERE") + " " + g.expr(depth-1)
+		}
+		return s + " | " + g.expr(depth-1) + "]"
+	case 6:
+		return g.kw(g.pick("ALL", "ANY", "NONE", "SINGLE")) + "(" + g.filter(depth-1) + ")"
+	case 7:
+		return g.relationshipsPattern(depth - 1)
+	case 8:
+		return "(" + g.expr(depth-1) + ")"
+	case 9:
+		name := g.pick("f", "toLower", "apoc.coll.sum", "a.b.c", "count", "EXISTS", "exists")
+		s := name + "("
+		if g.chance(3) {
+			s += g.kw("DISTINCT") + " "
+		}
+		return s + g.list(g.r.Intn(3), ", ", func() string { return g.expr(depth - 1) }) + ")"
+	case 10:
+		s := g.kw(g.pick("EXISTS", "COUNT")) + g.pick("", " ") + "{" + g.sp()
+		if g.chance(2) {
+			s += g.pattern(depth-1) + g.where(depth-1)
+		} else {
+			s += g.kw("MATCH") + " " + g.pattern(depth-1) + g.where(depth-1)
+			if g.chance(2) {
+				s += g.sp() + g.kw("RETURN") + " " + g.expr(depth-1)
+			}
+		}
+		return s + g.sp() + "}"
+	default:
+		return g.atom(0)
+	}
+}
+
+func (g *queryGen) filter(depth int) string {
+	s := g.name() + g.sp() + g.kw("IN") + g.sp() + g.expr(depth)
+	if g.chance(2) {
+		s += g.sp() + g.kw("WHERE") + g.sp() + g.expr(depth)
+	}
+	return s
+}
+
+// propertyOrLabels returns an atom with optional property lookups and labels.
+func (g *queryGen) propertyOrLabels(depth int) string {
+	s := g.atom(depth)
+	for i := g.r.Intn(3) - 1; i > 0; i-- {
+		s += "." + g.schemaName()
+	}
+	if g.chance(5) {
+		s += g.labels()
+	}
+	return s
+}
+
+func (g *queryGen) expr(depth int) string {
+	if depth <= 0 || g.chance(3) {
+		return g.propertyOrLabels(depth)
+	}
+	switch g.r.Intn(8) {
+	case 0:
+		op := g.pick("+", "-", "*", "/", "%", "^", "=", "<>", "<", ">", "<=", ">=")
+		return g.expr(depth-1) + " " + op + " " + g.operand(depth-1)
+	case 1:
+		op := g.kw(g.pick("OR", "XOR", "AND"))
+		return g.expr(depth-1) + g.sp() + op + g.sp() + g.expr(depth-1)
+	case 2:
+		return g.kw("NOT") + g.sp() + g.expr(depth-1)
+	case 3:
+		return g.pick("-", "+", "- ") + g.operand(depth-1)
+	case 4:
+		op := g.kw(g.pick("STARTS WITH", "ENDS WITH", "CONTAINS", "IN"))
+		return g.expr(depth-1) + g.sp() + op + g.sp() + g.propertyOrLabels(depth-1)
+	case 5:
+		return g.expr(depth-1) + g.sp() + g.kw(g.pick("IS NULL", "IS NOT NULL"))
+	case 6:
+		switch g.r.Intn(4) {
+		case 0:
+			return g.expr(depth-1) + "[" + g.expr(depth-1) + "]"
+		case 1:
+			return g.expr(depth-1) + "[" + g.expr(depth-1) + ".." + g.expr(depth-1) + "]"
+		case 2:
+			return g.expr(depth-1) + "[.." + g.expr(depth-1) + "]"
+		default:
+			return g.expr(depth-1) + "[" + g.expr(depth-1) + "..]"
+		}
+	default:
+		return g.propertyOrLabels(depth)
+	}
+}
+
+// operand returns an expression which can follow an arithmetic or comparison operator,
+// NOT has lower precedence than them so it's parenthesized.
+func (g *queryGen) operand(depth int) string {
+	s := g.expr(depth)
+	if strings.HasPrefix(strings.ToUpper(s), "NOT") {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+func (g *queryGen) labels() string {
+	return g.list(g.r.Intn(2)+1, "", func() string { return ":" + g.schemaName() })
+}
+
+func (g *queryGen) properties(depth int) string {
+	if g.chance(3) {
+		return g.param()
+	}
+	return "{" + g.list(g.r.Intn(3), ", ", func() string { return g.schemaName() + ": " + g.expr(depth) }) + "}"
+}
+
+func (g *queryGen) nodePattern(depth int) string {
+	s := "("
+	if g.chance(2) {
+		s += g.name()
+	}
+	if g.chance(2) {
+		s += g.labels()
+	}
+	if g.chance(3) {
+		s += " " + g.properties(depth)
+	}
+	return s + ")"
+}
+
+func (g *queryGen) relationshipPattern(depth int) string {
+	detail := ""
+	if !g.chance(3) {
+		detail = "["
+		if g.chance(2) {
+			detail += g.name()
+		}
+		if g.chance(2) {
+			detail += ":" + g.list(g.r.Intn(3)+1, "|"+g.pick("", ":"), g.schemaName)
+		}
+		if g.chance(2) {
+			detail += g.pick("*", "*2", "*1..", "*..3", "*1..3", "*..", "*0x2")
+		}
+		if g.chance(3) {
+			detail += " " + g.properties(depth)
+		}
+		detail += "]"
+	}
+	return g.pick("<-", "-") + detail + g.pick("->", "-")
+}
+
+func (g *queryGen) relationshipsPattern(depth int) string {
+	s := g.nodePattern(depth)
+	for i := g.r.Intn(2) + 1; i > 0; i-- {
+		s += g.relationshipPattern(depth) + g.nodePattern(depth)
+	}
+	return s
+}
+
+func (g *queryGen) patternPart(depth int) string {
+	s := ""
+	if g.chance(3) {
+		s = g.name() + " = "
+	}
+	element := g.nodePattern(depth)
+	for i := g.r.Intn(3); i > 0; i-- {
+		element += g.relationshipPattern(depth) + g.nodePattern(depth)
+	}
+	if g.chance(5) {
+		element = "(" + element + ")"
+	}
+	return s + element
+}
+
+func (g *queryGen) pattern(depth int) string {
+	return g.list(g.r.Intn(2)+1, ", ", func() string { return g.patternPart(depth) })
+}
+
+func (g *queryGen) where(depth int) string {
+	if g.chance(2) {
+		return ""
+	}
+	return g.sp() + g.kw("WHERE") + g.sp() + g.expr(depth)
+}
+
+func (g *queryGen) procedure(depth int) string {
+	return g.pick("db.labels", "my.proc", "p") + "(" + g.list(g.r.Intn(3), ", ", func() string { return g.expr(depth) }) + ")"
+}
+
+func (g *queryGen) yield(depth int) string {
+	if g.chance(2) {
+		return ""
+	}
+	s := g.sp() + g.kw("YIELD") + g.sp()
+	if g.chance(4) {
+		s += "*"
+	} else {
+		s += g.list(g.r.Intn(2)+1, ", ", func() string {
+			if g.chance(2) {
+				return g.name() + g.sp() + g.kw("AS") + g.sp() + g.name()
+			}
+			return g.name()
+		})
+	}
+	return s + g.where(depth)
+}
+
+func (g *queryGen) subqueryClause(depth int) string {
+	s := g.kw("CALL") + g.pick("", " ") + "{" + g.sp()
+	if g.chance(2) {
+		s += g.kw("WITH") + g.sp() + g.pick("*", g.name()) + g.sp()
+	}
+	s += g.readingClause(depth-1) + g.sp()
+	if g.chance(2) {
+		s += g.updatingClause(depth - 1)
+	} else {
+		s += g.kw("RETURN") + g.sp() + g.returnBody(depth-1)
+	}
+	s += g.sp() + "}"
+	if g.chance(2) {
+		s += g.sp() + g.kw("IN") + g.sp()
+		if g.chance(3) {
+			s += g.number() + g.sp() + g.kw("CONCURRENT") + g.sp()
+		}
+		s += g.kw("TRANSACTIONS")
+		if g.chance(2) {
+			s += g.sp() + g.kw("OF") + g.sp() + g.pick("1", "1000", "$batch") + g.sp() + g.kw(g.pick("ROW", "ROWS"))
+		}
+		if g.chance(2) {
+			s += g.sp() + g.kw("ON") + g.sp() + g.kw("ERROR") + g.sp() + g.kw(g.pick("CONTINUE", "BREAK", "FAIL"))
+		}
+	}
+	return s
+}
+
+func (g *queryGen) readingClause(depth int) string {
+	if depth > 0 && g.chance(8) {
+		return g.subqueryClause(depth)
+	}
+	switch g.r.Intn(3) {
+	case 0:
+		return g.kw("UNWIND") + g.sp() + g.expr(depth) + g.sp() + g.kw("AS") + g.sp() + g.name()
+	case 1:
+		return g.kw("CALL") + g.sp() + g.procedure(depth) + g.yield(depth)
+	default:
+		s := g.kw("MATCH") + g.sp() + g.pattern(depth) + g.where(depth)
+		if g.chance(3) {
+			s = g.kw("OPTIONAL") + g.sp() + s
+		}
+		return s
+	}
+}
+
+func (g *queryGen) setItem(depth int) string {
+	switch g.r.Intn(4) {
+	case 0:
+		return g.atom(0) + "." + g.schemaName() + " = " + g.expr(depth)
+	case 1:
+		return g.name() + " = " + g.expr(depth)
+	case 2:
+		return g.name() + " += " + g.expr(depth)
+	default:
+		return g.name() + g.labels()
+	}
+}
+
+func (g *queryGen) setClause(depth int) string {
+	return g.kw("SET") + g.sp() + g.list(g.r.Intn(2)+1, ",", func() string { return g.setItem(depth) })
+}
+
+func (g *queryGen) updatingClause(depth int) string {
+	switch g.r.Intn(5) {
+	case 0:
+		return g.kw("CREATE") + g.sp() + g.pattern(depth)
+	case 1:
+		s := g.kw("MERGE") + g.sp() + g.patternPart(depth)
+		for i := g.r.Intn(3); i > 0; i-- {
+			s += g.sp() + g.kw("ON") + g.sp() + g.kw(g.pick("CREATE", "MATCH")) + g.sp() + g.setClause(depth)
+		}
+		return s
+	case 2:
+		return g.setClause(depth)
+	case 3:
+		s := g.kw("DELETE") + g.sp() + g.list(g.r.Intn(2)+1, ", ", func() string { return g.expr(depth) })
+		if g.chance(2) {
+			s = g.kw("DETACH") + g.sp() + s
+		}
+		return s
+	default:
+		return g.kw("REMOVE") + g.sp() + g.list(g.r.Intn(2)+1, ", ", func() string {
+			if g.chance(2) {
+				return g.name() + g.labels()
+			}
+			return g.atom(0) + "." + g.schemaName()
+		})
+	}
+}
+
+func (g *queryGen) returnBody(depth int) string {
+	items := g.list(g.r.Intn(3)+1, ", ", func() string {
+		if g.chance(2) {
+			return g.expr(depth) + g.sp() + g.kw("AS") + g.sp() + g.name()
+		}
+		return g.expr(depth)
+	})
+	if g.chance(4) {
+		items = "*, " + items
+	} else if g.chance(4) {
+		items = "*"
+	}
+	s := items
+	if g.chance(3) {
+		s += g.sp() + g.kw("ORDER") + g.sp() + g.kw("BY") + g.sp() + g.list(g.r.Intn(2)+1, ", ", func() string {
+			return g.expr(depth) + g.pick("", " "+g.kw(g.pick("ASC", "ASCENDING", "DESC", "DESCENDING")))
+		})
+	}
+	if g.chance(3) {
+		s += g.sp() + g.kw("SKIP") + g.sp() + g.expr(depth)
+	}
+	if g.chance(3) {
+		s += g.sp() + g.kw("LIMIT") + g.sp() + g.expr(depth)
+	}
+	return s
+}
+
+func (g *queryGen) clauses(n int, clause func(depth int) string) []string {
+	clauses := make([]string, n)
+	for i := range clauses {
+		clauses[i] = clause(g.r.Intn(3))
+	}
+	return clauses
+}
+
+func (g *queryGen) singleQuery() string {
+	var clauses []string
+	for i := g.r.Intn(3); i > 0; i-- {
+		clauses = append(clauses, g.clauses(g.r.Intn(3), g.readingClause)...)
+		clauses = append(clauses, g.clauses(g.r.Intn(2), g.updatingClause)...)
+		with := g.kw("WITH")
+		if g.chance(3) {
+			with += g.sp() + g.kw("DISTINCT")
+		}
+		clauses = append(clauses, with+g.sp()+g.returnBody(g.r.Intn(3))+g.where(g.r.Intn(3)))
+	}
+	clauses = append(clauses, g.clauses(g.r.Intn(3), g.readingClause)...)
+	updating := g.clauses(g.r.Intn(3), g.updatingClause)
+	clauses = append(clauses, updating...)
+	if len(updating) == 0 || g.chance(2) {
+		ret := g.kw("RETURN")
+		if g.chance(3) {
+			ret += g.sp() + g.kw("DISTINCT")
+		}
+		clauses = append(clauses, ret+g.sp()+g.returnBody(g.r.Intn(3)))
+	}
+	s := clauses[0]
+	for _, clause := range clauses[1:] {
+		s += g.clauseSep() + clause
+	}
+	return s
+}
+
+// clauseSep returns a required space between clauses, sometimes a comment on its own line.
+func (g *queryGen) clauseSep() string {
+	if g.chance(5) {
+		return "\n" + g.pick("", "  ") + g.pick("// c", "/* c */", "// c\n// d") + "\n"
+	}
+	return g.sp()
+}
+
+func (g *queryGen) query() string {
+	if g.chance(10) {
+		s := g.kw("CALL") + g.sp() + g.pick("db.labels", g.procedure(2))
+		if g.chance(2) {
+			s += g.yield(2)
+		}
+		return s
+	}
+	s := g.singleQuery()
+	for i := g.r.Intn(3) - 1; i > 0; i-- {
+		s += g.sp() + g.kw("UNION") + g.pick("", g.sp()+g.kw("ALL")) + g.sp() + g.singleQuery()
+	}
+	return s
+}
+
+// TestRoundTripProperty checks the round trip of random cypher generated from the grammar.
+func TestRoundTripProperty(t *testing.T) {
+	n := 200
+	if testing.Short() {
+		n = 20
+	}
+	parser := New()
+	g := &queryGen{r: rand.New(rand.NewSource(1))}
+	for i := 0; i < n; i++ {
+		checkRoundTrip(t, parser, g.query())
+	}
+}
+
+func TestEqual(t *testing.T) {
+	cases := []struct {
+		a, b  string
+		flags ast.EqualFlags
+		equal bool
+	}{
+		{"MATCH (n) RETURN n", "MATCH (n) RETURN n", 0, true},
+		{"MATCH (n) RETURN n", "MATCH (n) RETURN m", 0, false},
+		{"MATCH (n) RETURN n", "MATCH (n)  RETURN n", 0, false},
+		{"MATCH (n) RETURN n", "MATCH (n)  RETURN n", ast.EqualIgnorePos, false},
+		{"MATCH (n) RETURN n", "match (n) return n", ast.EqualIgnoreText, true},
+		{"MATCH (n) RETURN n", "match (n)\nreturn n", ast.EqualIgnoreText, false},
+		{"MATCH (n) RETURN n", "match (n)\nreturn n", ast.EqualIgnorePos | ast.EqualIgnoreText, true},
+		{"MATCH (n) RETURN n", "MATCH (n) RETURN n // c", ast.EqualIgnorePos | ast.EqualIgnoreText, false},
+		{"MATCH (n) RETURN n // c", "MATCH (n)\nRETURN n // c", ast.EqualIgnorePos | ast.EqualIgnoreText, true},
+		{"RETURN 1 + 2 * 3", "RETURN (1 + 2) * 3", ast.EqualIgnorePos | ast.EqualIgnoreText, false},
+		{"MATCH (n)-->(m) RETURN n", "MATCH (n)<--(m) RETURN n", ast.EqualIgnorePos | ast.EqualIgnoreText, false},
+		{"MATCH (n) RETURN n", "MATCH (`n`) RETURN `n`", ast.EqualIgnorePos, false},
+		{"MATCH (n) RETURN n", "MATCH (`n`) RETURN `n`", ast.EqualIgnorePos | ast.EqualIgnoreText, true},
+		{"MATCH (a:match)-[:Return]->(count) RETURN a", "MATCH (`a`:`match`)-[:`Return`]->(`count`) RETURN `a`", ast.EqualIgnorePos | ast.EqualIgnoreText, true},
+		{"MATCH (n) RETURN n", "MATCH (`n `) RETURN `n `", ast.EqualIgnorePos | ast.EqualIgnoreText, false},
+		{"MATCH (n:match) RETURN n", "MATCH (n:`Match`) RETURN n", ast.EqualIgnorePos | ast.EqualIgnoreText, false},
+	}
+	parser := New()
+	for _, c := range cases {
+		a, err := parser.Parse(c.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := parser.Parse(c.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ast.EqualWithFlags(a, b, c.flags) != c.equal {
+			t.Fatalf("%q and %q: expected equal to be %v", c.a, c.b, c.equal)
+		}
+	}
+}
+
+// allNodes returns all nodes reachable from the exported fields of n.
+func allNodes(n ast.Node) []ast.Node {
+	var nodes []ast.Node
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Interface, reflect.Ptr:
+			if v.IsNil() {
+				return
+			}
+			if n, ok := v.Interface().(ast.Node); ok && v.Kind() == reflect.Ptr {
+				nodes = append(nodes, n)
+			}
+			walk(v.Elem())
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				if !v.Type().Field(i).Anonymous {
+					walk(v.Field(i))
+				}
+			}
+		}
+	}
+	walk(reflect.ValueOf(n))
+	return nodes
+}
+
+func TestClone(t *testing.T) {
+	parser := New()
+	for _, cypher := range roundTripCorpus {
+		stmt, err := parser.Parse(cypher)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var original strings.Builder
+		stmt.Restore(ast.NewRestoreContext(&original))
+
+		cloned := ast.Clone(stmt)
+		if !ast.Equal(stmt, cloned) {
+			t.Fatalf("clone of %q is not equal", cypher)
+		}
+		shared := map[ast.Node]bool{}
+		for _, n := range allNodes(stmt) {
+			shared[n] = true
+		}
+		for _, n := range allNodes(cloned) {
+			if shared[n] {
+				t.Fatalf("clone of %q shares %T", cypher, n)
+			}
+			if n, ok := n.(*ast.SymbolicNameNode); ok {
+				n.Value += "_renamed"
+			}
+			n.Comments().Leading = append(n.Comments().Leading, &ast.Comment{Text: "/* renamed */"})
+		}
+
+		var restored strings.Builder
+		stmt.Restore(ast.NewRestoreContext(&restored))
+		if restored.String() != original.String() {
+			t.Fatalf("original is modified through clone: %s", restored.String())
+		}
+		if ast.Equal(stmt, cloned) {
+			t.Fatalf("modified clone of %q is equal", cypher)
+		}
+	}
+	if ast.Clone(nil) != nil {
+		t.Fatal("clone of nil is not nil")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	parser := New()
+	for _, cypher := range roundTripCorpus {
+		stmt, err := parser.Parse(cypher)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ast.MarshalJSON(stmt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := ast.UnmarshalJSON(data)
+		if err != nil {
+			t.Fatalf("%s: %v", data, err)
+		}
+		if !ast.Equal(stmt, decoded) {
+			t.Fatalf("decoded %q is not equal: %s", cypher, data)
+		}
+	}
+
+	stmt, err := parser.Parse("MATCH (n)-[*2..]->(m) SET n:A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ast.MarshalJSON(stmt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		`{"version":1,"node":{"type":"CypherStmt","start":{"Offset":0,"Line":1,"Column":0},`,
+		`{"type":"RelationshipDetail",`,
+		`"MinHops":2,"MaxHops":-1,`,
+		`{"type":"SetItem",`,
+	} {
+		if !strings.Contains(string(data), s) {
+			t.Fatalf("%s is not in %s", s, data)
+		}
+	}
+
+	for _, c := range []struct {
+		data string
+		err  string
+	}{
+		{`{"version":2,"node":null}`, "ast: unsupported JSON version 2"},
+		{`{"version":1,"node":{"type":"Foo"}}`, `ast: unknown node type "Foo"`},
+		{`{"version":1,"node":{"type":"VariableNode","Foo":1}}`, "ast: unknown field VariableNode.Foo"},
+		{`{"version":1,"node":{"type":"UnwindClause","Expr":{"type":"Pattern"},"Variable":{"type":"SchemaNameNode"}}}`,
+			"ast: invalid UnwindClause.Variable: ast: SchemaNameNode is not *ast.VariableNode"},
+	} {
+		if _, err := ast.UnmarshalJSON([]byte(c.data)); err == nil || err.Error() != c.err {
+			t.Fatalf("%s: obtained error: %v; expected: %s", c.data, err, c.err)
+		}
+	}
+}
+
+func TestProtobuf(t *testing.T) {
+	parser := New()
+	queries := append([]string{}, roundTripCorpus...)
+	g := &queryGen{r: rand.New(rand.NewSource(1))}
+	for i := 0; i < 20; i++ {
+		queries = append(queries, g.query())
+	}
+	for _, cypher := range queries {
+		stmt, err := parser.Parse(cypher)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := astpb.Marshal(stmt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := astpb.Unmarshal(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ast.Equal(stmt, decoded) {
+			t.Fatalf("decoded %q is not equal", cypher)
+		}
+	}
+
+	// every message of nodes is converted from the node with the same name
+	fields := astpb.File_ast_proto.Messages().ByName("Node").Fields()
+	for i := 0; i < fields.Len(); i++ {
+		name := fields.Get(i).Message().Name()
+		m := &astpb.Node{}
+		m.ProtoReflect().Set(fields.Get(i), protoreflect.ValueOfMessage(newMessage(t, name)))
+		n, err := astpb.ToNode(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reflect.TypeOf(n).Elem().Name() != string(name) {
+			t.Fatalf("%s is converted to %T", name, n)
+		}
+		if _, err := astpb.FromNode(n); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := astpb.ToNode(&astpb.Node{}); err == nil || err.Error() != "astpb: empty Node" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m := &astpb.Node{Node: &astpb.Node_UnwindClause{UnwindClause: &astpb.UnwindClause{
+		Variable: &astpb.VariableNode{},
+		Expr:     &astpb.Expr{},
+	}}}
+	if _, err := astpb.ToNode(m); err == nil || err.Error() != "astpb: UnwindClause.Expr: astpb: empty Expr" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestEnumNumbers pins the numbers of the enums in package ast, which are encoded as numbers
+// by MarshalJSON and astpb, so reordering the constants fails instead of corrupting stored trees.
+func TestEnumNumbers(t *testing.T) {
+	cases := []struct {
+		value int
+		enum  protoreflect.Enum
+	}{
+		{int(ast.CypherStmtQuery), astpb.CypherStmtType_CYPHER_STMT_QUERY},
+		{int(ast.CypherStmtStandaloneCall), astpb.CypherStmtType_CYPHER_STMT_STANDALONE_CALL},
+		{int(ast.FilterListComprehension), astpb.FilterType_FILTER_LIST_COMPREHENSION},
+		{int(ast.FilterAll), astpb.FilterType_FILTER_ALL},
+		{int(ast.FilterAny), astpb.FilterType_FILTER_ANY},
+		{int(ast.FilterSingle), astpb.FilterType_FILTER_SINGLE},
+		{int(ast.FilterNone), astpb.FilterType_FILTER_NONE},
+		{int(ast.LiteralNumber), astpb.LiteralType_LITERAL_NUMBER},
+		{int(ast.LiteralString), astpb.LiteralType_LITERAL_STRING},
+		{int(ast.LiteralBoolean), astpb.LiteralType_LITERAL_BOOLEAN},
+		{int(ast.LiteralNull), astpb.LiteralType_LITERAL_NULL},
+		{int(ast.LiteralMap), astpb.LiteralType_LITERAL_MAP},
+		{int(ast.LiteralList), astpb.LiteralType_LITERAL_LIST},
+		{int(ast.MergeActionCreate), astpb.MergeActionType_MERGE_ACTION_CREATE},
+		{int(ast.MergeActionMatch), astpb.MergeActionType_MERGE_ACTION_MATCH},
+		{int(ast.NumberLiteralInteger), astpb.NumberLiteralType_NUMBER_LITERAL_INTEGER},
+		{int(ast.NumberLiteralDouble), astpb.NumberLiteralType_NUMBER_LITERAL_DOUBLE},
+		{int(ast.OnErrorNone), astpb.OnErrorType_ON_ERROR_NONE},
+		{int(ast.OnErrorContinue), astpb.OnErrorType_ON_ERROR_CONTINUE},
+		{int(ast.OnErrorBreak), astpb.OnErrorType_ON_ERROR_BREAK},
+		{int(ast.OnErrorFail), astpb.OnErrorType_ON_ERROR_FAIL},
+		{int(ast.OpAdd), astpb.OpType_OP_ADD},
+		{int(ast.OpSub), astpb.OpType_OP_SUB},
+		{int(ast.OpMul), astpb.OpType_OP_MUL},
+		{int(ast.OpDiv), astpb.OpType_OP_DIV},
+		{int(ast.OpMod), astpb.OpType_OP_MOD},
+		{int(ast.OpPow), astpb.OpType_OP_POW},
+		{int(ast.OpPlus), astpb.OpType_OP_PLUS},
+		{int(ast.OpMinus), astpb.OpType_OP_MINUS},
+		{int(ast.OpEQ), astpb.OpType_OP_EQ},
+		{int(ast.OpNE), astpb.OpType_OP_NE},
+		{int(ast.OpLT), astpb.OpType_OP_LT},
+		{int(ast.OpGT), astpb.OpType_OP_GT},
+		{int(ast.OpLTE), astpb.OpType_OP_LTE},
+		{int(ast.OpGTE), astpb.OpType_OP_GTE},
+		{int(ast.OpOr), astpb.OpType_OP_OR},
+		{int(ast.OpAnd), astpb.OpType_OP_AND},
+		{int(ast.OpXor), astpb.OpType_OP_XOR},
+		{int(ast.OpNot), astpb.OpType_OP_NOT},
+		{int(ast.ParameterSymbolicName), astpb.ParameterType_PARAMETER_SYMBOLIC_NAME},
+		{int(ast.ParameterDecimalInteger), astpb.ParameterType_PARAMETER_DECIMAL_INTEGER},
+		{int(ast.PredicationStringOp), astpb.PredicationType_PREDICATION_STRING_OP},
+		{int(ast.PredicationListOp), astpb.PredicationType_PREDICATION_LIST_OP},
+		{int(ast.PredicationNullOp), astpb.PredicationType_PREDICATION_NULL_OP},
+		{int(ast.PropertiesMapLiteral), astpb.PropertiesType_PROPERTIES_MAP_LITERAL},
+		{int(ast.PropertiesParameter), astpb.PropertiesType_PROPERTIES_PARAMETER},
+		{int(ast.ReadingClauseMatch), astpb.ReadingClauseType_READING_CLAUSE_MATCH},
+		{int(ast.ReadingClauseUnwind), astpb.ReadingClauseType_READING_CLAUSE_UNWIND},
+		{int(ast.ReadingClauseInQueryCall), astpb.ReadingClauseType_READING_CLAUSE_IN_QUERY_CALL},
+		{int(ast.RelationshipIn), astpb.RelationshipType_RELATIONSHIP_IN},
+		{int(ast.RelationshipOut), astpb.RelationshipType_RELATIONSHIP_OUT},
+		{int(ast.RelationshipBoth), astpb.RelationshipType_RELATIONSHIP_BOTH},
+		{int(ast.RelationshipAll), astpb.RelationshipType_RELATIONSHIP_ALL},
+		{int(ast.RemoveItemVariable), astpb.RemoveItemType_REMOVE_ITEM_VARIABLE},
+		{int(ast.RemoveItemProperty), astpb.RemoveItemType_REMOVE_ITEM_PROPERTY},
+		{int(ast.SchemaNameSymbolicName), astpb.SchemaNameType_SCHEMA_NAME_SYMBOLIC_NAME},
+		{int(ast.SchemaNameReservedWord), astpb.SchemaNameType_SCHEMA_NAME_RESERVED_WORD},
+		{int(ast.SetItemProperty), astpb.SetItemType_SET_ITEM_PROPERTY},
+		{int(ast.SetItemVariableAssignment), astpb.SetItemType_SET_ITEM_VARIABLE_ASSIGNMENT},
+		{int(ast.SetItemVariableIncrement), astpb.SetItemType_SET_ITEM_VARIABLE_INCREMENT},
+		{int(ast.SetItemVariableLabel), astpb.SetItemType_SET_ITEM_VARIABLE_LABEL},
+		{int(ast.SortAscending), astpb.SortType_SORT_ASCENDING},
+		{int(ast.SortDescending), astpb.SortType_SORT_DESCENDING},
+		{int(ast.StringOperationStartsWith), astpb.StringOperationType_STRING_OPERATION_STARTS_WITH},
+		{int(ast.StringOperationEndsWith), astpb.StringOperationType_STRING_OPERATION_ENDS_WITH},
+		{int(ast.StringOperationContains), astpb.StringOperationType_STRING_OPERATION_CONTAINS},
+		{int(ast.SymbolicNameUnescaped), astpb.SymbolicNameType_SYMBOLIC_NAME_UNESCAPED},
+		{int(ast.SymbolicNameEscaped), astpb.SymbolicNameType_SYMBOLIC_NAME_ESCAPED},
+		{int(ast.SymbolicNameHexLetter), astpb.SymbolicNameType_SYMBOLIC_NAME_HEX_LETTER},
+		{int(ast.SymbolicNameCount), astpb.SymbolicNameType_SYMBOLIC_NAME_COUNT},
+		{int(ast.SymbolicNameFilter), astpb.SymbolicNameType_SYMBOLIC_NAME_FILTER},
+		{int(ast.SymbolicNameExtract), astpb.SymbolicNameType_SYMBOLIC_NAME_EXTRACT},
+		{int(ast.SymbolicNameAny), astpb.SymbolicNameType_SYMBOLIC_NAME_ANY},
+		{int(ast.SymbolicNameNone), astpb.SymbolicNameType_SYMBOLIC_NAME_NONE},
+		{int(ast.SymbolicNameSingle), astpb.SymbolicNameType_SYMBOLIC_NAME_SINGLE},
+		{int(ast.SymbolicNameExists), astpb.SymbolicNameType_SYMBOLIC_NAME_EXISTS},
+	}
+	counts := map[protoreflect.FullName]int{}
+	for _, c := range cases {
+		if c.value != int(c.enum.Number()) {
+			t.Fatalf("%s is %d, expected %d", c.enum.Descriptor().Values().ByNumber(c.enum.Number()).Name(), c.value, c.enum.Number())
+		}
+		counts[c.enum.Descriptor().FullName()]++
+	}
+	enums := astpb.File_ast_proto.Enums()
+	for i := 0; i < enums.Len(); i++ {
+		if enum := enums.Get(i); counts[enum.FullName()] != enum.Values().Len() {
+			t.Fatalf("values of %s are not all pinned", enum.Name())
+		}
+	}
+}
+
+func newMessage(t *testing.T, name protoreflect.Name) protoreflect.Message {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(astpb.File_ast_proto.Package().Append(name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mt.New()
+}
+
+func TestApply(t *testing.T) {
+	parser := New()
+	parse := func(cypher string) ast.Stmt {
+		stmt, err := parser.Parse(cypher)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return stmt
+	}
+	restore := func(n ast.Node) string {
+		var b strings.Builder
+		n.Restore(ast.NewRestoreContext(&b))
+		return b.String()
+	}
+
+	// order of pre and post, with the names and indexes of fields
+	var trace []string
+	ast.Apply(parse("MATCH (n) RETURN n, 1"), func(c *ast.Cursor) bool {
+		if c.Parent() != nil {
+			trace = append(trace, fmt.Sprintf("%s[%d] %T", c.Name(), c.Index(), c.Node()))
+		}
+		_, isPattern := c.Node().(*ast.Pattern)
+		return !isPattern
+	}, func(c *ast.Cursor) bool {
+		_, isLiteral := c.Node().(*ast.LiteralExpr)
+		return !isLiteral
+	})
+	expected := []string{
+		"Query[-1] *ast.QueryStmt",
+		"Clauses[0] *ast.MatchClause",
+		"Pattern[-1] *ast.Pattern",
+		"Clauses[1] *ast.ReturnClause",
+		"ReturnBody[-1] *ast.ReturnBody",
+		"ReturnItems[0] *ast.ReturnItem",
+		"Expr[-1] *ast.VariableNode",
+		"SymbolicName[-1] *ast.SymbolicNameNode",
+		"ReturnItems[1] *ast.ReturnItem",
+		"Expr[-1] *ast.LiteralExpr",
+		"Number[-1] *ast.NumberLiteral",
+	}
+	if !reflect.DeepEqual(trace, expected) {
+		t.Fatalf("obtained: %v; expected: %v", trace, expected)
+	}
+
+	inserted := parse("MATCH (x) RETURN x").(*ast.CypherStmt).Query.Clauses[0]
+	cases := []struct {
+		original string
+		pre      ast.ApplyFunc
+		target   string
+	}{
+		{"MATCH (n) WHERE n.a > 1 RETURN n.a", func(c *ast.Cursor) bool {
+			if v, ok := c.Node().(*ast.VariableNode); ok && v.Name() == "n" {
+				c.Replace(&ast.VariableNode{SymbolicName: &ast.SymbolicNameNode{Value: "m"}})
+			}
+			return true
+		}, "MATCH (`m`) WHERE `m`.`a` > 1 RETURN `m`.`a`"},
+		{"RETURN 1, 2, 3, 4", func(c *ast.Cursor) bool {
+			if c.Name() == "ReturnItems" && (c.Node().Text() == "1" || c.Node().Text() == "3") {
+				c.Delete()
+			}
+			return true
+		}, "RETURN 2, 4"},
+		{"MATCH (n) RETURN n", func(c *ast.Cursor) bool {
+			if _, ok := c.Node().(*ast.ReturnClause); ok {
+				c.InsertBefore(inserted)
+				c.InsertAfter(inserted)
+			}
+			// inserted clauses aren't walked
+			if c.Node() == inserted {
+				t.Fatal("inserted node is walked")
+			}
+			return true
+		}, "MATCH (`n`) MATCH (`x`) RETURN `n` MATCH (`x`)"},
+		{"MATCH (n) WHERE n.a RETURN n", func(c *ast.Cursor) bool {
+			if c.Name() == "Where" {
+				c.Replace(nil)
+			}
+			return true
+		}, "MATCH (`n`) RETURN `n`"},
+		{"MATCH (n) RETURN n", func(c *ast.Cursor) bool {
+			if c.Parent() == nil {
+				c.Replace(parse("RETURN 1"))
+				return false
+			}
+			return true
+		}, "RETURN 1"},
+	}
+	for _, c := range cases {
+		result := ast.Apply(parse(c.original), c.pre, nil)
+		if restore(result) != c.target {
+			t.Fatalf("obtained: %s; expected: %s", restore(result), c.target)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != "ast: VariableNode.SymbolicName can't be *ast.LiteralExpr" {
+			t.Fatalf("unexpected panic: %v", r)
+		}
+	}()
+	ast.Apply(parse("RETURN n"), func(c *ast.Cursor) bool {
+		if _, ok := c.Node().(*ast.SymbolicNameNode); ok {
+			c.Replace(&ast.LiteralExpr{})
+		}
+		return true
+	}, nil)
+}
+
+// replaceVisitor replaces variables named from with to, in Enter or Leave.
+type replaceVisitor struct {
+	from, to string
+	inLeave  bool
+}
+
+func (v *replaceVisitor) replace(node ast.Node) ast.Node {
+	if n, ok := node.(*ast.VariableNode); ok && n.Name() == v.from {
+		return &ast.VariableNode{SymbolicName: &ast.SymbolicNameNode{Value: v.to}}
+	}
+	return node
+}
+
+func (v *replaceVisitor) Enter(node ast.Node) (ast.Node, bool) {
+	if v.inLeave {
+		return node, false
+	}
+	node = v.replace(node)
+	_, skip := node.(*ast.VariableNode)
+	return node, skip
+}
+
+func (v *replaceVisitor) Leave(node ast.Node) (ast.Node, bool) {
+	if v.inLeave {
+		return v.replace(node), true
+	}
+	return node, true
+}
+
+// stopVisitor stops the traversal when leaving the first literal.
+type stopVisitor struct {
+	collectVisitor
+}
+
+func (v *stopVisitor) Leave(node ast.Node) (ast.Node, bool) {
+	_, isLiteral := node.(*ast.LiteralExpr)
+	return node, !isLiteral
+}
+
+func TestAccept(t *testing.T) {
+	parser := New()
+
+	// every child is visited once, in the order they're written
+	queries := append([]string{}, roundTripCorpus...)
+	g := &queryGen{r: rand.New(rand.NewSource(1))}
+	for i := 0; i < 50; i++ {
+		queries = append(queries, g.query())
+	}
+	for _, cypher := range queries {
+		stmt, err := parser.Parse(cypher)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v := &collectVisitor{}
+		if _, ok := stmt.Accept(v); !ok {
+			t.Fatalf("%q: Accept returns false", cypher)
+		}
+		visited := map[ast.Node]int{}
+		for i, n := range v.nodes {
+			visited[n]++
+			if i > 0 && n.Start().Offset < v.nodes[i-1].Start().Offset {
+				t.Fatalf("%q: %T at %d is visited after %T at %d", cypher, n, n.Start().Offset, v.nodes[i-1], v.nodes[i-1].Start().Offset)
+			}
+		}
+		nodes := allNodes(stmt)
+		if len(visited) != len(nodes) || len(v.nodes) != len(nodes) {
+			t.Fatalf("%q: %d of %d nodes are visited", cypher, len(v.nodes), len(nodes))
+		}
+		for _, n := range nodes {
+			if visited[n] != 1 {
+				t.Fatalf("%q: %T is visited %d times", cypher, n, visited[n])
+			}
+		}
+	}
+
+	// nil children are skipped
+	fields := astpb.File_ast_proto.Messages().ByName("Node").Fields()
+	for i := 0; i < fields.Len(); i++ {
+		m := &astpb.Node{}
+		m.ProtoReflect().Set(fields.Get(i), protoreflect.ValueOfMessage(newMessage(t, fields.Get(i).Message().Name())))
+		n, err := astpb.ToNode(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v := &collectVisitor{}
+		n.Accept(v)
+		if len(v.nodes) != 1 {
+			t.Fatalf("%d nodes are visited in empty %T", len(v.nodes), n)
+		}
+	}
+
+	// replaced nodes are stored back
+	for _, c := range []struct {
+		original string
+		target   string
+	}{
+		{"MATCH (n)-[r]->(n {a: n.a}) WHERE n.name STARTS WITH n.prefix RETURN n",
+			"MATCH (`m`)-[`r`]->(`m`{a: `m`.`a`}) WHERE `m`.`name` STARTS WITH `m`.`prefix` RETURN `m`"},
+		{"RETURN CASE WHEN n THEN n END, n[n..], n[..n], n IN n, [x IN n WHERE n | n]",
+			"RETURN CASE WHEN `m` THEN `m` END, `m`[`m`..], `m`[..`m`], `m` IN `m`, [`x` IN `m` WHERE `m` | `m`]"},
+		{"UNWIND n AS n WITH n ORDER BY n SKIP n LIMIT n SET n.a = n,n += n DELETE n",
+			"UNWIND `m` AS `m` WITH `m` ORDER BY `m` ASC SKIP `m` LIMIT `m` SET `m`.`a` = `m`,`m` += `m` DELETE `m`"},
+	} {
+		for _, inLeave := range []bool{false, true} {
+			stmt, err := parser.Parse(c.original)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt.Accept(&replaceVisitor{from: "n", to: "m", inLeave: inLeave})
+			var target strings.Builder
+			stmt.Restore(ast.NewRestoreContext(&target))
+			if target.String() != c.target {
+				t.Fatalf("obtained: %s; expected: %s", target.String(), c.target)
+			}
+		}
+	}
+
+	// the traversal is stopped if Leave returns false
+	stmt, err := parser.Parse("MATCH (n) WHERE n.a = 1 RETURN n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &stopVisitor{}
+	if _, ok := stmt.Accept(v); ok {
+		t.Fatal("Accept returns true")
+	}
+	if last := v.nodes[len(v.nodes)-1]; last.Text() != "1" {
+		t.Fatalf("%T %s is visited after the traversal is stopped", last, last.Text())
+	}
+}
+
+// checkParents checks that the parent of every node under root is the node containing it.
+func checkParents(t *testing.T, root ast.Node) {
+	for _, parent := range allNodes(root) {
+		for _, child := range children(parent) {
+			if child.Parent() != parent {
+				t.Fatalf("parent of %T %q is %T, expected %T", child, child.Text(), child.Parent(), parent)
+			}
+		}
+	}
+}
+
+// children returns the nodes directly contained in n.
+func children(n ast.Node) []ast.Node {
+	var nodes []ast.Node
+	for _, c := range allNodes(n)[1:] {
+		direct := true
+		for _, nested := range nodes {
+			if containsNode(allNodes(nested), c) {
+				direct = false
+				break
+			}
+		}
+		if direct {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+func containsNode(nodes []ast.Node, n ast.Node) bool {
+	for _, node := range nodes {
+		if node == n {
+			return true
+		}
+	}
+	return false
+}
+
+func TestParents(t *testing.T) {
+	parser := New()
+	stmt, err := parser.Parse("MATCH (n) WHERE n.a = 1 MERGE (m {b: n.b}) ON CREATE SET m.c = n.c RETURN n.d UNION MATCH (n) RETURN n.e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkParents(t, stmt)
+	if stmt.Parent() != nil {
+		t.Fatalf("parent of root is %T", stmt.Parent())
+	}
+
+	var clauses, where []string
+	for _, n := range allNodes(stmt) {
+		if p, ok := n.(*ast.PropertyLookup); ok {
+			clauses = append(clauses, fmt.Sprintf("%s %T", p.PropertyKey.Name(), ast.EnclosingClause(p)))
+			for _, a := range ast.Ancestors(p) {
+				if m, ok := a.(*ast.MatchClause); ok && m.Where != nil && containsNode(allNodes(m.Where), p) {
+					where = append(where, p.PropertyKey.Name())
+				}
+			}
+		}
+	}
+	expected := []string{"a *ast.MatchClause", "b *ast.MergeClause", "c *ast.MergeClause", "c *ast.MergeClause", "d *ast.ReturnClause", "e *ast.ReturnClause"}
+	if !reflect.DeepEqual(clauses, expected) {
+		t.Fatalf("obtained: %v; expected: %v", clauses, expected)
+	}
+	if !reflect.DeepEqual(where, []string{"a"}) {
+		t.Fatalf("obtained: %v; expected: [a]", where)
+	}
+	ancestors := ast.Ancestors(stmt.(*ast.CypherStmt).Query.Clauses[0])
+	if len(ancestors) != 2 || ancestors[0] != stmt.(*ast.CypherStmt).Query || ancestors[1] != stmt {
+		t.Fatalf("unexpected ancestors: %v", ancestors)
+	}
+	if ast.EnclosingClause(stmt) != nil || ast.EnclosingClause(stmt.(*ast.CypherStmt).Query) != nil {
+		t.Fatal("root is in a clause")
+	}
+
+	sub, err := parser.Parse("MATCH (n) CALL { WITH n MATCH (n)-->(m) RETURN m.x AS x } RETURN x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkParents(t, sub)
+	clauses = nil
+	for _, v := range ast.FindAll[*ast.VariableNode](sub) {
+		clauses = append(clauses, fmt.Sprintf("%T", ast.EnclosingClause(v)))
+	}
+	expected = []string{"*ast.MatchClause", "*ast.WithClause", "*ast.MatchClause", "*ast.MatchClause", "*ast.ReturnClause", "*ast.ReturnClause", "*ast.ReturnClause"}
+	if !reflect.DeepEqual(clauses, expected) {
+		t.Fatalf("obtained: %v; expected: %v", clauses, expected)
+	}
+	// the importing WITH of each branch of a UNION stays in the branch
+	sub, err = parser.Parse("MATCH (n) CALL { WITH n RETURN n.x AS x UNION WITH n RETURN n.y AS x } RETURN x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subquery, _ := ast.Find[*ast.SubqueryClause](sub); subquery.ImportingWith != nil {
+		t.Fatal("importing WITH of a UNION is lifted")
+	}
+	clauses = nil
+	for _, v := range ast.FindAll[*ast.VariableNode](sub) {
+		clauses = append(clauses, fmt.Sprintf("%T", ast.EnclosingClause(v)))
+	}
+	expected = []string{"*ast.MatchClause", "*ast.WithClause", "*ast.ReturnClause", "*ast.ReturnClause", "*ast.WithClause", "*ast.ReturnClause", "*ast.ReturnClause", "*ast.ReturnClause"}
+	if !reflect.DeepEqual(clauses, expected) {
+		t.Fatalf("obtained: %v; expected: %v", clauses, expected)
+	}
+
+	// parents are kept by Clone, decoders and Apply
+	cloned := ast.Clone(stmt)
+	checkParents(t, cloned)
+	original := allNodes(stmt)
+	for _, n := range allNodes(cloned) {
+		if containsNode(original, n.Parent()) {
+			t.Fatalf("parent of cloned %T is in the original", n)
+		}
+	}
+	data, err := ast.MarshalJSON(stmt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := ast.UnmarshalJSON(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkParents(t, decoded)
+	data, err = astpb.Marshal(stmt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err = astpb.Unmarshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkParents(t, decoded)
+	ast.Apply(stmt, func(c *ast.Cursor) bool {
+		if _, ok := c.Node().(*ast.PropertyLookup); ok {
+			c.Replace(&ast.PropertyLookup{PropertyKey: &ast.SchemaNameNode{SymbolicName: &ast.SymbolicNameNode{Value: "x"}}})
+			return false
+		}
+		return true
+	}, nil)
+	for _, n := range allNodes(stmt) {
+		if p, ok := n.(*ast.PropertyLookup); ok && (p.PropertyKey.Name() != "x" || p.Parent() == nil || ast.EnclosingClause(p) == nil) {
+			t.Fatalf("parent of replaced %T isn't set", n)
+		}
+	}
+}
+
+func TestInspect(t *testing.T) {
+	parser := New()
+	stmt, err := parser.Parse("MATCH (n:Person)-[r]->(m) WHERE n.age > 1 + $min RETURN n.name, m ORDER BY m.age")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &collectVisitor{}
+	stmt.Accept(v)
+	var nodes []ast.Node
+	depth := 0
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if n == nil {
+			depth--
+			return false
+		}
+		nodes = append(nodes, n)
+		depth++
+		return true
+	})
+	if depth != 0 || !reflect.DeepEqual(nodes, v.nodes) {
+		t.Fatalf("unexpected nodes of Inspect: %d, %d", len(nodes), len(v.nodes))
+	}
+	nodes = nil
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if n != nil {
+			nodes = append(nodes, n)
+		}
+		_, ok := n.(*ast.PatternElement)
+		return !ok
+	})
+	for _, n := range nodes {
+		if _, ok := n.(*ast.NodePattern); ok {
+			t.Fatal("children of skipped node are inspected")
+		}
+	}
+
+	var names []string
+	for _, n := range ast.FindAll[*ast.VariableNode](stmt) {
+		names = append(names, n.Name())
+	}
+	expected := []string{"n", "r", "m", "n", "n", "m", "m"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("obtained: %v; expected: %v", names, expected)
+	}
+	if exprs := ast.FindAll[ast.Expr](stmt); len(exprs) == 0 {
+		t.Fatal("no expressions found")
+	}
+	if stmts := ast.FindAll[*ast.CypherStmt](stmt); len(stmts) != 1 || stmts[0] != stmt {
+		t.Fatal("root isn't found")
+	}
+	binary, ok := ast.Find[*ast.BinaryExpr](stmt)
+	if !ok || binary.Text() != "n.age > 1 + $min" {
+		t.Fatalf("unexpected first BinaryExpr: %v", binary)
+	}
+	if _, ok := ast.Find[*ast.CaseExpr](stmt); ok {
+		t.Fatal("CaseExpr is found")
+	}
+	if ast.FindAll[*ast.VariableNode](nil) != nil {
+		t.Fatal("nodes found in nil")
+	}
+}
+
+type opVisitor struct {
+	ast.BaseTypedVisitor
+	ops    []string
+	labels []string
+}
+
+func (v *opVisitor) VisitBinaryExpr(n *ast.BinaryExpr) bool {
+	v.ops = append(v.ops, n.Op.String())
+	return false
+}
+
+func (v *opVisitor) VisitNodePattern(n *ast.NodePattern) bool {
+	for _, label := range n.Labels {
+		v.labels = append(v.labels, label.LabelName.Name())
+	}
+	return false
+}
+
+// VisitCaseExpr skips the children.
+func (v *opVisitor) VisitCaseExpr(n *ast.CaseExpr) bool {
+	return true
+}
+
+func TestTypedVisitor(t *testing.T) {
+	parser := New()
+	stmt, err := parser.Parse("MATCH (n:Person)-->(m:Movie:Film) WHERE n.age > 1 + 2 * 3 RETURN CASE WHEN n.a = 1 THEN 2 END")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &opVisitor{}
+	stmt.Accept(ast.AdaptTypedVisitor(v))
+	if ops := strings.Join(v.ops, " "); ops != "> + *" {
+		t.Fatalf("obtained: %s; expected: > + *", ops)
+	}
+	if labels := strings.Join(v.labels, " "); labels != "Person Movie Film" {
+		t.Fatalf("obtained: %s; expected: Person Movie Film", labels)
+	}
+
+	// every node type has a method
+	typed := reflect.TypeOf((*ast.TypedVisitor)(nil)).Elem()
+	g := &queryGen{r: rand.New(rand.NewSource(1))}
+	queries := append([]string{}, roundTripCorpus...)
+	for i := 0; i < 50; i++ {
+		queries = append(queries, g.query())
+	}
+	for _, query := range queries {
+		stmt, err := parser.Parse(query)
+		if err != nil {
+			t.Fatalf("%v: %s", err, query)
+		}
+		for _, n := range allNodes(stmt) {
+			name := reflect.TypeOf(n).Elem().Name()
+			if _, ok := typed.MethodByName("Visit" + name); !ok {
+				t.Fatalf("TypedVisitor has no method for %s", name)
+			}
+		}
+	}
+}
+
+func TestBuild(t *testing.T) {
+	cases := []struct {
+		query  *build.Query
+		cypher string
+	}{
+		{
+			build.Match(build.Node("n").Labels("Person")).Where(build.Prop("n", "age").Gt(build.Param("min"))).Return("n"),
+			"MATCH (n:Person) WHERE n.age > $min RETURN n",
+		},
+		{
+			build.Match(build.Node("a").Out(build.Rel("r", "KNOWS", "LIKES").Hops(1, 3), build.Node("b").Labels("Person").Props(build.Map{"name": "leiysky", "age": 18}))).
+				OptionalMatch(build.Node("b").Related(nil, build.Node("")).Named("p")).
+				Where(build.Not(build.Prop("a", "x").IsNull().Or(build.Prop("b", "y").In(build.List(1, 2.5, -3))))).
+				Where(build.Prop("a", "name").StartsWith("A").And(true)).
+				With("a", build.As(build.FuncDistinct("count", build.Var("b")), "c")).
+				OrderBy(build.Desc("c"), "a").Skip(1).Limit(build.Param("limit")).
+				Where(build.Var("c").Gt(build.Lit(1).Add(2).Mul(3).Pow(build.Neg(build.Var("c"))))).
+				ReturnDistinct("*", build.As(build.Func("apoc.coll.sum", build.List(1, 2)), "sum")),
+			"MATCH (a)-[r:KNOWS|LIKES*1..3]->(b:Person{age: 18, name: 'leiysky'}) OPTIONAL MATCH p = (b)--() WHERE NOT (a.x IS NULL OR b.y IN [1, 2.5, -3]) AND (a.name STARTS WITH 'A' AND TRUE) WITH a, count(DISTINCT b) AS c ORDER BY c DESC, a ASC SKIP 1 LIMIT $`limit` WHERE c > ((1 + 2) * 3) ^ -c RETURN DISTINCT *, apoc.coll.sum([1, 2]) AS sum",
+		},
+		{
+			build.Unwind(build.Param("rows"), "row").
+				Merge(build.Node("n").Labels("Node").Props(build.Map{"id": build.Prop("row", "id")})).
+				OnCreateSet(build.Assign(build.Prop("n", "created"), build.Func("timestamp")), build.AddLabels("n", "New")).
+				OnMatchSet(build.Increment("n", build.Var("row"))).
+				Create(build.Node("m").In(build.Rel("", "OF").Props(build.Param("props")), build.Node("n"))).
+				Set(build.Assign(build.Var("m"), build.Map{"a": []interface{}{nil, "x"}})).
+				DetachDelete("row").
+				Return(build.CountAll()),
+			"UNWIND $rows AS row MERGE (n:Node{id: row.id}) ON CREATE SET n.created = timestamp(),n:New ON MATCH SET n += row CREATE (m)<-[:`OF` $props]-(n) SET m = {a: [NULL, 'x']} DETACH DELETE row RETURN COUNT(*)",
+		},
+		{
+			build.Match(build.Node("n")).Return("n").UnionAll(build.Match(build.Node("m")).Return(build.As("m", "n"))).Union(build.Return(build.As(build.Lit(1).Sub(build.Lit(2).Sub(3)), "n"))),
+			"MATCH (n) RETURN n UNION ALL MATCH (m) RETURN m AS n UNION RETURN 1 - (2 - 3) AS n",
+		},
+		{
+			build.Match(build.Node("n").Labels("Person) DETACH DELETE (m", "match")).
+				Where(build.Prop("n", "name").Eq("' OR 1 = 1 //").And(build.Prop("n", "order").Index(0).Contains(build.Var("count")))).
+				Return(build.Var("n").Prop("a").Prop("b"), build.Lit(1).Prop("a"), build.Var("n").HasLabels("A").Prop("b")),
+			"MATCH (n:`Person) DETACH DELETE (m`:`match`) WHERE n.name = '\\' OR 1 = 1 //' AND n.`order`[0] CONTAINS `count` RETURN n.a.b, (1).a, (n:A).b",
+		},
+	}
+	parser := New()
+	for _, c := range cases {
+		stmt, err := c.query.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		cypher, err := c.query.Cypher()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cypher != c.cypher {
+			t.Fatalf("obtained: %s; expected: %s", cypher, c.cypher)
+		}
+		parsed, err := parser.Parse(cypher)
+		if err != nil {
+			t.Fatalf("%v: %s", err, cypher)
+		}
+		if !ast.EqualWithFlags(stmt, parsed, ast.EqualIgnorePos|ast.EqualIgnoreText) {
+			t.Fatalf("ast changed after parsing: %s", cypher)
+		}
+	}
+
+	errors := []*build.Query{
+		build.Match(build.Node("n")),
+		build.Match(build.Node("n")).With("n"),
+		build.Create(build.Node("n")).Match(build.Node("m")).Return("m"),
+		build.Return("n").Return("m"),
+		build.Match().Return("n"),
+		build.Return(),
+		build.Return("n", "*"),
+		build.Create(build.Node("n")).Where(true),
+		build.Create(build.Node("n")).OrderBy("n"),
+		build.Create(build.Node("n")).OnCreateSet(build.AddLabels("n", "A")),
+		build.Match(build.Node("n")).Set(build.Assign(build.Func("f"), 1)),
+		build.Match(build.Node("n").Props("a")).Return("n"),
+		build.Match(build.Node("n").Out(build.Rel("").Hops(-2, 1), build.Node(""))).Return("n"),
+		build.Return(build.Lit(math.NaN())),
+		build.Return(build.Lit(struct{}{})),
+		build.Return(build.Var("n").Add(build.Expr{})),
+		build.Return("n").Union(build.Match(build.Node("n"))),
+	}
+	for i, q := range errors {
+		if _, err := q.Build(); err == nil || !strings.HasPrefix(err.Error(), "build: ") {
+			t.Fatalf("unexpected error of query %d: %v", i, err)
+		}
+	}
+}
+
+func TestParseScript(t *testing.T) {
+	script := "match (n {name: 'a;b'}) return n;\n" +
+		"// comment;\n" +
+		";\n" +
+		"match (`a;b`) /* ; */ return `a;b`  ;\n" +
+		"  match (n)\n" +
+		"return n"
+	parser := New()
+	stmts, err := parser.ParseScript(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		target     string
+		text       string
+		start, end ast.Pos
+	}{
+		{"MATCH (`n`{name: 'a;b'}) RETURN `n`", "match (n {name: 'a;b'}) return n", ast.Pos{Offset: 0, Line: 1, Column: 0}, ast.Pos{Offset: 32, Line: 1, Column: 32}},
+		{"MATCH (`a;b`) /* ; */ RETURN `a;b`", "match (`a;b`) /* ; */ return `a;b`", ast.Pos{Offset: 48, Line: 4, Column: 0}, ast.Pos{Offset: 82, Line: 4, Column: 34}},
+		{"MATCH (`n`) RETURN `n`", "match (n)\nreturn n", ast.Pos{Offset: 88, Line: 5, Column: 2}, ast.Pos{Offset: 106, Line: 6, Column: 8}},
+	}
+	if len(stmts) != len(expected) {
+		t.Fatalf("obtained %d statements", len(stmts))
+	}
+	var target strings.Builder
+	for i, stmt := range stmts {
+		target.Reset()
+		stmt.Restore(ast.NewRestoreContext(&target))
+		e := expected[i]
+		if target.String() != e.target {
+			t.Fatalf("obtained: %s; expected: %s", target.String(), e.target)
+		}
+		if stmt.Text() != e.text || stmt.Start() != e.start || stmt.End() != e.end {
+			t.Fatalf("obtained: %q %v %v", stmt.Text(), stmt.Start(), stmt.End())
+		}
+		if script[stmt.Start().Offset:stmt.End().Offset] != stmt.Text() {
+			t.Fatalf("text mismatch: %q", stmt.Text())
+		}
+	}
+
+	_, err = parser.ParseScript("match (n) return n;\nmatch (n) return")
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e.Line != 2 || e.Column != 16 || e.Token != "<EOF>" {
+		t.Fatalf("obtained: %v", e)
+	}
+
+	stmts, err = parser.ParseScript(" ; // nothing\n")
+	if err != nil || len(stmts) != 0 {
+		t.Fatalf("obtained: %v %v", stmts, err)
+	}
+}
+
+func TestParser(t *testing.T) {
+	t.Skip()
+	parser := New()
+	parser.Parse(`
+MATCH (n)-[:Label *0123]-()
+WHERE n.id = n +1
+SET n = {}
+RETURN n`)
+}
+
+func TestMultiPartQuery(t *testing.T) {
+	t.Skip()
+	parser := New()
+	parser.Parse(`
+MATCH (n)
+SET n.id = 1
+WITH n
+MATCH (n)
+RETURN n
+`)
+}
+
+func TestExpression(t *testing.T) {
+	t.Skip()
+	parser := New()
+	stmt, err := parser.Parse(`
+	MATCH (n:Label1:Label2)-[r:Type1|Type2*1..2{name:'hello'}]->(n1)
+	WHERE n.a OR n AND n XOR NOT n < n > n = n <= n >= n <> n + n - n * n / n % -n
+	MATCH (n)
+	WHERE 1 = 1 < 1
+	RETURN n
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt.Accept(&testVisitor{})
+	rst := ast.NewRestoreContext(os.Stdout)
+	stmt.Restore(rst)
+}
+
+var benchCorpus = []string{
+	"MATCH (n:Person {name: 'leiysky'}) RETURN n",
+	"MATCH (a:Person)-[:KNOWS]->(b:Person)-[:KNOWS]->(c:Person) WHERE a.age > 18 AND c.name STARTS WITH 'A' RETURN a, b, c",
+	"MATCH (n) WHERE n.name = 'leiysky' OPTIONAL MATCH (n)-[r*1..3]-(m) RETURN n, count(DISTINCT m) AS cnt",
+	"WITH [1, 2, 3] AS xs WITH xs WHERE size(xs) % 2 = 1 RETURN xs",
+	"MATCH (n:Label1:Label2)-[r:Type1|Type2*1..2{name:'hello'}]->(n1) WHERE n.a OR n.b AND NOT n.c < 1 RETURN n",
+	"MATCH (n) SET n.updated = timestamp() SET n += {visited: true} RETURN n",
+	"CREATE (a:Person {name: 'a'})-[:KNOWS {since: 2019}]->(b:Person {name: 'b'}) RETURN a, b",
+	"MATCH (n) CALL my.proc(n) YIELD score WHERE score > 0.5 RETURN n, score",
+	"MATCH (n) RETURN [x IN n.list WHERE x > 1 | x * 2], any(x IN n.list WHERE x IS NULL)",
+	"MATCH (n) WITH n MATCH (n)-->(m) WITH n, m MATCH (m)-->(o) RETURN n, m, o",
+}
+
+func BenchmarkParse(b *testing.B) {
+	parser := New()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, cypher := range benchCorpus {
+			if _, err := parser.Parse(cypher); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkParseTreeSLL and BenchmarkParseTreeLL compare the prediction modes
+// without the conversion into ast.
+
+func BenchmarkParseTreeSLL(b *testing.B) {
+	benchmarkParseTree(b, func(parser *ps.CypherParser) {
+		if _, ok := parseSLL(parser); !ok {
+			b.Fatal("SLL failed")
+		}
+	})
+}
+
+func BenchmarkParseTreeLL(b *testing.B) {
+	benchmarkParseTree(b, func(parser *ps.CypherParser) {
+		parser.Interpreter.SetPredictionMode(antlr.PredictionModeLL)
+		parser.RemoveErrorListeners()
+		parser.Cypher()
+	})
+}
+
+func benchmarkParseTree(b *testing.B, parse func(parser *ps.CypherParser)) {
+	instance := ps.NewInstance()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, cypher := range benchCorpus {
+			lexer := instance.NewLexer(antlr.NewInputStream(cypher))
+			tokenStream := antlr.NewCommonTokenStream(lexer, antlr.LexerDefaultTokenChannel)
+			parse(instance.NewParser(tokenStream))
+		}
+	}
 }